@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -14,12 +16,14 @@ import (
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5/middleware"
 	oapiMiddleware "github.com/oapi-codegen/nethttp-middleware"
 
 	apiv1 "github.com/ricleal/twitter-clone/internal/api/v1"
 	openapiv1 "github.com/ricleal/twitter-clone/internal/api/v1/openapi"
 	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/events"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
 	"github.com/ricleal/twitter-clone/internal/service/store"
 )
@@ -33,6 +37,18 @@ const (
 	shutdownTimeout    = 10 * time.Second // graceful shutdown deadline
 )
 
+const (
+	eventHistorySize = 1024 // events kept for clients resuming a stream
+	eventBufferSize  = 64   // events queued for a stream before it is dropped
+)
+
+const wsPongWait = 60 * time.Second // WebSocket clients silent for longer are dropped
+
+const (
+	sessionTTL       = 24 * time.Hour // lifetime of the session tokens issued at login
+	sessionKeyLength = 32             // bytes of the key generated when SESSION_KEY is unset
+)
+
 // requestLogger returns a middleware that logs each request using slog.
 func requestLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -41,6 +57,7 @@ func requestLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			next.ServeHTTP(ww, r)
 			logger.Info("request",
+				"request_id", middleware.GetReqID(r.Context()),
 				"method", r.Method,
 				"path", r.URL.Path,
 				"status", ww.Status(),
@@ -50,8 +67,32 @@ func requestLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
-func apiV1Router(root *http.ServeMux, logger *slog.Logger, su service.UserService, st service.TweetService) error {
-	twitterAPI := apiv1.New(logger, su, st)
+// exposeRequestID echoes the request ID assigned by middleware.RequestID in the
+// response headers, so clients can quote it when reporting a problem.
+func exposeRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(middleware.RequestIDHeader, middleware.GetReqID(r.Context()))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func apiV1Router(
+	root *http.ServeMux,
+	logger *slog.Logger,
+	adminToken string,
+	su service.UserService,
+	st service.TweetService,
+	sf service.FollowService,
+	stl service.TimelineService,
+	sl service.LikeService,
+	sb service.BookmarkService,
+	sr service.RelationshipService,
+	sm service.MessageService,
+	sn service.NotificationService,
+	ss service.StreamService,
+	sessions service.SessionService,
+) error {
+	twitterAPI := apiv1.New(logger, su, st, sf, stl, sl, sb, sr, sm, sn, ss, sessions)
 
 	swagger, err := openapiv1.GetSwagger()
 	if err != nil {
@@ -70,15 +111,25 @@ func apiV1Router(root *http.ServeMux, logger *slog.Logger, su service.UserServic
 		return fmt.Errorf("error marshaling swagger: %w", err)
 	}
 
-	openapiv1.HandlerWithOptions(twitterAPI, openapiv1.StdHTTPServerOptions{
-		BaseURL:    "/api/v1",
-		BaseRouter: root,
+	// the generated routes are served by chi, which prefers static path
+	// segments such as /users/by-username over parameters such as /users/{id}.
+	// The last middleware runs first, so sessions are authenticated before the
+	// validator checks the security requirements.
+	root.Handle("/api/v1/", openapiv1.HandlerWithOptions(twitterAPI, openapiv1.ChiServerOptions{
+		BaseURL:          "/api/v1",
+		ErrorHandlerFunc: apiv1.ParamErrorHandler(logger),
 		Middlewares: []openapiv1.MiddlewareFunc{
-			oapiMiddleware.OapiRequestValidator(swagger),
+			oapiMiddleware.OapiRequestValidatorWithOptions(swagger, &oapiMiddleware.Options{
+				Options: openapi3filter.Options{
+					AuthenticationFunc: apiv1.Authenticator(adminToken),
+				},
+				ErrorHandlerWithOpts: apiv1.ValidationErrorHandler(logger),
+			}),
+			apiv1.SessionMiddleware(logger, sessions),
 			middleware.AllowContentType("application/json"),
 			middleware.SetHeader("Content-Type", "application/json"),
 		},
-	})
+	}))
 
 	root.HandleFunc("GET /api/v1/api.json", func(w http.ResponseWriter, _ *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -116,8 +167,22 @@ func run(ctx context.Context, logger *slog.Logger, port int) error {
 	defer dbServer.Close()
 
 	s := store.NewPersistentStore(dbServer.DB())
-	st := service.NewTweetService(s)
-	su := service.NewUserService(s)
+	broker := events.NewBroker(eventHistorySize, eventBufferSize)
+	st := service.NewTweetService(s, broker)
+	su := service.NewUserService(s, service.DefaultPasswordParams)
+	sf := service.NewFollowService(s, broker)
+	stl := service.NewTimelineService(s)
+	sl := service.NewLikeService(s, broker)
+	sb := service.NewBookmarkService(s)
+	sr := service.NewRelationshipService(s)
+	sm := service.NewMessageService(s, broker)
+	sn := service.NewNotificationService(s)
+	ss := service.NewStreamService(s, broker)
+	sessionKey, err := loadSessionKey(logger)
+	if err != nil {
+		return err
+	}
+	sessions := service.NewSessionService(s, sessionKey, sessionTTL)
 
 	// Set up the root mux
 	mux := http.NewServeMux()
@@ -132,20 +197,58 @@ func run(ctx context.Context, logger *slog.Logger, port int) error {
 	})
 
 	// Set up API v1
-	if routerErr := apiV1Router(mux, logger, su, st); routerErr != nil {
+	// Admin endpoints are disabled unless ADMIN_TOKEN is set
+	adminToken := os.Getenv("ADMIN_TOKEN")
+	routerErr := apiV1Router(mux, logger, adminToken, su, st, sf, stl, sl, sb, sr, sm, sn, ss, sessions)
+	if routerErr != nil {
 		return fmt.Errorf("setting up api router: %w", routerErr)
 	}
 
+	// WebSocket gateway for live timelines, hashtags and conversations
+	gateway := apiv1.NewWebSocketGateway(logger, sessions, ss, wsPongWait)
+	mux.Handle("GET /ws", gateway)
+
 	// Wrap with global middleware (outermost = first to run)
 	var h http.Handler = mux
 	h = middleware.StripSlashes(h)
 	h = middleware.Recoverer(h)
+	h = exposeRequestID(h)
 	h = requestLogger(logger)(h)
+	h = middleware.RequestID(h)
+
+	// streams never go idle on their own, so shutting down ends them: the
+	// WebSocket clients are told first, before their subscriptions go away
+	return serve(ctx, logger, h, port, gateway.Shutdown, func(context.Context) error {
+		broker.Close()
+		return nil
+	})
+}
 
-	return serve(ctx, logger, h, port)
+// loadSessionKey returns the key session tokens are signed with, taken from
+// SESSION_KEY. Without it a random key is used, and restarting the server logs
+// everyone out.
+func loadSessionKey(logger *slog.Logger) ([]byte, error) {
+	if key := os.Getenv("SESSION_KEY"); key != "" {
+		return []byte(key), nil
+	}
+	logger.Warn("SESSION_KEY is not set; sessions will not survive a restart")
+	key := make([]byte, sessionKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generating session key: %w", err)
+	}
+	return key, nil
 }
 
-func serve(ctx context.Context, logger *slog.Logger, handler http.Handler, port int) error {
+// serve runs the server until it fails or a signal stops it. It then stops
+// taking connections and, while waiting for the open requests to finish,
+// calls the shutdown functions in order to end the long-lived connections.
+func serve(
+	ctx context.Context,
+	logger *slog.Logger,
+	handler http.Handler,
+	port int,
+	shutdown ...func(context.Context) error,
+) error {
 	srv := &http.Server{
 		Handler:      handler,
 		Addr:         fmt.Sprintf(":%d", port),
@@ -177,9 +280,16 @@ func serve(ctx context.Context, logger *slog.Logger, handler http.Handler, port
 	}
 
 	logger.Info("shutting down...")
-	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+	// the signal has canceled ctx, and the shutdown needs a deadline of its own
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
 	defer cancel()
-	if err := srv.Shutdown(ctx); err != nil {
+	srvErr := make(chan error, 1)
+	go func() { srvErr <- srv.Shutdown(ctx) }()
+	var err error
+	for _, f := range shutdown {
+		err = errors.Join(err, f(ctx))
+	}
+	if err = errors.Join(err, <-srvErr); err != nil {
 		return fmt.Errorf("failed to shutdown gracefully: %w", err)
 	}
 	logger.Info("Server shutdown gracefully")
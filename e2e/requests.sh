@@ -36,7 +36,7 @@ check_error_shape() {
 	name="$1"
 	expected_code="$2"
 	expected_message="$3"
-	if jq -e ".code == ${expected_code} and .message == \"${expected_message}\"" /tmp/response_body.txt >/dev/null 2>&1; then
+	if jq -e ".status == ${expected_code} and .detail == \"${expected_message}\"" /tmp/response_body.txt >/dev/null 2>&1; then
 		echo "${name}:true"
 	else
 		echo "${name}:false"
@@ -50,6 +50,7 @@ request tweets_empty ${API}/tweets
 
 request users_invalid_uuid ${API}/users/not-a-uuid
 check_body_non_empty users_invalid_uuid_payload
+check_jq_true users_invalid_uuid_problem '.status == 400 and .instance == "/api/v1/users/not-a-uuid" and .details[0].field == "id" and (.request_id | type == "string")'
 request tweets_invalid_uuid ${API}/tweets/not-a-uuid
 check_body_non_empty tweets_invalid_uuid_payload
 
@@ -64,22 +65,40 @@ request users_bad_payload -X POST -H "Content-Type: application/json" \
 check_body_non_empty users_bad_payload_payload
 
 request users_create -X POST -H "Content-Type: application/json" \
-	-d '{ "username": "foo", "name": "John Doe", "email": "jd@mail.com" }' \
+	-d '{ "username": "foo", "name": "John Doe", "email": "jd@mail.com", "password": "s3cret-pass" }' \
 	${API}/users
 
 request users_duplicate_username -X POST -H "Content-Type: application/json" \
-	-d '{ "username": "foo", "name": "John Doe 2", "email": "jd2@mail.com" }' \
+	-d '{ "username": "foo", "name": "John Doe 2", "email": "jd2@mail.com", "password": "s3cret-pass" }' \
 	${API}/users
-check_error_shape users_duplicate_username_payload 500 "Error creating user"
+check_error_shape users_duplicate_username_payload 409 "User already exists"
+check_jq_true users_duplicate_username_field '.field == "username"'
 
 request users_duplicate_email -X POST -H "Content-Type: application/json" \
-	-d '{ "username": "foo2", "name": "John Doe 3", "email": "jd@mail.com" }' \
+	-d '{ "username": "foo2", "name": "John Doe 3", "email": "jd@mail.com", "password": "s3cret-pass" }' \
 	${API}/users
-check_error_shape users_duplicate_email_payload 500 "Error creating user"
+check_error_shape users_duplicate_email_payload 409 "User already exists"
+check_jq_true users_duplicate_email_field '.field == "email"'
+
+request users_weak_password -X POST -H "Content-Type: application/json" \
+	-d '{ "username": "foo3", "email": "jd3@mail.com", "password": "foo3foo3foo3" }' \
+	${API}/users
+check_jq_true users_weak_password_details '.details == [{"code": "not_allowed", "field": "password", "message": "password must not contain the username"}]'
+
+request auth_login -X POST -H "Content-Type: application/json" \
+	-d '{ "username": "foo", "password": "s3cret-pass" }' \
+	${API}/auth/login
+check_jq_true auth_login_payload '.user.username == "foo" and (.token | type == "string" and length > 0) and (.user | has("password") or has("password_hash") | not)'
+token=$(jq -r '.token // empty' /tmp/response_body.txt)
+auth="Authorization: Bearer ${token}"
+request auth_login_wrong_password -X POST -H "Content-Type: application/json" \
+	-d '{ "username": "foo", "password": "wrong horse battery" }' \
+	${API}/auth/login
+check_error_shape auth_login_wrong_password_payload 401 "Invalid username or password"
 
 request users_list ${API}/users
-check_jq_true users_list_payload 'length == 1 and .[0].username == "foo" and .[0].email == "jd@mail.com" and .[0].name == "John Doe" and (. [0].id | type == "string" and length > 0)'
-user_id=$(jq -r '.[0].id // empty' /tmp/response_body.txt)
+check_jq_true users_list_payload '.data | length == 1 and .[0].username == "foo" and .[0].email == "jd@mail.com" and .[0].name == "John Doe" and (. [0].id | type == "string" and length > 0)'
+user_id=$(jq -r '.data[0].id // empty' /tmp/response_body.txt)
 
 if [ -z "$user_id" ]; then
 	echo "error:user_id_missing"
@@ -89,35 +108,55 @@ fi
 request users_get ${API}/users/${user_id}
 check_jq_true users_get_payload '.username == "foo" and .email == "jd@mail.com" and .name == "John Doe" and (.id | type == "string" and length > 0)'
 
-request tweets_bad_payload -X POST -H "Content-Type: application/json" \
-	-d '{"user_id":"'$user_id'"}' \
+request users_patch -X PATCH -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "name": "Johnny Doe" }' \
+	${API}/users/${user_id}
+check_jq_true users_patch_payload '.username == "foo" and .email == "jd@mail.com" and .name == "Johnny Doe" and .updated_at >= .created_at'
+request users_patch_invalid_email -X PATCH -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "email": "not-an-email" }' \
+	${API}/users/${user_id}
+request users_patch_anonymous -X PATCH -H "Content-Type: application/json" \
+	-d '{ "name": "Anonymous" }' \
+	${API}/users/${user_id}
+request users_patch_other -X PATCH -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "name": "Somebody Else" }' \
+	${API}/users/00000000-0000-0000-0000-000000000000
+check_error_shape users_patch_other_payload 403 "Forbidden"
+
+request tweets_bad_payload -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{}' \
 	${API}/tweets
 check_body_non_empty tweets_bad_payload_payload
 
-request tweets_invalid_user -X POST -H "Content-Type: application/json" \
-	-d '{"user_id":"00000000-0000-0000-0000-000000000000", "content": "Hello World!" }' \
+request tweets_anonymous -X POST -H "Content-Type: application/json" \
+	-d '{ "content": "Hello World!" }' \
 	${API}/tweets
-check_error_shape tweets_invalid_user_payload 400 "Invalid user ID"
+check_jq_true tweets_anonymous_payload '.status == 401'
+request tweets_invalid_token -X POST -H "Content-Type: application/json" -H "Authorization: Bearer not-a-token" \
+	-d '{ "content": "Hello World!" }' \
+	${API}/tweets
+check_error_shape tweets_invalid_token_payload 401 "Invalid session token"
 
 tweet_280=$(printf '%*s' 280 '' | tr ' ' 'a')
 tweet_281=$(printf '%*s' 281 '' | tr ' ' 'b')
 
-request tweets_len_280 -X POST -H "Content-Type: application/json" \
-	-d '{"user_id":"'$user_id'", "content": "'$tweet_280'" }' \
+request tweets_len_280 -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "'$tweet_280'" }' \
 	${API}/tweets
 
-request tweets_len_281 -X POST -H "Content-Type: application/json" \
-	-d '{"user_id":"'$user_id'", "content": "'$tweet_281'" }' \
+request tweets_len_281 -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "'$tweet_281'" }' \
 	${API}/tweets
-check_error_shape tweets_len_281_payload 500 "Error creating tweet"
+check_error_shape tweets_len_281_payload 422 "tweet content is too long"
+check_jq_true tweets_len_281_details '.details == [{"code": "too_long", "field": "content", "message": "tweet content is too long"}]'
 
-request tweets_create -X POST -H "Content-Type: application/json" \
-	-d '{"user_id":"'$user_id'", "content": "Hello World!" }' \
+request tweets_create -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Hello World!" }' \
 	${API}/tweets
 
 request tweets_list ${API}/tweets
-check_jq_true tweets_list_payload 'length >= 2 and (map(.content) | index("Hello World!")) != null and (map(.content) | index("'$tweet_280'")) != null and all(.[]; (.id | type == "string" and length > 0) and (.user_id | type == "string" and length > 0))'
-tweet_id=$(jq -r '.[] | select(.content == "Hello World!") | .id' /tmp/response_body.txt | head -n 1)
+check_jq_true tweets_list_payload '.data | length >= 2 and (map(.content) | index("Hello World!")) != null and (map(.content) | index("'$tweet_280'")) != null and all(.[]; (.id | type == "string" and length > 0) and (.user_id | type == "string" and length > 0))'
+tweet_id=$(jq -r '.data[] | select(.content == "Hello World!") | .id' /tmp/response_body.txt | head -n 1)
 
 if [ -z "$tweet_id" ]; then
 	echo "error:tweet_id_missing"
@@ -126,3 +165,212 @@ fi
 
 request tweets_get ${API}/tweets/${tweet_id}
 check_jq_true tweets_get_payload '.content == "Hello World!" and (.id | type == "string" and length > 0) and (.user_id | type == "string" and length > 0)'
+
+request timeline ${API}/users/${user_id}/timeline?limit=1
+check_jq_true timeline_payload '(.data | length == 1) and .data[0].content == "Hello World!" and (.next_cursor | type == "string" and length > 0)'
+request timeline_unknown_user ${API}/users/00000000-0000-0000-0000-000000000000/timeline
+check_error_shape timeline_unknown_user_payload 404 "User not found"
+request timeline_invalid_cursor "${API}/users/${user_id}/timeline?cursor=invalid"
+check_error_shape timeline_invalid_cursor_payload 400 "Invalid cursor"
+request tweets_page ${API}/tweets?limit=1
+check_jq_true tweets_page_payload '(.data | length == 1) and (.next_cursor | type == "string" and length > 0)'
+request tweets_page_invalid_limit ${API}/tweets?limit=0
+request tweets_page_invalid_cursor ${API}/tweets?cursor=invalid
+check_error_shape tweets_page_invalid_cursor_payload 400 "Invalid cursor"
+
+request tweets_delete -X DELETE -H "$auth" ${API}/tweets/${tweet_id}
+request tweets_deleted_get ${API}/tweets/${tweet_id}
+check_error_shape tweets_deleted_get_payload 404 "Tweet not found"
+request admin_tweets_deleted_unauthorized ${API}/admin/tweets/deleted
+request admin_tweets_deleted -H "X-Admin-Token: ${ADMIN_TOKEN}" ${API}/admin/tweets/deleted
+check_jq_true admin_tweets_deleted_payload 'length == 1 and .[0].id == "'$tweet_id'" and (.[0].deleted_at | type == "string")'
+request admin_tweets_restore -X POST -H "X-Admin-Token: ${ADMIN_TOKEN}" ${API}/admin/tweets/${tweet_id}/restore
+request tweets_restored_get ${API}/tweets/${tweet_id}
+
+request tweets_like -X POST -H "$auth" ${API}/tweets/${tweet_id}/like
+request tweets_like_twice -X POST -H "$auth" ${API}/tweets/${tweet_id}/like
+check_error_shape tweets_like_twice_payload 409 "Tweet already liked"
+request tweets_liked_get -H "$auth" ${API}/tweets/${tweet_id}
+check_jq_true tweets_liked_get_payload '.like_count == 1 and .liked == true'
+request tweets_likes ${API}/tweets/${tweet_id}/likes
+check_jq_true tweets_likes_payload 'length == 1 and .[0].id == "'$user_id'"'
+request tweets_unlike -X DELETE -H "$auth" ${API}/tweets/${tweet_id}/like
+request tweets_unliked_get ${API}/tweets/${tweet_id}
+check_jq_true tweets_unliked_get_payload '.like_count == 0 and .liked == false'
+
+request tweets_retweet -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "kind": "retweet", "content": "", "referenced_tweet_id": "'$tweet_id'" }' \
+	${API}/tweets
+request tweets_retweet_twice -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "kind": "retweet", "content": "", "referenced_tweet_id": "'$tweet_id'" }' \
+	${API}/tweets
+check_error_shape tweets_retweet_twice_payload 409 "Tweet already retweeted"
+request tweets_quote -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "kind": "quote", "content": "So true", "referenced_tweet_id": "'$tweet_id'" }' \
+	${API}/tweets
+request tweets_referenced_get ${API}/tweets/${tweet_id}
+check_jq_true tweets_referenced_get_payload '.kind == "tweet" and .retweet_count == 1 and .quote_count == 1'
+request tweets_references_list ${API}/tweets?limit=2
+check_jq_true tweets_references_list_payload '.data | map(.referenced_tweet.id) == ["'$tweet_id'", "'$tweet_id'"]'
+
+request tweets_reply -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Hello back!", "in_reply_to_tweet_id": "'$tweet_id'" }' \
+	${API}/tweets
+request tweets_reply_unknown -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Hello?", "in_reply_to_tweet_id": "00000000-0000-0000-0000-000000000000" }' \
+	${API}/tweets
+check_error_shape tweets_reply_unknown_payload 422 "tweet replied to not found"
+request tweets_thread ${API}/tweets/${tweet_id}/thread
+check_jq_true tweets_thread_payload '.tweet.id == "'$tweet_id'" and (.replies | map(.tweet.content) == ["Hello back!"]) and .replies[0].tweet.conversation_id == "'$tweet_id'"'
+request tweets_thread_unknown ${API}/tweets/00000000-0000-0000-0000-000000000000/thread
+
+request users_tweets "${API}/users/${user_id}/tweets?limit=1"
+check_jq_true users_tweets_payload '(.data | length == 1) and .data[0].user_id == "'$user_id'" and (.next_cursor | type == "string")'
+request users_tweets_unknown ${API}/users/00000000-0000-0000-0000-000000000000/tweets
+check_error_shape users_tweets_unknown_payload 404 "User not found"
+
+request users_by_username ${API}/users/by-username/FOO
+check_jq_true users_by_username_payload '.id == "'$user_id'" and .username == "foo"'
+request users_by_username_unknown ${API}/users/by-username/nobody
+check_error_shape users_by_username_unknown_payload 404 "User not found"
+request admin_users_by_email_unauthorized "${API}/admin/users/by-email?email=jd@mail.com"
+request admin_users_by_email -H "X-Admin-Token: ${ADMIN_TOKEN}" "${API}/admin/users/by-email?email=jd@mail.com"
+check_jq_true admin_users_by_email_payload '.id == "'$user_id'"'
+
+request hashtags_tweet -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Learning #Go!" }' \
+	${API}/tweets
+request hashtags_tweets ${API}/hashtags/%23GO/tweets
+check_jq_true hashtags_tweets_payload '.data | map(.content) == ["Learning #Go!"]'
+request hashtags_tweets_unused ${API}/hashtags/unused/tweets
+request hashtags_tweets_invalid ${API}/hashtags/123/tweets
+check_error_shape hashtags_tweets_invalid_payload 422 "invalid hashtag"
+
+request mentions_tweet -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Note to @FOO and @nobody" }' \
+	${API}/tweets
+request users_mentions ${API}/users/${user_id}/mentions
+check_jq_true users_mentions_payload '(.data | length == 1) and .data[0].mentions == [{"user_id": "'$user_id'", "username": "foo", "start": 8, "end": 12}]'
+request users_mentions_unknown ${API}/users/00000000-0000-0000-0000-000000000000/mentions
+check_error_shape users_mentions_unknown_payload 404 "User not found"
+
+request search_tweets "${API}/search/tweets?q=hello+from:foo&sort=recency"
+check_jq_true search_tweets_payload '(.data | length > 0) and (.data | all(.user_id == "'$user_id'" and (.content | ascii_downcase | contains("hello"))))'
+request search_tweets_phrase "${API}/search/tweets?q=%22learning+go%22"
+check_jq_true search_tweets_phrase_payload '.data | map(.content) == ["Learning #Go!"]'
+request search_tweets_no_terms "${API}/search/tweets?q=-hello"
+check_error_shape search_tweets_no_terms_payload 422 "search query has no terms"
+request search_users "${API}/search/users?q=fooo"
+check_jq_true search_users_payload '.data[0].id == "'$user_id'"'
+request search_users_blank "${API}/search/users?q=+"
+check_error_shape search_users_blank_payload 422 "search query has no terms"
+
+request tweets_bookmark -X POST -H "$auth" ${API}/tweets/${tweet_id}/bookmark
+request tweets_bookmark_twice -X POST -H "$auth" ${API}/tweets/${tweet_id}/bookmark
+check_error_shape tweets_bookmark_twice_payload 409 "Tweet already bookmarked"
+request users_bookmarks -H "$auth" ${API}/users/${user_id}/bookmarks
+check_jq_true users_bookmarks_payload '.data | map(.id) == ["'$tweet_id'"]'
+request users_bookmarks_anonymous ${API}/users/${user_id}/bookmarks
+check_jq_true users_bookmarks_anonymous_payload '.status == 401'
+request tweets_unbookmark -X DELETE -H "$auth" ${API}/tweets/${tweet_id}/bookmark
+request users_bookmarks_empty -H "$auth" ${API}/users/${user_id}/bookmarks
+
+request users_create_other -X POST -H "Content-Type: application/json" \
+	-d '{ "username": "bar", "name": "Jane Roe", "email": "jr@mail.com", "password": "s3cret-pass" }' \
+	${API}/users
+request users_by_username_other ${API}/users/by-username/bar
+other_id=$(jq -r '.id // empty' /tmp/response_body.txt)
+${CURL} -X POST -H "Content-Type: application/json" \
+	-d '{ "username": "bar", "password": "s3cret-pass" }' \
+	${API}/auth/login >/dev/null
+other_auth="Authorization: Bearer $(jq -r '.token // empty' /tmp/response_body.txt)"
+request users_bookmarks_forbidden -H "$other_auth" ${API}/users/${user_id}/bookmarks
+check_error_shape users_bookmarks_forbidden_payload 403 "Bookmarks are private"
+
+request users_block -X POST -H "$other_auth" ${API}/users/${other_id}/blocks/${user_id}
+request users_block_twice -X POST -H "$other_auth" ${API}/users/${other_id}/blocks/${user_id}
+check_error_shape users_block_twice_payload 409 "Already blocking user"
+request users_block_self -X POST -H "$auth" ${API}/users/${user_id}/blocks/${user_id}
+check_error_shape users_block_self_payload 400 "Users cannot block themselves"
+request users_block_other -X POST -H "$auth" ${API}/users/${other_id}/blocks/${user_id}
+check_error_shape users_block_other_payload 403 "Forbidden"
+request users_blocks -H "$other_auth" ${API}/users/${other_id}/blocks
+check_jq_true users_blocks_payload 'map(.id) == ["'$user_id'"]'
+request users_blocks_forbidden -H "$auth" ${API}/users/${other_id}/blocks
+check_error_shape users_blocks_forbidden_payload 403 "Blocked users are private"
+request tweets_get_blocked -H "$other_auth" ${API}/tweets/${tweet_id}
+check_error_shape tweets_get_blocked_payload 403 "Blocked"
+request users_follow_blocked -X POST -H "$auth" ${API}/users/${other_id}/follow
+check_error_shape users_follow_blocked_payload 403 "Blocked"
+request users_unblock -X DELETE -H "$other_auth" ${API}/users/${other_id}/blocks/${user_id}
+request users_unblock_twice -X DELETE -H "$other_auth" ${API}/users/${other_id}/blocks/${user_id}
+check_error_shape users_unblock_twice_payload 404 "Not blocking user"
+request tweets_get_unblocked -H "$other_auth" ${API}/tweets/${tweet_id}
+
+request users_mute -X POST -H "$other_auth" ${API}/users/${other_id}/mutes/${user_id}
+request users_mutes -H "$other_auth" ${API}/users/${other_id}/mutes
+check_jq_true users_mutes_payload 'map(.id) == ["'$user_id'"]'
+request tweets_list_muted -H "$other_auth" ${API}/tweets
+request users_unmute -X DELETE -H "$other_auth" ${API}/users/${other_id}/mutes/${user_id}
+request users_mutes_empty -H "$other_auth" ${API}/users/${other_id}/mutes
+
+request conversations_create -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "member_ids": ["'$other_id'"] }' \
+	${API}/conversations
+conversation_id=$(jq -r '.id // empty' /tmp/response_body.txt)
+request conversations_create_alone -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "member_ids": ["'$user_id'"] }' \
+	${API}/conversations
+request messages_send -X POST -H "Content-Type: application/json" -H "$auth" \
+	-d '{ "content": "Hi bar!" }' \
+	${API}/conversations/${conversation_id}/messages
+check_jq_true messages_send_payload '.content == "Hi bar!" and .sender_id == "'$user_id'"'
+request users_conversations -H "$other_auth" ${API}/users/${other_id}/conversations
+check_jq_true users_conversations_payload \
+	'.data[0].last_message.content == "Hi bar!" and (.data[0].members | map(.unread_count) | add) == 1'
+request users_conversations_forbidden -H "$auth" ${API}/users/${other_id}/conversations
+check_error_shape users_conversations_forbidden_payload 403 "Conversations are private"
+request messages_list -H "$other_auth" ${API}/conversations/${conversation_id}/messages
+check_jq_true messages_list_payload '.data | map(.content) == ["Hi bar!"]'
+request messages_list_anonymous ${API}/conversations/${conversation_id}/messages
+check_jq_true messages_list_anonymous_payload '.status == 401'
+request conversations_read -X POST -H "$other_auth" ${API}/conversations/${conversation_id}/read
+request users_conversations_read -H "$other_auth" ${API}/users/${other_id}/conversations
+check_jq_true users_conversations_read_payload '.data[0].members | all(.unread_count == 0)'
+
+request tweets_like_other -X POST -H "$other_auth" ${API}/tweets/${tweet_id}/like
+request notifications_list -H "$auth" "${API}/notifications?type=like"
+check_jq_true notifications_list_payload \
+	'.data[0].tweet_id == "'$tweet_id'" and .data[0].read == false and (.data[0].actors | map(.username) | index("bar")) != null'
+request notifications_list_invalid_type -H "$auth" "${API}/notifications?type=poke"
+request notifications_list_anonymous ${API}/notifications
+check_jq_true notifications_list_anonymous_payload '.status == 401'
+request notifications_unread_count -H "$auth" ${API}/notifications/unread-count
+check_jq_true notifications_unread_count_payload '.count >= 1'
+request notifications_read -X POST -H "$auth" ${API}/notifications/read
+request notifications_unread_count_read -H "$auth" ${API}/notifications/unread-count
+check_jq_true notifications_unread_count_read_payload '.count == 0'
+
+request stream_invalid_last_event_id -H "Last-Event-ID: abc" "${API}/stream"
+check_error_shape stream_invalid_last_event_id_payload 400 "Invalid Last-Event-ID"
+# a stream never ends on its own: take what the replay sends, then hang up
+code=$(curl -s -N --max-time 2 -H "Last-Event-ID: 1" -H "$auth" -o /tmp/response_body.txt -w %{http_code} \
+	"${API}/stream" || true)
+echo "stream_resume:${code}"
+if grep -q "^event: tweet$" /tmp/response_body.txt; then
+	echo "stream_resume_payload:true"
+else
+	echo "stream_resume_payload:false"
+fi
+
+WS="http://${HOSTNAME}:${API_PORT}/ws"
+request ws_anonymous "${WS}?user_id=${user_id}"
+check_error_shape ws_anonymous_payload 401 "Authentication required"
+request ws_other_user -H "$other_auth" "${WS}?user_id=${user_id}"
+check_error_shape ws_other_user_payload 403 "Forbidden"
+# the upgraded connection stays open: check the handshake, then hang up
+code=$(curl -s -N --max-time 2 -o /dev/null -w %{http_code} \
+	-H "Connection: Upgrade" -H "Upgrade: websocket" -H "Sec-WebSocket-Version: 13" \
+	-H "Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==" -H "$auth" \
+	"${WS}?user_id=${user_id}" || true)
+echo "ws_upgrade:${code}"
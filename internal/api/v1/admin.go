@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// List deleted tweets
+// (GET /admin/tweets/deleted).
+func (t *twitterAPI) GetAdminTweetsDeleted(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tweets, err := t.tweetService.FindDeleted(ctx)
+	if err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing deleted tweets", err)
+		return
+	}
+	if len(tweets) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, "", tweets); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPITweets(tweets)) //nolint:errcheck,gosec //ignore error
+}
+
+// Restore a deleted tweet
+// (POST /admin/tweets/{id}/restore).
+func (t *twitterAPI) PostAdminTweetsIdRestore( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if err := t.tweetService.Restore(ctx, id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Deleted tweet not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error restoring tweet", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List deleted users
+// (GET /admin/users/deleted).
+func (t *twitterAPI) GetAdminUsersDeleted(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	users, err := t.userService.FindDeleted(ctx)
+	if err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing deleted users", err)
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
+
+// Get user by email
+// (GET /admin/users/by-email).
+func (t *twitterAPI) GetAdminUsersByEmail(
+	w http.ResponseWriter,
+	r *http.Request,
+	params openapi.GetAdminUsersByEmailParams,
+) {
+	ctx := r.Context()
+
+	user, err := t.userService.FindByEmail(ctx, string(params.Email))
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting user", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUser(*user)) //nolint:errcheck,gosec //ignore error
+}
+
+// Restore a deleted user
+// (POST /admin/users/{id}/restore).
+func (t *twitterAPI) PostAdminUsersIdRestore( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if err := t.userService.Restore(ctx, id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Deleted user not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error restoring user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
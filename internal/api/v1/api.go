@@ -16,9 +16,18 @@ import (
 
 // twitterAPI is the implementation of the Twitter API.
 type twitterAPI struct {
-	logger       *slog.Logger
-	tweetService service.TweetService
-	userService  service.UserService
+	logger              *slog.Logger
+	tweetService        service.TweetService
+	userService         service.UserService
+	followService       service.FollowService
+	timelineService     service.TimelineService
+	likeService         service.LikeService
+	bookmarkService     service.BookmarkService
+	relationService     service.RelationshipService
+	messageService      service.MessageService
+	notificationService service.NotificationService
+	streamService       service.StreamService
+	sessionService      service.SessionService
 }
 
 // New returns a new twitterServer with the given services.
@@ -26,39 +35,60 @@ func New(
 	logger *slog.Logger,
 	userService service.UserService,
 	tweetService service.TweetService,
+	followService service.FollowService,
+	timelineService service.TimelineService,
+	likeService service.LikeService,
+	bookmarkService service.BookmarkService,
+	relationService service.RelationshipService,
+	messageService service.MessageService,
+	notificationService service.NotificationService,
+	streamService service.StreamService,
+	sessionService service.SessionService,
 ) openapi.ServerInterface {
 	return &twitterAPI{
-		logger:       logger.With("component", "api"),
-		tweetService: tweetService,
-		userService:  userService,
+		logger:              logger.With("component", "api"),
+		tweetService:        tweetService,
+		userService:         userService,
+		followService:       followService,
+		timelineService:     timelineService,
+		likeService:         likeService,
+		bookmarkService:     bookmarkService,
+		relationService:     relationService,
+		messageService:      messageService,
+		notificationService: notificationService,
+		streamService:       streamService,
+		sessionService:      sessionService,
 	}
 }
 
 // List all tweets
 // (GET /tweets).
-func (t *twitterAPI) GetTweets(w http.ResponseWriter, r *http.Request) {
+func (t *twitterAPI) GetTweets(w http.ResponseWriter, r *http.Request, params openapi.GetTweetsParams) {
 	ctx := r.Context()
 
-	tweets, err := t.tweetService.FindAll(ctx)
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.tweetService.FindPage(ctx, viewerID(ctx), cursor, limit)
 	if err != nil {
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error listing tweets", err)
+		if errors.Is(err, entities.ErrInvalidCursor) {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing tweets", err)
 		return
 	}
-	if len(tweets) == 0 {
+	if len(page.Items) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
-
-	openapiTweets := make([]openapi.Tweet, 0, len(tweets))
-	for _, tweet := range tweets {
-		tweetID := tweet.ID
-		openapiTweets = append(openapiTweets, openapi.Tweet{
-			Id:      &tweetID,
-			Content: tweet.Content,
-			UserId:  tweet.UserID,
-		})
+	if err = t.likeService.Annotate(ctx, viewerID(ctx), page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
 	}
-	json.NewEncoder(w).Encode(openapiTweets) //nolint:errcheck,gosec //ignore error
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
 }
 
 // Create a tweet
@@ -66,24 +96,39 @@ func (t *twitterAPI) GetTweets(w http.ResponseWriter, r *http.Request) {
 func (t *twitterAPI) PostTweets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
 	var newTweet openapi.Tweet
 	if err := json.NewDecoder(r.Body).Decode(&newTweet); err != nil {
-		sendAPIError(t.logger, w, http.StatusBadRequest, "Invalid format for Tweet", err)
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for Tweet", err)
 		return
 	}
 
-	// convert openapi.Tweet to entity.Tweet
+	// convert openapi.Tweet to entity.Tweet, written by the acting user
 	tweet := &entities.Tweet{
-		Content: newTweet.Content,
-		UserID:  newTweet.UserId,
+		Content:           newTweet.Content,
+		UserID:            userID,
+		ReferencedTweetID: newTweet.ReferencedTweetId,
+		InReplyToTweetID:  newTweet.InReplyToTweetId,
+	}
+	if newTweet.Kind != nil {
+		tweet.Kind = entities.TweetKind(*newTweet.Kind)
 	}
 
 	if err := t.tweetService.Create(ctx, tweet); err != nil {
-		if errors.Is(err, entities.ErrInvalidUserID) {
-			sendAPIError(t.logger, w, http.StatusBadRequest, "Invalid user ID", err)
-			return
+		switch {
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrAlreadyRetweeted):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Tweet already retweeted", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error creating tweet", err)
 		}
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error creating tweet", err)
 		return
 	}
 
@@ -99,57 +144,81 @@ func (t *twitterAPI) GetTweetsId( //nolint:revive,staticcheck // generated metho
 ) {
 	ctx := r.Context()
 
-	tweet, err := t.tweetService.FindByID(ctx, id.String())
+	tweet, err := t.tweetService.FindByID(ctx, id.String(), viewerID(ctx))
 	if err != nil {
-		if errors.Is(err, entities.ErrNotFound) {
-			sendAPIError(t.logger, w, http.StatusNotFound, "Tweet not found", err)
-			return
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting tweet", err)
 		}
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error getting tweet", err)
+		return
+	}
+	tweets := []entities.Tweet{*tweet}
+	if err = t.likeService.Annotate(ctx, viewerID(ctx), tweets); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
 		return
 	}
 
-	openapiTweet := openapi.Tweet{
-		Id:      &tweet.ID,
-		Content: tweet.Content,
-		UserId:  tweet.UserID,
+	json.NewEncoder(w).Encode(toAPITweet(tweets[0])) //nolint:errcheck,gosec //ignore error
+}
+
+// Delete a tweet
+// (DELETE /tweets/{id}).
+func (t *twitterAPI) DeleteTweetsId( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
 	}
 
-	json.NewEncoder(w).Encode(openapiTweet) //nolint:errcheck,gosec //ignore error
+	if err := t.tweetService.Delete(ctx, id.String(), userID.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Only the author can delete a tweet", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error deleting tweet", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 // List all users
 // (GET /users).
-func (t *twitterAPI) GetUsers(w http.ResponseWriter, r *http.Request) {
+func (t *twitterAPI) GetUsers(w http.ResponseWriter, r *http.Request, params openapi.GetUsersParams) {
 	ctx := r.Context()
 
-	users, err := t.userService.FindAll(ctx)
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.userService.FindPage(ctx, cursor, limit)
 	if err != nil {
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error listing users", err)
+		if errors.Is(err, entities.ErrInvalidCursor) {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing users", err)
 		return
 	}
-	if len(users) == 0 {
+	if len(page.Items) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	// convert entity.User to openapi.User
-	openapiUsers := make([]*openapi.User, 0, len(users))
-	for _, user := range users {
-		userID := user.ID
-		openapiUsers = append(openapiUsers, &openapi.User{
-			Id:       &userID,
-			Username: user.Username,
-			Email:    openapi_types.Email(user.Email),
-		})
-		if user.Name != "" {
-			userName := user.Name
-			openapiUsers[len(openapiUsers)-1].Name = &userName
-		}
-	}
-
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(openapiUsers) //nolint:errcheck,gosec //ignore error
+	json.NewEncoder(w).Encode(openapi.UserPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPIUsers(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
 }
 
 // Create a user
@@ -157,13 +226,13 @@ func (t *twitterAPI) GetUsers(w http.ResponseWriter, r *http.Request) {
 func (t *twitterAPI) PostUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var newUser openapi.User
+	var newUser openapi.NewUser
 	if err := json.NewDecoder(r.Body).Decode(&newUser); err != nil {
-		sendAPIError(t.logger, w, http.StatusBadRequest, "Invalid format for User", err)
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for User", err)
 		return
 	}
 
-	// convert openapi.User to entity.User
+	// convert openapi.NewUser to entity.User
 	user := &entities.User{
 		Username: newUser.Username,
 		Email:    string(newUser.Email),
@@ -171,15 +240,61 @@ func (t *twitterAPI) PostUsers(w http.ResponseWriter, r *http.Request) {
 	if newUser.Name != nil {
 		user.Name = *newUser.Name
 	}
+	var password string
+	if newUser.Password != nil {
+		password = *newUser.Password
+	}
 
-	if err := t.userService.Create(ctx, user); err != nil {
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error creating user", err)
+	if err := t.userService.Create(ctx, user, password); err != nil {
+		if errors.Is(err, entities.ErrConflict) {
+			sendAPIError(t.logger, w, r, http.StatusConflict, "User already exists", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error creating user", err)
 		return
 	}
 
 	w.WriteHeader(http.StatusCreated)
 }
 
+// Log in with a username and password
+// (POST /auth/login).
+func (t *twitterAPI) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var login openapi.Login
+	if err := json.NewDecoder(r.Body).Decode(&login); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for Login", err)
+		return
+	}
+	var password string
+	if login.Password != nil {
+		password = *login.Password
+	}
+
+	user, err := t.userService.Authenticate(ctx, login.Username, password)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCredentials) {
+			sendAPIError(t.logger, w, r, http.StatusUnauthorized, "Invalid username or password", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error logging in", err)
+		return
+	}
+
+	session, err := t.sessionService.Issue(ctx, user)
+	if err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error logging in", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.Session{ //nolint:errcheck,gosec //ignore error
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+		User:      toAPIUser(session.User),
+	})
+}
+
 // Get user profile by ID
 // (GET /users/{id}).
 func (t *twitterAPI) GetUsersId( //nolint:revive,staticcheck // generated method; interface name preserved
@@ -192,21 +307,196 @@ func (t *twitterAPI) GetUsersId( //nolint:revive,staticcheck // generated method
 	user, err := t.userService.FindByID(ctx, id.String())
 	if err != nil {
 		if errors.Is(err, entities.ErrNotFound) {
-			sendAPIError(t.logger, w, http.StatusNotFound, "User not found", err)
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting user", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUser(*user)) //nolint:errcheck,gosec //ignore error
+}
+
+// Get user by username
+// (GET /users/by-username/{username}).
+func (t *twitterAPI) GetUsersByUsernameUsername(
+	w http.ResponseWriter,
+	r *http.Request,
+	username string,
+) {
+	ctx := r.Context()
+
+	user, err := t.userService.FindByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
 			return
 		}
-		sendAPIError(t.logger, w, http.StatusInternalServerError, "Error getting user", err)
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting user", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUser(*user)) //nolint:errcheck,gosec //ignore error
+}
+
+// Update a user profile
+// (PATCH /users/{id}).
+func (t *twitterAPI) PatchUsersId( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	var patch openapi.UserUpdate
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for UserUpdate", err)
+		return
+	}
+
+	upd := entities.UserUpdate{
+		Username: patch.Username,
+		Name:     patch.Name,
+	}
+	if patch.Email != nil {
+		email := string(*patch.Email)
+		upd.Email = &email
+	}
+
+	user, err := t.userService.Update(ctx, id.String(), upd)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrConflict):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "User already exists", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error updating user", err)
+		}
 		return
 	}
 
+	json.NewEncoder(w).Encode(toAPIUser(*user)) //nolint:errcheck,gosec //ignore error
+}
+
+// Delete a user
+// (DELETE /users/{id}).
+func (t *twitterAPI) DeleteUsersId( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	if err := t.userService.Delete(ctx, id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error deleting user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pageParams unwraps the optional pagination query parameters. Zero values let
+// the service pick its defaults.
+func pageParams(cursor *openapi.Cursor, limit *openapi.Limit) (string, int) {
+	var c string
+	var l int
+	if cursor != nil {
+		c = *cursor
+	}
+	if limit != nil {
+		l = *limit
+	}
+	return c, l
+}
+
+// nextCursor returns the cursor to report to the client, nil on the last page.
+func nextCursor(cursor string) *string {
+	if cursor == "" {
+		return nil
+	}
+	return &cursor
+}
+
+// toAPITweet converts an entities.Tweet to an openapi.Tweet, along with the
+// tweet it references when that is embedded.
+func toAPITweet(tweet entities.Tweet) openapi.Tweet {
+	kind := openapi.TweetKind(tweet.Kind)
+	apiTweet := openapi.Tweet{
+		Id:                &tweet.ID,
+		Content:           tweet.Content,
+		UserId:            &tweet.UserID,
+		Kind:              &kind,
+		ReferencedTweetId: tweet.ReferencedTweetID,
+		InReplyToTweetId:  tweet.InReplyToTweetID,
+		ConversationId:    &tweet.ConversationID,
+		CreatedAt:         &tweet.CreatedAt,
+		UpdatedAt:         &tweet.UpdatedAt,
+		DeletedAt:         tweet.DeletedAt,
+		LikeCount:         &tweet.LikeCount,
+		Liked:             &tweet.Liked,
+		RetweetCount:      &tweet.RetweetCount,
+		QuoteCount:        &tweet.QuoteCount,
+	}
+	mentions := make([]openapi.Mention, 0, len(tweet.Mentions))
+	for _, m := range tweet.Mentions {
+		mentions = append(mentions, openapi.Mention{
+			UserId:   m.UserID,
+			Username: m.Username,
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	apiTweet.Mentions = &mentions
+	if tweet.ReferencedTweet != nil {
+		referenced := toAPITweet(*tweet.ReferencedTweet)
+		apiTweet.ReferencedTweet = &referenced
+	}
+	return apiTweet
+}
+
+// toAPITweets converts a slice of entities.Tweet to openapi.Tweet.
+func toAPITweets(tweets []entities.Tweet) []openapi.Tweet {
+	openapiTweets := make([]openapi.Tweet, 0, len(tweets))
+	for _, tweet := range tweets {
+		openapiTweets = append(openapiTweets, toAPITweet(tweet))
+	}
+	return openapiTweets
+}
+
+// toAPIUser converts an entities.User to an openapi.User.
+func toAPIUser(user entities.User) openapi.User {
 	openapiUser := openapi.User{
-		Id:       &user.ID,
-		Username: user.Username,
-		Email:    openapi_types.Email(user.Email),
+		Id:        &user.ID,
+		Username:  user.Username,
+		Email:     openapi_types.Email(user.Email),
+		CreatedAt: &user.CreatedAt,
+		UpdatedAt: &user.UpdatedAt,
+		DeletedAt: user.DeletedAt,
 	}
 	if user.Name != "" {
 		openapiUser.Name = &user.Name
 	}
+	return openapiUser
+}
 
-	json.NewEncoder(w).Encode(openapiUser) //nolint:errcheck,gosec //ignore error
+// toAPIUsers converts a slice of entities.User to openapi.User.
+func toAPIUsers(users []entities.User) []openapi.User {
+	openapiUsers := make([]openapi.User, 0, len(users))
+	for _, user := range users {
+		openapiUsers = append(openapiUsers, toAPIUser(user))
+	}
+	return openapiUsers
 }
@@ -1,19 +1,32 @@
 package v1_test
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/go-chi/chi/v5/middleware"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	oapiMiddleware "github.com/oapi-codegen/nethttp-middleware"
 	"github.com/stretchr/testify/suite"
 
 	api "github.com/ricleal/twitter-clone/internal/api/v1"
 	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
 	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/events"
 	"github.com/ricleal/twitter-clone/internal/service/store"
 	"github.com/ricleal/twitter-clone/testhelpers"
 )
@@ -21,9 +34,19 @@ import (
 type APITestSuite struct {
 	suite.Suite
 
-	server *httptest.Server
+	server   *httptest.Server
+	broker   *events.Broker
+	gateway  *api.WebSocketGateway
+	wsServer *httptest.Server
 }
 
+// wsPongWait is short for the tests to see unresponsive clients dropped.
+const wsPongWait = 300 * time.Millisecond
+
+// testPasswordParams make hashing cheap, which the tests need more than they
+// need it to be hard.
+var testPasswordParams = service.PasswordParams{Memory: 64, Time: 1, Threads: 1}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestAPITestSuite(t *testing.T) {
@@ -33,17 +56,45 @@ func TestAPITestSuite(t *testing.T) {
 func (ts *APITestSuite) SetupTest() {
 	// Set up our data store
 	s := store.NewMemStore()
-	st := service.NewTweetService(s)
-	su := service.NewUserService(s)
+	ts.broker = events.NewBroker(100, 100)
+	st := service.NewTweetService(s, ts.broker)
+	su := service.NewUserService(s, testPasswordParams)
+	sf := service.NewFollowService(s, ts.broker)
+	stl := service.NewTimelineService(s)
+	sl := service.NewLikeService(s, ts.broker)
+	sb := service.NewBookmarkService(s)
+	sr := service.NewRelationshipService(s)
+	sm := service.NewMessageService(s, ts.broker)
+	sn := service.NewNotificationService(s)
+	ss := service.NewStreamService(s, ts.broker)
+	sessions := service.NewSessionService(s, []byte("test key"), time.Hour)
 	// set up our API
-	twitterAPI := api.New(slog.New(slog.DiscardHandler), su, st)
-	mux := http.NewServeMux()
-	openapi.HandlerFromMux(twitterAPI, mux)
-	ts.server = httptest.NewServer(mux)
+	twitterAPI := api.New(slog.New(slog.DiscardHandler), su, st, sf, stl, sl, sb, sr, sm, sn, ss, sessions)
+	ts.server = httptest.NewServer(openapi.HandlerWithOptions(twitterAPI, openapi.ChiServerOptions{
+		ErrorHandlerFunc: api.ParamErrorHandler(slog.New(slog.DiscardHandler)),
+		Middlewares:      []openapi.MiddlewareFunc{api.SessionMiddleware(slog.New(slog.DiscardHandler), sessions)},
+	}))
+	ts.gateway = api.NewWebSocketGateway(slog.New(slog.DiscardHandler), sessions, ss, wsPongWait)
+	ts.wsServer = httptest.NewServer(ts.gateway)
 }
 
 func (ts *APITestSuite) TearDownTest() {
 	ts.server.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ts.Require().NoError(ts.gateway.Shutdown(ctx))
+	ts.wsServer.Close()
+}
+
+// login logs username in with the password every test user has and returns
+// the headers authenticating requests on their behalf.
+func (ts *APITestSuite) login(ctx context.Context, username string) map[string]string {
+	var session openapi.Session
+	statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/auth/login",
+		`{ "username": "`+username+`", "password": "s3cret-pass" }`, &session)
+	ts.Require().NoError(err)
+	ts.Require().Equal(http.StatusOK, statusCode)
+	return map[string]string{"Authorization": "Bearer " + session.Token}
 }
 
 func (ts *APITestSuite) TestGetUsersEmpty() {
@@ -58,7 +109,7 @@ func (ts *APITestSuite) TestGetUsersEmpty() {
 func (ts *APITestSuite) TestCreateAndGetUser() {
 	ctx := context.Background()
 
-	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com" }`
+	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com", "password": "s3cret-pass" }`
 	var userID string
 	ts.Run("Create user", func() {
 		var response struct{}
@@ -67,15 +118,15 @@ func (ts *APITestSuite) TestCreateAndGetUser() {
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
 	ts.Run("Get users", func() {
-		var response []map[string]any
+		var response openapi.UserPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 1)
-		ts.Require().Equal("foo", response[0]["username"])
-		ts.Require().Equal("John Doe", response[0]["name"])
-		ts.Require().Equal("jd@mail.com", response[0]["email"])
-		userID = response[0]["id"].(string) //nolint:forcetypeassert // this is a test
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("foo", response.Data[0].Username)
+		ts.Require().Equal("John Doe", *response.Data[0].Name)
+		ts.Require().Equal("jd@mail.com", string(response.Data[0].Email))
+		userID = response.Data[0].Id.String()
 	})
 	ts.Run("Get user", func() {
 		var response map[string]any
@@ -97,8 +148,9 @@ func (ts *APITestSuite) TestCreateAndGetUser() {
 func (ts *APITestSuite) TestCreateAndGetTweets() {
 	ctx := context.Background()
 
-	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com" }`
+	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com", "password": "s3cret-pass" }`
 	var userID string
+	var auth map[string]string
 
 	ts.Run("Create user", func() {
 		var response struct{}
@@ -107,52 +159,75 @@ func (ts *APITestSuite) TestCreateAndGetTweets() {
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
 	ts.Run("Get users", func() {
-		var response []map[string]any
+		var response openapi.UserPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		userID = response[0]["id"].(string) //nolint:forcetypeassert // this is a test
+		userID = response.Data[0].Id.String()
+		auth = ts.login(ctx, "foo")
 	})
 	ts.Run("Create tweet", func() {
-		tweetStr := `{ "user_id": "` + userID + `", "content": "Hello World" }`
+		tweetStr := `{ "content": "Hello World" }`
 		var response struct{}
-		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
-	ts.Run("Create tweet invalid user", func() {
-		// invalid user id
-		tweetStr := `{ "user_id": "` + uuid.NewString() + `", "content": "Hello World" }`
-		var response struct{}
+	ts.Run("Create tweet anonymously", func() {
+		tweetStr := `{ "content": "Hello World" }`
+		var response openapi.Error
 		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
 		ts.Require().NoError(err)
-		ts.Require().Equal(http.StatusBadRequest, statusCode)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Create tweet with an invalid token", func() {
+		tweetStr := `{ "content": "Hello World" }`
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets",
+			map[string]string{"Authorization": "Bearer forged"}, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		ts.Require().Equal("Invalid session token", *response.Detail)
+	})
+	ts.Run("Create tweet too long", func() {
+		tweetStr := `{ "content": "` + strings.Repeat("a", 281) + `" }`
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().NotNil(response.Details)
+		ts.Require().Equal([]openapi.ErrorDetail{{
+			Code:    entities.CodeTooLong,
+			Field:   "content",
+			Message: "tweet content is too long",
+		}}, *response.Details)
 	})
 	var tweetID string
 	ts.Run("Get tweets", func() {
-		var response []openapi.Tweet
+		var response openapi.TweetPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 1)
-		ts.Require().Equal("Hello World", response[0].Content)
-		tweetID = response[0].Id.String()
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello World", response.Data[0].Content)
+		ts.Require().Equal(userID, response.Data[0].UserId.String())
+		tweetID = response.Data[0].Id.String()
 	})
 	ts.Run("Create tweet 2", func() {
-		tweetStr := `{ "user_id": "` + userID + `", "content": "Hello World 2" }`
+		tweetStr := `{ "content": "Hello World 2" }`
 		var response struct{}
-		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
 	ts.Run("Get tweets", func() {
-		var response []openapi.Tweet
+		var response openapi.TweetPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 2)
+		ts.Require().Len(response.Data, 2)
 		contents := map[string]bool{}
-		for _, t := range response {
+		for _, t := range response.Data {
 			contents[t.Content] = true
 		}
 		ts.Require().True(contents["Hello World"])
@@ -172,3 +247,2291 @@ func (ts *APITestSuite) TestCreateAndGetTweets() {
 		ts.Require().Equal(http.StatusNotFound, statusCode)
 	})
 }
+
+func (ts *APITestSuite) TestFollowUsers() {
+	ctx := context.Background()
+
+	var johnID, janeID string
+	ts.Run("Create users", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "name": "John Doe", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "name": "Jane Doe", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var response openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range response.Data {
+			switch u.Username {
+			case "john":
+				johnID = u.Id.String()
+			case "jane":
+				janeID = u.Id.String()
+			}
+		}
+	})
+	johnAuth := ts.login(ctx, "john")
+	followURL := ts.server.URL + "/users/" + janeID + "/follow"
+	ts.Run("Follow user", func() {
+		var response struct{}
+		statusCode, err := testhelpers.PostWithHeaders(ctx, followURL, johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+	})
+	ts.Run("Follow user twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, followURL, johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+	})
+	ts.Run("Follow anonymously", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, followURL, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Follow self", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(
+			ctx, ts.server.URL+"/users/"+johnID+"/follow", johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+	ts.Run("Follow unknown user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(
+			ctx, ts.server.URL+"/users/"+uuid.NewString()+"/follow", johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("Get followers", func() {
+		var response []openapi.User
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+janeID+"/followers", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response, 1)
+		ts.Require().Equal("john", response[0].Username)
+	})
+	ts.Run("Get following", func() {
+		var response []openapi.User
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+johnID+"/following", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response, 1)
+		ts.Require().Equal("jane", response[0].Username)
+	})
+	ts.Run("Unfollow user", func() {
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, followURL, johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Unfollow user twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, followURL, johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("Get followers empty", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+janeID+"/followers", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestLikeTweets() {
+	ctx := context.Background()
+
+	var johnID, janeID, tweetID string
+	ts.Run("Create users and tweet", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "name": "John Doe", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "name": "Jane Doe", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			switch u.Username {
+			case "john":
+				johnID = u.Id.String()
+			case "jane":
+				janeID = u.Id.String()
+			}
+		}
+
+		var response struct{}
+		tweetStr := `{ "content": "Hello, world!" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "john"), tweetStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var tweets openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		tweetID = tweets.Data[0].Id.String()
+	})
+	auth := map[string]map[string]string{johnID: ts.login(ctx, "john"), janeID: ts.login(ctx, "jane")}
+	likeURL := ts.server.URL + "/tweets/" + tweetID + "/like"
+	ts.Run("Like tweet", func() {
+		for _, userID := range []string{johnID, janeID} {
+			var response struct{}
+			statusCode, err := testhelpers.PostWithHeaders(ctx, likeURL, auth[userID], "", &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Like tweet twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, likeURL, auth[johnID], "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+	})
+	ts.Run("Like unknown tweet", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(
+			ctx, ts.server.URL+"/tweets/"+uuid.NewString()+"/like", auth[johnID], "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("Like anonymously", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, likeURL, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Get likes", func() {
+		var response []openapi.User
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+tweetID+"/likes", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response, 2)
+	})
+	ts.Run("Unlike tweet", func() {
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, likeURL, auth[janeID], &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Unlike tweet twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, likeURL, auth[janeID], &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("Get tweet with like count", func() {
+		for viewer, wantLiked := range map[string]bool{johnID: true, janeID: false, "": false} {
+			var response openapi.Tweet
+			statusCode, err := testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, auth[viewer],
+				&response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusOK, statusCode)
+			ts.Require().Equal(1, *response.LikeCount)
+			ts.Require().Equal(wantLiked, *response.Liked)
+		}
+	})
+	ts.Run("List tweets with like count", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets", auth[johnID], &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal(1, *response.Data[0].LikeCount)
+		ts.Require().True(*response.Data[0].Liked)
+	})
+}
+
+func (ts *APITestSuite) TestBookmarks() {
+	ctx := context.Background()
+
+	var johnID string
+	var tweetIDs []string
+	ts.Run("Create users and tweets", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			if u.Username == "john" {
+				johnID = u.Id.String()
+			}
+		}
+
+		janeAuth := ts.login(ctx, "jane")
+		for _, content := range []string{"first", "second"} {
+			var response struct{}
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", janeAuth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var tweets openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		// bookmarked newest first, so the bookmarks list the oldest tweet first
+		for _, tweet := range tweets.Data {
+			tweetIDs = append(tweetIDs, tweet.Id.String())
+		}
+	})
+	johnAuth := ts.login(ctx, "john")
+	bookmarkURL := func(tweetID string) string {
+		return ts.server.URL + "/tweets/" + tweetID + "/bookmark"
+	}
+	ts.Run("Bookmark tweets", func() {
+		for _, tweetID := range tweetIDs {
+			var response struct{}
+			statusCode, err := testhelpers.PostWithHeaders(ctx, bookmarkURL(tweetID), johnAuth, "", &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Bookmark tweet twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, bookmarkURL(tweetIDs[0]), johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+	})
+	ts.Run("Bookmark anonymously", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, bookmarkURL(tweetIDs[0]), "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Bookmark unknown tweet", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, bookmarkURL(uuid.NewString()), johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	bookmarksURL := ts.server.URL + "/users/" + johnID + "/bookmarks"
+	ts.Run("List bookmarks", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.GetWithHeaders(ctx, bookmarksURL+"?limit=1", johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("first", response.Data[0].Content)
+		ts.Require().NotNil(response.NextCursor)
+
+		var last openapi.TweetPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, bookmarksURL+"?limit=1&cursor="+*response.NextCursor,
+			johnAuth, &last)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(last.Data, 1)
+		ts.Require().Equal("second", last.Data[0].Content)
+		ts.Require().Nil(last.NextCursor)
+	})
+	ts.Run("List bookmarks of another user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, bookmarksURL, ts.login(ctx, "jane"), &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+	ts.Run("List bookmarks anonymously", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, bookmarksURL, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Deleted and removed bookmarks disappear", func() {
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetIDs[0],
+			ts.login(ctx, "jane"), &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		statusCode, err = testhelpers.DeleteWithHeaders(ctx, bookmarkURL(tweetIDs[1]), johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+
+		statusCode, err = testhelpers.GetWithHeaders(ctx, bookmarksURL, johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Remove bookmark twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, bookmarkURL(tweetIDs[1]), johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestBlocksAndMutes() {
+	ctx := context.Background()
+
+	var johnID, janeID, tweetID string
+	ts.Run("Create users and a tweet", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			switch u.Username {
+			case "john":
+				johnID = u.Id.String()
+			case "jane":
+				janeID = u.Id.String()
+			}
+		}
+
+		var response struct{}
+		tweetStr := `{ "content": "hello" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "jane"), tweetStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var tweets openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		tweetID = tweets.Data[0].Id.String()
+	})
+	johnAuth, janeAuth := ts.login(ctx, "john"), ts.login(ctx, "jane")
+	ts.Run("Mute hides tweets from the public timeline", func() {
+		var response struct{}
+		muteURL := ts.server.URL + "/users/" + johnID + "/mutes/" + janeID
+		statusCode, err := testhelpers.PostWithHeaders(ctx, muteURL, johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		statusCode, err = testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets", johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		// the tweet itself can still be opened
+		var tweet openapi.Tweet
+		statusCode, err = testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, johnAuth, &tweet)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		var muted []openapi.User
+		statusCode, err = testhelpers.GetWithHeaders(ctx, ts.server.URL+"/users/"+johnID+"/mutes", johnAuth, &muted)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(muted, 1)
+		statusCode, err = testhelpers.DeleteWithHeaders(ctx, muteURL, johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	blockURL := ts.server.URL + "/users/" + janeID + "/blocks/" + johnID
+	ts.Run("Block", func() {
+		var response struct{}
+		statusCode, err := testhelpers.PostWithHeaders(ctx, blockURL, janeAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var problem openapi.Error
+		statusCode, err = testhelpers.PostWithHeaders(ctx, blockURL, janeAuth, "", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/users/"+janeID+"/blocks/"+janeID, janeAuth,
+			"", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+	ts.Run("Block on behalf of another user", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, blockURL, johnAuth, "", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+	ts.Run("Blocked user cannot see, reply to or follow the blocker", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, johnAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		statusCode, err = testhelpers.GetWithHeaders(ctx, ts.server.URL+"/users/"+janeID+"/tweets", johnAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		replyStr := `{ "content": "hi", "in_reply_to_tweet_id": "` + tweetID + `" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", johnAuth, replyStr, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		followURL := ts.server.URL + "/users/" + janeID + "/follow"
+		statusCode, err = testhelpers.PostWithHeaders(ctx, followURL, johnAuth, "", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+	ts.Run("List blocks", func() {
+		blocksURL := ts.server.URL + "/users/" + janeID + "/blocks"
+		var problem openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, blocksURL, johnAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		statusCode, err = testhelpers.Get(ctx, blocksURL, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		var blocked []openapi.User
+		statusCode, err = testhelpers.GetWithHeaders(ctx, blocksURL, janeAuth, &blocked)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(blocked, 1)
+		ts.Require().Equal(johnID, blocked[0].Id.String())
+	})
+	ts.Run("Unblock", func() {
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, blockURL, janeAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		var problem openapi.Error
+		statusCode, err = testhelpers.DeleteWithHeaders(ctx, blockURL, janeAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+		var tweet openapi.Tweet
+		statusCode, err = testhelpers.GetWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, johnAuth, &tweet)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestMessages() {
+	ctx := context.Background()
+
+	var johnID, janeID, jimID string
+	ts.Run("Create users", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jim", "email": "jim@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			switch u.Username {
+			case "john":
+				johnID = u.Id.String()
+			case "jane":
+				janeID = u.Id.String()
+			case "jim":
+				jimID = u.Id.String()
+			}
+		}
+	})
+	johnAuth := ts.login(ctx, "john")
+	var conversationID string
+	ts.Run("Create conversation", func() {
+		var problem openapi.Error
+		aloneStr := `{ "member_ids": ["` + johnID + `"] }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/conversations", johnAuth, aloneStr,
+			&problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+
+		var conversation openapi.Conversation
+		conversationStr := `{ "member_ids": ["` + janeID + `"] }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/conversations", johnAuth, conversationStr,
+			&conversation)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		ts.Require().Len(conversation.Members, 2)
+		ts.Require().Nil(conversation.LastMessage)
+		conversationID = conversation.Id.String()
+	})
+	messagesURL := ts.server.URL + "/conversations/" + conversationID + "/messages"
+	ts.Run("Send messages", func() {
+		for _, content := range []string{"hi", "how are you?"} {
+			var message openapi.Message
+			messageStr := `{ "content": "` + content + `" }`
+			statusCode, err := testhelpers.PostWithHeaders(ctx, messagesURL, johnAuth, messageStr, &message)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+			ts.Require().Equal(content, message.Content)
+			ts.Require().Equal(johnID, message.SenderId.String())
+			ts.Require().NotNil(message.Id)
+		}
+		var problem openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, messagesURL, ts.login(ctx, "jim"), `{ "content": "hi" }`,
+			&problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		statusCode, err = testhelpers.PostWithHeaders(ctx, messagesURL, johnAuth, `{ "content": " " }`, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+	})
+	janeAuth, jimAuth := ts.login(ctx, "jane"), ts.login(ctx, "jim")
+	conversationsURL := ts.server.URL + "/users/" + janeID + "/conversations"
+	ts.Run("List conversations with unread counts", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, conversationsURL, johnAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		statusCode, err = testhelpers.Get(ctx, conversationsURL, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		var page openapi.ConversationPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, conversationsURL, janeAuth, &page)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(page.Data, 1)
+		ts.Require().Equal("how are you?", page.Data[0].LastMessage.Content)
+		unread := map[string]int{}
+		for _, m := range page.Data[0].Members {
+			unread[m.Username] = m.UnreadCount
+		}
+		ts.Require().Equal(map[string]int{"john": 0, "jane": 2}, unread)
+		var response struct{}
+		jimURL := ts.server.URL + "/users/" + jimID + "/conversations"
+		statusCode, err = testhelpers.GetWithHeaders(ctx, jimURL, jimAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Page through history and mark read", func() {
+		var page openapi.MessagePage
+		statusCode, err := testhelpers.GetWithHeaders(ctx, messagesURL+"?limit=1", janeAuth, &page)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(page.Data, 1)
+		ts.Require().Equal("how are you?", page.Data[0].Content)
+		ts.Require().NotNil(page.NextCursor)
+		var last openapi.MessagePage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, messagesURL+"?cursor="+*page.NextCursor, janeAuth, &last)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal("hi", last.Data[0].Content)
+		ts.Require().Nil(last.NextCursor)
+		var problem openapi.Error
+		statusCode, err = testhelpers.GetWithHeaders(ctx, messagesURL, jimAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+
+		readURL := ts.server.URL + "/conversations/" + conversationID + "/read"
+		var response struct{}
+		statusCode, err = testhelpers.PostWithHeaders(ctx, readURL, janeAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		statusCode, err = testhelpers.PostWithHeaders(ctx, readURL, jimAuth, "", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		statusCode, err = testhelpers.Post(ctx, readURL, "", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		var conversations openapi.ConversationPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, conversationsURL, janeAuth, &conversations)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, m := range conversations.Data[0].Members {
+			ts.Require().Zero(m.UnreadCount)
+		}
+	})
+	ts.Run("Blocked users cannot message each other", func() {
+		var response struct{}
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/users/"+janeID+"/blocks/"+johnID,
+			janeAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var problem openapi.Error
+		statusCode, err = testhelpers.PostWithHeaders(ctx, messagesURL, johnAuth, `{ "content": "hi" }`, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+		conversationStr := `{ "member_ids": ["` + janeID + `"] }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/conversations", johnAuth, conversationStr,
+			&problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestNotifications() {
+	ctx := context.Background()
+
+	var johnID, tweetID string
+	ts.Run("Create users and tweet", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jim", "email": "jim@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			if u.Username == "john" {
+				johnID = u.Id.String()
+			}
+		}
+
+		var response struct{}
+		tweetStr := `{ "content": "Hello, world!" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "john"), tweetStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var tweets openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		tweetID = tweets.Data[0].Id.String()
+	})
+	johnAuth := ts.login(ctx, "john")
+	notificationsURL := ts.server.URL + "/notifications"
+	ts.Run("No notifications yet", func() {
+		var response struct{}
+		statusCode, err := testhelpers.GetWithHeaders(ctx, notificationsURL, johnAuth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Follows, likes and replies notify", func() {
+		for _, username := range []string{"jane", "jim"} {
+			var response struct{}
+			auth := ts.login(ctx, username)
+			followURL := ts.server.URL + "/users/" + johnID + "/follow"
+			statusCode, err := testhelpers.PostWithHeaders(ctx, followURL, auth, "", &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+			likeURL := ts.server.URL + "/tweets/" + tweetID + "/like"
+			statusCode, err = testhelpers.PostWithHeaders(ctx, likeURL, auth, "", &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var response struct{}
+		replyStr := `{ "content": "hi @john", "in_reply_to_tweet_id": "` + tweetID + `" }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "jane"), replyStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+	})
+	ts.Run("List grouped notifications", func() {
+		var page openapi.NotificationPage
+		statusCode, err := testhelpers.GetWithHeaders(ctx, notificationsURL+"?limit=2", johnAuth, &page)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(page.Data, 2)
+		ts.Require().Equal(openapi.NotificationTypeReply, page.Data[0].Type)
+		ts.Require().Equal(openapi.NotificationTypeLike, page.Data[1].Type)
+		ts.Require().Equal(2, page.Data[1].ActorCount)
+		ts.Require().Equal("jim", page.Data[1].Actors[0].Username)
+		ts.Require().Equal(tweetID, page.Data[1].TweetId.String())
+		ts.Require().NotNil(page.NextCursor)
+		var last openapi.NotificationPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, notificationsURL+"?cursor="+*page.NextCursor, johnAuth, &last)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(last.Data, 1)
+		ts.Require().Equal(openapi.NotificationTypeFollow, last.Data[0].Type)
+		ts.Require().Nil(last.Data[0].TweetId)
+		ts.Require().Nil(last.NextCursor)
+
+		var likes openapi.NotificationPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, notificationsURL+"?type=like", johnAuth, &likes)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(likes.Data, 1)
+		var problem openapi.Error
+		statusCode, err = testhelpers.GetWithHeaders(ctx, notificationsURL+"?type=poke", johnAuth, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		statusCode, err = testhelpers.Get(ctx, notificationsURL, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("Count and mark read", func() {
+		countURL := ts.server.URL + "/notifications/unread-count"
+		var count openapi.UnreadCount
+		statusCode, err := testhelpers.GetWithHeaders(ctx, countURL, johnAuth, &count)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal(3, count.Count)
+
+		var response struct{}
+		readURL := ts.server.URL + "/notifications/read"
+		statusCode, err = testhelpers.PostWithHeaders(ctx, readURL, ts.login(ctx, "jane"), "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		statusCode, err = testhelpers.GetWithHeaders(ctx, countURL, johnAuth, &count)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal(3, count.Count)
+		statusCode, err = testhelpers.PostWithHeaders(ctx, readURL, johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+		var after openapi.UnreadCount
+		statusCode, err = testhelpers.GetWithHeaders(ctx, countURL, johnAuth, &after)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Zero(after.Count)
+		var page openapi.NotificationPage
+		statusCode, err = testhelpers.GetWithHeaders(ctx, notificationsURL, johnAuth, &page)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, n := range page.Data {
+			ts.Require().True(n.Read)
+		}
+	})
+}
+
+// sseEvent is a Server-Sent Event as read by readEvent.
+type sseEvent struct {
+	id, event, data string
+}
+
+// readEvent reads the next event of a stream, skipping comments.
+func readEvent(r *bufio.Reader) (sseEvent, error) {
+	var e sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return e, err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "":
+			if e.event != "" {
+				return e, nil
+			}
+		case "id":
+			e.id = value
+		case "event":
+			e.event = value
+		case "data":
+			e.data = value
+		}
+	}
+}
+
+// openStream opens an event stream at url, resuming after lastEventID unless
+// it is empty. The stream is closed when ctx is done.
+func (ts *APITestSuite) openStream(
+	ctx context.Context,
+	url string,
+	headers map[string]string,
+	lastEventID string,
+) (*http.Response, *bufio.Reader) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	ts.Require().NoError(err)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := http.DefaultClient.Do(req) //nolint:gosec // G107: URLs are controlled by tests
+	ts.Require().NoError(err)
+	ts.T().Cleanup(func() { res.Body.Close() })
+	return res, bufio.NewReader(res.Body)
+}
+
+func (ts *APITestSuite) TestStream() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ts.Run("Create users", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	johnAuth := ts.login(ctx, "john")
+	streamURL := ts.server.URL + "/stream"
+	ts.Run("Tweets and notifications are pushed", func() {
+		streamCtx, closeStream := context.WithCancel(ctx)
+		defer closeStream()
+		res, stream := ts.openStream(streamCtx, streamURL, johnAuth, "")
+		ts.Require().Equal(http.StatusOK, res.StatusCode)
+		ts.Require().Equal("text/event-stream", res.Header.Get("Content-Type"))
+
+		var response struct{}
+		tweetStr := `{ "content": "Hello, world!" }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", johnAuth, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		e, err := readEvent(stream)
+		ts.Require().NoError(err)
+		ts.Require().Equal("1", e.id)
+		ts.Require().Equal("tweet", e.event)
+		var tweet openapi.Tweet
+		ts.Require().NoError(json.Unmarshal([]byte(e.data), &tweet))
+		ts.Require().Equal("Hello, world!", tweet.Content)
+		ts.Require().NotNil(tweet.Id)
+
+		likeURL := ts.server.URL + "/tweets/" + tweet.Id.String() + "/like"
+		statusCode, err = testhelpers.PostWithHeaders(ctx, likeURL, ts.login(ctx, "jane"), "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		e, err = readEvent(stream)
+		ts.Require().NoError(err)
+		ts.Require().Equal(sseEvent{"2", "notification", `{"count":1}`}, e)
+	})
+	ts.Run("Resume from the last event seen", func() {
+		streamCtx, closeStream := context.WithCancel(ctx)
+		defer closeStream()
+		res, stream := ts.openStream(streamCtx, streamURL, johnAuth, "1")
+		ts.Require().Equal(http.StatusOK, res.StatusCode)
+		e, err := readEvent(stream)
+		ts.Require().NoError(err)
+		ts.Require().Equal("2", e.id)
+		ts.Require().Equal("notification", e.event)
+
+		var problem openapi.Error
+		res, _ = ts.openStream(ctx, streamURL, johnAuth, "not-a-number")
+		ts.Require().Equal(http.StatusBadRequest, res.StatusCode)
+		ts.Require().NoError(json.NewDecoder(res.Body).Decode(&problem))
+		ts.Require().Equal("Invalid Last-Event-ID", *problem.Detail)
+		res, _ = ts.openStream(ctx, streamURL, map[string]string{"Authorization": "Bearer not-a-token"}, "")
+		ts.Require().Equal(http.StatusUnauthorized, res.StatusCode)
+	})
+	ts.Run("Streams outlive the write timeout", func() {
+		server := httptest.NewUnstartedServer(ts.server.Config.Handler)
+		server.Config.WriteTimeout = 50 * time.Millisecond
+		server.Start()
+		defer server.Close()
+		streamCtx, closeStream := context.WithCancel(ctx)
+		defer closeStream()
+		res, stream := ts.openStream(streamCtx, server.URL+"/stream", nil, "")
+		ts.Require().Equal(http.StatusOK, res.StatusCode)
+
+		time.Sleep(100 * time.Millisecond)
+		var response struct{}
+		tweetStr := `{ "content": "still there?" }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "jane"), tweetStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		e, err := readEvent(stream)
+		ts.Require().NoError(err)
+		ts.Require().Equal("tweet", e.event)
+		ts.Require().Contains(e.data, "still there?")
+	})
+	ts.Run("Closing the broker ends the streams", func() {
+		res, stream := ts.openStream(ctx, ts.server.URL+"/stream", nil, "")
+		ts.Require().Equal(http.StatusOK, res.StatusCode)
+		ts.broker.Close()
+		_, err := readEvent(stream)
+		ts.Require().ErrorIs(err, io.EOF)
+
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/stream", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusServiceUnavailable, statusCode)
+	})
+}
+
+// wsFrame is a frame of the WebSocket gateway.
+type wsFrame struct {
+	Type           string           `json:"type"`
+	Channel        string           `json:"channel"`
+	Tag            string           `json:"tag"`
+	ConversationID string           `json:"conversation_id"`
+	UserID         string           `json:"user_id"`
+	Tweet          *openapi.Tweet   `json:"tweet"`
+	Message        *openapi.Message `json:"message"`
+	Error          string           `json:"error"`
+}
+
+// wsClient is a WebSocket client reading its frames in the background, which
+// also answers the pings of the gateway.
+type wsClient struct {
+	conn   *websocket.Conn
+	frames chan wsFrame
+	err    chan error // the error that ended reading
+}
+
+// dialWS connects to the WebSocket gateway with the given headers and query.
+func (ts *APITestSuite) dialWS(headers map[string]string, query string) (*websocket.Conn, *http.Response, error) {
+	url := "ws" + strings.TrimPrefix(ts.wsServer.URL, "http") + "/ws?" + query
+	header := make(http.Header)
+	for k, v := range headers {
+		header.Set(k, v)
+	}
+	conn, res, err := websocket.DefaultDialer.Dial(url, header)
+	if res != nil {
+		res.Body.Close()
+	}
+	if conn != nil {
+		ts.T().Cleanup(func() { conn.Close() })
+	}
+	return conn, res, err
+}
+
+// newWSClient connects the user of auth to the WebSocket gateway and reads its
+// frames.
+func (ts *APITestSuite) newWSClient(auth map[string]string) *wsClient {
+	conn, _, err := ts.dialWS(auth, "")
+	ts.Require().NoError(err)
+	c := &wsClient{conn: conn, frames: make(chan wsFrame, 100), err: make(chan error, 1)}
+	go func() {
+		for {
+			var f wsFrame
+			if err := conn.ReadJSON(&f); err != nil {
+				c.err <- err
+				close(c.frames)
+				return
+			}
+			c.frames <- f
+		}
+	}()
+	return c
+}
+
+// send sends a request frame to the gateway.
+func (ts *APITestSuite) send(c *wsClient, request string) {
+	ts.Require().NoError(c.conn.WriteMessage(websocket.TextMessage, []byte(request)))
+}
+
+// next returns the next frame c got.
+func (ts *APITestSuite) next(c *wsClient) wsFrame {
+	select {
+	case f, ok := <-c.frames:
+		if !ok {
+			ts.FailNow("connection closed", "%v", <-c.err)
+		}
+		return f
+	case <-time.After(2 * time.Second):
+		ts.FailNow("no frame")
+	}
+	return wsFrame{}
+}
+
+func (ts *APITestSuite) TestWebSocket() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var johnID, janeID string
+	ts.Run("Create users", func() {
+		for _, userStr := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jim", "email": "jim@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			switch u.Username {
+			case "john":
+				johnID = u.Id.String()
+			case "jane":
+				janeID = u.Id.String()
+			}
+		}
+	})
+	johnAuth, janeAuth, jimAuth := ts.login(ctx, "john"), ts.login(ctx, "jane"), ts.login(ctx, "jim")
+	var conversationID string
+	ts.Run("John follows Jane and talks to her", func() {
+		var response struct{}
+		followURL := ts.server.URL + "/users/" + janeID + "/follow"
+		statusCode, err := testhelpers.PostWithHeaders(ctx, followURL, johnAuth, "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		var conversation openapi.Conversation
+		conversationStr := `{ "member_ids": ["` + janeID + `"] }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/conversations", johnAuth, conversationStr,
+			&conversation)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		conversationID = conversation.Id.String()
+	})
+	ts.Run("Unauthenticated clients are turned away", func() {
+		for _, tt := range []struct {
+			name    string
+			headers map[string]string
+			query   string
+			want    int
+		}{
+			{"no token", nil, "", http.StatusUnauthorized},
+			{"no token for a user", nil, "user_id=" + johnID, http.StatusUnauthorized},
+			{"invalid token", map[string]string{"Authorization": "Bearer not-a-token"}, "", http.StatusUnauthorized},
+			{"invalid query token", nil, "access_token=not-a-token", http.StatusUnauthorized},
+			{"another user", janeAuth, "user_id=" + johnID, http.StatusForbidden},
+			{"invalid user ID", johnAuth, "user_id=not-a-uuid", http.StatusBadRequest},
+			{
+				"another origin",
+				map[string]string{"Authorization": johnAuth["Authorization"], "Origin": "https://example.com"},
+				"",
+				http.StatusForbidden,
+			},
+		} {
+			_, res, err := ts.dialWS(tt.headers, tt.query)
+			ts.Require().ErrorIs(err, websocket.ErrBadHandshake, tt.name)
+			ts.Require().Equal(tt.want, res.StatusCode, tt.name)
+		}
+	})
+	ts.Run("Tokens are also taken from the query", func() {
+		token := strings.TrimPrefix(johnAuth["Authorization"], "Bearer ")
+		conn, _, err := ts.dialWS(nil, "user_id="+johnID+"&access_token="+token)
+		ts.Require().NoError(err)
+		ts.Require().NoError(conn.Close())
+	})
+
+	john := ts.newWSClient(johnAuth)
+	ts.Run("Events are pushed on the channels subscribed to", func() {
+		ts.send(john, `{ "type": "subscribe", "channel": "home" }`)
+		ts.send(john, `{ "type": "subscribe", "channel": "hashtag", "tag": "#Go" }`)
+		ts.send(john, `{ "type": "subscribe", "channel": "conversation", "conversation_id": "`+conversationID+`" }`)
+		ts.Require().Equal(wsFrame{Type: "subscribed", Channel: "home"}, ts.next(john))
+		ts.Require().Equal(wsFrame{Type: "subscribed", Channel: "hashtag", Tag: "#Go"}, ts.next(john))
+		ts.Require().Equal(wsFrame{Type: "subscribed", Channel: "conversation", ConversationID: conversationID},
+			ts.next(john))
+
+		jane := ts.newWSClient(janeAuth)
+		ts.send(jane, `{ "type": "typing", "conversation_id": "`+conversationID+`" }`)
+		var response struct{}
+		tweetStr := `{ "content": "#go live" }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", janeAuth, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var message openapi.Message
+		messageStr := `{ "content": "hi" }`
+		messagesURL := ts.server.URL + "/conversations/" + conversationID + "/messages"
+		statusCode, err = testhelpers.PostWithHeaders(ctx, messagesURL, janeAuth, messageStr, &message)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		// channels are forwarded independently of one another
+		byChannel := make(map[string][]wsFrame)
+		for range 4 {
+			f := ts.next(john)
+			byChannel[f.Channel] = append(byChannel[f.Channel], f)
+		}
+		ts.Require().Len(byChannel["home"], 1)
+		ts.Require().Equal("tweet", byChannel["home"][0].Type)
+		ts.Require().Equal("#go live", byChannel["home"][0].Tweet.Content)
+		ts.Require().Len(byChannel["hashtag"], 1)
+		ts.Require().Equal("#Go", byChannel["hashtag"][0].Tag)
+		ts.Require().Equal("#go live", byChannel["hashtag"][0].Tweet.Content)
+		ts.Require().Len(byChannel["conversation"], 2)
+		ts.Require().Equal("typing", byChannel["conversation"][0].Type)
+		ts.Require().Equal(janeID, byChannel["conversation"][0].UserID)
+		ts.Require().Equal("message", byChannel["conversation"][1].Type)
+		ts.Require().Equal(message.Id, byChannel["conversation"][1].Message.Id)
+		ts.Require().Equal("hi", byChannel["conversation"][1].Message.Content)
+	})
+	ts.Run("Bad requests get error frames", func() {
+		for _, tt := range []struct{ request, error string }{
+			{`not json`, "Invalid request"},
+			{`{ "type": "poke" }`, "Unknown request type"},
+			{`{ "type": "subscribe", "channel": "moon" }`, "Unknown channel"},
+			{`{ "type": "subscribe", "channel": "hashtag", "tag": "#1" }`, "Invalid hashtag"},
+			{`{ "type": "subscribe", "channel": "conversation", "conversation_id": "1" }`, "Invalid conversation ID"},
+			{
+				`{ "type": "subscribe", "channel": "conversation", "conversation_id": "` + uuid.NewString() + `" }`,
+				"Conversation not found",
+			},
+		} {
+			ts.send(john, tt.request)
+			f := ts.next(john)
+			ts.Require().Equal("error", f.Type, tt.request)
+			ts.Require().Equal(tt.error, f.Error, tt.request)
+		}
+
+		jim := ts.newWSClient(jimAuth)
+		ts.send(jim, `{ "type": "typing", "conversation_id": "`+conversationID+`" }`)
+		f := ts.next(jim)
+		ts.Require().Equal("error", f.Type)
+		ts.Require().Equal("Not a member of the conversation", f.Error)
+	})
+	ts.Run("Unsubscribing stops the events of a channel", func() {
+		ts.send(john, `{ "type": "unsubscribe", "channel": "home" }`)
+		ts.Require().Equal(wsFrame{Type: "unsubscribed", Channel: "home"}, ts.next(john))
+		for _, content := range []string{"untagged", "#go again"} {
+			var response struct{}
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", janeAuth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		f := ts.next(john)
+		ts.Require().Equal("hashtag", f.Channel)
+		ts.Require().Equal("#go again", f.Tweet.Content)
+	})
+	ts.Run("Clients answering pings are kept", func() {
+		time.Sleep(3 * wsPongWait)
+		ts.send(john, `{ "type": "subscribe", "channel": "hashtag", "tag": "#Go" }`)
+		ts.Require().Equal(wsFrame{Type: "subscribed", Channel: "hashtag", Tag: "#Go"}, ts.next(john))
+	})
+	ts.Run("Clients not answering pings are dropped", func() {
+		conn, _, err := ts.dialWS(jimAuth, "")
+		ts.Require().NoError(err)
+		// pings are only answered while reading
+		time.Sleep(3 * wsPongWait)
+		ts.Require().NoError(conn.SetReadDeadline(time.Now().Add(2 * time.Second)))
+		for err == nil {
+			_, _, err = conn.ReadMessage()
+		}
+		// answering the pings read before the close frame may fail first
+		var netErr net.Error
+		ts.Require().False(errors.As(err, &netErr) && netErr.Timeout(), "got %v", err)
+	})
+	ts.Run("Clients too slow to read are dropped", func() {
+		conn, _, err := ts.dialWS(johnAuth, "")
+		ts.Require().NoError(err)
+		subscribe := []byte(`{ "type": "subscribe", "channel": "home" }`)
+		ts.Require().NoError(conn.WriteMessage(websocket.TextMessage, subscribe))
+		var f wsFrame
+		ts.Require().NoError(conn.ReadJSON(&f))
+		ts.Require().Equal("subscribed", f.Type)
+
+		// far more than the connection buffers, with nobody reading
+		tweet := &entities.Tweet{ID: uuid.New(), UserID: uuid.MustParse(johnID), Content: strings.Repeat("a", 64<<10)}
+		for range 1000 {
+			ts.broker.Publish(events.Event{Kind: events.KindTweet, Tweet: tweet})
+		}
+		ts.Require().NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		for err == nil {
+			_, _, err = conn.ReadMessage()
+		}
+		ts.Require().True(websocket.IsCloseError(err, websocket.CloseTryAgainLater), "got %v", err)
+	})
+	ts.Run("Shutting down closes the connections", func() {
+		ts.Require().NoError(ts.gateway.Shutdown(ctx))
+		_, ok := <-john.frames
+		ts.Require().False(ok)
+		err := <-john.err
+		ts.Require().True(websocket.IsCloseError(err, websocket.CloseGoingAway), "got %v", err)
+
+		_, res, err := ts.dialWS(johnAuth, "")
+		ts.Require().ErrorIs(err, websocket.ErrBadHandshake)
+		ts.Require().Equal(http.StatusServiceUnavailable, res.StatusCode)
+	})
+}
+
+func (ts *APITestSuite) TestRetweetsAndQuotes() {
+	ctx := context.Background()
+
+	var auth map[string]string
+	var originalID string
+	ts.Run("Create user and tweet", func() {
+		var response struct{}
+		userStr := `{ "username": "john", "name": "John Doe", "email": "john@mail.com", "password": "s3cret-pass" }`
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		auth = ts.login(ctx, "john")
+
+		tweetStr := `{ "content": "Hello, world!" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		var tweets openapi.TweetPage
+		_, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		originalID = tweets.Data[0].Id.String()
+	})
+	reference := func(kind, content string) string {
+		return `{ "kind": "` + kind + `", "content": "` + content + `", "referenced_tweet_id": "` + originalID + `" }`
+	}
+	ts.Run("Retweet and quote", func() {
+		for _, tweetStr := range []string{reference("retweet", ""), reference("quote", "So true")} {
+			var response struct{}
+			statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Retweet twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, reference("retweet", ""),
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+	})
+	ts.Run("Retweet with content", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, reference("retweet", "x"),
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().Equal("content", (*response.Details)[0].Field)
+	})
+	ts.Run("Get original with counts", func() {
+		var response openapi.Tweet
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+originalID, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal(openapi.TweetKindTweet, *response.Kind)
+		ts.Require().Equal(1, *response.RetweetCount)
+		ts.Require().Equal(1, *response.QuoteCount)
+	})
+	ts.Run("List tweets with embedded originals", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 3)
+		for _, tweet := range response.Data[:2] {
+			ts.Require().NotNil(tweet.ReferencedTweet)
+			ts.Require().Equal(originalID, tweet.ReferencedTweet.Id.String())
+			ts.Require().Equal("Hello, world!", tweet.ReferencedTweet.Content)
+		}
+	})
+}
+
+func (ts *APITestSuite) TestRepliesAndThread() {
+	ctx := context.Background()
+
+	var auth map[string]string
+	var rootID, replyID string
+	post := func(tweetStr string) int {
+		var response struct{}
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+		ts.Require().NoError(err)
+		return statusCode
+	}
+	newest := func() openapi.Tweet {
+		var tweets openapi.TweetPage
+		_, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		return tweets.Data[0]
+	}
+	replyTo := func(parentID, content string) string {
+		return `{ "content": "` + content + `", "in_reply_to_tweet_id": "` + parentID + `" }`
+	}
+	ts.Run("Create user and tweet", func() {
+		var response struct{}
+		userStr := `{ "username": "john", "name": "John Doe", "email": "john@mail.com", "password": "s3cret-pass" }`
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+		auth = ts.login(ctx, "john")
+
+		ts.Require().Equal(http.StatusCreated, post(`{ "content": "root" }`))
+		rootID = newest().Id.String()
+	})
+	ts.Run("Reply and reply to the reply", func() {
+		ts.Require().Equal(http.StatusCreated, post(replyTo(rootID, "reply")))
+		reply := newest()
+		replyID = reply.Id.String()
+		ts.Require().Equal(rootID, reply.InReplyToTweetId.String())
+		ts.Require().Equal(rootID, reply.ConversationId.String())
+
+		ts.Require().Equal(http.StatusCreated, post(replyTo(replyID, "nested")))
+		ts.Require().Equal(rootID, newest().ConversationId.String())
+	})
+	ts.Run("Reply to unknown tweet", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth,
+			replyTo(uuid.New().String(), "x"), &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().Equal("in_reply_to_tweet_id", (*response.Details)[0].Field)
+	})
+	ts.Run("Get thread", func() {
+		var response openapi.Thread
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+rootID+"/thread", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal("root", response.Tweet.Content)
+		ts.Require().Len(response.Replies, 1)
+		ts.Require().Equal("reply", response.Replies[0].Tweet.Content)
+		ts.Require().Len(response.Replies[0].Replies, 1)
+		ts.Require().Equal("nested", response.Replies[0].Replies[0].Tweet.Content)
+	})
+	ts.Run("Get thread with depth", func() {
+		var response openapi.Thread
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+rootID+"/thread?depth=1", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Replies, 1)
+		ts.Require().Empty(response.Replies[0].Replies)
+	})
+	ts.Run("Get thread of unknown tweet", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+uuid.New().String()+"/thread", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestUserTweets() {
+	ctx := context.Background()
+
+	userIDs := map[string]string{}
+	ts.Run("Create users and tweets", func() {
+		for _, username := range []string{"john", "jane"} {
+			userStr := `{ "username": "` + username + `", "email": "` + username + `@mail.com",` +
+				` "password": "s3cret-pass" }`
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var users openapi.UserPage
+		_, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		for _, u := range users.Data {
+			userIDs[u.Username] = u.Id.String()
+		}
+		auth := ts.login(ctx, "john")
+		for _, content := range []string{"first", "second"} {
+			var response struct{}
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("List tweets of user page by page", func() {
+		var response openapi.TweetPage
+		url := ts.server.URL + "/users/" + userIDs["john"] + "/tweets?limit=1"
+		statusCode, err := testhelpers.Get(ctx, url, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("second", response.Data[0].Content)
+		ts.Require().NotNil(response.NextCursor)
+
+		var next openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, url+"&cursor="+*response.NextCursor, &next)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(next.Data, 1)
+		ts.Require().Equal("first", next.Data[0].Content)
+		ts.Require().Nil(next.NextCursor)
+	})
+	ts.Run("List tweets of user without tweets", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["jane"]+"/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("List tweets of unknown user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+uuid.New().String()+"/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestTimeline() {
+	ctx := context.Background()
+
+	userIDs := map[string]string{}
+	ts.Run("Create users", func() {
+		for _, username := range []string{"john", "jane"} {
+			userStr := `{ "username": "` + username + `", "email": "` + username + `@mail.com",` +
+				` "password": "s3cret-pass" }`
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", userStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		var response openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range response.Data {
+			userIDs[u.Username] = u.Id.String()
+		}
+	})
+	ts.Run("Get empty timeline", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["john"]+"/timeline", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Create tweets", func() {
+		for _, username := range []string{"john", "jane"} {
+			tweetStr := `{ "content": "Hello from ` + username + `" }`
+			var response struct{}
+			statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, username),
+				tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Get timeline without follows", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["john"]+"/timeline", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello from john", response.Data[0].Content)
+		ts.Require().Nil(response.NextCursor)
+	})
+	ts.Run("Get timeline with follows", func() {
+		var followResponse struct{}
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/users/"+userIDs["jane"]+"/follow",
+			ts.login(ctx, "john"), "", &followResponse)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		var response openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["john"]+"/timeline?limit=1", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello from jane", response.Data[0].Content)
+		ts.Require().NotNil(response.NextCursor)
+
+		statusCode, err = testhelpers.Get(ctx,
+			ts.server.URL+"/users/"+userIDs["john"]+"/timeline?limit=1&cursor="+*response.NextCursor, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello from john", response.Data[0].Content)
+	})
+	ts.Run("Get timeline with invalid cursor", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx,
+			ts.server.URL+"/users/"+userIDs["john"]+"/timeline?cursor=not-a-cursor", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+	ts.Run("Get timeline unknown user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+uuid.NewString()+"/timeline", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestPaginateTweets() {
+	ctx := context.Background()
+
+	ts.Run("Create user and tweets", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "foo", "email": "jd@mail.com", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		auth := ts.login(ctx, "foo")
+		for _, content := range []string{"one", "two", "three"} {
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	var cursor string
+	ts.Run("Get first page", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets?limit=2", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 2)
+		ts.Require().Equal("three", response.Data[0].Content)
+		ts.Require().Equal("two", response.Data[1].Content)
+		ts.Require().NotNil(response.NextCursor)
+		cursor = *response.NextCursor
+	})
+	ts.Run("Get last page", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets?limit=2&cursor="+cursor, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("one", response.Data[0].Content)
+		ts.Require().Nil(response.NextCursor)
+	})
+	ts.Run("Get page with invalid cursor", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets?cursor=invalid", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestHashtagTweets() {
+	ctx := context.Background()
+
+	ts.Run("Create user and tagged tweets", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "foo", "email": "jd@mail.com", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		auth := ts.login(ctx, "foo")
+		for _, content := range []string{"Learning #Go", "Learning #Rust", "More #go, please"} {
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("List tweets by hashtag", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/hashtags/GO/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 2)
+		ts.Require().Equal("More #go, please", response.Data[0].Content)
+		ts.Require().Equal("Learning #Go", response.Data[1].Content)
+		ts.Require().Nil(response.NextCursor)
+	})
+	ts.Run("List tweets by escaped hashtag", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/hashtags/%23rust/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Learning #Rust", response.Data[0].Content)
+	})
+	ts.Run("List tweets by unused hashtag", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/hashtags/zig/tweets", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("List tweets by invalid hashtag", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/hashtags/123/tweets", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().NotNil(problem.Detail)
+		ts.Require().Equal("invalid hashtag", *problem.Detail)
+	})
+}
+
+func (ts *APITestSuite) TestMentions() {
+	ctx := context.Background()
+
+	userIDs := map[string]string{}
+	ts.Run("Create users and a mentioning tweet", func() {
+		var response struct{}
+		for _, username := range []string{"john", "jane"} {
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+				`{ "username": "`+username+`", "email": "`+username+`@mail.com", "password": "s3cret-pass" }`,
+				&response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, user := range users.Data {
+			userIDs[user.Username] = user.Id.String()
+		}
+
+		tweetStr := `{ "content": "Hi @Jane and @nobody" }`
+		statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", ts.login(ctx, "john"), tweetStr,
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+	})
+	ts.Run("List mentions of a user", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["jane"]+"/mentions", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().NotNil(response.Data[0].Mentions)
+		mentions := *response.Data[0].Mentions
+		ts.Require().Len(mentions, 1)
+		ts.Require().Equal(userIDs["jane"], mentions[0].UserId.String())
+		ts.Require().Equal("jane", mentions[0].Username)
+		ts.Require().Equal(3, mentions[0].Start)
+		ts.Require().Equal(8, mentions[0].End)
+	})
+	ts.Run("List mentions of a user nobody mentions", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+userIDs["john"]+"/mentions", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("List mentions of an unknown user", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users/"+uuid.NewString()+"/mentions", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestSearchTweets() {
+	ctx := context.Background()
+
+	ts.Run("Create user and tweets", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "foo", "email": "jd@mail.com", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		auth := ts.login(ctx, "foo")
+		for _, content := range []string{"Hello big world", "Hello hello", "Goodbye world"} {
+			tweetStr := `{ "content": "` + content + `" }`
+			statusCode, err = testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Search by relevance", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/tweets?q=hello+from:FOO&limit=1", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello hello", response.Data[0].Content)
+		ts.Require().NotNil(response.NextCursor)
+
+		var last openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx,
+			ts.server.URL+"/search/tweets?q=hello+from:FOO&limit=1&cursor="+*response.NextCursor, &last)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(last.Data, 1)
+		ts.Require().Equal("Hello big world", last.Data[0].Content)
+		ts.Require().Nil(last.NextCursor)
+	})
+	ts.Run("Search by recency with operators", func() {
+		var response openapi.TweetPage
+		statusCode, err := testhelpers.Get(ctx,
+			ts.server.URL+"/search/tweets?sort=recency&q=world+-%22big+world%22", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Goodbye world", response.Data[0].Content)
+	})
+	ts.Run("Search without results", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/tweets?q=nothing", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Search without terms", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/tweets?q=-hello", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().NotNil(problem.Detail)
+		ts.Require().Equal("search query has no terms", *problem.Detail)
+	})
+	ts.Run("Search with invalid cursor", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/tweets?q=hello&cursor=invalid", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestSearchUsers() {
+	ctx := context.Background()
+
+	ts.Run("Create users", func() {
+		for _, body := range []string{
+			`{ "username": "foo", "email": "foo@mail.com", "name": "Foo Fighter", "password": "s3cret-pass" }`,
+			`{ "username": "food", "email": "food@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "bar", "email": "bar@mail.com", "name": "Bar Foo", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", body, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+	})
+	ts.Run("Search users", func() {
+		var response openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/users?q=FOO&limit=2", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response.Data, 2)
+		ts.Require().Equal("foo", response.Data[0].Username)
+		ts.Require().Equal("food", response.Data[1].Username)
+		ts.Require().NotNil(response.NextCursor)
+
+		var last openapi.UserPage
+		statusCode, err = testhelpers.Get(ctx,
+			ts.server.URL+"/search/users?q=FOO&limit=2&cursor="+*response.NextCursor, &last)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(last.Data, 1)
+		ts.Require().Equal("bar", last.Data[0].Username)
+		ts.Require().Nil(last.NextCursor)
+	})
+	ts.Run("Search without results", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/users?q=nobody", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Search with a blank query", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/users?q=+", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+	})
+	ts.Run("Search with invalid cursor", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/search/users?q=foo&cursor=invalid", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestDeleteAndRestoreTweet() {
+	ctx := context.Background()
+
+	var auth map[string]string
+	var tweetID string
+	ts.Run("Create users and tweet", func() {
+		for _, body := range []string{
+			`{ "username": "foo", "email": "jd@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "bar", "email": "bar@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", body, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+		auth = ts.login(ctx, "foo")
+
+		var response struct{}
+		tweetStr := `{ "content": "Hello World" }`
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		var tweets openapi.TweetPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &tweets)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		tweetID = tweets.Data[0].Id.String()
+		ts.Require().NotNil(tweets.Data[0].CreatedAt)
+		ts.Require().False(tweets.Data[0].CreatedAt.IsZero())
+		ts.Require().Equal(tweets.Data[0].CreatedAt, tweets.Data[0].UpdatedAt)
+	})
+	ts.Run("Delete tweet of another user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, ts.login(ctx, "bar"),
+			&response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+	ts.Run("Delete tweet", func() {
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Deleted tweet is hidden", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets/"+tweetID, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+
+		var empty struct{}
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets", &empty)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+	})
+	ts.Run("Delete tweet twice", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/tweets/"+tweetID, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("List deleted tweets", func() {
+		var response []openapi.Tweet
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/admin/tweets/deleted", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response, 1)
+		ts.Require().Equal(tweetID, response[0].Id.String())
+		ts.Require().NotNil(response[0].DeletedAt)
+	})
+	ts.Run("Restore tweet", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/admin/tweets/"+tweetID+"/restore", "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+
+		var tweet openapi.Tweet
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/tweets/"+tweetID, &tweet)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Nil(tweet.DeletedAt)
+	})
+	ts.Run("Restore live tweet", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/admin/tweets/"+tweetID+"/restore", "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestDeleteAndRestoreUser() {
+	ctx := context.Background()
+
+	var userID string
+	ts.Run("Create user", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "foo", "email": "jd@mail.com", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusCreated, statusCode)
+
+		var users openapi.UserPage
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		userID = users.Data[0].Id.String()
+	})
+	ts.Run("Delete another user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/users/"+uuid.NewString(),
+			ts.login(ctx, "foo"), &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+	ts.Run("Delete user", func() {
+		auth := ts.login(ctx, "foo")
+		var response struct{}
+		statusCode, err := testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/users/"+userID, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+
+		var user openapi.Error
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/users/"+userID, &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+		// deleting the user ended their session
+		statusCode, err = testhelpers.DeleteWithHeaders(ctx, ts.server.URL+"/users/"+userID, auth, &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("List deleted users", func() {
+		var response []openapi.User
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/admin/users/deleted", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Len(response, 1)
+		ts.Require().Equal("foo", response[0].Username)
+	})
+	ts.Run("Restore user", func() {
+		var response struct{}
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/admin/users/"+userID+"/restore", "", &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNoContent, statusCode)
+
+		var user openapi.User
+		statusCode, err = testhelpers.Get(ctx, ts.server.URL+"/users/"+userID, &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+	})
+	ts.Run("Delete anonymously", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Delete(ctx, ts.server.URL+"/users/"+userID, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestUpdateUser() {
+	ctx := context.Background()
+
+	var johnID string
+	ts.Run("Create users", func() {
+		for _, body := range []string{
+			`{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`,
+			`{ "username": "jane", "email": "jane@mail.com", "password": "s3cret-pass" }`,
+		} {
+			var response struct{}
+			statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users", body, &response)
+			ts.Require().NoError(err)
+			ts.Require().Equal(http.StatusCreated, statusCode)
+		}
+
+		var users openapi.UserPage
+		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &users)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		for _, u := range users.Data {
+			if u.Username == "john" {
+				johnID = u.Id.String()
+			}
+		}
+	})
+	ts.Run("Create duplicate user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "john", "email": "johnny@mail.com", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+		ts.Require().Equal("User already exists", *response.Detail)
+		ts.Require().NotNil(response.Field)
+		ts.Require().Equal("username", *response.Field)
+	})
+	auth := ts.login(ctx, "john")
+	ts.Run("Create user with invalid email", func() {
+		// well-formed address that the domain rules reject
+		var response openapi.Error
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/users",
+			`{ "username": "jim", "email": "jim@localhost", "password": "s3cret-pass" }`, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().Equal("Validation failed", response.Title)
+		ts.Require().NotNil(response.Details)
+		ts.Require().Len(*response.Details, 1)
+		ts.Require().Equal("email", (*response.Details)[0].Field)
+		ts.Require().Equal(entities.CodeInvalidFormat, (*response.Details)[0].Code)
+	})
+	ts.Run("Update name", func() {
+		var user openapi.User
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+johnID,
+			`{ "name": "John Doe" }`, auth, &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal("John Doe", *user.Name)
+		ts.Require().Equal("john", user.Username)
+		ts.Require().Equal("john@mail.com", string(user.Email))
+	})
+	ts.Run("Username taken", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+johnID,
+			`{ "username": "jane" }`, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+		ts.Require().NotNil(response.Field)
+		ts.Require().Equal("username", *response.Field)
+	})
+	ts.Run("Email taken", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+johnID,
+			`{ "email": "jane@mail.com" }`, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusConflict, statusCode)
+		ts.Require().NotNil(response.Field)
+		ts.Require().Equal("email", *response.Field)
+	})
+	ts.Run("Invalid email", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+johnID,
+			`{ "email": "john" }`, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+	ts.Run("Email rejected by domain rules", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+johnID,
+			`{ "email": "john@localhost" }`, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().NotNil(response.Details)
+		ts.Require().Equal("email", (*response.Details)[0].Field)
+	})
+	ts.Run("Another user", func() {
+		var response openapi.Error
+		statusCode, err := testhelpers.Patch(ctx, ts.server.URL+"/users/"+uuid.NewString(),
+			`{ "name": "Nobody" }`, auth, &response)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusForbidden, statusCode)
+	})
+}
+
+// newValidatedServer starts a server wired like the real one: requests go
+// through the session middleware and the OpenAPI validator, and carry a
+// request ID.
+func (ts *APITestSuite) newValidatedServer(adminToken string) *httptest.Server {
+	swagger, err := openapi.GetSwagger()
+	ts.Require().NoError(err)
+	swagger.Servers = nil
+	logger := slog.New(slog.DiscardHandler)
+	validator := oapiMiddleware.OapiRequestValidatorWithOptions(swagger, &oapiMiddleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: api.Authenticator(adminToken),
+		},
+		ErrorHandlerWithOpts: api.ValidationErrorHandler(logger),
+	})
+	s := store.NewMemStore()
+	b := events.NewBroker(100, 100)
+	sessions := service.NewSessionService(s, []byte("test key"), time.Hour)
+	twitterAPI := api.New(logger,
+		service.NewUserService(s, testPasswordParams), service.NewTweetService(s, b),
+		service.NewFollowService(s, b), service.NewTimelineService(s), service.NewLikeService(s, b),
+		service.NewBookmarkService(s), service.NewRelationshipService(s), service.NewMessageService(s, b),
+		service.NewNotificationService(s), service.NewStreamService(s, b), sessions)
+	return httptest.NewServer(middleware.RequestID(openapi.HandlerWithOptions(twitterAPI, openapi.ChiServerOptions{
+		ErrorHandlerFunc: api.ParamErrorHandler(logger),
+		Middlewares:      []openapi.MiddlewareFunc{validator, api.SessionMiddleware(logger, sessions)},
+	})))
+}
+
+func (ts *APITestSuite) TestAuthenticator() {
+	ctx := context.Background()
+
+	server := ts.newValidatedServer("secret")
+	defer server.Close()
+
+	ts.Run("Bearer token", func() {
+		// the validator only accepts plain JSON bodies
+		post := func(path, body string, headers map[string]string) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+path, strings.NewReader(body))
+			ts.Require().NoError(err)
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			ts.Require().NoError(err)
+			return resp
+		}
+		resp := post("/users", `{ "username": "john", "email": "john@mail.com", "password": "s3cret-pass" }`, nil)
+		resp.Body.Close()
+		ts.Require().Equal(http.StatusCreated, resp.StatusCode)
+		resp = post("/auth/login", `{ "username": "john", "password": "s3cret-pass" }`, nil)
+		var session openapi.Session
+		ts.Require().NoError(json.NewDecoder(resp.Body).Decode(&session))
+		resp.Body.Close()
+		ts.Require().Equal(http.StatusOK, resp.StatusCode)
+
+		resp = post("/tweets", `{ "content": "hello" }`, nil)
+		resp.Body.Close()
+		ts.Require().Equal(http.StatusUnauthorized, resp.StatusCode)
+		resp = post("/tweets", `{ "content": "hello" }`, map[string]string{"Authorization": "Bearer " + session.Token})
+		resp.Body.Close()
+		ts.Require().Equal(http.StatusCreated, resp.StatusCode)
+	})
+
+	for _, token := range []string{"", "wrong"} {
+		var problem openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, server.URL+"/admin/tweets/deleted",
+			map[string]string{"X-Admin-Token": token}, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		ts.Require().Equal(int32(http.StatusUnauthorized), problem.Status)
+		ts.Require().Equal("Unauthorized", problem.Title)
+	}
+
+	var response struct{}
+	statusCode, err := testhelpers.GetWithHeaders(ctx, server.URL+"/admin/tweets/deleted",
+		map[string]string{"X-Admin-Token": "secret"}, &response)
+	ts.Require().NoError(err)
+	ts.Require().Equal(http.StatusNoContent, statusCode)
+}
+
+func (ts *APITestSuite) TestUserLookup() {
+	ctx := context.Background()
+
+	server := ts.newValidatedServer("secret")
+	defer server.Close()
+
+	// the validator only accepts plain JSON bodies
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/users",
+		strings.NewReader(`{ "username": "Alice", "email": "alice@mail.com", "password": "s3cret-pass" }`))
+	ts.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	ts.Require().NoError(err)
+	resp.Body.Close()
+	ts.Require().Equal(http.StatusCreated, resp.StatusCode)
+
+	ts.Run("By username ignoring case", func() {
+		var user openapi.User
+		statusCode, err := testhelpers.Get(ctx, server.URL+"/users/by-username/alice", &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal("Alice", user.Username)
+	})
+	ts.Run("By unknown username", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, server.URL+"/users/by-username/bob", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+	ts.Run("By email requires admin token", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.Get(ctx, server.URL+"/admin/users/by-email?email=alice@mail.com", &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+	})
+	ts.Run("By email", func() {
+		var user openapi.User
+		statusCode, err := testhelpers.GetWithHeaders(ctx, server.URL+"/admin/users/by-email?email=alice@mail.com",
+			map[string]string{"X-Admin-Token": "secret"}, &user)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().Equal("Alice", user.Username)
+	})
+	ts.Run("By unknown email", func() {
+		var problem openapi.Error
+		statusCode, err := testhelpers.GetWithHeaders(ctx, server.URL+"/admin/users/by-email?email=bob@mail.com",
+			map[string]string{"X-Admin-Token": "secret"}, &problem)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusNotFound, statusCode)
+	})
+}
+
+func (ts *APITestSuite) TestLogin() {
+	ctx := context.Background()
+
+	server := ts.newValidatedServer("secret")
+	defer server.Close()
+
+	// post sends a JSON body and decodes the response into v
+	post := func(path, body string, v any) int {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+path, strings.NewReader(body))
+		ts.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		ts.Require().NoError(err)
+		defer resp.Body.Close()
+		if v != nil {
+			ts.Require().NoError(json.NewDecoder(resp.Body).Decode(v))
+		}
+		return resp.StatusCode
+	}
+
+	statusCode := post("/users", `{ "username": "Alice", "email": "alice@mail.com", "password": "wonderland" }`, nil)
+	ts.Require().Equal(http.StatusCreated, statusCode)
+
+	ts.Run("Weak password", func() {
+		var problem openapi.Error
+		statusCode := post("/users", `{ "username": "bob", "email": "bob@mail.com", "password": "bob12345" }`, &problem)
+		ts.Require().Equal(http.StatusUnprocessableEntity, statusCode)
+		ts.Require().NotNil(problem.Details)
+		ts.Require().Equal("password", (*problem.Details)[0].Field)
+		ts.Require().Equal("not_allowed", (*problem.Details)[0].Code)
+	})
+	ts.Run("Missing password", func() {
+		var problem openapi.Error
+		statusCode := post("/users", `{ "username": "bob", "email": "bob@mail.com" }`, &problem)
+		ts.Require().Equal(http.StatusBadRequest, statusCode)
+	})
+	ts.Run("Valid credentials", func() {
+		var session map[string]any
+		statusCode := post("/auth/login", `{ "username": "alice", "password": "wonderland" }`, &session)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		ts.Require().NotEmpty(session["token"])
+		ts.Require().NotEmpty(session["expires_at"])
+		user, ok := session["user"].(map[string]any)
+		ts.Require().True(ok)
+		ts.Require().Equal("Alice", user["username"])
+		ts.Require().NotContains(user, "password")
+		ts.Require().NotContains(user, "password_hash")
+	})
+	ts.Run("Wrong password", func() {
+		var problem openapi.Error
+		statusCode := post("/auth/login", `{ "username": "alice", "password": "looking glass" }`, &problem)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		ts.Require().Equal("Invalid username or password", *problem.Detail)
+	})
+	ts.Run("Unknown user", func() {
+		var problem openapi.Error
+		statusCode := post("/auth/login", `{ "username": "bob", "password": "wonderland" }`, &problem)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
+		ts.Require().Equal("Invalid username or password", *problem.Detail)
+	})
+	ts.Run("No hash in user responses", func() {
+		var page map[string]any
+		statusCode, err := testhelpers.Get(ctx, server.URL+"/users", &page)
+		ts.Require().NoError(err)
+		ts.Require().Equal(http.StatusOK, statusCode)
+		body, err := json.Marshal(page)
+		ts.Require().NoError(err)
+		ts.Require().NotContains(string(body), "password")
+		ts.Require().NotContains(string(body), "argon2id")
+	})
+}
+
+func (ts *APITestSuite) TestProblemDetails() {
+	ctx := context.Background()
+
+	server := ts.newValidatedServer("secret")
+	defer server.Close()
+
+	// do sends a request and decodes the problem details in the response
+	do := func(method, path, body string) (*http.Response, openapi.Error) {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+path, strings.NewReader(body))
+		ts.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		ts.Require().NoError(err)
+		defer resp.Body.Close()
+		var problem openapi.Error
+		ts.Require().NoError(json.NewDecoder(resp.Body).Decode(&problem))
+		ts.Require().Equal("application/problem+json", resp.Header.Get("Content-Type"))
+		ts.Require().Equal(int32(resp.StatusCode), problem.Status)
+		ts.Require().NotNil(problem.RequestId)
+		ts.Require().NotNil(problem.Instance)
+		ts.Require().Equal(path, *problem.Instance)
+		return resp, problem
+	}
+
+	ts.Run("Handler error", func() {
+		path := "/users/" + uuid.NewString()
+		resp, problem := do(http.MethodGet, path, "")
+		ts.Require().Equal(http.StatusNotFound, resp.StatusCode)
+		ts.Require().Equal("about:blank", problem.Type)
+		ts.Require().Equal("Not Found", problem.Title)
+		ts.Require().Equal("User not found", *problem.Detail)
+	})
+	ts.Run("Invalid path parameter", func() {
+		resp, problem := do(http.MethodGet, "/users/not-a-uuid", "")
+		ts.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+		ts.Require().Equal("Validation failed", problem.Title)
+		ts.Require().NotNil(problem.Details)
+		ts.Require().Equal("id", (*problem.Details)[0].Field)
+	})
+	ts.Run("Invalid body", func() {
+		resp, problem := do(http.MethodPost, "/users",
+			`{ "username": 1, "email": "jim@mail.com", "password": "s3cret-pass" }`)
+		ts.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+		ts.Require().Equal("Validation failed", problem.Title)
+		ts.Require().NotNil(problem.Details)
+		ts.Require().Equal("username", (*problem.Details)[0].Field)
+	})
+	ts.Run("Domain validation", func() {
+		resp, problem := do(http.MethodPost, "/users",
+			`{ "username": "jim", "email": "jim@localhost", "password": "s3cret-pass" }`)
+		ts.Require().Equal(http.StatusUnprocessableEntity, resp.StatusCode)
+		ts.Require().Equal("urn:twitter-clone:problem:validation", problem.Type)
+		ts.Require().Equal("invalid email", *problem.Detail)
+	})
+}
+
+func (ts *APITestSuite) TestParamErrorHandler() {
+	ctx := context.Background()
+
+	// Without the validator, parameters are checked by the generated router
+	var problem openapi.Error
+	statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets?limit=abc", &problem)
+	ts.Require().NoError(err)
+	ts.Require().Equal(http.StatusBadRequest, statusCode)
+	ts.Require().NotNil(problem.Details)
+	ts.Require().Equal("limit", (*problem.Details)[0].Field)
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+)
+
+// Names of the security schemes in openapi.yaml: AdminToken guards the admin
+// operations and BearerAuth those made on behalf of a user.
+const (
+	adminSecurityScheme  = "AdminToken"
+	bearerSecurityScheme = "BearerAuth"
+)
+
+var (
+	errInvalidAdminToken = errors.New("invalid admin token")
+	errUnauthenticated   = errors.New("not authenticated")
+	errNotOwner          = errors.New("acting on behalf of another user")
+)
+
+// userKey is the context key of the authenticated user.
+type userKey struct{}
+
+// authenticatedUser returns the user whose session token came with the
+// request, or nil.
+func authenticatedUser(ctx context.Context) *entities.User {
+	u, _ := ctx.Value(userKey{}).(*entities.User)
+	return u
+}
+
+// viewerID returns the ID of the authenticated user, or "" for anonymous
+// requests.
+func viewerID(ctx context.Context) string {
+	if u := authenticatedUser(ctx); u != nil {
+		return u.ID.String()
+	}
+	return ""
+}
+
+// bearerToken returns the token of an "Authorization: Bearer" header, and
+// whether the header is there at all.
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", false
+	}
+	scheme, token, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", true
+	}
+	return strings.TrimSpace(token), true
+}
+
+// SessionMiddleware returns a middleware that authenticates the requests
+// carrying a session token, making its user the one the handlers act on
+// behalf of. Requests without one go through anonymously, and those with an
+// invalid one are rejected with 401.
+func SessionMiddleware(logger *slog.Logger, sessions service.SessionService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			u, err := sessions.Authenticate(r.Context(), token)
+			if err != nil {
+				if errors.Is(err, entities.ErrInvalidToken) {
+					w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+					sendAPIError(logger, w, r, http.StatusUnauthorized, "Invalid session token", err)
+					return
+				}
+				sendAPIError(logger, w, r, http.StatusInternalServerError, "Error checking session token", err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey{}, u)))
+		})
+	}
+}
+
+// Authenticator returns an openapi3filter.AuthenticationFunc for the security
+// schemes of openapi.yaml. Admin operations need the given admin token, and an
+// empty one rejects every request, which disables them. Operations made on
+// behalf of a user need the session SessionMiddleware authenticated, so it
+// must run first.
+func Authenticator(adminToken string) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		switch input.SecuritySchemeName {
+		case adminSecurityScheme:
+			got := input.RequestValidationInput.Request.Header.Get(input.SecurityScheme.Name)
+			if adminToken == "" || subtle.ConstantTimeCompare([]byte(got), []byte(adminToken)) != 1 {
+				return errInvalidAdminToken
+			}
+			return nil
+		case bearerSecurityScheme:
+			if authenticatedUser(ctx) == nil {
+				return errUnauthenticated
+			}
+			return nil
+		default:
+			return fmt.Errorf("unsupported security scheme %q", input.SecuritySchemeName)
+		}
+	}
+}
+
+// actingUser returns the ID of the authenticated user, whom the request acts
+// on behalf of. Anonymous requests are answered with 401 and get false.
+func (t *twitterAPI) actingUser(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	u := authenticatedUser(r.Context())
+	if u == nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		sendAPIError(t.logger, w, r, http.StatusUnauthorized, "Authentication required", errUnauthenticated)
+		return uuid.Nil, false
+	}
+	return u.ID, true
+}
+
+// actingAs is actingUser for the operations on the resources of user id,
+// which only that user may act on: requests on behalf of anyone else are
+// answered with 403.
+func (t *twitterAPI) actingAs(w http.ResponseWriter, r *http.Request, id uuid.UUID) (uuid.UUID, bool) {
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return uuid.Nil, false
+	}
+	if userID != id {
+		sendAPIError(t.logger, w, r, http.StatusForbidden, "Forbidden", errNotOwner)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Bookmark a tweet
+// (POST /tweets/{id}/bookmark).
+func (t *twitterAPI) PostTweetsIdBookmark( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.bookmarkService.Bookmark(ctx, userID.String(), id.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+		case errors.Is(err, entities.ErrAlreadyBookmarked):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Tweet already bookmarked", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error bookmarking tweet", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Remove a bookmark
+// (DELETE /tweets/{id}/bookmark).
+func (t *twitterAPI) DeleteTweetsIdBookmark( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.bookmarkService.Unbookmark(ctx, userID.String(), id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotBookmarked) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not bookmarked", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error removing bookmark", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List the tweets a user bookmarked
+// (GET /users/{id}/bookmarks).
+func (t *twitterAPI) GetUsersIdBookmarks( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetUsersIdBookmarksParams,
+) {
+	ctx := r.Context()
+	viewerID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.bookmarkService.FindByUser(ctx, id.String(), viewerID.String(), cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Bookmarks are private", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing bookmarks", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, viewerID.String(), page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
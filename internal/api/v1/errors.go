@@ -1,27 +1,177 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/go-chi/chi/v5/middleware"
+	oapiMiddleware "github.com/oapi-codegen/nethttp-middleware"
 
 	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// problemContentType is the media type of RFC 7807 problem details.
+const problemContentType = "application/problem+json"
+
+// Problem types beyond plain HTTP errors, which use about:blank.
+const (
+	problemTypeBlank      = "about:blank"
+	problemTypeValidation = "urn:twitter-clone:problem:validation"
+	problemTypeConflict   = "urn:twitter-clone:problem:conflict"
 )
 
-// This function wraps sending of an error in the Error format, and
+// Codes reported in the details of requests rejected before reaching a handler.
+const (
+	codeInvalidParameter = "invalid_parameter"
+	codeInvalidBody      = "invalid_body"
+)
+
+// newProblem returns the problem details for a plain HTTP error on r.
+func newProblem(r *http.Request, code int, detail string) openapi.Error {
+	problem := openapi.Error{
+		Type:   problemTypeBlank,
+		Title:  http.StatusText(code),
+		Status: int32(code), //nolint:gosec // HTTP status codes safely fit in int32
+	}
+	if detail != "" {
+		problem.Detail = &detail
+	}
+	instance := r.URL.Path
+	problem.Instance = &instance
+	if id := middleware.GetReqID(r.Context()); id != "" {
+		problem.RequestId = &id
+	}
+	return problem
+}
+
+// writeProblem writes problem as an application/problem+json response.
+func writeProblem(w http.ResponseWriter, problem openapi.Error) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(int(problem.Status))
+	json.NewEncoder(w).Encode(problem) //nolint:errcheck,gosec //ignore error
+}
+
+// This function wraps sending of an error in the problem details format, and
 // handling the failure to marshal that.
+//
+// Domain validation failures are always answered with 422 and the offending
+// field listed in details, whatever code the caller asked for, so handlers do
+// not need a case for each validation rule.
 func sendAPIError(
 	logger *slog.Logger,
 	w http.ResponseWriter,
+	r *http.Request,
 	code int,
 	message string,
 	err error,
 ) {
-	logger.Error(message, "error", err)
-	apiErr := openapi.Error{
-		Code:    int32(code), //nolint:gosec // HTTP status codes safely fit in int32
-		Message: message,
+	var invalid *entities.ValidationError
+	if errors.As(err, &invalid) {
+		code = http.StatusUnprocessableEntity
+		message = invalid.Message
+	}
+	logger.Error(message, "error", err, "request_id", middleware.GetReqID(r.Context()))
+	problem := newProblem(r, code, message)
+	var conflict *entities.ConflictError
+	if errors.As(err, &conflict) {
+		problem.Type = problemTypeConflict
+		problem.Field = &conflict.Field
+	}
+	if invalid != nil {
+		problem.Type = problemTypeValidation
+		problem.Title = "Validation failed"
+		problem.Details = &[]openapi.ErrorDetail{{
+			Code:    invalid.Code,
+			Field:   invalid.Field,
+			Message: invalid.Message,
+		}}
+	}
+	writeProblem(w, problem)
+}
+
+// ValidationErrorHandler returns an error handler for the OpenAPI request
+// validator that renders its failures as problem details, listing the
+// offending parameter or body field in details when kin-openapi reports one.
+func ValidationErrorHandler(logger *slog.Logger) oapiMiddleware.ErrorHandlerWithOpts {
+	return func(
+		_ context.Context,
+		err error,
+		w http.ResponseWriter,
+		r *http.Request,
+		opts oapiMiddleware.ErrorHandlerOpts,
+	) {
+		logger.Info("request rejected by validator", "error", err, "request_id", middleware.GetReqID(r.Context()))
+		problem := newProblem(r, opts.StatusCode, err.Error())
+		if detail, ok := requestErrorDetail(err); ok {
+			problem.Type = problemTypeValidation
+			problem.Title = "Validation failed"
+			problem.Details = &[]openapi.ErrorDetail{detail}
+		}
+		writeProblem(w, problem)
+	}
+}
+
+// requestErrorDetail extracts the offending field from a kin-openapi request
+// validation error.
+func requestErrorDetail(err error) (openapi.ErrorDetail, bool) {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return openapi.ErrorDetail{}, false
+	}
+	if reqErr.Parameter != nil {
+		return openapi.ErrorDetail{
+			Code:    codeInvalidParameter,
+			Field:   reqErr.Parameter.Name,
+			Message: reqErr.Error(),
+		}, true
+	}
+	var schemaErr *openapi3.SchemaError
+	if reqErr.RequestBody != nil && errors.As(reqErr.Err, &schemaErr) {
+		return openapi.ErrorDetail{
+			Code:    codeInvalidBody,
+			Field:   strings.Join(schemaErr.JSONPointer(), "."),
+			Message: schemaErr.Reason,
+		}, true
+	}
+	return openapi.ErrorDetail{}, false
+}
+
+// ParamErrorHandler returns the handler for path and query parameters that
+// the generated router cannot bind, rendering them as problem details.
+func ParamErrorHandler(logger *slog.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		logger.Info("invalid request parameter", "error", err, "request_id", middleware.GetReqID(r.Context()))
+		problem := newProblem(r, http.StatusBadRequest, err.Error())
+		if field := paramName(err); field != "" {
+			problem.Type = problemTypeValidation
+			problem.Title = "Validation failed"
+			problem.Details = &[]openapi.ErrorDetail{{
+				Code:    codeInvalidParameter,
+				Field:   field,
+				Message: err.Error(),
+			}}
+		}
+		writeProblem(w, problem)
+	}
+}
+
+// paramName returns the name of the parameter a generated router error is
+// about, or "" if it is not about a single parameter.
+func paramName(err error) string {
+	var invalidFormat *openapi.InvalidParamFormatError
+	var required *openapi.RequiredParamError
+	switch {
+	case errors.As(err, &invalidFormat):
+		return invalidFormat.ParamName
+	case errors.As(err, &required):
+		return required.ParamName
 	}
-	w.WriteHeader(code)
-	json.NewEncoder(w).Encode(apiErr) //nolint:errcheck,gosec //ignore error
+	return ""
 }
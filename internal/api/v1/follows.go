@@ -0,0 +1,124 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Follow a user
+// (POST /users/{id}/follow).
+func (t *twitterAPI) PostUsersIdFollow( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.followService.Follow(ctx, userID.String(), id.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrSelfFollow):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Users cannot follow themselves", err)
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid follower ID", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrAlreadyFollowing):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Already following user", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error following user", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Unfollow a user
+// (DELETE /users/{id}/follow).
+func (t *twitterAPI) DeleteUsersIdFollow( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.followService.Unfollow(ctx, userID.String(), id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotFollowing) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Not following user", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error unfollowing user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List the followers of a user
+// (GET /users/{id}/followers).
+func (t *twitterAPI) GetUsersIdFollowers( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	users, err := t.followService.Followers(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing followers", err)
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
+
+// List the users followed by a user
+// (GET /users/{id}/following).
+func (t *twitterAPI) GetUsersIdFollowing( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	users, err := t.followService.Following(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing following", err)
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
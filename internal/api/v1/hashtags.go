@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// List the tweets tagged with a hashtag
+// (GET /hashtags/{tag}/tweets).
+func (t *twitterAPI) GetHashtagsTagTweets(
+	w http.ResponseWriter,
+	r *http.Request,
+	tag string,
+	params openapi.GetHashtagsTagTweetsParams,
+) {
+	ctx := r.Context()
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.tweetService.FindByHashtag(ctx, tag, viewerID(ctx), cursor, limit)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCursor) {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing tweets", err)
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, viewerID(ctx), page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
@@ -20,6 +21,7 @@ import (
 	api "github.com/ricleal/twitter-clone/internal/api/v1"
 	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
 	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/events"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/test"
 	"github.com/ricleal/twitter-clone/internal/service/store"
@@ -49,14 +51,24 @@ func (ts *APITestIntegrationSuite) SetupTest() {
 	require.NoError(ts.T(), err)
 
 	s := store.NewPersistentStore(ts.s.DB())
-	st := service.NewTweetService(s)
-	su := service.NewUserService(s)
+	b := events.NewBroker(100, 100)
+	st := service.NewTweetService(s, b)
+	su := service.NewUserService(s, testPasswordParams)
+	sf := service.NewFollowService(s, b)
+	stl := service.NewTimelineService(s)
+	sl := service.NewLikeService(s, b)
+	sb := service.NewBookmarkService(s)
+	sr := service.NewRelationshipService(s)
+	sm := service.NewMessageService(s, b)
+	sn := service.NewNotificationService(s)
+	ss := service.NewStreamService(s, b)
+	sessions := service.NewSessionService(s, []byte("test key"), time.Hour)
 
 	// set up our API
-	twitterAPI := api.New(nopLogger, su, st)
-	mux := http.NewServeMux()
-	openapi.HandlerFromMux(twitterAPI, mux)
-	ts.server = httptest.NewServer(mux)
+	twitterAPI := api.New(nopLogger, su, st, sf, stl, sl, sb, sr, sm, sn, ss, sessions)
+	ts.server = httptest.NewServer(openapi.HandlerWithOptions(twitterAPI, openapi.ChiServerOptions{
+		Middlewares: []openapi.MiddlewareFunc{api.SessionMiddleware(nopLogger, sessions)},
+	}))
 }
 
 func (ts *APITestIntegrationSuite) TearDownTest() {
@@ -78,7 +90,7 @@ func (ts *APITestIntegrationSuite) TestGetUsersEmpty() {
 func (ts *APITestIntegrationSuite) TestCreateAndGetUser() {
 	ctx := context.Background()
 
-	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com" }`
+	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com", "password": "s3cret-pass" }`
 	var userID string
 	ts.Run("Create user", func() {
 		var response struct{}
@@ -87,15 +99,15 @@ func (ts *APITestIntegrationSuite) TestCreateAndGetUser() {
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
 	ts.Run("Get users", func() {
-		var response []map[string]interface{}
+		var response openapi.UserPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 1)
-		ts.Require().Equal("foo", response[0]["username"])
-		ts.Require().Equal("John Doe", response[0]["name"])
-		ts.Require().Equal("jd@mail.com", response[0]["email"])
-		userID = response[0]["id"].(string) //nolint:errcheck,forcetypeassert  // this is a test
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("foo", response.Data[0].Username)
+		ts.Require().Equal("John Doe", *response.Data[0].Name)
+		ts.Require().Equal("jd@mail.com", string(response.Data[0].Email))
+		userID = response.Data[0].Id.String()
 	})
 	ts.Run("Get user", func() {
 		var response map[string]interface{}
@@ -117,8 +129,8 @@ func (ts *APITestIntegrationSuite) TestCreateAndGetUser() {
 func (ts *APITestIntegrationSuite) TestCreateAndGetTweets() {
 	ctx := context.Background()
 
-	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com" }`
-	var userID string
+	userStr := `{ "username": "foo", "name": "John Doe", "email": "jd@mail.com", "password": "s3cret-pass" }`
+	var auth map[string]string
 
 	ts.Run("Create user", func() {
 		var response struct{}
@@ -126,53 +138,53 @@ func (ts *APITestIntegrationSuite) TestCreateAndGetTweets() {
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
-	ts.Run("Get users", func() {
-		var response []map[string]interface{}
-		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
+	ts.Run("Log in", func() {
+		var response openapi.Session
+		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/auth/login",
+			`{ "username": "foo", "password": "s3cret-pass" }`, &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		userID = response[0]["id"].(string) //nolint:errcheck,forcetypeassert  // this is a test
+		auth = map[string]string{"Authorization": "Bearer " + response.Token}
 	})
 	ts.Run("Create tweet", func() {
-		tweetStr := `{ "user_id": "` + userID + `", "content": "Hello World" }`
+		tweetStr := `{ "content": "Hello World" }`
 		var response struct{}
-		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
-	ts.Run("Create tweet invalid user", func() {
-		// invalid user id
-		tweetStr := `{ "user_id": "` + uuid.NewString() + `", "content": "Hello World" }`
+	ts.Run("Create tweet anonymously", func() {
+		tweetStr := `{ "content": "Hello World" }`
 		var response struct{}
 		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
 		ts.Require().NoError(err)
-		ts.Require().Equal(http.StatusBadRequest, statusCode)
+		ts.Require().Equal(http.StatusUnauthorized, statusCode)
 	})
 	var tweetID string
 	ts.Run("Get tweets", func() {
-		var response []openapi.Tweet
+		var response openapi.TweetPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 1)
-		ts.Require().Equal("Hello World", response[0].Content)
-		tweetID = response[0].Id.String()
+		ts.Require().Len(response.Data, 1)
+		ts.Require().Equal("Hello World", response.Data[0].Content)
+		tweetID = response.Data[0].Id.String()
 	})
 	ts.Run("Create tweet 2", func() {
-		tweetStr := `{ "user_id": "` + userID + `", "content": "Hello World 2" }`
+		tweetStr := `{ "content": "Hello World 2" }`
 		var response struct{}
-		statusCode, err := testhelpers.Post(ctx, ts.server.URL+"/tweets", tweetStr, &response)
+		statusCode, err := testhelpers.PostWithHeaders(ctx, ts.server.URL+"/tweets", auth, tweetStr, &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusCreated, statusCode)
 	})
 	ts.Run("Get tweets", func() {
-		var response []openapi.Tweet
+		var response openapi.TweetPage
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/tweets", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusOK, statusCode)
-		ts.Require().Len(response, 2)
-		ts.Require().Equal("Hello World", response[0].Content)
-		ts.Require().Equal("Hello World 2", response[1].Content)
+		ts.Require().Len(response.Data, 2)
+		ts.Require().Equal("Hello World 2", response.Data[0].Content)
+		ts.Require().Equal("Hello World", response.Data[1].Content)
 	})
 	ts.Run("Get tweet", func() {
 		var response openapi.Tweet
@@ -200,7 +212,7 @@ func (ts *APITestIntegrationSuite) TestError() {
 		statusCode, err := testhelpers.Get(ctx, ts.server.URL+"/users", &response)
 		ts.Require().NoError(err)
 		ts.Require().Equal(http.StatusInternalServerError, statusCode)
-		ts.Require().Equal("Error listing users", response["message"])
-		ts.Require().Equal(float64(500), response["code"])
+		ts.Require().Equal("Error listing users", response["detail"])
+		ts.Require().Equal(float64(500), response["status"])
 	})
 }
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Like a tweet
+// (POST /tweets/{id}/like).
+func (t *twitterAPI) PostTweetsIdLike( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.likeService.Like(ctx, userID.String(), id.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+		case errors.Is(err, entities.ErrAlreadyLiked):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Tweet already liked", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error liking tweet", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Unlike a tweet
+// (DELETE /tweets/{id}/like).
+func (t *twitterAPI) DeleteTweetsIdLike( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.likeService.Unlike(ctx, userID.String(), id.String()); err != nil {
+		if errors.Is(err, entities.ErrNotLiked) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not liked", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error unliking tweet", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List the users who liked a tweet
+// (GET /tweets/{id}/likes).
+func (t *twitterAPI) GetTweetsIdLikes( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	users, err := t.likeService.Likers(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing likes", err)
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
@@ -0,0 +1,242 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Start a conversation
+// (POST /conversations).
+func (t *twitterAPI) PostConversations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	var newConversation openapi.NewConversation
+	if err := json.NewDecoder(r.Body).Decode(&newConversation); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for NewConversation", err)
+		return
+	}
+	memberIDs := make([]string, 0, len(newConversation.MemberIds))
+	for _, id := range newConversation.MemberIds {
+		memberIDs = append(memberIDs, id.String())
+	}
+
+	c, err := t.messageService.CreateConversation(ctx, userID.String(), memberIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error creating conversation", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toAPIConversation(*c)) //nolint:errcheck,gosec //ignore error
+}
+
+// List the conversations of a user
+// (GET /users/{id}/conversations).
+func (t *twitterAPI) GetUsersIdConversations( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetUsersIdConversationsParams,
+) {
+	ctx := r.Context()
+	viewerID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.messageService.FindConversations(ctx, id.String(), viewerID.String(), cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Conversations are private", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing conversations", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	conversations := make([]openapi.Conversation, 0, len(page.Items))
+	for _, c := range page.Items {
+		conversations = append(conversations, toAPIConversation(c))
+	}
+	json.NewEncoder(w).Encode(openapi.ConversationPage{ //nolint:errcheck,gosec //ignore error
+		Data:       conversations,
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// List the messages of a conversation
+// (GET /conversations/{id}/messages).
+func (t *twitterAPI) GetConversationsIdMessages( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetConversationsIdMessagesParams,
+) {
+	ctx := r.Context()
+	viewerID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.messageService.FindMessages(ctx, id.String(), viewerID.String(), cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Not a member of the conversation", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Conversation not found", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing messages", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	messages := make([]openapi.Message, 0, len(page.Items))
+	for _, m := range page.Items {
+		messages = append(messages, toAPIMessage(m))
+	}
+	json.NewEncoder(w).Encode(openapi.MessagePage{ //nolint:errcheck,gosec //ignore error
+		Data:       messages,
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// Send a message
+// (POST /conversations/{id}/messages).
+func (t *twitterAPI) PostConversationsIdMessages( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	var newMessage openapi.Message
+	if err := json.NewDecoder(r.Body).Decode(&newMessage); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid format for Message", err)
+		return
+	}
+	m := &entities.Message{
+		ConversationID: id,
+		SenderID:       userID,
+		Content:        newMessage.Content,
+	}
+
+	if err := t.messageService.Send(ctx, m); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Not a member of the conversation", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Conversation not found", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error sending message", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toAPIMessage(*m)) //nolint:errcheck,gosec //ignore error
+}
+
+// Mark a conversation read
+// (POST /conversations/{id}/read).
+func (t *twitterAPI) PostConversationsIdRead( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.messageService.MarkRead(ctx, id.String(), userID.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Not a member of the conversation", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Conversation not found", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error marking conversation read", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toAPIConversation converts an entities.Conversation to an openapi.Conversation.
+func toAPIConversation(c entities.Conversation) openapi.Conversation {
+	members := make([]openapi.ConversationMember, 0, len(c.Members))
+	for _, m := range c.Members {
+		members = append(members, openapi.ConversationMember{
+			UserId:      m.UserID,
+			Username:    m.Username,
+			UnreadCount: m.UnreadCount,
+		})
+	}
+	apiConversation := openapi.Conversation{
+		Id:        c.ID,
+		Members:   members,
+		CreatedAt: c.CreatedAt,
+	}
+	if c.LastMessage != nil {
+		last := toAPIMessage(*c.LastMessage)
+		apiConversation.LastMessage = &last
+	}
+	return apiConversation
+}
+
+// toAPIMessage converts an entities.Message to an openapi.Message.
+func toAPIMessage(m entities.Message) openapi.Message {
+	return openapi.Message{
+		Id:             &m.ID,
+		ConversationId: &m.ConversationID,
+		SenderId:       &m.SenderID,
+		Content:        m.Content,
+		CreatedAt:      &m.CreatedAt,
+	}
+}
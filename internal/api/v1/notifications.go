@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// List the notifications of a user
+// (GET /notifications).
+func (t *twitterAPI) GetNotifications(w http.ResponseWriter, r *http.Request, params openapi.GetNotificationsParams) {
+	ctx := r.Context()
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	var typ string
+	if params.Type != nil {
+		typ = string(*params.Type)
+	}
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.notificationService.FindByUser(ctx, userID.String(), typ, cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing notifications", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	notifications := make([]openapi.Notification, 0, len(page.Items))
+	for _, n := range page.Items {
+		notifications = append(notifications, toAPINotification(n))
+	}
+	json.NewEncoder(w).Encode(openapi.NotificationPage{ //nolint:errcheck,gosec //ignore error
+		Data:       notifications,
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// Mark notifications read
+// (POST /notifications/read).
+func (t *twitterAPI) PostNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	if err := t.notificationService.MarkRead(ctx, userID.String()); err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error marking notifications read", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Count unread notifications
+// (GET /notifications/unread-count).
+func (t *twitterAPI) GetNotificationsUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	count, err := t.notificationService.CountUnread(ctx, userID.String())
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting notifications", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.UnreadCount{Count: count}) //nolint:errcheck,gosec //ignore error
+}
+
+// toAPINotification converts an entities.Notification to an openapi.Notification.
+func toAPINotification(n entities.Notification) openapi.Notification {
+	actors := make([]openapi.NotificationActor, 0, len(n.Actors))
+	for _, a := range n.Actors {
+		actors = append(actors, openapi.NotificationActor{
+			UserId:   a.UserID,
+			Username: a.Username,
+		})
+	}
+	return openapi.Notification{
+		Id:         n.ID,
+		Type:       openapi.NotificationType(n.Type),
+		TweetId:    n.TweetID,
+		Actors:     actors,
+		ActorCount: n.ActorCount,
+		Read:       n.Read,
+		CreatedAt:  n.CreatedAt,
+	}
+}
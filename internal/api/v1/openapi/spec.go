@@ -18,17 +18,97 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RWT2/bPgz9KgZ/v6MQp83Nt60bigA79JCeimBQbTpVYUuqRK0zAn/3QZLt/HOatiuG",
-	"9BTFJMXHR/LZa8hVrZVESRayNdj8AWsejt+NUcYftFEaDQkMj3NVoP8t0OZGaBJKQhadk2BjUCpTc4IM",
-	"hKTZJTCgRmP8iys00DKo0Vq+OnpRbx5CLRkhV9C2DAw+OWGwgOwOuoS9+7JlsHhGpDHYklAGw96VDETh",
-	"Hw+onRPFYWYGzqL5+SrfA5Qx9+aK5RCi7h8xJ3/9rcURurHmotpJGZ+wd5cheY2jNHhwR4x7BQ2erENz",
-	"WI8PEbJU4TJBlbctngURmuTLzRwY/EJjY88vJtPJ1CNQGiXXAjKYTaaTGTDQnB4CDyn5vobjKvbXs8T9",
-	"1MwLyOAaaRE9PFSrlbSRwMvpdK//XOtK5CE0fbQeQT/3/iQI6xD4v8ESMvgv3WxI2q1HGoesHarmxvAm",
-	"Fr07zj+EpUSVSYc+2EvuKnoTppegxDUdSe0k/taYExYJdj4MrKtrbpoeGa+qLWha2RFmb5TdpvbJoaWv",
-	"qmg+rIKOzN0hI+OwPWjlxaFghOgkN8h9pdblOVpbuqpqzontq4Av4ZHtYOwmOl2Loj091vMibIPhNRIa",
-	"C9ndOBHzb+AXD7KwOtCvOwQt2KWXbdV8StCWf7lVr+z/WEUFEhfVWe3ONVJsZHLfeMZDO70ovqhPt8Hh",
-	"X8hTeJW8QZ0i9HMUpwHZcW3a0Prx0hSJfKcy+eDPI0wultoP8klZCrSfVqVAwicUpU3nR+rRRpWiwnOT",
-	"JLeFbVAmLwN8Nf7CiJ9jV5WS2H2UdY3pTNAu2z8BAAD//+uIQUAiDAAA",
+	"H4sIAAAAAAAC/+x9a2/bONbwXyG0LzAfXuXSThc7m37ZXuYSPO1M0abYBaZFlpaObU5l0kNSST2F//sD",
+	"nkNKlEzZTpqkdp58SyyRPOS536gvWaFmcyVBWpOdfMnmXPMZWND434taG6XdXyWYQou5FUpmJ9lvc/5n",
+	"DazAx8zyTyDZWKsZs1NgEj7bc/9IjfGnuYYLoWrD5nwCWZ4JN8mfNehFlmeSzyA7yWhElmemmMKMu0Xt",
+	"Yu6eGKuFnGTLZZ69EjNhV+F5zT+LWT1jsp6NAFcVFmaGWcU02FrLgTUrnC5esoQxryubnTw+zrMZTZud",
+	"PDp2/wnp/8sDZEJamIDOlstlmITOTckL0IYTeF+yuVZz0FYAPi00cAvlOcedjJWeub+ykls4sGIGWd7f",
+	"eZ6JsvNuXYsy9VrFjT2fgTHunE++ZP9Pwzg7yf521GL5yMN59Nq/tsyzGbhzM6sH+0pcAPNPAzKLaHM5",
+	"U7oEDSUbLVhtQLPTl+6s3elvWj8+pNe4RLZstsS15gtEuYY/a6GhzE5+z3DTAdg8PsiPzUg1+gMK66ZK",
+	"LLCCi1pq4OV5oWqZoKtfG3ryZ2qIzJWdgm7OxR0K/c2m3DCpLHOTZqtkkmfujM63RKZ7lwg1xQrxuYRZ",
+	"ozF5d2ubzueNJ5ju6ZTcIldcGZ+rmMyzSC6snvSLjrxwr6KseMr4yIC0TEl84Og7CJH1R4Kgp3b9o9Yp",
+	"AN5oNapgxkqwXFSGccNKGAtJpP32pxfsHz8c/yNnBvQFlIybD5LP55UocL9Hcxr+//8wSh5+kFneP0mc",
+	"dnXZHz/PKy5xDmbmUIixKJzYslNhmCqKWmuQBbSCFJdJUYsHPLEz0AdjAVXJLnglSlprzEVVazBuQ3i6",
+	"T46PGZcle/L4MdNg5koaMNuyMp7pS9piAvO4eoK7+KzZGAFop9yygtcGSvwV3Lw5g8PJIf5PmibQuAOb",
+	"syfH/0wKTGkslwUkzoPbaVjWUQwYu7Jwe84tl2pxoGEMiI/Uin4uz93dNU9f9lbMGa+MYkjbgmj7Pwdv",
+	"6dnBacmmwEvQqWWM5bZOoPmXs7M3jB6yQpUQwy6k/f5xUh5ZYavEGb2bKm2ZqWczrhc94mM4SwIy+qE/",
+	"1fu3p0yUIK0YL4ScrMyUMz5StT0ZVVx+YmOl2bziQjLcDxKAuQIaelIggIq7bM7uYxADLxuu7GloVSZ2",
+	"8poXUyHhwIlVPqocLrlR0tOnkMhe5wQpU5pZpc4rJSeps9qCJdR4DLJ0R0YvJ2aJNH2PGOoZly2g0cOG",
+	"4UgAbDzBQEoehLCiO8JXaiIS5s2cG3OpdFfHNT/218uzSy0s/CarRXZidQ1XVnxe3TUrpIT+a5B0Mv2D",
+	"eibZvxp54hmxUNKizhkzzuwlgJcP7h3DOMqfFQEPMoHO38ZjA5b9UaPWQjkDbGC53P1fTLnmhSXzZpVb",
+	"jeXaDi7jMfvdv767xtTXNUxW1Lh2R9eK6HGz542klrRiaMs5nm8asw0L9HkY974K45kzLjxcnpxzxi2b",
+	"KWPZo+Pj4+PkWbXbjw3ggSNznNeSdGqOzT7AxjmuubQBWQ7iesPoHsbaqfLmvNcg6QaMzMhp2VH78le4",
+	"XO/+katwLkqTshMaN6vjY8SG2Eb2XOtCRasPQP/epBwlmHlN2SxPvyTWHxDd+bfSDAHQtRriV2Wd7T2A",
+	"M15YpTd7iW5Rw0YwFZLsSBnPmhK7OHGCEM6mQAJJQxEE6uDMOZNwCcaysdDGbmu0xzt+5sBIMVVXTvVg",
+	"FK2A9wDEUIVHE63qeZanhdyAUBuynq+yzBB7oG/e0s9IqQo4eaxO1yft9zNvBXTPnQnjjVd8VIlPUDrD",
+	"TwPOBCVZD7n7jWz/ebXI2YyMEffrn7WywIQ1UI0bWeRs4LGqKnVpPshtdhQMb5AuPPV7RoOzPHMQoVCf",
+	"Vws03qQnRA9hlmcIQfZxZdZU5MVb055m8w5X+HPdGJRZpbrVmMxdxUg2wXcD+iqeboeV1jswJin44PNc",
+	"aDBXilVa9QkSpvZz4Bo0w6fMou9bBkP1WW2nSou/iK2Gnd/a66Z1R476q79zgimP9+NnSx3H2TQIie5p",
+	"OEbyf3b39pYeUOQGGravyitLZb90glRw0o3D8aWV3XtmD/An9xymv4YZjfM/ZTCb2wWKLy9ftjWfh8R9",
+	"5HyhH+CjMyjPnJEuUuLxTmzvEiq4Hft99TV5jhs+t+p8s4YShilJR0QEuY0C+SRk2cl+NBQTVEpLQZsU",
+	"B2mdbW2ly6nyerPB9/DRRXYTjlmd/t9TQLPZTcZrO3UKr3DIxuW2XykyCrzSTDD9e9yBfw5lyunG7Ijb",
+	"LZ/PgWtOMastPR0ZlMYAkI1kQFRsPvHKJXPwXdNhsK0OvIm5ledXEUSrA9dQb2s3BdOofMqCHOvIFQxV",
+	"01a2M/lo6e2OqFnkyodUz8uvliyR9TMkFjlqy7yJrCAXeanWgfjrvPsg+luYBvXGDVhKDcHsqIn0HtNp",
+	"LwIF9bWk/3klMdw/0qFkXNrr3hVVdQXvf0utNhgkuCkeumaYYAg3N0DhZJjuLoEb0O/x8B0UvCyFA4FX",
+	"b6Idj3llIL+F0FCMsZmQr0BO7DQutYh204McQ5pFrYVdvHMnTUA9K2dCngVPBIs/GreCFsr+c4AvHZx5",
+	"3yDgZS7+BxAx5LE43yShsdwgShEYcp58qQkljI+cjD6qXIbkkP02B43mrmG8sC6jo6SL5fBqTDOgEHfJ",
+	"TYfLGdUYCPuUqXbg5RQ0MGFdzEHNCTNunPsJy1zmSlt2Gdk/KYuHFKdVrAJ+AUzVNn7WRrAqVXwScpLT",
+	"X7QlpdmstvS3A/OQPZMh6+UekmtVegdPGKbBIQhKdinslD05fsTgAmRyJ5QyR0ZB8wsPvkXJ1No5FdkI",
+	"OVZIQJS3zM4uhbWg2bM3p1meOb+C0PPo8Pjw2OFQzUHyuchOsu8Pjw+/xzCgnSKJHHGH/iPa/JEXk+7B",
+	"BBJGwjs1tgf+JX9geRyiqxYsPEWH7zDLswZ/p2V2kv0MlqgSB7/06+VZm24/+ZI9Pj7uuV1xmYErL3C/",
+	"tQVLX6Nbl8u8t8lXwqBT190naRHvGgzCFpdAdGHcWDqQAqWW8HlO9AP+nZbRs5Pfuyz++8flxzzzCeuw",
+	"kf4ulnkP519EuTzSYKzSJNyVwW118fZGmRhxp+VbPyLvlMv9njZqqSBKYqTbTlvp4w2yIJhJk7VntkGB",
+	"Lj+u0M2TYbMawS2ZqYsCjBnXVbXYQ5z6Y2e8i9gYryi+jkaLg0YnTSCB0cCJ6ME9X/zYJAjWYBNfYrws",
+	"NRjTy2umaguDDtwCxQPqcvnxK2XDFlGyFRS530MR1B7SyM9AuRKnpOhYV6jjSoIeh1xTziN13aWYTxuY",
+	"m6U8bnLfhbzfRB/bVxTxiLOtJfz7tuL12wh4BOD+yvc6hPIjczpGYs9VmkLxicpxQ6o3Mq+d4UsWumlt",
+	"9hPG2aiXl4jjhnHJnmEzXoJ3uIQOBrwzbnMqrWS1tKJypq1PMxyyNx4Q40qDp8EWVrVFJ5e11MW4div5",
+	"t5QMyCTLOEGttZ1SAVZTefhclYsb0w8093K57NPw8haVUkhDJUjrhQYsIuSuNrcoYG4BsyRPjh/dHX2f",
+	"em+nLW/SDantEMO1AlJNXBQaaY63UDtWaMF27BXnZMwwh72zXDsfks21uHDsEY9jI4ybyqHgu1u1njsO",
+	"e/TPbnXLIXvfcTvdscaOp3Qg04iCS6ksG2H1HO+ub9UEvd8hlnnR2eTtsE2/+mcrBnp0Y8uvrt3jovi8",
+	"QsR4D9RGHInpqw0kyh4tJKiaLIHQxzFo/fkCs4Ful7jOJkfCdDE3xt36VszgkLm4ZNMZUnCJrSBuplnO",
+	"DGWHC15Vjvhrg4TcZRWO+bsUCf8MXQo+LQOsm8yUDtZvyVzJ07hv4TryzWRbvEldXrfq/sQViQmKdL/H",
+	"bT97yBZoHEc1roZsoaLXpTMg6EGWTs77saGuIR7cjWGmZX6OmalA6I5dsDXPNAzidILrmXIDiVPgAvTC",
+	"Tp0WIGUh7FbyfLe44ePtaJem+vVutUpn2aS4pEaWfVckIMuW5gdVSFMalOSc11x/MkTFDfckVAkR+2hx",
+	"TcbZkiXeUknebrDDJl+2A8iMa2f94SntOVk5iuibqlTj5QjMOXyWT8zRF8snSx+YHrROKALtiMO0fSqh",
+	"QgVpyc+3jalyhh11E5eHmXFbBO/UJYUcqVXAsfvou799553nCddl5aOfBTdDVsovfktnfHIWqsDWkuAv",
+	"AWZyjvUQGGnatHyyljhn/HNIJz7+4XjvLZe23mKN3bLTeZses+RfBgwX2oQj0UkInPBA38Q8cTH2MNPE",
+	"NbhIu764OsfsqMlDsVxTmY0/rRQabcNSP4WZ3TCcncVVS14RGOeE+xpRrn3pOhaQWYXTxvtilTA2NE7G",
+	"LQFUhn3IutsbLboRUbc+1qj3smG4cAVj64JR3m1J66LvDFOXsgOTHy2MhXJACHSg2iQAcHU3XW8VPC9h",
+	"QsdpKs3iH7VEe7NF8PslG1aK19eIiC7v7LF/s0IyPIobdx5ewXRLdZgkYlpuwsOkJdahf2+HbbaBurx8",
+	"34ygLqZaG6iLJLq34qApqZvA2pJNej0lONKGNSdxy3B+J1fXRlw6CIlLAG8zNRstk8rQrm6YNrOHZIGb",
+	"TKKQKMMA18V0k1X8U11VB9aVxtH7TF34GqiolRtLe2miQ/ZvTMmgf8WryldnP2UfSBOUbD7V3ID5kPl3",
+	"8DkVeGNvfwn6KTvADJPT9AcfMhrxIWPwuajqMiz1FIu5Tjqh9785o1uDUzKFjQp6QzTE2TcD5PgO97ed",
+	"VU3vsqAvU8rzz20N57/7C5DW1eWtKPVQ+045NFNX7jioztGxXLUg88k/CuXzeLjurwUr3Im58xsA3yg9",
+	"cHtTpqGCC19uHyyC+De0oYrFPdD5994f8HQcF3F5sUAp/2Gp8NdfixWJEDiRLHv6K0gHX+xhVQWak9Sw",
+	"i7kyORuBseQk+6KPdeyJyay75M5HW3HnPhF1U3G9hqZ3rWqlT7BRQYqxGvhsuOII9AXog3dOU/144WBg",
+	"NKLTgWKahB3WB0t1yVxKihv2X3z+Xyyvda9xrZurds68mynLHDtouExaRbSQ0F0lTLPHPyUXEZpFNssh",
+	"O7tGOTG7EHAJuueW/ojGOC6JeQIumcCeoEJJCVRLjYEBgdcoveLGHuAJHpy+RLeeLygs5qGeCWOgZEbI",
+	"AnBz7n4eRgkIutpLM2NFVbFPAHPj65xfVAJHW6WYqdQlswqfuwyFg7cUxsMDZA+YqaqrsgVzSFwQWWyQ",
+	"FG3fDxb502FoKEBcQJlT8bepe5qyX+veOZm1lyxuZmtnaR0hGActYQ9PuMI+7xraJqzsqVqiXaDiCPtw",
+	"vL5dBJeqfXy/wMa4Uop4hozAB9tl12KZzsNoNzFcgNhg9DZSh6ExfNvEYaqQ/J4UjLzAbYS7vWKuxQQf",
+	"7b4CC+lrWfBVlzQxcaGwE/uu3GMEbT3maIHq1pU8+girsCY4e+5lGjyQ4qbK4dBzsC/NBuE89pxG6PBb",
+	"GskHGwl2FEHHtyE50gjf5V6BDfLZtQsQP48WDjd9WXA0UuqTC8N2hcI6Rn0eRmxt1oU1moaWb8bCAXam",
+	"YaYu9p+L3+I2XI13QMoWCvg6KCQasqpd6G5wOKiqI5racxw2NDmkr48wwbc1f76idOBVEVtLn0f8ptqV",
+	"oNh7nL7HbcTadTNTXhNvd4i1QWa8Fzh71cFYigfNun7LGI1mr22lW2nFW+0zRKrxd4ftYmuJiK4zju9x",
+	"GiQR21yqlg6MNFzrXCms/KLLeHxtTglz66PvFPIzYlQJOTGda9YOWXQV27qKl3RELlCpv4Vta3GjlbK3",
+	"aLzlw+lvugutgguoNn/vBM8wnTF7FH/v5O+bPndyq/7G1FcGLPMUifine+ttNPfXWQ0Q3TFODLM+pUVt",
+	"Ul8fOxzIUD1kiO5SeLqwYAPhQEcrRqrCnfOhBDJubv0B++ke/xBdWU41CqUC+hSN2zD3ufWQ/PTRKKqm",
+	"bD7vEKYVhlEA65A918CxI6+YcjmBk+6LI8AMfXND2+XUf53DuIKUyjfIswq7EJ1k9n20obwXG/s09C+K",
+	"efyY6if9TD7oOIKx0kCdIlN3eY1UEkJP1SI0BtJarnxeFFNfyxU+8hHad1du3nGpNAlQDpbVB365pY7B",
+	"9i6Ga4Rm3eBdj8yuhl7bCr3myo5AnEdfwl/LtYLQZ/E1NNXriQr1Qen3fBHmeB9/Q2JDs79/L6Hho4vE",
+	"hvX8wwUfm0kkvsGjOdSIUrYJ0eP4K0foSbu6V7A4icZhCthAdQFmOEjvb43Yj+si7luEvvau1pDnuZPI",
+	"uSM+n2s1FhXsNJ97GEME3qHJFokb95oWBfwWkWFzDfHns0aqXKA2IFOlXOFnuljS16u4jga/cFLpOxB2",
+	"hXBu3uaIrnq84xs+Bql1XrZV9btHtVsHNonGeG8fXe11hFVQw17eq6YWsb3HkYb0L6IK5VQ+9HE2paYc",
+	"p/rCDR3YQY0dPKBPtrp+IKw5ECLxXPGc9rDHQvVW4ngBI7t8pdZWXTVEfzymvgE6dp2reoIXey83p2E6",
+	"5HOGA0+vEGhrrruuJa3+tCHkdMPH3cTjegDaBr6hllV/YHdk8nlg7kPiCDcSWX3DiaOborTdprNvQGVD",
+	"QYh7QmPPOxTWF3k+G71Be4fW5UZ9N+n4FRXePFnXVxyS4BTviHR7Y83esHZvtvkNFfxDI84uGgYhmdYn",
+	"7BVWWblTLskunRtLWoYRBm9i8VmuPtPwwjouG2SYnAEvpqEzwFDhfLiNhfsv6PmuQ2yd9MXoOlzddbe2",
+	"dP9iugeOu/ptd5sYr0uNe8x/nY30290j9vP3H2xrkNPNFVc0j9DOHYeR3zKoGcC4DyYu7eQqNu51kXen",
+	"qBsyG+8L4n7qoi3Jjt3k/pA++Kl59yG80g2vtKe4yyVRDZQbxbM76m3pQcjJAz2k6aHc8VKPNqDWAOwy",
+	"j2nKiD84eM1L4Kin9/qlQe4yTflgjT40M651AT3J4R06A6RcW7hangNH9N096hP/BkmO17WFByXcF7qE",
+	"jvuV4SBKTdLvtfIbSDlfld7AtXc3u+HA25XkBhHk3vt9bhtX8fq+lsZ2msLunr6G/NN7QV2v62TZJTVm",
+	"iBlUQsLGOyuwcb29saX5qkcrTbEUl2zcLezPtbr3LED1YIHebwt0pT1iqmbAAlGucaG3vGklQbVf5xtt",
+	"d/3eA13+3/WMYpp1hjWfpLsc6du1LypHf/QFW08f/lG2/Lj83wEAn8stJaKbAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,35 +4,530 @@
 package openapi
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Error defines model for Error.
+const (
+	AdminTokenScopes = "AdminToken.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationTypeFollow  NotificationType = "follow"
+	NotificationTypeLike    NotificationType = "like"
+	NotificationTypeMention NotificationType = "mention"
+	NotificationTypeQuote   NotificationType = "quote"
+	NotificationTypeReply   NotificationType = "reply"
+	NotificationTypeRetweet NotificationType = "retweet"
+)
+
+// Valid indicates whether the value is a known member of the NotificationType enum.
+func (e NotificationType) Valid() bool {
+	switch e {
+	case NotificationTypeFollow:
+		return true
+	case NotificationTypeLike:
+		return true
+	case NotificationTypeMention:
+		return true
+	case NotificationTypeQuote:
+		return true
+	case NotificationTypeReply:
+		return true
+	case NotificationTypeRetweet:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for TweetKind.
+const (
+	TweetKindQuote   TweetKind = "quote"
+	TweetKindRetweet TweetKind = "retweet"
+	TweetKindTweet   TweetKind = "tweet"
+)
+
+// Valid indicates whether the value is a known member of the TweetKind enum.
+func (e TweetKind) Valid() bool {
+	switch e {
+	case TweetKindQuote:
+		return true
+	case TweetKindRetweet:
+		return true
+	case TweetKindTweet:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetNotificationsParamsType.
+const (
+	GetNotificationsParamsTypeFollow  GetNotificationsParamsType = "follow"
+	GetNotificationsParamsTypeLike    GetNotificationsParamsType = "like"
+	GetNotificationsParamsTypeMention GetNotificationsParamsType = "mention"
+	GetNotificationsParamsTypeQuote   GetNotificationsParamsType = "quote"
+	GetNotificationsParamsTypeReply   GetNotificationsParamsType = "reply"
+	GetNotificationsParamsTypeRetweet GetNotificationsParamsType = "retweet"
+)
+
+// Valid indicates whether the value is a known member of the GetNotificationsParamsType enum.
+func (e GetNotificationsParamsType) Valid() bool {
+	switch e {
+	case GetNotificationsParamsTypeFollow:
+		return true
+	case GetNotificationsParamsTypeLike:
+		return true
+	case GetNotificationsParamsTypeMention:
+		return true
+	case GetNotificationsParamsTypeQuote:
+		return true
+	case GetNotificationsParamsTypeReply:
+		return true
+	case GetNotificationsParamsTypeRetweet:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetSearchTweetsParamsSort.
+const (
+	Recency   GetSearchTweetsParamsSort = "recency"
+	Relevance GetSearchTweetsParamsSort = "relevance"
+)
+
+// Valid indicates whether the value is a known member of the GetSearchTweetsParamsSort enum.
+func (e GetSearchTweetsParamsSort) Valid() bool {
+	switch e {
+	case Recency:
+		return true
+	case Relevance:
+		return true
+	default:
+		return false
+	}
+}
+
+// Conversation defines model for Conversation.
+type Conversation struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+	LastMessage *Message           `json:"last_message,omitempty"`
+
+	// Members Live members of the conversation, ordered by user ID
+	Members []ConversationMember `json:"members"`
+}
+
+// ConversationMember defines model for ConversationMember.
+type ConversationMember struct {
+	// UnreadCount Number of messages from other members the member has not read
+	UnreadCount int                `json:"unread_count"`
+	UserId      openapi_types.UUID `json:"user_id"`
+	Username    string             `json:"username"`
+}
+
+// ConversationPage defines model for ConversationPage.
+type ConversationPage struct {
+	Data []Conversation `json:"data"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Error Problem details as defined by RFC 7807, served as
+// application/problem+json.
 type Error struct {
-	// Code Error code
-	Code int32 `json:"code"`
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Details Per-field validation failures, set on 400 and 422 responses
+	Details *[]ErrorDetail `json:"details,omitempty"`
+
+	// Field Name of the field that caused the error, e.g. the taken username on a 409
+	Field *string `json:"field,omitempty"`
 
-	// Message Error message
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId ID of the request, also sent in the X-Request-Id header
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code
+	Status int32 `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI identifying the problem type, about:blank for plain HTTP errors
+	Type string `json:"type"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Code Machine-readable reason, e.g. invalid_format or too_long
+	Code string `json:"code"`
+
+	// Field Name of the offending field
+	Field string `json:"field"`
+
+	// Message Human-readable description of the failure
 	Message string `json:"message"`
 }
 
+// Login defines model for Login.
+type Login struct {
+	Password *string `json:"password,omitempty"`
+	Username string  `json:"username"`
+}
+
+// Mention An @username in the content of a tweet that names a user
+type Mention struct {
+	// End Offset just past the username in the content, in characters
+	End int `json:"end"`
+
+	// Start Offset of the '@' in the content, in characters
+	Start  int                `json:"start"`
+	UserId openapi_types.UUID `json:"user_id"`
+
+	// Username Current username of the user
+	Username string `json:"username"`
+}
+
+// Message defines model for Message.
+type Message struct {
+	// Content Text of the message, at most 10000 characters
+	Content        string              `json:"content"`
+	ConversationId *openapi_types.UUID `json:"conversation_id,omitempty"`
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+	SenderId       *openapi_types.UUID `json:"sender_id,omitempty"`
+}
+
+// MessagePage defines model for MessagePage.
+type MessagePage struct {
+	Data []Message `json:"data"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// NewConversation defines model for NewConversation.
+type NewConversation struct {
+	// MemberIds IDs of the other members
+	MemberIds []openapi_types.UUID `json:"member_ids"`
+}
+
+// NewUser defines model for NewUser.
+type NewUser struct {
+	Email    openapi_types.Email `json:"email"`
+	Name     *string             `json:"name,omitempty"`
+	Password *string             `json:"password,omitempty"`
+	Username string              `json:"username"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// ActorCount Number of users behind the notification
+	ActorCount int `json:"actor_count"`
+
+	// Actors The most recent users behind the notification, newest first
+	Actors []NotificationActor `json:"actors"`
+
+	// CreatedAt Time of the newest notification of the group
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id ID of the newest notification of the group
+	Id   openapi_types.UUID `json:"id"`
+	Read bool               `json:"read"`
+
+	// TweetId Tweet the notification is about: the liked or retweeted tweet, or the reply, mention or quote itself; absent for follows
+	TweetId *openapi_types.UUID `json:"tweet_id,omitempty"`
+	Type    NotificationType    `json:"type"`
+}
+
+// NotificationType defines model for Notification.Type.
+type NotificationType string
+
+// NotificationActor defines model for NotificationActor.
+type NotificationActor struct {
+	UserId   openapi_types.UUID `json:"user_id"`
+	Username string             `json:"username"`
+}
+
+// NotificationPage defines model for NotificationPage.
+type NotificationPage struct {
+	Data []Notification `json:"data"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Token Bearer token to send in the Authorization header
+	Token string `json:"token"`
+	User  User   `json:"user"`
+}
+
+// Thread defines model for Thread.
+type Thread struct {
+	// Replies Replies to the tweet, oldest first
+	Replies []Thread `json:"replies"`
+	Tweet   Tweet    `json:"tweet"`
+}
+
 // Tweet defines model for Tweet.
 type Tweet struct {
-	Content string              `json:"content"`
-	Id      *openapi_types.UUID `json:"id,omitempty"`
-	UserId  openapi_types.UUID  `json:"user_id"`
+	// Content Text of the tweet; empty for retweets
+	Content string `json:"content"`
+
+	// ConversationId ID of the tweet that started the reply chain
+	ConversationId *openapi_types.UUID `json:"conversation_id,omitempty"`
+	CreatedAt      *time.Time          `json:"created_at,omitempty"`
+	DeletedAt      *time.Time          `json:"deleted_at,omitempty"`
+	Id             *openapi_types.UUID `json:"id,omitempty"`
+
+	// InReplyToTweetId Tweet this one replies to
+	InReplyToTweetId *openapi_types.UUID `json:"in_reply_to_tweet_id,omitempty"`
+	Kind             *TweetKind          `json:"kind,omitempty"`
+
+	// LikeCount Number of users who liked the tweet
+	LikeCount *int `json:"like_count,omitempty"`
+
+	// Liked Whether the authenticated user liked the tweet
+	Liked *bool `json:"liked,omitempty"`
+
+	// Mentions Users mentioned in the content, in order of appearance
+	Mentions *[]Mention `json:"mentions,omitempty"`
+
+	// QuoteCount Number of live quotes of the tweet
+	QuoteCount      *int   `json:"quote_count,omitempty"`
+	ReferencedTweet *Tweet `json:"referenced_tweet,omitempty"`
+
+	// ReferencedTweetId Tweet retweeted or quoted; required for retweets and quotes
+	ReferencedTweetId *openapi_types.UUID `json:"referenced_tweet_id,omitempty"`
+
+	// RetweetCount Number of live retweets of the tweet
+	RetweetCount *int       `json:"retweet_count,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+
+	// UserId ID of the author, the user who created the tweet
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// TweetKind defines model for Tweet.Kind.
+type TweetKind string
+
+// TweetPage defines model for TweetPage.
+type TweetPage struct {
+	Data []Tweet `json:"data"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// UnreadCount defines model for UnreadCount.
+type UnreadCount struct {
+	Count int `json:"count"`
 }
 
 // User defines model for User.
 type User struct {
-	Email    openapi_types.Email `json:"email"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	Name     *string             `json:"name,omitempty"`
-	Username string              `json:"username"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	DeletedAt *time.Time          `json:"deleted_at,omitempty"`
+	Email     openapi_types.Email `json:"email"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+	Username  string              `json:"username"`
+}
+
+// UserPage defines model for UserPage.
+type UserPage struct {
+	Data []User `json:"data"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// UserUpdate defines model for UserUpdate.
+type UserUpdate struct {
+	Email    *openapi_types.Email `json:"email,omitempty"`
+	Name     *string              `json:"name,omitempty"`
+	Username *string              `json:"username,omitempty"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// GetAdminUsersByEmailParams defines parameters for GetAdminUsersByEmail.
+type GetAdminUsersByEmailParams struct {
+	// Email Email address of the user
+	Email openapi_types.Email `form:"email" json:"email"`
+}
+
+// GetConversationsIdMessagesParams defines parameters for GetConversationsIdMessages.
+type GetConversationsIdMessagesParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetHashtagsTagTweetsParams defines parameters for GetHashtagsTagTweets.
+type GetHashtagsTagTweetsParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Type Only list notifications of this type
+	Type *GetNotificationsParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetNotificationsParamsType defines parameters for GetNotifications.
+type GetNotificationsParamsType string
+
+// GetSearchTweetsParams defines parameters for GetSearchTweets.
+type GetSearchTweetsParams struct {
+	// Q Search query
+	Q string `form:"q" json:"q"`
+
+	// Sort Order of the results; cursors only page results in the order they came from
+	Sort *GetSearchTweetsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSearchTweetsParamsSort defines parameters for GetSearchTweets.
+type GetSearchTweetsParamsSort string
+
+// GetSearchUsersParams defines parameters for GetSearchUsers.
+type GetSearchUsersParams struct {
+	// Q Search query
+	Q string `form:"q" json:"q"`
+
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetStreamParams defines parameters for GetStream.
+type GetStreamParams struct {
+	// LastEventID ID of the last event received, to resume from
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
+// GetTweetsParams defines parameters for GetTweets.
+type GetTweetsParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTweetsIdThreadParams defines parameters for GetTweetsIdThread.
+type GetTweetsIdThreadParams struct {
+	// Depth Number of reply levels to return
+	Depth *int `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdBookmarksParams defines parameters for GetUsersIdBookmarks.
+type GetUsersIdBookmarksParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdConversationsParams defines parameters for GetUsersIdConversations.
+type GetUsersIdConversationsParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdMentionsParams defines parameters for GetUsersIdMentions.
+type GetUsersIdMentionsParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdTimelineParams defines parameters for GetUsersIdTimeline.
+type GetUsersIdTimelineParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersIdTweetsParams defines parameters for GetUsersIdTweets.
+type GetUsersIdTweetsParams struct {
+	// Cursor Opaque cursor taken from the next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
+type PostAuthLoginJSONRequestBody = Login
+
+// PostConversationsJSONRequestBody defines body for PostConversations for application/json ContentType.
+type PostConversationsJSONRequestBody = NewConversation
+
+// PostConversationsIdMessagesJSONRequestBody defines body for PostConversationsIdMessages for application/json ContentType.
+type PostConversationsIdMessagesJSONRequestBody = Message
+
 // PostTweetsJSONRequestBody defines body for PostTweets for application/json ContentType.
 type PostTweetsJSONRequestBody = Tweet
 
 // PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
-type PostUsersJSONRequestBody = User
+type PostUsersJSONRequestBody = NewUser
+
+// PatchUsersIdJSONRequestBody defines body for PatchUsersId for application/json ContentType.
+type PatchUsersIdJSONRequestBody = UserUpdate
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// List the users a user blocks
+// (GET /users/{id}/blocks).
+func (t *twitterAPI) GetUsersIdBlocks( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+	viewerID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := t.relationService.Blocked(ctx, id.String(), viewerID.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked users are private", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing blocked users", err)
+		}
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
+
+// Block a user
+// (POST /users/{id}/blocks/{target_id}).
+func (t *twitterAPI) PostUsersIdBlocksTargetId( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	targetID uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	if err := t.relationService.Block(ctx, id.String(), targetID.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrSelfBlock):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Users cannot block themselves", err)
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrAlreadyBlocked):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Already blocking user", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error blocking user", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Unblock a user
+// (DELETE /users/{id}/blocks/{target_id}).
+func (t *twitterAPI) DeleteUsersIdBlocksTargetId( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	targetID uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	if err := t.relationService.Unblock(ctx, id.String(), targetID.String()); err != nil {
+		if errors.Is(err, entities.ErrNotBlocked) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Not blocking user", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error unblocking user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List the users a user mutes
+// (GET /users/{id}/mutes).
+func (t *twitterAPI) GetUsersIdMutes( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+) {
+	ctx := r.Context()
+	viewerID, ok := t.actingUser(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := t.relationService.Muted(ctx, id.String(), viewerID.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrForbidden):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Muted users are private", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing muted users", err)
+		}
+		return
+	}
+	if len(users) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIUsers(users)) //nolint:errcheck,gosec //ignore error
+}
+
+// Mute a user
+// (POST /users/{id}/mutes/{target_id}).
+func (t *twitterAPI) PostUsersIdMutesTargetId( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	targetID uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	if err := t.relationService.Mute(ctx, id.String(), targetID.String()); err != nil {
+		switch {
+		case errors.Is(err, entities.ErrSelfBlock):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Users cannot mute themselves", err)
+		case errors.Is(err, entities.ErrInvalidUserID):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrAlreadyMuted):
+			sendAPIError(t.logger, w, r, http.StatusConflict, "Already muting user", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error muting user", err)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Unmute a user
+// (DELETE /users/{id}/mutes/{target_id}).
+func (t *twitterAPI) DeleteUsersIdMutesTargetId( //nolint:revive,staticcheck // generated method name
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	targetID uuid.UUID,
+) {
+	ctx := r.Context()
+
+	if _, ok := t.actingAs(w, r, id); !ok {
+		return
+	}
+
+	if err := t.relationService.Unmute(ctx, id.String(), targetID.String()); err != nil {
+		if errors.Is(err, entities.ErrNotMuted) {
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Not muting user", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error unmuting user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Search tweets
+// (GET /search/tweets).
+func (t *twitterAPI) GetSearchTweets(w http.ResponseWriter, r *http.Request, params openapi.GetSearchTweetsParams) {
+	ctx := r.Context()
+
+	var order entities.SearchOrder
+	if params.Sort != nil {
+		order = entities.SearchOrder(*params.Sort)
+	}
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.tweetService.Search(ctx, params.Q, order, viewerID(ctx), cursor, limit)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCursor) {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error searching tweets", err)
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, viewerID(ctx), page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// Search users
+// (GET /search/users).
+func (t *twitterAPI) GetSearchUsers(w http.ResponseWriter, r *http.Request, params openapi.GetSearchUsersParams) {
+	ctx := r.Context()
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.userService.Search(ctx, params.Q, cursor, limit)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidCursor) {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+			return
+		}
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error searching users", err)
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.UserPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPIUsers(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
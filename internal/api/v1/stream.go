@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+)
+
+// streamKeepAlive is how often an idle stream gets a comment line, so that
+// proxies keep it open and a gone client is noticed.
+const streamKeepAlive = 15 * time.Second
+
+// Stream live events
+// (GET /stream).
+func (t *twitterAPI) GetStream(w http.ResponseWriter, r *http.Request, params openapi.GetStreamParams) {
+	ctx := r.Context()
+
+	var lastEventID uint64
+	if params.LastEventID != nil {
+		id, err := strconv.ParseUint(*params.LastEventID, 10, 64)
+		if err != nil {
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid Last-Event-ID", err)
+			return
+		}
+		lastEventID = id
+	}
+	viewer := viewerID(ctx)
+	sub, missed, err := t.streamService.Subscribe(ctx, viewer, lastEventID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, events.ErrClosed):
+			sendAPIError(t.logger, w, r, http.StatusServiceUnavailable, "Server shutting down", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error subscribing to events", err)
+		}
+		return
+	}
+	defer sub.Close()
+
+	// the server's write timeout is meant for plain requests, not streams
+	rc := http.NewResponseController(w)
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error starting stream", err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for _, e := range missed {
+		if err = t.writeEvent(ctx, w, viewer, e); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = rc.Flush()
+	}
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+	for err == nil {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				// the client fell behind, or the server is shutting down
+				return
+			}
+			err = t.writeEvent(ctx, w, viewer, e)
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+	}
+	t.logger.Info("stream ended", "error", err, "request_id", middleware.GetReqID(ctx))
+}
+
+// writeEvent writes e as a Server-Sent Event. Notification events carry the
+// unread count of the viewer.
+func (t *twitterAPI) writeEvent(ctx context.Context, w io.Writer, viewer string, e events.Event) error {
+	var data any
+	switch e.Kind {
+	case events.KindTweet:
+		data = toAPITweet(*e.Tweet)
+	case events.KindNotification:
+		count, err := t.notificationService.CountUnread(ctx, viewer)
+		if err != nil {
+			return err
+		}
+		data = openapi.UnreadCount{Count: count}
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Kind, payload)
+	return err
+}
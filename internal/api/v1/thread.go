@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Get the reply tree of a tweet
+// (GET /tweets/{id}/thread).
+func (t *twitterAPI) GetTweetsIdThread( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetTweetsIdThreadParams,
+) {
+	ctx := r.Context()
+
+	depth := 0
+	if params.Depth != nil {
+		depth = *params.Depth
+	}
+	viewer := viewerID(ctx)
+	thread, err := t.tweetService.Thread(ctx, id.String(), viewer, depth)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "Tweet not found", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting thread", err)
+		}
+		return
+	}
+	if err = t.annotateThread(ctx, viewer, thread); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(toAPIThread(*thread)) //nolint:errcheck,gosec //ignore error
+}
+
+// annotateThread fills in the like counts of every tweet in thread in one
+// call, by flattening the tree and writing the annotated tweets back in the
+// same order.
+func (t *twitterAPI) annotateThread(ctx context.Context, viewer string, thread *entities.Thread) error {
+	var nodes []*entities.Thread
+	var walk func(th *entities.Thread)
+	walk = func(th *entities.Thread) {
+		nodes = append(nodes, th)
+		for i := range th.Replies {
+			walk(&th.Replies[i])
+		}
+	}
+	walk(thread)
+
+	tweets := make([]entities.Tweet, 0, len(nodes))
+	for _, n := range nodes {
+		tweets = append(tweets, n.Tweet)
+	}
+	if err := t.likeService.Annotate(ctx, viewer, tweets); err != nil {
+		return fmt.Errorf("could not annotate thread: %w", err)
+	}
+	for i, n := range nodes {
+		n.Tweet = tweets[i]
+	}
+	return nil
+}
+
+// toAPIThread converts an entities.Thread to an openapi.Thread.
+func toAPIThread(thread entities.Thread) openapi.Thread {
+	replies := make([]openapi.Thread, 0, len(thread.Replies))
+	for _, r := range thread.Replies {
+		replies = append(replies, toAPIThread(r))
+	}
+	return openapi.Thread{
+		Tweet:   toAPITweet(thread.Tweet),
+		Replies: replies,
+	}
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// List the tweets of a user
+// (GET /users/{id}/tweets).
+func (t *twitterAPI) GetUsersIdTweets( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetUsersIdTweetsParams,
+) {
+	ctx := r.Context()
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	viewer := viewerID(ctx)
+	page, err := t.tweetService.FindByUserID(ctx, id.String(), viewer, cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing tweets", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, viewer, page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// List the tweets mentioning a user
+// (GET /users/{id}/mentions).
+func (t *twitterAPI) GetUsersIdMentions( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetUsersIdMentionsParams,
+) {
+	ctx := r.Context()
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	viewer := viewerID(ctx)
+	page, err := t.tweetService.FindMentions(ctx, id.String(), viewer, cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrBlocked):
+			sendAPIError(t.logger, w, r, http.StatusForbidden, "Blocked", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error listing mentions", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = t.likeService.Annotate(ctx, viewer, page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
+
+// Get the home timeline of a user
+// (GET /users/{id}/timeline).
+func (t *twitterAPI) GetUsersIdTimeline( //nolint:revive,staticcheck // generated method; interface name preserved
+	w http.ResponseWriter,
+	r *http.Request,
+	id uuid.UUID,
+	params openapi.GetUsersIdTimelineParams,
+) {
+	ctx := r.Context()
+
+	cursor, limit := pageParams(params.Cursor, params.Limit)
+	page, err := t.timelineService.Home(ctx, id.String(), cursor, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			sendAPIError(t.logger, w, r, http.StatusNotFound, "User not found", err)
+		case errors.Is(err, entities.ErrInvalidCursor):
+			sendAPIError(t.logger, w, r, http.StatusBadRequest, "Invalid cursor", err)
+		default:
+			sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error getting timeline", err)
+		}
+		return
+	}
+	if len(page.Items) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	// the owner of a home timeline is the one viewing it
+	if err = t.likeService.Annotate(ctx, id.String(), page.Items); err != nil {
+		sendAPIError(t.logger, w, r, http.StatusInternalServerError, "Error counting likes", err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(openapi.TweetPage{ //nolint:errcheck,gosec //ignore error
+		Data:       toAPITweets(page.Items),
+		NextCursor: nextCursor(page.NextCursor),
+	})
+}
@@ -0,0 +1,560 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+
+	"github.com/ricleal/twitter-clone/internal/api/v1/openapi"
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+)
+
+const (
+	wsWriteWait        = 10 * time.Second // time allowed to write a frame
+	wsMaxRequestSize   = 4096             // largest frame a client may send, in bytes
+	wsSendBuffer       = 64               // frames queued for a client before it is dropped
+	wsMaxSubscriptions = 16               // channels a connection may subscribe to at once
+)
+
+// Channels a WebSocket client can subscribe to.
+const (
+	wsChannelHome         = "home"
+	wsChannelHashtag      = "hashtag"
+	wsChannelConversation = "conversation"
+)
+
+// Types of the frames sent by WebSocket clients.
+const (
+	wsRequestSubscribe   = "subscribe"
+	wsRequestUnsubscribe = "unsubscribe"
+	wsRequestTyping      = "typing"
+)
+
+// Types of the frames sent to WebSocket clients, besides the kinds of the
+// events they carry.
+const (
+	wsFrameSubscribed   = "subscribed"
+	wsFrameUnsubscribed = "unsubscribed"
+	wsFrameError        = "error"
+)
+
+// wsRequest is a frame sent by a client. Subscriptions name a channel, with
+// its tag or conversation; typing names a conversation.
+type wsRequest struct {
+	Type           string `json:"type"`
+	Channel        string `json:"channel,omitempty"`
+	Tag            string `json:"tag,omitempty"`
+	ConversationID string `json:"conversation_id,omitempty"`
+}
+
+// wsChannel identifies a subscription of a connection. Tags are kept as the
+// client gave them, so that it can tell its subscriptions apart.
+type wsChannel struct {
+	name           string
+	tag            string
+	conversationID string
+}
+
+// frame returns a frame of type typ about ch.
+func (ch wsChannel) frame(typ string) wsFrame {
+	return wsFrame{Type: typ, Channel: ch.name, Tag: ch.tag, ConversationID: ch.conversationID}
+}
+
+// wsFrame is a frame sent to a client: an acknowledgment or an error about a
+// request, or an event on one of its channels.
+type wsFrame struct {
+	Type           string           `json:"type"`
+	Channel        string           `json:"channel,omitempty"`
+	Tag            string           `json:"tag,omitempty"`
+	ConversationID string           `json:"conversation_id,omitempty"`
+	UserID         string           `json:"user_id,omitempty"`
+	Tweet          *openapi.Tweet   `json:"tweet,omitempty"`
+	Message        *openapi.Message `json:"message,omitempty"`
+	Error          string           `json:"error,omitempty"`
+}
+
+// WebSocketGateway serves live events over WebSocket. A client connects as the
+// user of its session token, subscribes to its home timeline, hashtags and its
+// conversations, and gets a JSON frame for each event on them. It can tell the
+// other members of a conversation that it is typing.
+//
+// Browsers cannot set headers on WebSocket handshakes, so the token may come
+// in the access_token query parameter instead of an Authorization header.
+// Handshakes from pages of other origins are refused.
+//
+// Clients that do not answer pings, or read their frames too slowly, are
+// dropped.
+type WebSocketGateway struct {
+	logger        *slog.Logger
+	sessions      service.SessionService
+	streamService service.StreamService
+	upgrader      websocket.Upgrader
+	pongWait      time.Duration
+
+	mu      sync.Mutex
+	conns   map[*wsConn]struct{}
+	closing bool
+	wg      sync.WaitGroup
+}
+
+// NewWebSocketGateway returns a WebSocketGateway authenticating its clients
+// with sessions and dropping those that do not answer a ping within pongWait.
+func NewWebSocketGateway(
+	logger *slog.Logger,
+	sessions service.SessionService,
+	streamService service.StreamService,
+	pongWait time.Duration,
+) *WebSocketGateway {
+	return &WebSocketGateway{
+		logger:        logger.With("component", "websocket"),
+		sessions:      sessions,
+		streamService: streamService,
+		upgrader:      websocket.Upgrader{CheckOrigin: sameOrigin},
+		pongWait:      pongWait,
+		conns:         make(map[*wsConn]struct{}),
+	}
+}
+
+// ServeHTTP authenticates the request, upgrades it to a WebSocket connection
+// and serves it until either end closes it. A user_id query parameter, if
+// any, must be the authenticated user.
+func (g *WebSocketGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	u, ok := g.authenticate(w, r)
+	if !ok {
+		return
+	}
+	userID := u.ID.String()
+	if id := r.URL.Query().Get("user_id"); id != "" {
+		if err := uuid.Validate(id); err != nil {
+			sendAPIError(g.logger, w, r, http.StatusBadRequest, "Invalid user ID", err)
+			return
+		}
+		if id != userID {
+			sendAPIError(g.logger, w, r, http.StatusForbidden, "Forbidden", errNotOwner)
+			return
+		}
+	}
+	if g.isClosing() {
+		sendAPIError(g.logger, w, r, http.StatusServiceUnavailable, "Server shutting down", nil)
+		return
+	}
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has answered the request already
+		g.logger.Info("websocket upgrade failed", "error", err, "request_id", middleware.GetReqID(ctx))
+		return
+	}
+	c := &wsConn{
+		gateway: g,
+		conn:    conn,
+		userID:  userID,
+		send:    make(chan wsFrame, wsSendBuffer),
+		done:    make(chan struct{}),
+		subs:    make(map[wsChannel]*events.Subscription),
+	}
+	if !g.track(c) {
+		c.close(websocket.CloseGoingAway, "server shutting down")
+	}
+	err = c.serve(ctx)
+	g.untrack(c)
+	g.logger.Info("websocket closed", "error", err, "request_id", middleware.GetReqID(ctx))
+}
+
+// authenticate returns the user of the session token of r, from its
+// Authorization header or access_token query parameter. Requests without a
+// valid one are answered with 401.
+func (g *WebSocketGateway) authenticate(w http.ResponseWriter, r *http.Request) (*entities.User, bool) {
+	token, ok := bearerToken(r)
+	if !ok {
+		token = r.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		sendAPIError(g.logger, w, r, http.StatusUnauthorized, "Authentication required", errUnauthenticated)
+		return nil, false
+	}
+	u, err := g.sessions.Authenticate(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidToken) {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			sendAPIError(g.logger, w, r, http.StatusUnauthorized, "Invalid session token", err)
+			return nil, false
+		}
+		sendAPIError(g.logger, w, r, http.StatusInternalServerError, "Error checking session token", err)
+		return nil, false
+	}
+	return u, true
+}
+
+// sameOrigin accepts the handshakes of clients that send no Origin, which are
+// not browsers, and of pages served from the gateway's own host, so that other
+// sites cannot open connections from the browsers of their visitors.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// Shutdown sends a close frame to every client and waits for the connections
+// to end, closing those left when ctx is done. New clients are turned away.
+// The http.Server does not track WebSocket connections, so its Shutdown does
+// not wait for them.
+func (g *WebSocketGateway) Shutdown(ctx context.Context) error {
+	g.mu.Lock()
+	g.closing = true
+	for c := range g.conns {
+		c.close(websocket.CloseGoingAway, "server shutting down")
+	}
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		g.mu.Lock()
+		for c := range g.conns {
+			_ = c.conn.Close()
+		}
+		g.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (g *WebSocketGateway) isClosing() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.closing
+}
+
+// track adds c to the connections Shutdown waits for, unless the gateway is
+// shutting down already.
+func (g *WebSocketGateway) track(c *wsConn) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closing {
+		return false
+	}
+	g.conns[c] = struct{}{}
+	g.wg.Add(1)
+	return true
+}
+
+func (g *WebSocketGateway) untrack(c *wsConn) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.conns[c]; ok {
+		delete(g.conns, c)
+		g.wg.Done()
+	}
+}
+
+// wsConn is a client connection of a WebSocketGateway. Requests are read, and
+// frames written, by a goroutine each. The frames of the subscriptions are
+// queued for the writer without waiting, so a client too slow to read them
+// cannot hold up anything else.
+type wsConn struct {
+	gateway *WebSocketGateway
+	conn    *websocket.Conn
+	userID  string
+	send    chan wsFrame
+	// done is closed, once, when the connection is to end with closeMsg.
+	done      chan struct{}
+	closeOnce sync.Once
+	closeMsg  []byte
+
+	// subs is only changed by the reader
+	mu   sync.Mutex
+	subs map[wsChannel]*events.Subscription
+}
+
+// serve reads requests until the connection ends, and then cleans up after
+// it. It returns the error that ended reading.
+func (c *wsConn) serve(ctx context.Context) error {
+	written := make(chan struct{})
+	go func() {
+		c.write()
+		close(written)
+	}()
+	err := c.read(ctx)
+	c.close(websocket.CloseNormalClosure, "")
+	<-written
+
+	c.mu.Lock()
+	subs := c.subs
+	c.subs = nil
+	c.mu.Unlock()
+	for _, sub := range subs {
+		sub.Close()
+	}
+	_ = c.conn.Close()
+	return err
+}
+
+// read handles the requests of the client until reading fails: the client
+// closed the connection, did not answer a ping in time, or the connection is
+// closing and the client answered, or not, the close frame.
+func (c *wsConn) read(ctx context.Context) error {
+	c.conn.SetReadLimit(wsMaxRequestSize)
+	pongWait := c.gateway.pongWait
+	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		return err
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		var req wsRequest
+		if err = json.Unmarshal(data, &req); err != nil {
+			c.queue(wsFrame{Type: wsFrameError, Error: "Invalid request"})
+			continue
+		}
+		c.handle(ctx, req)
+	}
+}
+
+// write writes the queued frames, and pings the client, until the connection
+// is to end. It then sends the close frame, and leaves the reader a little
+// time to get the answer.
+func (c *wsConn) write() {
+	// two pings within pongWait leave a client time to answer one
+	ping := time.NewTicker(c.gateway.pongWait / 2)
+	defer ping.Stop()
+	for {
+		var err error
+		select {
+		case <-c.done:
+			_ = c.conn.WriteControl(websocket.CloseMessage, c.closeMsg, time.Now().Add(wsWriteWait))
+			_ = c.conn.SetReadDeadline(time.Now().Add(wsWriteWait))
+			return
+		case f := <-c.send:
+			if err = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait)); err == nil {
+				err = c.conn.WriteJSON(f)
+			}
+		case <-ping.C:
+			err = c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+		}
+		if err != nil {
+			// the connection is broken: stop the reader now
+			c.close(websocket.CloseAbnormalClosure, "")
+			_ = c.conn.SetReadDeadline(time.Now())
+			return
+		}
+	}
+}
+
+// close marks the connection to end with a close frame of code and text. Only
+// the first call counts.
+func (c *wsConn) close(code int, text string) {
+	c.closeOnce.Do(func() {
+		c.closeMsg = websocket.FormatCloseMessage(code, text)
+		close(c.done)
+	})
+}
+
+// queue queues f for the writer, unless the connection is ending. A client
+// wsSendBuffer frames behind is dropped. It reports whether f was queued.
+func (c *wsConn) queue(f wsFrame) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+	select {
+	case c.send <- f:
+		return true
+	default:
+		c.close(websocket.CloseTryAgainLater, "too slow")
+		return false
+	}
+}
+
+// handle handles a request of the client.
+func (c *wsConn) handle(ctx context.Context, req wsRequest) {
+	switch req.Type {
+	case wsRequestSubscribe:
+		c.subscribe(ctx, req)
+	case wsRequestUnsubscribe:
+		c.unsubscribe(req)
+	case wsRequestTyping:
+		ch := wsChannel{name: wsChannelConversation, conversationID: req.ConversationID}
+		if err := uuid.Validate(req.ConversationID); err != nil {
+			c.queue(wsError(ch, "Invalid conversation ID"))
+			return
+		}
+		if err := c.gateway.streamService.Typing(ctx, req.ConversationID, c.userID); err != nil {
+			c.fail(ch, err)
+		}
+	default:
+		c.queue(wsFrame{Type: wsFrameError, Error: "Unknown request type"})
+	}
+}
+
+// subscribe subscribes the client to the channel of req. Subscribing to a
+// channel twice is the same as once.
+func (c *wsConn) subscribe(ctx context.Context, req wsRequest) {
+	ch, ok := c.channel(req)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	_, subscribed := c.subs[ch]
+	count := len(c.subs)
+	c.mu.Unlock()
+	if subscribed {
+		c.queue(ch.frame(wsFrameSubscribed))
+		return
+	}
+	if count >= wsMaxSubscriptions {
+		c.queue(wsError(ch, "Too many subscriptions"))
+		return
+	}
+
+	var sub *events.Subscription
+	var err error
+	switch ch.name {
+	case wsChannelHome:
+		sub, err = c.gateway.streamService.SubscribeHome(ctx, c.userID)
+	case wsChannelHashtag:
+		sub, err = c.gateway.streamService.SubscribeHashtag(ctx, ch.tag, c.userID)
+	case wsChannelConversation:
+		sub, err = c.gateway.streamService.SubscribeConversation(ctx, ch.conversationID, c.userID)
+	}
+	if err != nil {
+		c.fail(ch, err)
+		return
+	}
+	c.mu.Lock()
+	c.subs[ch] = sub
+	c.mu.Unlock()
+	// the acknowledgment comes before the events
+	c.queue(ch.frame(wsFrameSubscribed))
+	go c.forward(ch, sub)
+}
+
+// unsubscribe ends the subscription of the client to the channel of req.
+func (c *wsConn) unsubscribe(req wsRequest) {
+	ch, ok := c.channel(req)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	sub := c.subs[ch]
+	delete(c.subs, ch)
+	c.mu.Unlock()
+	if sub != nil {
+		sub.Close()
+	}
+	c.queue(ch.frame(wsFrameUnsubscribed))
+}
+
+// channel returns the channel named by req, or answers the client with an
+// error if there is no such channel.
+func (c *wsConn) channel(req wsRequest) (wsChannel, bool) {
+	ch := wsChannel{name: req.Channel}
+	switch req.Channel {
+	case wsChannelHome:
+		return ch, true
+	case wsChannelHashtag:
+		ch.tag = req.Tag
+		return ch, true
+	case wsChannelConversation:
+		ch.conversationID = req.ConversationID
+		if err := uuid.Validate(req.ConversationID); err != nil {
+			c.queue(wsError(ch, "Invalid conversation ID"))
+			return ch, false
+		}
+		return ch, true
+	}
+	c.queue(wsError(ch, "Unknown channel"))
+	return ch, false
+}
+
+// forward queues a frame for every event of sub until it ends. A subscription
+// still in use when it ends was dropped by the broker: the client fell behind
+// and is dropped too.
+func (c *wsConn) forward(ch wsChannel, sub *events.Subscription) {
+	for e := range sub.Events() {
+		if !c.queue(wsEventFrame(ch, e)) {
+			return
+		}
+	}
+	c.mu.Lock()
+	dropped := c.subs[ch] == sub
+	c.mu.Unlock()
+	if dropped {
+		c.close(websocket.CloseTryAgainLater, "too slow")
+	}
+}
+
+// fail answers the client with the error a request about ch ended in.
+func (c *wsConn) fail(ch wsChannel, err error) {
+	var message string
+	switch {
+	case errors.Is(err, entities.ErrInvalidHashtag):
+		message = "Invalid hashtag"
+	case errors.Is(err, entities.ErrNotFound) && ch.name == wsChannelConversation:
+		message = "Conversation not found"
+	case errors.Is(err, entities.ErrNotFound):
+		message = "User not found"
+	case errors.Is(err, entities.ErrForbidden):
+		message = "Not a member of the conversation"
+	case errors.Is(err, events.ErrClosed):
+		message = "Server shutting down"
+	default:
+		message = "Internal server error"
+		c.gateway.logger.Error("websocket request failed", "error", err, "user_id", c.userID)
+	}
+	c.queue(wsError(ch, message))
+}
+
+// wsError returns an error frame about ch.
+func wsError(ch wsChannel, message string) wsFrame {
+	f := ch.frame(wsFrameError)
+	f.Error = message
+	return f
+}
+
+// wsEventFrame returns the frame of e, an event on ch.
+func wsEventFrame(ch wsChannel, e events.Event) wsFrame {
+	f := ch.frame(string(e.Kind))
+	switch e.Kind {
+	case events.KindTweet:
+		t := toAPITweet(*e.Tweet)
+		f.Tweet = &t
+	case events.KindMessage:
+		m := toAPIMessage(*e.Message)
+		f.Message = &m
+	case events.KindTyping:
+		f.UserID = e.UserID
+	}
+	return f
+}
@@ -5,8 +5,192 @@ import "errors"
 var (
 	// ErrNotFound is returned when a requested entity does not exist.
 	ErrNotFound = errors.New("not found")
-	// ErrInvalidEmail is returned when a user has an invalid email according to the regex.
-	ErrInvalidEmail = errors.New("invalid email")
 	// ErrInvalidUserID is returned when creating a tweet a user has an invalid ID.
 	ErrInvalidUserID = errors.New("invalid user id")
+	// ErrSelfFollow is returned when a user tries to follow themselves.
+	ErrSelfFollow = errors.New("users cannot follow themselves")
+	// ErrAlreadyFollowing is returned when a user tries to follow someone they already follow.
+	ErrAlreadyFollowing = errors.New("already following user")
+	// ErrNotFollowing is returned when a user tries to unfollow someone they do not follow.
+	ErrNotFollowing = errors.New("not following user")
+	// ErrSelfBlock is returned when a user tries to block or mute themselves.
+	ErrSelfBlock = errors.New("users cannot block or mute themselves")
+	// ErrAlreadyBlocked is returned when a user blocks someone they already block.
+	ErrAlreadyBlocked = errors.New("already blocking user")
+	// ErrNotBlocked is returned when a user unblocks someone they do not block.
+	ErrNotBlocked = errors.New("not blocking user")
+	// ErrAlreadyMuted is returned when a user mutes someone they already mute.
+	ErrAlreadyMuted = errors.New("already muting user")
+	// ErrNotMuted is returned when a user unmutes someone they do not mute.
+	ErrNotMuted = errors.New("not muting user")
+	// ErrBlocked is returned when a user acts on, or asks for the tweets of, a
+	// user who blocks them or whom they block.
+	ErrBlocked = errors.New("blocked")
+	// ErrConflict is returned when a write would break a uniqueness rule. The
+	// returned error is a *ConflictError naming the offending field.
+	ErrConflict = errors.New("conflict")
+	// ErrAlreadyLiked is returned when a user likes a tweet they already like.
+	ErrAlreadyLiked = errors.New("tweet already liked")
+	// ErrNotLiked is returned when a user unlikes a tweet they do not like.
+	ErrNotLiked = errors.New("tweet not liked")
+	// ErrAlreadyBookmarked is returned when a user bookmarks a tweet twice.
+	ErrAlreadyBookmarked = errors.New("tweet already bookmarked")
+	// ErrNotBookmarked is returned when a user removes a bookmark they do not have.
+	ErrNotBookmarked = errors.New("tweet not bookmarked")
+	// ErrForbidden is returned when a user asks for data private to another
+	// user, acts on a conversation they are not a member of, or deletes a
+	// tweet that is not theirs.
+	ErrForbidden = errors.New("forbidden")
+	// ErrAlreadyRetweeted is returned when a user retweets a tweet twice.
+	ErrAlreadyRetweeted = errors.New("tweet already retweeted")
+	// ErrInvalidCredentials is returned when logging in with an unknown
+	// username or a wrong password, which are not told apart.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInvalidToken is returned for a session token that is malformed,
+	// forged, expired or issued to a user who no longer exists.
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrValidation is matched by every *ValidationError.
+	ErrValidation = errors.New("validation failed")
+)
+
+// Machine-readable codes carried by ValidationError.
+const (
+	CodeInvalidFormat = "invalid_format"
+	CodeTooLong       = "too_long"
+	CodeTooShort      = "too_short"
+	CodeRequired      = "required"
+	CodeNotAllowed    = "not_allowed"
+	CodeNotFound      = "not_found"
+)
+
+var (
+	// ErrInvalidEmail is returned when a user has an invalid email according to the regex.
+	ErrInvalidEmail = &ValidationError{Code: CodeInvalidFormat, Field: "email", Message: "invalid email"}
+	// ErrTweetTooLong is returned when the content of a tweet exceeds the length limit.
+	ErrTweetTooLong = &ValidationError{Code: CodeTooLong, Field: "content", Message: "tweet content is too long"}
+	// ErrInvalidTweetKind is returned when a tweet has an unknown kind.
+	ErrInvalidTweetKind = &ValidationError{Code: CodeInvalidFormat, Field: "kind", Message: "invalid tweet kind"}
+	// ErrRetweetContent is returned when a retweet carries content of its own.
+	ErrRetweetContent = &ValidationError{
+		Code:    CodeNotAllowed,
+		Field:   "content",
+		Message: "retweets cannot have content",
+	}
+	// ErrReferenceRequired is returned when a retweet or quote does not reference a tweet.
+	ErrReferenceRequired = &ValidationError{
+		Code:    CodeRequired,
+		Field:   "referenced_tweet_id",
+		Message: "retweets and quotes must reference a tweet",
+	}
+	// ErrUnexpectedReference is returned when a plain tweet references another tweet.
+	ErrUnexpectedReference = &ValidationError{
+		Code:    CodeNotAllowed,
+		Field:   "referenced_tweet_id",
+		Message: "only retweets and quotes can reference a tweet",
+	}
+	// ErrRetweetReply is returned when a retweet is also a reply.
+	ErrRetweetReply = &ValidationError{
+		Code:    CodeNotAllowed,
+		Field:   "in_reply_to_tweet_id",
+		Message: "retweets cannot be replies",
+	}
+	// ErrParentTweetNotFound is returned when the tweet replied to does not
+	// exist or has been deleted.
+	ErrParentTweetNotFound = &ValidationError{
+		Code:    CodeNotFound,
+		Field:   "in_reply_to_tweet_id",
+		Message: "tweet replied to not found",
+	}
+	// ErrReferencedTweetNotFound is returned when the referenced tweet does not
+	// exist or has been deleted.
+	ErrReferencedTweetNotFound = &ValidationError{
+		Code:    CodeNotFound,
+		Field:   "referenced_tweet_id",
+		Message: "referenced tweet not found",
+	}
+	// ErrInvalidHashtag is returned when a hashtag looked up has no letter or
+	// contains characters that cannot be part of a hashtag.
+	ErrInvalidHashtag = &ValidationError{Code: CodeInvalidFormat, Field: "tag", Message: "invalid hashtag"}
+	// ErrEmptySearchQuery is returned when a search query has nothing to look for,
+	// e.g. only excluded words.
+	ErrEmptySearchQuery = &ValidationError{Code: CodeRequired, Field: "q", Message: "search query has no terms"}
+	// ErrConversationMembersRequired is returned when a conversation is started
+	// without another user in it.
+	ErrConversationMembersRequired = &ValidationError{
+		Code:    CodeRequired,
+		Field:   "member_ids",
+		Message: "conversations need another member",
+	}
+	// ErrTooManyConversationMembers is returned when a conversation is started
+	// with more members than allowed.
+	ErrTooManyConversationMembers = &ValidationError{
+		Code:    CodeTooLong,
+		Field:   "member_ids",
+		Message: "too many conversation members",
+	}
+	// ErrConversationMemberNotFound is returned when a conversation is started
+	// with a user who does not exist or has been deleted.
+	ErrConversationMemberNotFound = &ValidationError{
+		Code:    CodeNotFound,
+		Field:   "member_ids",
+		Message: "conversation member not found",
+	}
+	// ErrMessageEmpty is returned when a message has no content.
+	ErrMessageEmpty = &ValidationError{Code: CodeRequired, Field: "content", Message: "message content is required"}
+	// ErrMessageTooLong is returned when the content of a message exceeds the length limit.
+	ErrMessageTooLong = &ValidationError{Code: CodeTooLong, Field: "content", Message: "message content is too long"}
+	// ErrInvalidNotificationType is returned when notifications are filtered by an unknown type.
+	ErrInvalidNotificationType = &ValidationError{
+		Code:    CodeInvalidFormat,
+		Field:   "type",
+		Message: "invalid notification type",
+	}
+	// ErrInvalidSearchOrder is returned when search results are asked in an unknown order.
+	ErrInvalidSearchOrder = &ValidationError{Code: CodeInvalidFormat, Field: "sort", Message: "invalid search order"}
+	// ErrPasswordRequired is returned when creating a user without a password.
+	ErrPasswordRequired = &ValidationError{Code: CodeRequired, Field: "password", Message: "password is required"}
+	// ErrPasswordTooShort is returned when a password is shorter than the policy allows.
+	ErrPasswordTooShort = &ValidationError{Code: CodeTooShort, Field: "password", Message: "password is too short"}
+	// ErrPasswordTooLong is returned when a password is longer than the policy allows.
+	ErrPasswordTooLong = &ValidationError{Code: CodeTooLong, Field: "password", Message: "password is too long"}
+	// ErrPasswordContainsUsername is returned when a password contains the
+	// username it protects.
+	ErrPasswordContainsUsername = &ValidationError{
+		Code:    CodeNotAllowed,
+		Field:   "password",
+		Message: "password must not contain the username",
+	}
 )
+
+// ConflictError reports the field whose value is already taken by another
+// entity. It matches ErrConflict with errors.Is.
+type ConflictError struct {
+	Field string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Field + " already taken"
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// ValidationError reports an input value that breaks a domain rule. Code is a
+// stable identifier clients can switch on, Field names the offending input.
+// It matches ErrValidation with errors.Is.
+type ValidationError struct {
+	Code    string
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
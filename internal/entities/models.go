@@ -1,6 +1,10 @@
 package entities
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // User represents a user in the domain.
 type User struct {
@@ -8,11 +12,204 @@ type User struct {
 	Username string
 	Email    string
 	Name     string
+	// PasswordHash is the PHC-formatted hash of the user's password, empty for
+	// users who have none. The API never renders it.
+	PasswordHash string
+	// CreatedAt and UpdatedAt are maintained by the repository.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// DeletedAt is set when the user has been soft-deleted.
+	DeletedAt *time.Time
+}
+
+// UserUpdate holds the fields of a partial user update. Nil fields are left
+// unchanged.
+type UserUpdate struct {
+	Username *string
+	Email    *string
+	Name     *string
+}
+
+// IsEmpty reports whether the update changes nothing.
+func (u UserUpdate) IsEmpty() bool {
+	return u.Username == nil && u.Email == nil && u.Name == nil
 }
 
+// Session is what a user gets on logging in: a bearer token standing for them
+// until ExpiresAt.
+type Session struct {
+	Token     string
+	User      User
+	ExpiresAt time.Time
+}
+
+// TweetKind tells plain tweets apart from retweets and quote tweets.
+type TweetKind string
+
+// Tweet kinds. Retweets and quotes reference another tweet; retweets carry no
+// content of their own.
+const (
+	TweetKindTweet   TweetKind = "tweet"
+	TweetKindRetweet TweetKind = "retweet"
+	TweetKindQuote   TweetKind = "quote"
+)
+
 // Tweet represents a tweet in the domain.
 type Tweet struct {
 	ID      uuid.UUID
 	Content string
 	UserID  uuid.UUID
+	// Kind defaults to TweetKindTweet when empty.
+	Kind TweetKind
+	// ReferencedTweetID is the tweet retweeted or quoted, and ReferencedTweet
+	// that tweet as embedded on reads; it is nil when the original has since
+	// been deleted.
+	ReferencedTweetID *uuid.UUID
+	ReferencedTweet   *Tweet
+	// RetweetCount and QuoteCount count the live tweets referencing this one.
+	RetweetCount int
+	QuoteCount   int
+	// InReplyToTweetID is the tweet this one replies to. ConversationID is the
+	// root of the reply chain, the tweet's own ID when it is not a reply; it
+	// is maintained by the repository.
+	InReplyToTweetID *uuid.UUID
+	ConversationID   uuid.UUID
+	// CreatedAt and UpdatedAt are maintained by the repository.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// LikeCount is the number of live users who liked the tweet, and Liked
+	// whether the viewing user is one of them. Both are filled in by
+	// LikeService.Annotate.
+	LikeCount int
+	Liked     bool
+	// Mentions are the users mentioned in Content, in order of appearance.
+	// They are resolved when the tweet is created and filled in on reads.
+	Mentions []Mention
+	// DeletedAt is set when the tweet has been soft-deleted.
+	DeletedAt *time.Time
+}
+
+// Mention is an @username in the content of a tweet that names a user. Start
+// and End are the offsets of the @username in characters (runes), End being
+// exclusive. Username is the current username of the user.
+type Mention struct {
+	UserID   uuid.UUID
+	Username string
+	Start    int
+	End      int
+}
+
+// Thread is a tweet and the tree of replies to it.
+type Thread struct {
+	Tweet   Tweet
+	Replies []Thread
+}
+
+// Bookmark is a tweet a user saved for later. Bookmarks are private to the
+// user who made them.
+type Bookmark struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Tweet     Tweet
+	CreatedAt time.Time
+}
+
+// Conversation is a private exchange of messages between two or more users.
+type Conversation struct {
+	ID uuid.UUID
+	// Members are the live users taking part, ordered by user ID.
+	Members []ConversationMember
+	// LastMessage is the most recent message, nil before the first one.
+	LastMessage *Message
+	CreatedAt   time.Time
+}
+
+// ConversationMember is a user taking part in a conversation. UnreadCount
+// counts the messages the other members sent after the last one the member
+// read.
+type ConversationMember struct {
+	UserID      uuid.UUID
+	Username    string
+	UnreadCount int
+}
+
+// Message is a message sent to a conversation by one of its members.
+type Message struct {
+	ID             uuid.UUID
+	ConversationID uuid.UUID
+	SenderID       uuid.UUID
+	Content        string
+	CreatedAt      time.Time
+}
+
+// NotificationType is what a notification tells its user about.
+type NotificationType string
+
+// Notification types. The tweet of a like or retweet is the user's tweet, the
+// one of a reply, mention or quote the new tweet itself.
+const (
+	NotificationFollow  NotificationType = "follow"
+	NotificationLike    NotificationType = "like"
+	NotificationReply   NotificationType = "reply"
+	NotificationMention NotificationType = "mention"
+	NotificationRetweet NotificationType = "retweet"
+	NotificationQuote   NotificationType = "quote"
+)
+
+// Grouped reports whether the notifications of the type about the same tweet
+// are listed as one. Replies, mentions and quotes each bring content of their
+// own, so they stand alone.
+func (t NotificationType) Grouped() bool {
+	return t == NotificationFollow || t == NotificationLike || t == NotificationRetweet
+}
+
+// Notification tells a user that others interacted with them or their tweets.
+// Grouped notifications with the same read state are listed as one: ID and
+// CreatedAt are those of the newest, Actors are its most recent distinct live
+// actors and ActorCount counts all of them.
+type Notification struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Type   NotificationType
+	// TweetID is nil for follows.
+	TweetID    *uuid.UUID
+	Actors     []NotificationActor
+	ActorCount int
+	Read       bool
+	CreatedAt  time.Time
+}
+
+// NotificationActor is a user whose action triggered a notification.
+type NotificationActor struct {
+	UserID   uuid.UUID
+	Username string
+}
+
+// SearchOrder is the order of tweet search results.
+type SearchOrder string
+
+// Search orders. Relevance ranks the tweets matching the most words first.
+const (
+	SearchOrderRelevance SearchOrder = "relevance"
+	SearchOrderRecency   SearchOrder = "recency"
+)
+
+// TweetQuery is a parsed tweet search. Words are lowercased and phrases are
+// their words joined by single spaces. A tweet matches when it contains all of
+// Terms and Phrases, none of Excluded, every one of Hashtags and, if set, was
+// written by FromUsername.
+type TweetQuery struct {
+	Terms        []string
+	Phrases      []string
+	Excluded     []string
+	Hashtags     []string
+	FromUsername string
+	Order        SearchOrder
+}
+
+// Page is a slice of results from a paginated listing. NextCursor is empty on
+// the last page.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
 }
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// BookmarkService is a domain service for the private bookmarks of users.
+type BookmarkService interface {
+	Bookmark(ctx context.Context, userID, tweetID string) error
+	Unbookmark(ctx context.Context, userID, tweetID string) error
+	// FindByUser returns a page of the tweets userID bookmarked, most recently
+	// bookmarked first. Bookmarks are private: viewerID must be userID.
+	FindByUser(ctx context.Context, userID, viewerID, cursor string, limit int) (entities.Page[entities.Tweet], error)
+}
+
+// bookmarkService is an implementation of the BookmarkService interface.
+type bookmarkService struct {
+	store store.Store
+}
+
+// NewBookmarkService returns a new BookmarkService.
+func NewBookmarkService(s store.Store) BookmarkService {
+	return &bookmarkService{s}
+}
+
+// Bookmark makes userID bookmark tweetID, unless userID and its author block
+// each other.
+func (s *bookmarkService) Bookmark(ctx context.Context, userID, tweetID string) error {
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		tweet, err := findTweet(ctx, scopedStore.Tweets(), tweetID)
+		if err != nil {
+			return err
+		}
+		if _, err = scopedStore.Users().FindByID(ctx, userID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return entities.ErrInvalidUserID
+			}
+			return fmt.Errorf("error finding user: %w", err)
+		}
+		if err = checkNotBlocked(ctx, scopedStore.Blocks(), userID, tweet.UserID.String()); err != nil {
+			return err
+		}
+		if err = scopedStore.Bookmarks().Create(ctx, userID, tweetID); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyBookmarked
+			}
+			return fmt.Errorf("could not create bookmark: %w", err)
+		}
+		return nil
+	}); errOut != nil {
+		return fmt.Errorf("could not bookmark tweet in the tx: %w", errOut)
+	}
+	return nil
+}
+
+// Unbookmark removes the bookmark of tweetID by userID.
+func (s *bookmarkService) Unbookmark(ctx context.Context, userID, tweetID string) error {
+	if err := s.store.Bookmarks().Delete(ctx, userID, tweetID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotBookmarked
+		}
+		return fmt.Errorf("could not remove bookmark: %w", err)
+	}
+	return nil
+}
+
+// FindByUser returns a page of the live tweets userID bookmarked, starting at
+// cursor, leaving out the ones by users blocking or blocked by userID. Cursors
+// hold the ID of a bookmark rather than of a tweet, so pages follow the order
+// of the bookmarks.
+func (s *bookmarkService) FindByUser(
+	ctx context.Context,
+	userID, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	if viewerID != userID {
+		return entities.Page[entities.Tweet]{}, entities.ErrForbidden
+	}
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, userID, false)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	limit = pageLimit(limit)
+	bookmarks, err := s.store.Bookmarks().FindByUser(ctx, userID, beforeID, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("could not find bookmarks: %w", err)
+	}
+	page := newPage(bookmarks, limit, func(b entities.Bookmark) uuid.UUID { return b.ID })
+
+	tweets := make([]entities.Tweet, 0, len(page.Items))
+	for _, b := range page.Items {
+		tweets = append(tweets, b.Tweet)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return entities.Page[entities.Tweet]{Items: tweets, NextCursor: page.NextCursor}, nil
+}
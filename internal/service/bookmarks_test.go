@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestBookmarks(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sb := service.NewBookmarkService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	createTweet(t, st, jane.ID, "hello")
+	createTweet(t, st, jane.ID, "world")
+	tweets, err := st.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	johnID := john.ID.String()
+	for _, tweet := range tweets {
+		if err = sb.Bookmark(ctx, johnID, tweet.ID.String()); err != nil {
+			t.Fatalf("Error bookmarking tweet: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		tweetID string
+		want    error
+	}{
+		{"twice", johnID, tweets[0].ID.String(), entities.ErrAlreadyBookmarked},
+		{"unknown tweet", johnID, uuid.NewString(), entities.ErrNotFound},
+		{"unknown user", uuid.NewString(), tweets[0].ID.String(), entities.ErrInvalidUserID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := sb.Bookmark(ctx, tt.userID, tt.tweetID); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Only John sees his bookmarks, one page at a time
+	if _, err = sb.FindByUser(ctx, johnID, jane.ID.String(), "", 10); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	page, err := sb.FindByUser(ctx, johnID, johnID, "", 1)
+	if err != nil {
+		t.Fatalf("Error listing bookmarks: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != tweets[len(tweets)-1].ID || page.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	page, err = sb.FindByUser(ctx, johnID, johnID, page.NextCursor, 1)
+	if err != nil {
+		t.Fatalf("Error listing bookmarks: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != tweets[0].ID || page.NextCursor != "" {
+		t.Errorf("Unexpected last page: %+v", page)
+	}
+
+	// A deleted tweet disappears, and a removed bookmark cannot be removed again
+	if err = st.Delete(ctx, tweets[0].ID.String(), tweets[0].UserID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	if err = sb.Unbookmark(ctx, johnID, tweets[1].ID.String()); err != nil {
+		t.Fatalf("Error removing bookmark: %v", err)
+	}
+	if err = sb.Unbookmark(ctx, johnID, tweets[1].ID.String()); !errors.Is(err, entities.ErrNotBookmarked) {
+		t.Errorf("Expected ErrNotBookmarked, got %v", err)
+	}
+	page, err = sb.FindByUser(ctx, johnID, johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error listing bookmarks: %v", err)
+	}
+	if len(page.Items) != 0 {
+		t.Errorf("Expected no bookmarks, got %+v", page.Items)
+	}
+}
@@ -0,0 +1,161 @@
+// Package events is an in-process publish/subscribe broker for the events of
+// committed writes, such as new tweets, that live clients are told about.
+package events
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// ErrClosed is returned when subscribing to a closed Broker.
+var ErrClosed = errors.New("broker closed")
+
+// Kind tells the events apart.
+type Kind string
+
+// Event kinds.
+const (
+	// KindTweet events carry a tweet just created.
+	KindTweet Kind = "tweet"
+	// KindNotification events tell UserID they were just notified.
+	KindNotification Kind = "notification"
+	// KindMessage events carry a direct message just sent to ConversationID.
+	KindMessage Kind = "message"
+	// KindTyping events tell the members of ConversationID that UserID is
+	// typing. Nothing is stored for them.
+	KindTyping Kind = "typing"
+)
+
+// Event is something that happened. IDs are assigned by the broker on
+// publication and increase by one with every event.
+type Event struct {
+	ID             uint64
+	Kind           Kind
+	Tweet          *entities.Tweet
+	Message        *entities.Message
+	ConversationID string
+	UserID         string
+}
+
+// Broker fans events out to subscribers. Publishing never blocks: a
+// subscriber whose buffer is full is dropped, its channel closed, and may
+// subscribe again from the last event it got, which the broker replays from
+// its history of recent events. A nil Broker drops every event.
+type Broker struct {
+	mu          sync.Mutex
+	nextID      uint64
+	history     []Event
+	historySize int
+	bufferSize  int
+	subs        map[*Subscription]struct{}
+	closed      bool
+}
+
+// NewBroker returns a Broker keeping the last historySize events for replay
+// and buffering up to bufferSize events for each subscriber.
+func NewBroker(historySize, bufferSize int) *Broker {
+	return &Broker{
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish assigns e the next ID and sends it to every subscriber it matches.
+func (b *Broker) Publish(e Event) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.nextID++
+	e.ID = b.nextID
+	if b.historySize > 0 {
+		if len(b.history) == b.historySize {
+			b.history = b.history[1:]
+		}
+		b.history = append(b.history, e)
+	}
+	for sub := range b.subs {
+		if !sub.match(e) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// too slow to keep up: let it resume from the history instead
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe returns a subscription to the events match accepts, and the
+// events after the one with ID after still in the history, which come before
+// anything sent on the subscription. An after of 0 replays nothing. Events
+// that have left the history by the time a subscriber resumes are lost.
+func (b *Broker) Subscribe(after uint64, match func(Event) bool) (*Subscription, []Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil, nil, ErrClosed
+	}
+	var missed []Event
+	if after > 0 {
+		for _, e := range b.history {
+			if e.ID > after && match(e) {
+				missed = append(missed, e)
+			}
+		}
+	}
+	sub := &Subscription{
+		broker: b,
+		match:  match,
+		ch:     make(chan Event, b.bufferSize),
+	}
+	b.subs[sub] = struct{}{}
+	return sub, missed, nil
+}
+
+// Close closes every subscription and turns new subscribers away.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		b.remove(sub)
+	}
+}
+
+// remove closes sub and forgets it. b.mu must be held.
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.ch)
+}
+
+// Subscription is a stream of events from a Broker.
+type Subscription struct {
+	broker *Broker
+	match  func(Event) bool
+	ch     chan Event
+}
+
+// Events returns the channel events are sent on. It is closed when the
+// subscription is, or when the subscriber falls too far behind.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s)
+}
@@ -0,0 +1,100 @@
+package events_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/service/events"
+)
+
+func all(events.Event) bool { return true }
+
+func TestBrokerPublishAndReplay(t *testing.T) {
+	b := events.NewBroker(2, 10)
+	onlyJohn := func(e events.Event) bool { return e.UserID == "john" }
+	sub, missed, err := b.Subscribe(0, onlyJohn)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer sub.Close()
+	if len(missed) != 0 {
+		t.Errorf("Expected nothing to replay, got %+v", missed)
+	}
+
+	for _, userID := range []string{"john", "jane", "john"} {
+		b.Publish(events.Event{Kind: events.KindNotification, UserID: userID})
+	}
+	for _, want := range []uint64{1, 3} {
+		if e := <-sub.Events(); e.ID != want || e.UserID != "john" {
+			t.Errorf("Expected event %d for John, got %+v", want, e)
+		}
+	}
+
+	// Only the last two events are kept for replay
+	resumed, missed, err := b.Subscribe(1, all)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer resumed.Close()
+	if len(missed) != 2 || missed[0].ID != 2 || missed[1].ID != 3 {
+		t.Errorf("Expected events 2 and 3, got %+v", missed)
+	}
+	if _, missed, _ = b.Subscribe(3, all); len(missed) != 0 {
+		t.Errorf("Expected nothing to replay, got %+v", missed)
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	b := events.NewBroker(10, 1)
+	slow, _, err := b.Subscribe(0, all)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	fast, _, err := b.Subscribe(0, all)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer fast.Close()
+
+	// Publishing does not wait for the slow subscriber, which is dropped
+	b.Publish(events.Event{Kind: events.KindTweet})
+	<-fast.Events()
+	b.Publish(events.Event{Kind: events.KindTweet})
+	<-fast.Events()
+	if e := <-slow.Events(); e.ID != 1 {
+		t.Errorf("Expected the first event, got %+v", e)
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Error("Expected the slow subscription to be closed")
+	}
+	slow.Close()
+
+	// It picks up where it left off
+	_, missed, err := b.Subscribe(1, all)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	if len(missed) != 1 || missed[0].ID != 2 {
+		t.Errorf("Expected the second event, got %+v", missed)
+	}
+}
+
+func TestBrokerClose(t *testing.T) {
+	b := events.NewBroker(10, 10)
+	sub, _, err := b.Subscribe(0, all)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	b.Close()
+	if _, ok := <-sub.Events(); ok {
+		t.Error("Expected the subscription to be closed")
+	}
+	sub.Close()
+	if _, _, err = b.Subscribe(0, all); !errors.Is(err, events.ErrClosed) {
+		t.Errorf("Expected ErrClosed, got %v", err)
+	}
+	b.Publish(events.Event{Kind: events.KindTweet})
+
+	var nilBroker *events.Broker
+	nilBroker.Publish(events.Event{Kind: events.KindTweet})
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// FollowService is a domain service for the social graph.
+type FollowService interface {
+	Follow(ctx context.Context, followerID, followeeID string) error
+	Unfollow(ctx context.Context, followerID, followeeID string) error
+	Followers(ctx context.Context, userID string) ([]entities.User, error)
+	Following(ctx context.Context, userID string) ([]entities.User, error)
+}
+
+// followService is an implementation of the FollowService interface.
+type followService struct {
+	store  store.Store
+	broker *events.Broker
+}
+
+// NewFollowService returns a new FollowService publishing to b, which may be
+// nil.
+func NewFollowService(s store.Store, b *events.Broker) FollowService {
+	return &followService{s, b}
+}
+
+// Follow makes followerID follow followeeID, unless either blocks the other.
+func (s *followService) Follow(ctx context.Context, followerID, followeeID string) error {
+	if followerID == followeeID {
+		return entities.ErrSelfFollow
+	}
+	var notified bool
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		if err := checkFollowUsers(ctx, scopedStore.Users(), followerID, followeeID); err != nil {
+			return err
+		}
+		if err := checkNotBlocked(ctx, scopedStore.Blocks(), followerID, followeeID); err != nil {
+			return err
+		}
+		if err := scopedStore.Follows().Create(ctx, followerID, followeeID); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyFollowing
+			}
+			return fmt.Errorf("could not create follow: %w", err)
+		}
+		var err error
+		notified, err = notify(ctx, scopedStore, followeeID, followerID, entities.NotificationFollow, "")
+		return err
+	}); errOut != nil {
+		return fmt.Errorf("could not follow user in the tx: %w", errOut)
+	}
+	if notified {
+		publishNotified(s.broker, followeeID)
+	}
+	return nil
+}
+
+// Unfollow makes followerID stop following followeeID.
+func (s *followService) Unfollow(ctx context.Context, followerID, followeeID string) error {
+	if err := s.store.Follows().Delete(ctx, followerID, followeeID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotFollowing
+		}
+		return fmt.Errorf("could not unfollow user: %w", err)
+	}
+	return nil
+}
+
+// Followers returns the users following userID.
+func (s *followService) Followers(ctx context.Context, userID string) ([]entities.User, error) {
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return nil, err
+	}
+	users, err := s.store.Follows().FindFollowers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find followers: %w", err)
+	}
+	return users, nil
+}
+
+// Following returns the users followed by userID.
+func (s *followService) Following(ctx context.Context, userID string) ([]entities.User, error) {
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return nil, err
+	}
+	users, err := s.store.Follows().FindFollowing(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find following: %w", err)
+	}
+	return users, nil
+}
+
+// checkFollowUsers verifies both ends of a follow exist. An unknown followee is
+// reported as not found, an unknown follower as an invalid user id.
+func checkFollowUsers(ctx context.Context, userRepo repository.UserRepository, followerID, followeeID string) error {
+	if err := checkUserExists(ctx, userRepo, followeeID); err != nil {
+		return err
+	}
+	if _, err := userRepo.FindByID(ctx, followerID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrInvalidUserID
+		}
+		return fmt.Errorf("error finding user: %w", err)
+	}
+	return nil
+}
+
+// checkUserExists returns entities.ErrNotFound if there is no user with the given ID.
+func checkUserExists(ctx context.Context, userRepo repository.UserRepository, userID string) error {
+	if _, err := userRepo.FindByID(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("error finding user: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// ExtractHashtags returns the normalized hashtags of content in order of first
+// appearance, without duplicates.
+//
+// A hashtag is a '#' (or its fullwidth form) followed by letters, marks, digits
+// and underscores, at least one of them a letter; see scanTokens for where it
+// can start and end.
+func ExtractHashtags(content string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tok := range scanTokens(content, isHashSign) {
+		if tag, ok := normalizeHashtag(tok.Body); ok && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// parseHashtag normalizes a hashtag given by a caller, with or without its
+// leading '#'.
+func parseHashtag(tag string) (string, error) {
+	if r, size := utf8.DecodeRuneInString(tag); isHashSign(r) {
+		tag = tag[size:]
+	}
+	for _, r := range tag {
+		if !isWordRune(r) {
+			return "", entities.ErrInvalidHashtag
+		}
+	}
+	normalized, ok := normalizeHashtag(tag)
+	if !ok {
+		return "", entities.ErrInvalidHashtag
+	}
+	return normalized, nil
+}
+
+// normalizeHashtag case-folds the body of a hashtag. It reports false when the
+// body has no letter, like "#1".
+func normalizeHashtag(body string) (string, bool) {
+	if !strings.ContainsFunc(body, unicode.IsLetter) {
+		return "", false
+	}
+	// lowering the upper case folds variants such as the final sigma too
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(unicode.ToUpper(r))
+	}, body), true
+}
+
+func isHashSign(r rune) bool {
+	return r == '#' || r == '＃'
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestExtractHashtags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"no hashtags", "Hello World!", nil},
+		{"case folding", "#Go and #GO and #go", []string{"go"}},
+		{"punctuation boundaries", "(#go), #rust! #zig.", []string{"go", "rust", "zig"}},
+		{"unicode letters", "#über #日本語 #café", []string{"über", "日本語", "café"}},
+		{"combining marks", "#Cafe\u0301!", []string{"cafe\u0301"}},
+		{"final sigma", "#ΟΔΟΣ #οδος #οδοσ", []string{"οδοσ"}},
+		{"digits and underscores", "#go_1 #2024", []string{"go_1"}},
+		{"fullwidth hash sign", "＃Go", []string{"go"}},
+		{"inside a word", "a#b c&#39;d ##e", nil},
+		{"stops at the next hash sign", "#go#rust", []string{"go"}},
+		{"lone hash sign", "# #", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.ExtractHashtags(tt.content); !slices.Equal(got, tt.want) {
+				t.Errorf("ExtractHashtags(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTweetFindByHashtag(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	createTweet(t, st, john.ID, "learning #Go")
+	createTweet(t, st, john.ID, "learning #rust")
+	createTweet(t, st, john.ID, "#go #GO again")
+	createTweet(t, st, john.ID, "more #go, please")
+
+	page, err := st.FindByHashtag(t.Context(), "#GO", "", "", 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Content != "more #go, please" || page.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	page, err = st.FindByHashtag(t.Context(), "go", "", page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Content != "learning #Go" || page.NextCursor != "" {
+		t.Errorf("Unexpected last page: %+v", page)
+	}
+
+	// Deleted tweets are not listed
+	if err = st.Delete(t.Context(), page.Items[0].ID.String(), page.Items[0].UserID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	page, err = st.FindByHashtag(t.Context(), "go", "", "", 10)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("Expected 2 tweets after delete, got %d", len(page.Items))
+	}
+
+	page, err = st.FindByHashtag(t.Context(), "unused", "", "", 10)
+	if err != nil || len(page.Items) != 0 {
+		t.Errorf("Expected an empty page, got %+v, %v", page, err)
+	}
+
+	for _, tag := range []string{"", "#", "123", "go-lang"} {
+		if _, err = st.FindByHashtag(t.Context(), tag, "", "", 10); !errors.Is(err, entities.ErrInvalidHashtag) {
+			t.Errorf("Expected ErrInvalidHashtag for %q, got %v", tag, err)
+		}
+	}
+}
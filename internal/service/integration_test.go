@@ -52,8 +52,8 @@ func (ts *TweetsTestSuite) TearDownTest() {
 
 func (ts *TweetsTestSuite) TestValid() {
 	s := store.NewPersistentStore(ts.s.DB())
-	st := service.NewTweetService(s)
-	su := service.NewUserService(s)
+	st := service.NewTweetService(s, nil)
+	su := service.NewUserService(s, testPasswordParams)
 	ctx := context.Background()
 
 	// get all users empty DB
@@ -70,7 +70,7 @@ func (ts *TweetsTestSuite) TestValid() {
 		Username: "test",
 		Email:    "test@test.com",
 		Name:     "John Doe",
-	})
+	}, testPassword)
 	ts.Require().NoError(err)
 	// get all users
 	users, err = su.FindAll(ctx)
@@ -99,7 +99,7 @@ func (ts *TweetsTestSuite) TestValid() {
 
 func (ts *TweetsTestSuite) TestInvalid() {
 	s := store.NewPersistentStore(ts.s.DB())
-	st := service.NewTweetService(s)
+	st := service.NewTweetService(s, nil)
 	ctx := context.Background()
 
 	// create a tweet with invalid user
@@ -109,3 +109,36 @@ func (ts *TweetsTestSuite) TestInvalid() {
 	})
 	ts.Require().Error(err)
 }
+
+func (ts *TweetsTestSuite) TestSearchOperatorWords() {
+	// words that text search parsers read as operators are plain words to
+	// both stores
+	queries := []string{"this or that", `"this or that"`, "this -or", "and not", "or"}
+	search := func(s store.Store) map[string][]string {
+		su := service.NewUserService(s, testPasswordParams)
+		st := service.NewTweetService(s, nil)
+		john := createUser(ts.T(), su, "john")
+		createTweet(ts.T(), st, john.ID, "this or that")
+		createTweet(ts.T(), st, john.ID, "this and that")
+		createTweet(ts.T(), st, john.ID, "that or this, and not the other")
+		createTweet(ts.T(), st, john.ID, "this")
+
+		results := make(map[string][]string, len(queries))
+		for _, query := range queries {
+			page, err := st.Search(context.Background(), query, entities.SearchOrderRecency, "", "", 10)
+			ts.Require().NoError(err, query)
+			contents := make([]string, 0, len(page.Items))
+			for _, tweet := range page.Items {
+				contents = append(contents, tweet.Content)
+			}
+			results[query] = contents
+		}
+		return results
+	}
+
+	want := search(store.NewMemStore())
+	ts.Require().Equal([]string{"that or this, and not the other", "this or that"}, want["this or that"])
+	ts.Require().Equal([]string{"this or that"}, want[`"this or that"`])
+	ts.Require().Equal([]string{"this", "this and that"}, want["this -or"])
+	ts.Require().Equal(want, search(store.NewPersistentStore(ts.s.DB())))
+}
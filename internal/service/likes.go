@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// LikeService is a domain service for likes of tweets.
+type LikeService interface {
+	Like(ctx context.Context, userID, tweetID string) error
+	Unlike(ctx context.Context, userID, tweetID string) error
+	Likers(ctx context.Context, tweetID string) ([]entities.User, error)
+	// Annotate fills in the like count of each tweet, and of the tweet it
+	// embeds, and whether viewerID liked it. An empty viewerID leaves Liked
+	// false.
+	Annotate(ctx context.Context, viewerID string, tweets []entities.Tweet) error
+}
+
+// likeService is an implementation of the LikeService interface.
+type likeService struct {
+	store  store.Store
+	broker *events.Broker
+}
+
+// NewLikeService returns a new LikeService publishing to b, which may be nil.
+func NewLikeService(s store.Store, b *events.Broker) LikeService {
+	return &likeService{s, b}
+}
+
+// Like makes userID like tweetID, unless userID and its author block each
+// other.
+func (s *likeService) Like(ctx context.Context, userID, tweetID string) error {
+	var authorID string
+	var notified bool
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		tweet, err := findTweet(ctx, scopedStore.Tweets(), tweetID)
+		if err != nil {
+			return err
+		}
+		if _, err = scopedStore.Users().FindByID(ctx, userID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return entities.ErrInvalidUserID
+			}
+			return fmt.Errorf("error finding user: %w", err)
+		}
+		if err = checkNotBlocked(ctx, scopedStore.Blocks(), userID, tweet.UserID.String()); err != nil {
+			return err
+		}
+		if err = scopedStore.Likes().Create(ctx, userID, tweetID); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyLiked
+			}
+			return fmt.Errorf("could not create like: %w", err)
+		}
+		authorID = tweet.UserID.String()
+		notified, err = notify(ctx, scopedStore, authorID, userID, entities.NotificationLike, tweetID)
+		return err
+	}); errOut != nil {
+		return fmt.Errorf("could not like tweet in the tx: %w", errOut)
+	}
+	if notified {
+		publishNotified(s.broker, authorID)
+	}
+	return nil
+}
+
+// Unlike makes userID stop liking tweetID.
+func (s *likeService) Unlike(ctx context.Context, userID, tweetID string) error {
+	if err := s.store.Likes().Delete(ctx, userID, tweetID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotLiked
+		}
+		return fmt.Errorf("could not unlike tweet: %w", err)
+	}
+	return nil
+}
+
+// Likers returns the users who liked tweetID.
+func (s *likeService) Likers(ctx context.Context, tweetID string) ([]entities.User, error) {
+	if err := checkTweetExists(ctx, s.store.Tweets(), tweetID); err != nil {
+		return nil, err
+	}
+	users, err := s.store.Likes().FindLikers(ctx, tweetID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find likers: %w", err)
+	}
+	return users, nil
+}
+
+// Annotate fills in LikeCount and Liked on tweets and their embedded tweets.
+func (s *likeService) Annotate(ctx context.Context, viewerID string, tweets []entities.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(tweets))
+	for _, t := range tweets {
+		ids = append(ids, t.ID.String())
+		if t.ReferencedTweet != nil {
+			ids = append(ids, t.ReferencedTweet.ID.String())
+		}
+	}
+
+	counts, err := s.store.Likes().CountByTweet(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("could not count likes: %w", err)
+	}
+	liked := map[string]bool{}
+	if viewerID != "" {
+		if liked, err = s.store.Likes().FindLikedByUser(ctx, viewerID, ids); err != nil {
+			return fmt.Errorf("could not find liked tweets: %w", err)
+		}
+	}
+
+	for i := range tweets {
+		id := tweets[i].ID.String()
+		tweets[i].LikeCount = counts[id]
+		tweets[i].Liked = liked[id]
+		if r := tweets[i].ReferencedTweet; r != nil {
+			r.LikeCount = counts[r.ID.String()]
+			r.Liked = liked[r.ID.String()]
+		}
+	}
+	return nil
+}
+
+// checkTweetExists returns entities.ErrNotFound if there is no live tweet with
+// the given ID.
+func checkTweetExists(ctx context.Context, tweetRepo repository.TweetRepository, tweetID string) error {
+	_, err := findTweet(ctx, tweetRepo, tweetID)
+	return err
+}
+
+// findTweet returns the live tweet with the given ID, or entities.ErrNotFound
+// if there is none.
+func findTweet(ctx context.Context, tweetRepo repository.TweetRepository, tweetID string) (*entities.Tweet, error) {
+	tweet, err := tweetRepo.FindByID(ctx, tweetID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("error finding tweet: %w", err)
+	}
+	return tweet, nil
+}
@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestLikes(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sl := service.NewLikeService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	createTweet(t, st, john.ID, "hello")
+	createTweet(t, st, john.ID, "world")
+	tweets, err := st.FindAll(t.Context())
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	liked := tweets[0].ID.String()
+
+	for _, u := range []entities.User{john, jane} {
+		if err = sl.Like(t.Context(), u.ID.String(), liked); err != nil {
+			t.Fatalf("Error liking tweet: %v", err)
+		}
+	}
+	if err = sl.Unlike(t.Context(), john.ID.String(), liked); err != nil {
+		t.Fatalf("Error unliking tweet: %v", err)
+	}
+
+	// Jane's view: one like on the liked tweet, none on the other
+	if err = sl.Annotate(t.Context(), jane.ID.String(), tweets); err != nil {
+		t.Fatalf("Error annotating tweets: %v", err)
+	}
+	for _, tweet := range tweets {
+		wantCount, wantLiked := 0, false
+		if tweet.ID.String() == liked {
+			wantCount, wantLiked = 1, true
+		}
+		if tweet.LikeCount != wantCount || tweet.Liked != wantLiked {
+			t.Errorf("Tweet %q: expected count %d liked %v, got %d %v",
+				tweet.Content, wantCount, wantLiked, tweet.LikeCount, tweet.Liked)
+		}
+	}
+
+	// Anonymous view: counts only
+	if err = sl.Annotate(t.Context(), "", tweets); err != nil {
+		t.Fatalf("Error annotating tweets: %v", err)
+	}
+	for _, tweet := range tweets {
+		if tweet.Liked {
+			t.Errorf("Tweet %q: expected not liked without a viewer", tweet.Content)
+		}
+	}
+}
+
+func TestLikeErrors(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sl := service.NewLikeService(s, nil)
+
+	john := createUser(t, su, "john")
+	createTweet(t, st, john.ID, "hello")
+	tweets, err := st.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	tweetID, johnID := tweets[0].ID.String(), john.ID.String()
+	if err = sl.Like(ctx, johnID, tweetID); err != nil {
+		t.Fatalf("Error liking tweet: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		do      func() error
+		wantErr error
+	}{
+		{"like twice", func() error { return sl.Like(ctx, johnID, tweetID) }, entities.ErrAlreadyLiked},
+		{"unknown tweet", func() error { return sl.Like(ctx, johnID, uuid.NewString()) }, entities.ErrNotFound},
+		{"unknown user", func() error { return sl.Like(ctx, uuid.NewString(), tweetID) }, entities.ErrInvalidUserID},
+		{"unlike unliked", func() error { return sl.Unlike(ctx, johnID, uuid.NewString()) }, entities.ErrNotLiked},
+		{"likers of unknown tweet", func() error {
+			_, likersErr := sl.Likers(ctx, uuid.NewString())
+			return likersErr
+		}, entities.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.do(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
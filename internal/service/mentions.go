@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// resolveMentions returns the @usernames of content that name a user, in
+// order of appearance. Usernames are matched regardless of case; the ones
+// naming nobody, or one of hidden, are left as plain text.
+func resolveMentions(
+	ctx context.Context,
+	userRepo repository.UserRepository,
+	content string,
+	hidden []string,
+) ([]entities.Mention, error) {
+	var mentions []entities.Mention
+	for _, tok := range scanTokens(content, isAtSign) {
+		user, err := userRepo.FindByUsername(ctx, tok.Body)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("error finding mentioned user: %w", err)
+		}
+		if slices.Contains(hidden, user.ID.String()) {
+			continue
+		}
+		mentions = append(mentions, entities.Mention{
+			UserID:   user.ID,
+			Username: user.Username,
+			Start:    tok.Start,
+			End:      tok.End,
+		})
+	}
+	return mentions, nil
+}
+
+func isAtSign(r rune) bool {
+	return r == '@' || r == '＠'
+}
@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestTweetCreateMentions(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+
+	tests := []struct {
+		name    string
+		content string
+		want    []entities.Mention
+	}{
+		{"no mentions", "Hello World!", nil},
+		{"unknown usernames are plain text", "Hi @nobody", nil},
+		{"case insensitive", "(@JANE)", []entities.Mention{{UserID: jane.ID, Username: "jane", Start: 1, End: 6}}},
+		{"offsets in characters", "¡Hola @jane! cc @john", []entities.Mention{
+			{UserID: jane.ID, Username: "jane", Start: 6, End: 11},
+			{UserID: john.ID, Username: "john", Start: 16, End: 21},
+		}},
+		{"repeated mentions", "@jane @jane", []entities.Mention{
+			{UserID: jane.ID, Username: "jane", Start: 0, End: 5},
+			{UserID: jane.ID, Username: "jane", Start: 6, End: 11},
+		}},
+		{"emails are not mentions", "mail john@jane.com", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tweet := &entities.Tweet{UserID: john.ID, Content: tt.content}
+			if err := st.Create(t.Context(), tweet); err != nil {
+				t.Fatalf("Error creating tweet: %v", err)
+			}
+			got, err := st.FindByID(t.Context(), tweet.ID.String(), "")
+			if err != nil {
+				t.Fatalf("Error finding tweet: %v", err)
+			}
+			if len(got.Mentions) != len(tt.want) {
+				t.Fatalf("Expected mentions %+v, got %+v", tt.want, got.Mentions)
+			}
+			for i := range tt.want {
+				if got.Mentions[i] != tt.want[i] {
+					t.Errorf("Expected mentions %+v, got %+v", tt.want, got.Mentions)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestTweetFindMentions(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	createTweet(t, st, john.ID, "hi @jane")
+	createTweet(t, st, john.ID, "hi @john")
+	createTweet(t, st, jane.ID, "@jane @jane talking to myself")
+	createTweet(t, st, john.ID, "bye @Jane")
+
+	page, err := st.FindMentions(t.Context(), jane.ID.String(), "", "", 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Content != "bye @Jane" || page.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	if len(page.Items[1].Mentions) != 2 {
+		t.Errorf("Expected both mentions of the tweet, got %+v", page.Items[1].Mentions)
+	}
+	page, err = st.FindMentions(t.Context(), jane.ID.String(), "", page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Content != "hi @jane" || page.NextCursor != "" {
+		t.Errorf("Unexpected last page: %+v", page)
+	}
+
+	// Mentions of deleted users are no longer returned
+	if err = su.Delete(t.Context(), jane.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	tweet, err := st.FindByID(t.Context(), page.Items[0].ID.String(), "")
+	if err != nil {
+		t.Fatalf("Error finding tweet: %v", err)
+	}
+	if len(tweet.Mentions) != 0 {
+		t.Errorf("Expected no mentions, got %+v", tweet.Mentions)
+	}
+
+	if _, err = st.FindMentions(t.Context(), uuid.NewString(), "", "", 2); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
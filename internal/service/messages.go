@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+const maxMessageLength = 10000 // Twitter's direct message limit
+
+// MaxConversationMembers is the largest number of users, the one starting it
+// included, a conversation can have.
+const MaxConversationMembers = 20
+
+// MessageService is a domain service for the private conversations between
+// users. Only the members of a conversation can read or post to it, and users
+// blocking or blocked by one another cannot start or keep up a conversation.
+type MessageService interface {
+	// CreateConversation starts a conversation between userID and memberIDs.
+	CreateConversation(ctx context.Context, userID string, memberIDs []string) (*entities.Conversation, error)
+	// Send posts m to m.ConversationID on behalf of m.SenderID.
+	Send(ctx context.Context, m *entities.Message) error
+	// FindConversations returns a page of the conversations of userID, most
+	// recently active first. The list is private: viewerID must be userID.
+	FindConversations(
+		ctx context.Context,
+		userID, viewerID, cursor string,
+		limit int,
+	) (entities.Page[entities.Conversation], error)
+	// FindMessages returns a page of the messages of conversationID, newest
+	// first. viewerID must be a member of the conversation.
+	FindMessages(
+		ctx context.Context,
+		conversationID, viewerID, cursor string,
+		limit int,
+	) (entities.Page[entities.Message], error)
+	// MarkRead marks every message of conversationID read by userID.
+	MarkRead(ctx context.Context, conversationID, userID string) error
+}
+
+// messageService is an implementation of the MessageService interface.
+type messageService struct {
+	store  store.Store
+	broker *events.Broker
+}
+
+// NewMessageService returns a new MessageService publishing to b, which may be
+// nil.
+func NewMessageService(s store.Store, b *events.Broker) MessageService {
+	return &messageService{s, b}
+}
+
+// CreateConversation starts a conversation between userID and memberIDs, no
+// two of whom may block each other. Repeated member IDs, and userID among
+// them, are ignored.
+func (s *messageService) CreateConversation(
+	ctx context.Context,
+	userID string,
+	memberIDs []string,
+) (*entities.Conversation, error) {
+	others := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if id != userID && !slices.Contains(others, id) {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return nil, entities.ErrConversationMembersRequired
+	}
+	if len(others) >= MaxConversationMembers {
+		return nil, entities.ErrTooManyConversationMembers
+	}
+
+	var c entities.Conversation
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		if _, err := scopedStore.Users().FindByID(ctx, userID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return entities.ErrInvalidUserID
+			}
+			return fmt.Errorf("error finding user: %w", err)
+		}
+		for _, id := range others {
+			if _, err := scopedStore.Users().FindByID(ctx, id); err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					return entities.ErrConversationMemberNotFound
+				}
+				return fmt.Errorf("error finding user: %w", err)
+			}
+		}
+		// no two members may block each other, or they could never write
+		members := append(others, userID)
+		for i, id := range members {
+			for _, otherID := range members[i+1:] {
+				if err := checkNotBlocked(ctx, scopedStore.Blocks(), id, otherID); err != nil {
+					return err
+				}
+			}
+		}
+		if err := scopedStore.Messages().CreateConversation(ctx, &c, members); err != nil {
+			return fmt.Errorf("could not create conversation: %w", err)
+		}
+		return nil
+	}); errOut != nil {
+		return nil, fmt.Errorf("could not create conversation in the tx: %w", errOut)
+	}
+	return &c, nil
+}
+
+// Send posts m to its conversation, which the sender must be a member of and
+// whose other members must neither block nor be blocked by the sender. The
+// message is published once committed.
+func (s *messageService) Send(ctx context.Context, m *entities.Message) error {
+	if strings.TrimSpace(m.Content) == "" {
+		return entities.ErrMessageEmpty
+	}
+	if len(m.Content) > maxMessageLength {
+		return entities.ErrMessageTooLong
+	}
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		c, err := findConversation(ctx, scopedStore.Messages(), m.ConversationID.String(), m.SenderID.String())
+		if err != nil {
+			return err
+		}
+		for _, member := range c.Members {
+			if member.UserID == m.SenderID {
+				continue
+			}
+			err = checkNotBlocked(ctx, scopedStore.Blocks(), m.SenderID.String(), member.UserID.String())
+			if err != nil {
+				return err
+			}
+		}
+		if err = scopedStore.Messages().Create(ctx, m); err != nil {
+			return fmt.Errorf("could not create message: %w", err)
+		}
+		return nil
+	}); errOut != nil {
+		return fmt.Errorf("could not send message in the tx: %w", errOut)
+	}
+	published := *m
+	s.broker.Publish(events.Event{
+		Kind:           events.KindMessage,
+		Message:        &published,
+		ConversationID: m.ConversationID.String(),
+	})
+	return nil
+}
+
+// FindConversations returns a page of the conversations of userID starting at
+// cursor. Cursors hold the ID of the last message of a conversation, or of the
+// conversation itself before its first message, so pages follow activity.
+func (s *messageService) FindConversations(
+	ctx context.Context,
+	userID, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Conversation], error) {
+	if viewerID != userID {
+		return entities.Page[entities.Conversation]{}, entities.ErrForbidden
+	}
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Conversation]{}, err
+	}
+	if err = checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return entities.Page[entities.Conversation]{}, err
+	}
+	limit = pageLimit(limit)
+	conversations, err := s.store.Messages().FindConversations(ctx, userID, beforeID, limit+1)
+	if err != nil {
+		return entities.Page[entities.Conversation]{}, fmt.Errorf("could not find conversations: %w", err)
+	}
+	return newPage(conversations, limit, func(c entities.Conversation) uuid.UUID {
+		if c.LastMessage != nil {
+			return c.LastMessage.ID
+		}
+		return c.ID
+	}), nil
+}
+
+// FindMessages returns a page of the messages of conversationID starting at
+// cursor.
+func (s *messageService) FindMessages(
+	ctx context.Context,
+	conversationID, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Message], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Message]{}, err
+	}
+	if _, err = findConversation(ctx, s.store.Messages(), conversationID, viewerID); err != nil {
+		return entities.Page[entities.Message]{}, err
+	}
+	limit = pageLimit(limit)
+	messages, err := s.store.Messages().FindMessages(ctx, conversationID, beforeID, limit+1)
+	if err != nil {
+		return entities.Page[entities.Message]{}, fmt.Errorf("could not find messages: %w", err)
+	}
+	return newPage(messages, limit, func(m entities.Message) uuid.UUID { return m.ID }), nil
+}
+
+// MarkRead marks the messages of conversationID up to its last one read by
+// userID.
+func (s *messageService) MarkRead(ctx context.Context, conversationID, userID string) error {
+	c, err := findConversation(ctx, s.store.Messages(), conversationID, userID)
+	if err != nil {
+		return err
+	}
+	if c.LastMessage == nil {
+		return nil
+	}
+	if err = s.store.Messages().MarkRead(ctx, conversationID, userID, c.LastMessage.ID.String()); err != nil {
+		return fmt.Errorf("could not mark messages read: %w", err)
+	}
+	return nil
+}
+
+// findConversation returns the conversation id, or entities.ErrNotFound if
+// there is none and entities.ErrForbidden if userID is not one of its members.
+func findConversation(
+	ctx context.Context,
+	messageRepo repository.MessageRepository,
+	id, userID string,
+) (*entities.Conversation, error) {
+	c, err := messageRepo.FindConversation(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("error finding conversation: %w", err)
+	}
+	isMember := slices.ContainsFunc(c.Members, func(m entities.ConversationMember) bool {
+		return m.UserID.String() == userID
+	})
+	if !isMember {
+		return nil, entities.ErrForbidden
+	}
+	return c, nil
+}
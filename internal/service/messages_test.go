@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestMessages(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	sm := service.NewMessageService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	jim := createUser(t, su, "jim")
+	johnID, janeID, jimID := john.ID.String(), jane.ID.String(), jim.ID.String()
+
+	tooMany := make([]string, service.MaxConversationMembers)
+	for i := range tooMany {
+		tooMany[i] = createUser(t, su, "user"+string(rune('a'+i))).ID.String()
+	}
+	tests := []struct {
+		name      string
+		userID    string
+		memberIDs []string
+		want      error
+	}{
+		{"alone", johnID, []string{johnID}, entities.ErrConversationMembersRequired},
+		{"too many", johnID, tooMany, entities.ErrTooManyConversationMembers},
+		{"unknown member", johnID, []string{uuid.NewString()}, entities.ErrConversationMemberNotFound},
+		{"unknown user", uuid.NewString(), []string{janeID}, entities.ErrInvalidUserID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := sm.CreateConversation(ctx, tt.userID, tt.memberIDs); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Repeated members are ignored, and members come ordered by user ID
+	c, err := sm.CreateConversation(ctx, johnID, []string{janeID, jimID, janeID})
+	if err != nil {
+		t.Fatalf("Error creating conversation: %v", err)
+	}
+	if len(c.Members) != 3 || c.LastMessage != nil {
+		t.Fatalf("Expected three members and no message, got %+v", c)
+	}
+	for i := 1; i < len(c.Members); i++ {
+		if c.Members[i-1].UserID.String() >= c.Members[i].UserID.String() {
+			t.Errorf("Expected members ordered by user ID, got %+v", c.Members)
+		}
+	}
+
+	for _, content := range []string{"hi all", "how are you?"} {
+		m := &entities.Message{ConversationID: c.ID, SenderID: john.ID, Content: content}
+		if err = sm.Send(ctx, m); err != nil {
+			t.Fatalf("Error sending message: %v", err)
+		}
+	}
+	reply := &entities.Message{ConversationID: c.ID, SenderID: jane.ID, Content: "fine"}
+	if err = sm.Send(ctx, reply); err != nil {
+		t.Fatalf("Error sending message: %v", err)
+	}
+	if reply.ID == uuid.Nil || reply.CreatedAt.IsZero() {
+		t.Errorf("Expected the message to be stored, got %+v", reply)
+	}
+
+	sendTests := []struct {
+		name string
+		m    *entities.Message
+		want error
+	}{
+		{"empty", &entities.Message{ConversationID: c.ID, SenderID: john.ID, Content: "  "}, entities.ErrMessageEmpty},
+		{
+			"too long",
+			&entities.Message{ConversationID: c.ID, SenderID: john.ID, Content: strings.Repeat("a", 10001)},
+			entities.ErrMessageTooLong,
+		},
+		{
+			"unknown conversation",
+			&entities.Message{ConversationID: uuid.New(), SenderID: john.ID, Content: "hi"},
+			entities.ErrNotFound,
+		},
+		{
+			"not a member",
+			&entities.Message{ConversationID: c.ID, SenderID: uuid.New(), Content: "hi"},
+			entities.ErrForbidden,
+		},
+	}
+	for _, tt := range sendTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := sm.Send(ctx, tt.m); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Unread counts only cover the messages others sent after the last read one
+	page, err := sm.FindConversations(ctx, jimID, jimID, "", 10)
+	if err != nil {
+		t.Fatalf("Error listing conversations: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].LastMessage == nil || page.Items[0].LastMessage.ID != reply.ID {
+		t.Fatalf("Expected the conversation with Jane's reply last, got %+v", page.Items)
+	}
+	unread := map[uuid.UUID]int{}
+	for _, m := range page.Items[0].Members {
+		unread[m.UserID] = m.UnreadCount
+	}
+	if unread[john.ID] != 1 || unread[jane.ID] != 0 || unread[jim.ID] != 3 {
+		t.Errorf("Expected unread counts 1, 0 and 3, got %v", unread)
+	}
+	if err = sm.MarkRead(ctx, c.ID.String(), jimID); err != nil {
+		t.Fatalf("Error marking messages read: %v", err)
+	}
+	if err = sm.MarkRead(ctx, c.ID.String(), uuid.NewString()); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	c2, err := s.Messages().FindConversation(ctx, c.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding conversation: %v", err)
+	}
+	for _, m := range c2.Members {
+		if m.UserID == jim.ID && m.UnreadCount != 0 {
+			t.Errorf("Expected Jim to have read everything, got %d unread", m.UnreadCount)
+		}
+	}
+
+	// The list of conversations is private
+	if _, err = sm.FindConversations(ctx, jimID, johnID, "", 10); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+
+	// History comes newest first, one page at a time, to members only
+	history, err := sm.FindMessages(ctx, c.ID.String(), jimID, "", 2)
+	if err != nil {
+		t.Fatalf("Error listing messages: %v", err)
+	}
+	if len(history.Items) != 2 || history.Items[0].Content != "fine" || history.NextCursor == "" {
+		t.Fatalf("Expected Jane's reply first and a next page, got %+v", history)
+	}
+	history, err = sm.FindMessages(ctx, c.ID.String(), jimID, history.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("Error listing messages: %v", err)
+	}
+	if len(history.Items) != 1 || history.Items[0].Content != "hi all" || history.NextCursor != "" {
+		t.Errorf("Expected John's first message last, got %+v", history)
+	}
+	if _, err = sm.FindMessages(ctx, c.ID.String(), uuid.NewString(), "", 2); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestMessagesBlocked(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	sm := service.NewMessageService(s, nil)
+	sr := service.NewRelationshipService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	jim := createUser(t, su, "jim")
+	johnID, janeID, jimID := john.ID.String(), jane.ID.String(), jim.ID.String()
+
+	c, err := sm.CreateConversation(ctx, johnID, []string{janeID})
+	if err != nil {
+		t.Fatalf("Error creating conversation: %v", err)
+	}
+	if err = sr.Block(ctx, janeID, johnID); err != nil {
+		t.Fatalf("Error blocking user: %v", err)
+	}
+
+	// Whoever blocked, neither can write to the other
+	if _, err = sm.CreateConversation(ctx, johnID, []string{janeID}); !errors.Is(err, entities.ErrBlocked) {
+		t.Errorf("Expected ErrBlocked, got %v", err)
+	}
+	// Nor can anyone else put them in a group together
+	if _, err = sm.CreateConversation(ctx, jimID, []string{johnID, janeID}); !errors.Is(err, entities.ErrBlocked) {
+		t.Errorf("Expected ErrBlocked, got %v", err)
+	}
+	for _, sender := range []uuid.UUID{john.ID, jane.ID} {
+		err = sm.Send(ctx, &entities.Message{ConversationID: c.ID, SenderID: sender, Content: "hi"})
+		if !errors.Is(err, entities.ErrBlocked) {
+			t.Errorf("Expected ErrBlocked, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// maxNotificationActors is the number of most recent actors listed on a group
+// of notifications; ActorCount still counts all of them.
+const maxNotificationActors = 3
+
+// NotificationService is a domain service for the notifications of users.
+// Notifications are written by the other services, in the transaction of the
+// action that triggers them.
+type NotificationService interface {
+	// FindByUser returns a page of the notifications of userID, newest first,
+	// only of type typ unless it is empty.
+	FindByUser(ctx context.Context, userID, typ, cursor string, limit int) (entities.Page[entities.Notification], error)
+	// CountUnread counts the unread notifications of userID, a group counting
+	// as one.
+	CountUnread(ctx context.Context, userID string) (int, error)
+	// MarkRead marks every notification of userID read.
+	MarkRead(ctx context.Context, userID string) error
+}
+
+// notificationService is an implementation of the NotificationService interface.
+type notificationService struct {
+	store store.Store
+}
+
+// NewNotificationService returns a new NotificationService.
+func NewNotificationService(s store.Store) NotificationService {
+	return &notificationService{s}
+}
+
+// FindByUser returns a page of the notifications of userID starting at cursor.
+// Cursors hold the ID of the newest notification of a group.
+func (s *notificationService) FindByUser(
+	ctx context.Context,
+	userID, typ, cursor string,
+	limit int,
+) (entities.Page[entities.Notification], error) {
+	notificationType := entities.NotificationType(typ)
+	if typ != "" && !slices.Contains(notificationTypes, notificationType) {
+		return entities.Page[entities.Notification]{}, entities.ErrInvalidNotificationType
+	}
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Notification]{}, err
+	}
+	if err = checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return entities.Page[entities.Notification]{}, err
+	}
+	limit = pageLimit(limit)
+	notifications, err := s.store.Notifications().FindByUser(ctx, userID, notificationType, beforeID, limit+1)
+	if err != nil {
+		return entities.Page[entities.Notification]{}, fmt.Errorf("could not find notifications: %w", err)
+	}
+	for i := range notifications {
+		if len(notifications[i].Actors) > maxNotificationActors {
+			notifications[i].Actors = notifications[i].Actors[:maxNotificationActors]
+		}
+	}
+	return newPage(notifications, limit, func(n entities.Notification) uuid.UUID { return n.ID }), nil
+}
+
+// CountUnread counts the unread notifications of userID.
+func (s *notificationService) CountUnread(ctx context.Context, userID string) (int, error) {
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return 0, err
+	}
+	count, err := s.store.Notifications().CountUnread(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("could not count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks every notification of userID read.
+func (s *notificationService) MarkRead(ctx context.Context, userID string) error {
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return err
+	}
+	if err := s.store.Notifications().MarkRead(ctx, userID); err != nil {
+		return fmt.Errorf("could not mark notifications read: %w", err)
+	}
+	return nil
+}
+
+// notificationTypes are the types notifications can be filtered by.
+var notificationTypes = []entities.NotificationType{
+	entities.NotificationFollow,
+	entities.NotificationLike,
+	entities.NotificationReply,
+	entities.NotificationMention,
+	entities.NotificationRetweet,
+	entities.NotificationQuote,
+}
+
+// notify records that actorID did something of type typ to userID, about
+// tweetID unless it is empty, and reports whether it did. Users are not
+// notified of their own actions, nor of those of users they block, are blocked
+// by or mute.
+func notify(
+	ctx context.Context,
+	s store.Store,
+	userID, actorID string,
+	typ entities.NotificationType,
+	tweetID string,
+) (bool, error) {
+	if userID == actorID {
+		return false, nil
+	}
+	hidden, err := hiddenAuthors(ctx, s, userID, true)
+	if err != nil {
+		return false, err
+	}
+	if slices.Contains(hidden, actorID) {
+		return false, nil
+	}
+	if err = s.Notifications().Create(ctx, userID, actorID, typ, tweetID); err != nil {
+		return false, fmt.Errorf("could not create notification: %w", err)
+	}
+	return true, nil
+}
+
+// notifyTweet notifies the users the new tweet t reaches out to: the author of
+// parent, the tweet it replies to, the author of original, the tweet it
+// retweets or quotes, and the users it mentions. Either tweet may be nil. Each
+// user is notified once, of the first of these that applies. The users
+// notified are returned.
+func notifyTweet(
+	ctx context.Context,
+	s store.Store,
+	t *entities.Tweet,
+	parent, original *entities.Tweet,
+) ([]string, error) {
+	actorID := t.UserID.String()
+	seen := make(map[uuid.UUID]bool)
+	var notified []string
+	send := func(userID uuid.UUID, typ entities.NotificationType, tweetID uuid.UUID) error {
+		if seen[userID] {
+			return nil
+		}
+		seen[userID] = true
+		ok, err := notify(ctx, s, userID.String(), actorID, typ, tweetID.String())
+		if ok {
+			notified = append(notified, userID.String())
+		}
+		return err
+	}
+
+	if parent != nil {
+		if err := send(parent.UserID, entities.NotificationReply, t.ID); err != nil {
+			return nil, err
+		}
+	}
+	if original != nil {
+		// a retweet points at the original, a quote has content of its own
+		typ, tweetID := entities.NotificationQuote, t.ID
+		if t.Kind == entities.TweetKindRetweet {
+			typ, tweetID = entities.NotificationRetweet, original.ID
+		}
+		if err := send(original.UserID, typ, tweetID); err != nil {
+			return nil, err
+		}
+	}
+	for _, m := range t.Mentions {
+		if err := send(m.UserID, entities.NotificationMention, t.ID); err != nil {
+			return nil, err
+		}
+	}
+	return notified, nil
+}
+
+// publishNotified tells the live streams of userIDs that they were notified.
+func publishNotified(b *events.Broker, userIDs ...string) {
+	for _, userID := range userIDs {
+		b.Publish(events.Event{Kind: events.KindNotification, UserID: userID})
+	}
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestNotifications(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sf := service.NewFollowService(s, nil)
+	sl := service.NewLikeService(s, nil)
+	sr := service.NewRelationshipService(s)
+	sn := service.NewNotificationService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	jim := createUser(t, su, "jim")
+	joe := createUser(t, su, "joe")
+	amy := createUser(t, su, "amy")
+	johnID := john.ID.String()
+
+	tweet := &entities.Tweet{UserID: john.ID, Content: "hello"}
+	if err := st.Create(ctx, tweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	tweetID := tweet.ID.String()
+
+	for _, u := range []entities.User{jane, jim} {
+		if err := sf.Follow(ctx, u.ID.String(), johnID); err != nil {
+			t.Fatalf("Error following user: %v", err)
+		}
+	}
+	// John liking his own tweet is no news to him
+	for _, u := range []entities.User{jane, jim, joe, amy, john} {
+		if err := sl.Like(ctx, u.ID.String(), tweetID); err != nil {
+			t.Fatalf("Error liking tweet: %v", err)
+		}
+	}
+	// A reply mentioning John notifies him once, of the reply
+	tweets := []*entities.Tweet{
+		{UserID: jane.ID, Content: "@john hi", InReplyToTweetID: &tweet.ID},
+		{UserID: jim.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &tweet.ID},
+		{UserID: joe.ID, Content: "nice", Kind: entities.TweetKindQuote, ReferencedTweetID: &tweet.ID},
+		{UserID: amy.ID, Content: "hey @john"},
+	}
+	for _, tw := range tweets {
+		if err := st.Create(ctx, tw); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+	}
+	// Nor do muted users notify
+	if err := sr.Mute(ctx, johnID, amy.ID.String()); err != nil {
+		t.Fatalf("Error muting user: %v", err)
+	}
+	if err := st.Create(ctx, &entities.Tweet{UserID: amy.ID, Content: "@john again"}); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+
+	page, err := sn.FindByUser(ctx, johnID, "", "", 0)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	var types []entities.NotificationType
+	for _, n := range page.Items {
+		types = append(types, n.Type)
+	}
+	want := []entities.NotificationType{
+		entities.NotificationMention,
+		entities.NotificationQuote,
+		entities.NotificationRetweet,
+		entities.NotificationReply,
+		entities.NotificationLike,
+		entities.NotificationFollow,
+	}
+	if len(types) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, types)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, types)
+		}
+	}
+	if page.Items[0].TweetID == nil || *page.Items[0].TweetID != tweets[3].ID {
+		t.Errorf("Expected the mention to point at the mentioning tweet, got %v", page.Items[0].TweetID)
+	}
+	if page.Items[2].TweetID == nil || *page.Items[2].TweetID != tweet.ID {
+		t.Errorf("Expected the retweet to point at the original, got %v", page.Items[2].TweetID)
+	}
+
+	// Only the most recent likers are listed
+	likes, err := sn.FindByUser(ctx, johnID, string(entities.NotificationLike), "", 0)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(likes.Items) != 1 || likes.Items[0].ActorCount != 4 || len(likes.Items[0].Actors) != 3 {
+		t.Fatalf("Expected one group of 4 likes, got %+v", likes.Items)
+	}
+	if likes.Items[0].Actors[0].UserID != amy.ID {
+		t.Errorf("Expected Amy as the latest liker, got %+v", likes.Items[0].Actors)
+	}
+
+	first, err := sn.FindByUser(ctx, johnID, "", "", 4)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(first.Items) != 4 || first.NextCursor == "" {
+		t.Fatalf("Expected a full first page, got %+v", first)
+	}
+	last, err := sn.FindByUser(ctx, johnID, "", first.NextCursor, 4)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(last.Items) != 2 || last.NextCursor != "" || last.Items[1].Type != entities.NotificationFollow {
+		t.Errorf("Expected the likes and follows on the last page, got %+v", last)
+	}
+
+	count, err := sn.CountUnread(ctx, johnID)
+	if err != nil {
+		t.Fatalf("Error counting unread notifications: %v", err)
+	}
+	if count != 6 {
+		t.Errorf("Expected 6 unread notifications, got %d", count)
+	}
+	if err = sn.MarkRead(ctx, johnID); err != nil {
+		t.Fatalf("Error marking notifications read: %v", err)
+	}
+	if count, err = sn.CountUnread(ctx, johnID); err != nil || count != 0 {
+		t.Errorf("Expected no unread notifications, got %d, %v", count, err)
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		typ    string
+		cursor string
+		want   error
+	}{
+		{"invalid type", johnID, "poke", "", entities.ErrInvalidNotificationType},
+		{"invalid cursor", johnID, "", "not-a-cursor", entities.ErrInvalidCursor},
+		{"unknown user", uuid.NewString(), "", "", entities.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := sn.FindByUser(ctx, tt.userID, tt.typ, tt.cursor, 0); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
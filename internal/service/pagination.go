@@ -0,0 +1,101 @@
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+const (
+	// DefaultPageLimit is the page size used when the caller does not ask for one.
+	DefaultPageLimit = 20
+	// MaxPageLimit is the largest page size a caller can ask for.
+	MaxPageLimit = 100
+)
+
+// pageLimit clamps a requested page size to [1, MaxPageLimit], falling back
+// to DefaultPageLimit when none was given.
+func pageLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultPageLimit
+	case limit > MaxPageLimit:
+		return MaxPageLimit
+	default:
+		return limit
+	}
+}
+
+// encodeCursor turns the ID of the last item of a page into an opaque cursor.
+func encodeCursor(id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id.String()))
+}
+
+// decodeCursor returns the ID encoded in cursor. An empty cursor decodes to an
+// empty ID, i.e. the first page.
+func decodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", entities.ErrInvalidCursor
+	}
+	id, err := uuid.Parse(string(raw))
+	if err != nil {
+		return "", entities.ErrInvalidCursor
+	}
+	return id.String(), nil
+}
+
+// newPage builds a page from items fetched with one extra row beyond limit:
+// if the extra row is present there is a next page, starting after the last
+// item kept.
+func newPage[T any](items []T, limit int, id func(T) uuid.UUID) entities.Page[T] {
+	if len(items) <= limit {
+		return entities.Page[T]{Items: items}
+	}
+	items = items[:limit]
+	return entities.Page[T]{
+		Items:      items,
+		NextCursor: encodeCursor(id(items[limit-1])),
+	}
+}
+
+// encodeOffsetCursor turns the position of the first item of the next page
+// into an opaque cursor, for listings that are not ordered by ID.
+func encodeOffsetCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodeOffsetCursor returns the offset encoded in cursor. An empty cursor
+// decodes to 0, i.e. the first page.
+func decodeOffsetCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, entities.ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, entities.ErrInvalidCursor
+	}
+	return offset, nil
+}
+
+// newOffsetPage builds a page from items fetched at offset with one extra row
+// beyond limit, like newPage.
+func newOffsetPage[T any](items []T, offset, limit int) entities.Page[T] {
+	if len(items) <= limit {
+		return entities.Page[T]{Items: items}
+	}
+	return entities.Page[T]{
+		Items:      items[:limit],
+		NextCursor: encodeOffsetCursor(offset + limit),
+	}
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestTweetFindPage(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	for _, content := range []string{"1", "2", "3", "4", "5"} {
+		createTweet(t, st, john.ID, content)
+	}
+
+	// Walk all pages following the cursors
+	var contents []string
+	cursor := ""
+	for pages := 1; ; pages++ {
+		page, err := st.FindPage(t.Context(), "", cursor, 2)
+		if err != nil {
+			t.Fatalf("Error retrieving page: %v", err)
+		}
+		for _, tweet := range page.Items {
+			contents = append(contents, tweet.Content)
+		}
+		if page.NextCursor == "" {
+			if pages != 3 {
+				t.Errorf("Expected 3 pages, got %d", pages)
+			}
+			break
+		}
+		cursor = page.NextCursor
+	}
+	want := []string{"5", "4", "3", "2", "1"}
+	if len(contents) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, contents)
+	}
+	for i := range want {
+		if contents[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, contents)
+			break
+		}
+	}
+}
+
+func TestTweetFindByUserID(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	for _, content := range []string{"1", "2", "3"} {
+		createTweet(t, st, john.ID, content)
+		createTweet(t, st, jane.ID, "jane "+content)
+	}
+
+	page, err := st.FindByUserID(t.Context(), john.ID.String(), "", "", 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Content != "3" || page.Items[1].Content != "2" || page.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	page, err = st.FindByUserID(t.Context(), john.ID.String(), "", page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Content != "1" || page.NextCursor != "" {
+		t.Errorf("Unexpected last page: %+v", page)
+	}
+
+	if _, err = st.FindByUserID(t.Context(), uuid.NewString(), "", "", 2); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserFindPageDefaultLimit(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+
+	createUser(t, su, "john")
+	createUser(t, su, "jane")
+
+	page, err := su.FindPage(t.Context(), "", 0)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Errorf("Expected 2 users, got %d", len(page.Items))
+	}
+	if page.Items[0].Username != "jane" {
+		t.Errorf("Expected newest user first, got %s", page.Items[0].Username)
+	}
+	if page.NextCursor != "" {
+		t.Errorf("Expected no next cursor, got %q", page.NextCursor)
+	}
+}
+
+func TestFindPageInvalidCursor(t *testing.T) {
+	s := store.NewMemStore()
+	st := service.NewTweetService(s, nil)
+
+	for _, cursor := range []string{"not base64!", "bm90LWEtdXVpZA"} {
+		if _, err := st.FindPage(t.Context(), "", cursor, 10); !errors.Is(err, entities.ErrInvalidCursor) {
+			t.Errorf("Expected ErrInvalidCursor for %q, got %v", cursor, err)
+		}
+	}
+}
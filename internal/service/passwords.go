@@ -0,0 +1,113 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/argon2"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// Password policy, in characters. NIST SP 800-63B asks for at least 8; the
+// upper bound caps the hashing work a single request can ask for.
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 128
+)
+
+const (
+	passwordSaltLength = 16 // bytes of random salt per hash
+	passwordKeyLength  = 32 // bytes of derived key
+)
+
+// errInvalidPasswordHash is returned for a stored hash that cannot be parsed.
+var errInvalidPasswordHash = errors.New("invalid password hash")
+
+// PasswordParams are the argon2id parameters passwords are hashed with.
+// Passwords hashed with other parameters are rehashed on their next
+// successful login, so raising them upgrades every active user in time.
+type PasswordParams struct {
+	Memory  uint32 // KiB
+	Time    uint32 // passes over the memory
+	Threads uint8
+}
+
+// DefaultPasswordParams are the argon2id parameters OWASP recommends at the
+// least.
+var DefaultPasswordParams = PasswordParams{Memory: 19 * 1024, Time: 2, Threads: 1}
+
+// validatePassword checks that password follows the password policy for the
+// user username.
+func validatePassword(password, username string) error {
+	n := utf8.RuneCountInString(password)
+	switch {
+	case n == 0:
+		return entities.ErrPasswordRequired
+	case n < minPasswordLength:
+		return entities.ErrPasswordTooShort
+	case n > maxPasswordLength:
+		return entities.ErrPasswordTooLong
+	case username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)):
+		return entities.ErrPasswordContainsUsername
+	}
+	return nil
+}
+
+// hashPassword hashes password with p and a random salt into a PHC string
+// such as "$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>".
+func hashPassword(password string, p PasswordParams) (string, error) {
+	salt := make([]byte, passwordSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("could not generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, p.Time, p.Memory, p.Threads, passwordKeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// verifyPassword reports whether password is the one hash was made from and,
+// if so, whether hash is stale: made with other parameters than p.
+func verifyPassword(password, hash string, p PasswordParams) (bool, bool, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return false, false, errInvalidPasswordHash
+	}
+	var version int
+	var params PasswordParams
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return false, false, fmt.Errorf("%w: %w", errInvalidPasswordHash, err)
+	}
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads)
+	if err != nil {
+		return false, false, fmt.Errorf("%w: %w", errInvalidPasswordHash, err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, fmt.Errorf("%w: %w", errInvalidPasswordHash, err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, false, fmt.Errorf("%w: %w", errInvalidPasswordHash, err)
+	}
+	// argon2 panics on these
+	if params.Time == 0 || params.Threads == 0 || len(key) == 0 {
+		return false, false, errInvalidPasswordHash
+	}
+
+	derived := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(derived, key) != 1 {
+		return false, false, nil
+	}
+	stale := version != argon2.Version || params != p ||
+		len(salt) != passwordSaltLength || len(key) != passwordKeyLength
+	return true, stale, nil
+}
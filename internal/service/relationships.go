@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// RelationshipService is a domain service for the users blocking and muting
+// others. A block works both ways: neither user can follow, reply to, mention
+// or see the tweets of the other. A mute only hides the muted user's tweets
+// from the muter's timelines and searches.
+type RelationshipService interface {
+	Block(ctx context.Context, userID, targetID string) error
+	Unblock(ctx context.Context, userID, targetID string) error
+	// Blocked returns the users userID blocks. The list is private: viewerID
+	// must be userID.
+	Blocked(ctx context.Context, userID, viewerID string) ([]entities.User, error)
+	Mute(ctx context.Context, userID, targetID string) error
+	Unmute(ctx context.Context, userID, targetID string) error
+	// Muted returns the users userID mutes. The list is private: viewerID must
+	// be userID.
+	Muted(ctx context.Context, userID, viewerID string) ([]entities.User, error)
+}
+
+// relationshipService is an implementation of the RelationshipService interface.
+type relationshipService struct {
+	store store.Store
+}
+
+// NewRelationshipService returns a new RelationshipService.
+func NewRelationshipService(s store.Store) RelationshipService {
+	return &relationshipService{s}
+}
+
+// Block makes userID block targetID, and removes the follows between them in
+// either direction.
+func (s *relationshipService) Block(ctx context.Context, userID, targetID string) error {
+	if userID == targetID {
+		return entities.ErrSelfBlock
+	}
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		if err := checkFollowUsers(ctx, scopedStore.Users(), userID, targetID); err != nil {
+			return err
+		}
+		if err := scopedStore.Blocks().Create(ctx, userID, targetID); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyBlocked
+			}
+			return fmt.Errorf("could not create block: %w", err)
+		}
+		for _, pair := range [][2]string{{userID, targetID}, {targetID, userID}} {
+			err := scopedStore.Follows().Delete(ctx, pair[0], pair[1])
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				return fmt.Errorf("could not remove follow: %w", err)
+			}
+		}
+		return nil
+	}); errOut != nil {
+		return fmt.Errorf("could not block user in the tx: %w", errOut)
+	}
+	return nil
+}
+
+// Unblock makes userID stop blocking targetID. Follows removed by the block
+// are not restored.
+func (s *relationshipService) Unblock(ctx context.Context, userID, targetID string) error {
+	if err := s.store.Blocks().Delete(ctx, userID, targetID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotBlocked
+		}
+		return fmt.Errorf("could not unblock user: %w", err)
+	}
+	return nil
+}
+
+// Blocked returns the users userID blocks, most recently blocked first.
+func (s *relationshipService) Blocked(ctx context.Context, userID, viewerID string) ([]entities.User, error) {
+	if viewerID != userID {
+		return nil, entities.ErrForbidden
+	}
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return nil, err
+	}
+	users, err := s.store.Blocks().FindBlocked(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find blocked users: %w", err)
+	}
+	return users, nil
+}
+
+// Mute makes userID mute targetID.
+func (s *relationshipService) Mute(ctx context.Context, userID, targetID string) error {
+	if userID == targetID {
+		return entities.ErrSelfBlock
+	}
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		if err := checkFollowUsers(ctx, scopedStore.Users(), userID, targetID); err != nil {
+			return err
+		}
+		if err := scopedStore.Mutes().Create(ctx, userID, targetID); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyMuted
+			}
+			return fmt.Errorf("could not create mute: %w", err)
+		}
+		return nil
+	}); errOut != nil {
+		return fmt.Errorf("could not mute user in the tx: %w", errOut)
+	}
+	return nil
+}
+
+// Unmute makes userID stop muting targetID.
+func (s *relationshipService) Unmute(ctx context.Context, userID, targetID string) error {
+	if err := s.store.Mutes().Delete(ctx, userID, targetID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotMuted
+		}
+		return fmt.Errorf("could not unmute user: %w", err)
+	}
+	return nil
+}
+
+// Muted returns the users userID mutes, most recently muted first.
+func (s *relationshipService) Muted(ctx context.Context, userID, viewerID string) ([]entities.User, error) {
+	if viewerID != userID {
+		return nil, entities.ErrForbidden
+	}
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return nil, err
+	}
+	users, err := s.store.Mutes().FindMuted(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find muted users: %w", err)
+	}
+	return users, nil
+}
+
+// hiddenAuthors returns the IDs of the users whose tweets viewerID does not
+// see: the ones blocking or blocked by viewerID and, if withMuted is set, the
+// ones viewerID mutes. Anonymous viewers, with an empty viewerID, see everyone.
+func hiddenAuthors(ctx context.Context, s store.Store, viewerID string, withMuted bool) ([]string, error) {
+	if viewerID == "" {
+		return nil, nil
+	}
+	blocked, err := s.Blocks().FindBlocked(ctx, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find blocked users: %w", err)
+	}
+	blockers, err := s.Blocks().FindBlockers(ctx, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find blockers: %w", err)
+	}
+	users := make([]entities.User, 0, len(blocked)+len(blockers))
+	users = append(users, blocked...)
+	users = append(users, blockers...)
+	if withMuted {
+		muted, mutedErr := s.Mutes().FindMuted(ctx, viewerID)
+		if mutedErr != nil {
+			return nil, fmt.Errorf("could not find muted users: %w", mutedErr)
+		}
+		users = append(users, muted...)
+	}
+
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID.String())
+	}
+	return ids, nil
+}
+
+// checkNotBlocked returns entities.ErrBlocked if either of userID and otherID
+// blocks the other.
+func checkNotBlocked(ctx context.Context, blockRepo repository.BlockRepository, userID, otherID string) error {
+	for _, pair := range [][2]string{{userID, otherID}, {otherID, userID}} {
+		blocks, err := blockRepo.Exists(ctx, pair[0], pair[1])
+		if err != nil {
+			return fmt.Errorf("error finding block: %w", err)
+		}
+		if blocks {
+			return entities.ErrBlocked
+		}
+	}
+	return nil
+}
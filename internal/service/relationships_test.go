@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestBlocks(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sf := service.NewFollowService(s, nil)
+	sl := service.NewLikeService(s, nil)
+	sb := service.NewBookmarkService(s)
+	sr := service.NewRelationshipService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	johnID, janeID := john.ID.String(), jane.ID.String()
+	createTweet(t, st, jane.ID, "hello from jane")
+	janeTweets, err := st.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	janeTweet := janeTweets[0]
+	if err = sf.Follow(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error following user: %v", err)
+	}
+	if err = sf.Follow(ctx, janeID, johnID); err != nil {
+		t.Fatalf("Error following user: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		targetID string
+		want     error
+	}{
+		{"self", johnID, johnID, entities.ErrSelfBlock},
+		{"unknown target", johnID, uuid.NewString(), entities.ErrNotFound},
+		{"unknown user", uuid.NewString(), janeID, entities.ErrInvalidUserID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := sr.Block(ctx, tt.userID, tt.targetID); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Blocking removes the follows both ways
+	if err = sr.Block(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error blocking user: %v", err)
+	}
+	if err = sr.Block(ctx, johnID, janeID); !errors.Is(err, entities.ErrAlreadyBlocked) {
+		t.Errorf("Expected ErrAlreadyBlocked, got %v", err)
+	}
+	for _, id := range []string{johnID, janeID} {
+		following, followErr := sf.Following(ctx, id)
+		if followErr != nil {
+			t.Fatalf("Error listing following: %v", followErr)
+		}
+		if len(following) != 0 {
+			t.Errorf("Expected no follows left, got %+v", following)
+		}
+	}
+
+	// Neither can follow, reply to or see the other, whoever blocked
+	for _, pair := range [][2]string{{johnID, janeID}, {janeID, johnID}} {
+		if err = sf.Follow(ctx, pair[0], pair[1]); !errors.Is(err, entities.ErrBlocked) {
+			t.Errorf("Expected ErrBlocked following, got %v", err)
+		}
+		if _, err = st.FindByUserID(ctx, pair[1], pair[0], "", 10); !errors.Is(err, entities.ErrBlocked) {
+			t.Errorf("Expected ErrBlocked listing tweets, got %v", err)
+		}
+	}
+	reply := &entities.Tweet{UserID: john.ID, Content: "hi", InReplyToTweetID: &janeTweet.ID}
+	if err = st.Create(ctx, reply); !errors.Is(err, entities.ErrBlocked) {
+		t.Errorf("Expected ErrBlocked replying, got %v", err)
+	}
+	quote := &entities.Tweet{UserID: john.ID, Kind: entities.TweetKindQuote, ReferencedTweetID: &janeTweet.ID}
+	if err = st.Create(ctx, quote); !errors.Is(err, entities.ErrBlocked) {
+		t.Errorf("Expected ErrBlocked quoting, got %v", err)
+	}
+	if _, err = st.FindByID(ctx, janeTweet.ID.String(), johnID); !errors.Is(err, entities.ErrBlocked) {
+		t.Errorf("Expected ErrBlocked finding tweet, got %v", err)
+	}
+	page, err := st.FindPage(ctx, johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	if len(page.Items) != 0 {
+		t.Errorf("Expected Jane's tweet to be hidden, got %+v", page.Items)
+	}
+
+	// Nor like or bookmark the other's tweets
+	johnTweet := &entities.Tweet{UserID: john.ID, Content: "hello from john"}
+	if err = st.Create(ctx, johnTweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	for _, attempt := range []struct{ userID, tweetID string }{
+		{johnID, janeTweet.ID.String()},
+		{janeID, johnTweet.ID.String()},
+	} {
+		if err = sl.Like(ctx, attempt.userID, attempt.tweetID); !errors.Is(err, entities.ErrBlocked) {
+			t.Errorf("Expected ErrBlocked liking, got %v", err)
+		}
+		if err = sb.Bookmark(ctx, attempt.userID, attempt.tweetID); !errors.Is(err, entities.ErrBlocked) {
+			t.Errorf("Expected ErrBlocked bookmarking, got %v", err)
+		}
+	}
+	likers, err := sl.Likers(ctx, johnTweet.ID.String())
+	if err != nil {
+		t.Fatalf("Error listing likers: %v", err)
+	}
+	if len(likers) != 0 {
+		t.Errorf("Expected no likers, got %+v", likers)
+	}
+
+	// Mentions of a blocked user are left as plain text
+	mention := &entities.Tweet{UserID: john.ID, Content: "hi @jane"}
+	if err = st.Create(ctx, mention); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	if len(mention.Mentions) != 0 {
+		t.Errorf("Expected no mentions, got %+v", mention.Mentions)
+	}
+
+	// The block list is private to its owner
+	if _, err = sr.Blocked(ctx, johnID, janeID); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	blocked, err := sr.Blocked(ctx, johnID, johnID)
+	if err != nil {
+		t.Fatalf("Error listing blocked users: %v", err)
+	}
+	if len(blocked) != 1 || blocked[0].ID != jane.ID {
+		t.Errorf("Expected Jane to be blocked, got %+v", blocked)
+	}
+
+	if err = sr.Unblock(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error unblocking user: %v", err)
+	}
+	if err = sr.Unblock(ctx, johnID, janeID); !errors.Is(err, entities.ErrNotBlocked) {
+		t.Errorf("Expected ErrNotBlocked, got %v", err)
+	}
+	if _, err = st.FindByID(ctx, janeTweet.ID.String(), johnID); err != nil {
+		t.Errorf("Expected Jane's tweet after unblocking, got %v", err)
+	}
+}
+
+func TestMutes(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sf := service.NewFollowService(s, nil)
+	stl := service.NewTimelineService(s)
+	sr := service.NewRelationshipService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	johnID, janeID := john.ID.String(), jane.ID.String()
+	createTweet(t, st, jane.ID, "hello #go")
+	if err := sf.Follow(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error following user: %v", err)
+	}
+
+	if err := sr.Mute(ctx, johnID, johnID); !errors.Is(err, entities.ErrSelfBlock) {
+		t.Errorf("Expected ErrSelfBlock, got %v", err)
+	}
+	if err := sr.Mute(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error muting user: %v", err)
+	}
+	if err := sr.Mute(ctx, johnID, janeID); !errors.Is(err, entities.ErrAlreadyMuted) {
+		t.Errorf("Expected ErrAlreadyMuted, got %v", err)
+	}
+
+	// Muted tweets leave John's timelines and searches
+	home, err := stl.Home(ctx, johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error getting timeline: %v", err)
+	}
+	if len(home.Items) != 0 {
+		t.Errorf("Expected an empty timeline, got %+v", home.Items)
+	}
+	found, err := st.Search(ctx, "hello", "", johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error searching tweets: %v", err)
+	}
+	if len(found.Items) != 0 {
+		t.Errorf("Expected no search results, got %+v", found.Items)
+	}
+	tagged, err := st.FindByHashtag(ctx, "go", johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	if len(tagged.Items) != 0 {
+		t.Errorf("Expected no tagged tweets, got %+v", tagged.Items)
+	}
+
+	// but John still follows Jane and can open her profile
+	page, err := st.FindByUserID(ctx, janeID, johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error listing tweets: %v", err)
+	}
+	if len(page.Items) != 1 {
+		t.Errorf("Expected Jane's tweet, got %+v", page.Items)
+	}
+
+	if _, err = sr.Muted(ctx, johnID, janeID); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	muted, err := sr.Muted(ctx, johnID, johnID)
+	if err != nil {
+		t.Fatalf("Error listing muted users: %v", err)
+	}
+	if len(muted) != 1 || muted[0].ID != jane.ID {
+		t.Errorf("Expected Jane to be muted, got %+v", muted)
+	}
+
+	if err = sr.Unmute(ctx, johnID, janeID); err != nil {
+		t.Fatalf("Error unmuting user: %v", err)
+	}
+	if err = sr.Unmute(ctx, johnID, janeID); !errors.Is(err, entities.ErrNotMuted) {
+		t.Errorf("Expected ErrNotMuted, got %v", err)
+	}
+	home, err = stl.Home(ctx, johnID, "", 10)
+	if err != nil {
+		t.Fatalf("Error getting timeline: %v", err)
+	}
+	if len(home.Items) != 1 {
+		t.Errorf("Expected Jane's tweet back on the timeline, got %+v", home.Items)
+	}
+}
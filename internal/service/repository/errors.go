@@ -2,5 +2,23 @@ package repository
 
 import "errors"
 
-// ErrNotFound is returned when a record is not found.
-var ErrNotFound = errors.New("not found")
+var (
+	// ErrNotFound is returned when a record is not found.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when a record with the same key already exists.
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// ConflictError is returned when a unique field of a record is already taken.
+// It matches ErrAlreadyExists with errors.Is.
+type ConflictError struct {
+	Field string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Field + " already exists"
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrAlreadyExists
+}
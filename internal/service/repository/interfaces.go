@@ -7,17 +7,227 @@ import (
 )
 
 // UserRepository represents a repository for users.
+//
+// FindPage returns at most limit users ordered by ID descending, starting
+// after beforeID; an empty beforeID starts from the newest user.
+//
+// Users are soft-deleted: Delete only sets deleted_at, and every finder except
+// FindDeleted skips deleted users. Delete and Restore return ErrNotFound when
+// there is no live, respectively deleted, user with the given ID.
+//
+// Create and Update return a *ConflictError naming the field when another
+// user, deleted or not, already holds the username or email. Update applies
+// the non-nil fields of upd to a live user and returns the result. Usernames
+// compare regardless of case, both for uniqueness and in FindByUsername.
+//
+// Search returns the live users whose username or name resembles query, best
+// match first, skipping the first offset matches. How close a match must be is
+// up to the implementation.
 type UserRepository interface {
 	FindAll(ctx context.Context) ([]entities.User, error)
+	FindPage(ctx context.Context, beforeID string, limit int) ([]entities.User, error)
 	Create(ctx context.Context, u *entities.User) error
 	FindByID(ctx context.Context, id string) (*entities.User, error)
 	FindByUsername(ctx context.Context, username string) (*entities.User, error)
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
+	Search(ctx context.Context, query string, offset, limit int) ([]entities.User, error)
+	Update(ctx context.Context, id string, upd entities.UserUpdate) (*entities.User, error)
+	UpdatePasswordHash(ctx context.Context, id, hash string) error
+	Delete(ctx context.Context, id string) error
+	FindDeleted(ctx context.Context) ([]entities.User, error)
+	Restore(ctx context.Context, id string) error
 }
 
 // TweetRepository represents a repository for tweets.
+//
+// FindPage returns at most limit tweets ordered by ID descending, starting
+// after beforeID; an empty beforeID starts from the newest tweet. FindByUserID
+// pages through the tweets of one user in the same way, and FindTimeline
+// through those of userID and the users they follow.
+//
+// Tweets are soft-deleted in the same way as users.
+//
+// Create returns ErrAlreadyExists when a user already has a live retweet of
+// the same tweet. FindByIDs skips unknown and deleted tweets, and
+// CountReferences counts the live tweets of the given kind referencing each
+// of tweetIDs; tweets without references are missing from the map.
+//
+// Create sets ConversationID to the tweet's own ID unless it is already set.
+// FindThread returns the live tweet rootID and the live replies below it, at
+// most maxDepth levels deep, depth first with siblings oldest first. Replies
+// to a deleted tweet are left out along with it; a missing or deleted root is
+// ErrNotFound.
+//
+// Search returns the live tweets matching q. Tweets ordered by recency are
+// paged with beforeID like FindPage; tweets ordered by relevance, from the
+// tweets matching the query words most often, are paged with offset.
+//
+// Listings taking excludedUserIDs leave out the tweets written by those users;
+// FindThread leaves out their replies along with the replies below them.
 type TweetRepository interface {
 	FindAll(ctx context.Context) ([]entities.Tweet, error)
+	FindPage(ctx context.Context, beforeID string, limit int, excludedUserIDs []string) ([]entities.Tweet, error)
+	FindByUserID(ctx context.Context, userID, beforeID string, limit int) ([]entities.Tweet, error)
 	Create(ctx context.Context, t *entities.Tweet) error
 	FindByID(ctx context.Context, id string) (*entities.Tweet, error)
+	FindByIDs(ctx context.Context, ids []string) ([]entities.Tweet, error)
+	FindRetweet(ctx context.Context, userID, tweetID string) (*entities.Tweet, error)
+	FindThread(ctx context.Context, rootID string, maxDepth int, excludedUserIDs []string) ([]entities.Tweet, error)
+	Search(
+		ctx context.Context,
+		q entities.TweetQuery,
+		beforeID string,
+		offset, limit int,
+		excludedUserIDs []string,
+	) ([]entities.Tweet, error)
+	CountReferences(ctx context.Context, tweetIDs []string, kind entities.TweetKind) (map[string]int, error)
+	FindTimeline(
+		ctx context.Context,
+		userID, beforeID string,
+		limit int,
+		excludedUserIDs []string,
+	) ([]entities.Tweet, error)
+	Delete(ctx context.Context, id string) error
+	FindDeleted(ctx context.Context) ([]entities.Tweet, error)
+	Restore(ctx context.Context, id string) error
+}
+
+// FollowRepository represents a repository for the follower/followee graph.
+type FollowRepository interface {
+	Create(ctx context.Context, followerID, followeeID string) error
+	Delete(ctx context.Context, followerID, followeeID string) error
+	FindFollowers(ctx context.Context, userID string) ([]entities.User, error)
+	FindFollowing(ctx context.Context, userID string) ([]entities.User, error)
+}
+
+// BlockRepository represents a repository for the users blocking others.
+//
+// Exists reports whether blockerID blocks blockedID. FindBlocked and
+// FindBlockers return the live users userID blocks and is blocked by, most
+// recent first.
+type BlockRepository interface {
+	Create(ctx context.Context, blockerID, blockedID string) error
+	Delete(ctx context.Context, blockerID, blockedID string) error
+	Exists(ctx context.Context, blockerID, blockedID string) (bool, error)
+	FindBlocked(ctx context.Context, userID string) ([]entities.User, error)
+	FindBlockers(ctx context.Context, userID string) ([]entities.User, error)
+}
+
+// MuteRepository represents a repository for the users muting others.
+//
+// FindMuted returns the live users userID mutes, most recent first.
+type MuteRepository interface {
+	Create(ctx context.Context, muterID, mutedID string) error
+	Delete(ctx context.Context, muterID, mutedID string) error
+	FindMuted(ctx context.Context, userID string) ([]entities.User, error)
+}
+
+// LikeRepository represents a repository for likes of tweets by users.
+//
+// Likes by soft-deleted users are kept but neither listed nor counted.
+type LikeRepository interface {
+	Create(ctx context.Context, userID, tweetID string) error
+	Delete(ctx context.Context, userID, tweetID string) error
+	FindLikers(ctx context.Context, tweetID string) ([]entities.User, error)
+	// CountByTweet returns the like count of each of the given tweets; tweets
+	// without likes are missing from the map.
+	CountByTweet(ctx context.Context, tweetIDs []string) (map[string]int, error)
+	// FindLikedByUser returns which of the given tweets userID likes.
+	FindLikedByUser(ctx context.Context, userID string, tweetIDs []string) (map[string]bool, error)
+}
+
+// BookmarkRepository represents a repository for the bookmarks users keep of
+// tweets.
+//
+// Create returns ErrAlreadyExists when userID already bookmarked tweetID, and
+// Delete ErrNotFound when it did not. FindByUser returns at most limit of
+// userID's bookmarks made before the bookmark beforeID, most recent first, with
+// their tweets; bookmarks of deleted tweets and of tweets written by
+// excludedUserIDs are left out.
+type BookmarkRepository interface {
+	Create(ctx context.Context, userID, tweetID string) error
+	Delete(ctx context.Context, userID, tweetID string) error
+	FindByUser(
+		ctx context.Context,
+		userID, beforeID string,
+		limit int,
+		excludedUserIDs []string,
+	) ([]entities.Bookmark, error)
+}
+
+// HashtagRepository represents a repository for the hashtags of tweets.
+//
+// Tags are stored as given; normalizing them is up to the caller. Attach
+// links tweetID to each of tags, creating the hashtags that do not exist yet.
+// FindTweets pages through the live tweets tagged with tag in the same way as
+// TweetRepository.FindPage, leaving out those written by excludedUserIDs.
+type HashtagRepository interface {
+	Attach(ctx context.Context, tweetID string, tags []string) error
+	FindTweets(ctx context.Context, tag, beforeID string, limit int, excludedUserIDs []string) ([]entities.Tweet, error)
+}
+
+// MentionRepository represents a repository for the users mentioned in tweets.
+//
+// Mentions of soft-deleted users are kept but neither listed nor returned.
+type MentionRepository interface {
+	Create(ctx context.Context, tweetID string, mentions []entities.Mention) error
+	// FindByTweets returns the mentions of each of the given tweets ordered by
+	// offset; tweets without mentions are missing from the map.
+	FindByTweets(ctx context.Context, tweetIDs []string) (map[string][]entities.Mention, error)
+	// FindTweets pages through the live tweets mentioning userID in the same
+	// way as TweetRepository.FindPage, leaving out those written by
+	// excludedUserIDs.
+	FindTweets(
+		ctx context.Context,
+		userID, beforeID string,
+		limit int,
+		excludedUserIDs []string,
+	) ([]entities.Tweet, error)
+}
+
+// MessageRepository represents a repository for the private conversations
+// between users and their messages.
+//
+// CreateConversation stores c with memberIDs as its members, setting its ID,
+// CreatedAt and Members. Conversations are read with their live members, each
+// with their unread count, and their last message. FindConversation returns
+// ErrNotFound when there is no conversation id. FindConversations returns at
+// most limit of userID's conversations, most recently active first: ordered by
+// the ID of their last message or, before the first message, by their own ID,
+// descending, starting after beforeID.
+//
+// Create stores m, makes it the last message of its conversation and marks it
+// read by its sender. FindMessages pages through the messages of a
+// conversation in the same way as TweetRepository.FindPage. MarkRead marks the
+// messages of conversationID up to messageID read by userID.
+type MessageRepository interface {
+	CreateConversation(ctx context.Context, c *entities.Conversation, memberIDs []string) error
+	FindConversation(ctx context.Context, id string) (*entities.Conversation, error)
+	FindConversations(ctx context.Context, userID, beforeID string, limit int) ([]entities.Conversation, error)
+	Create(ctx context.Context, m *entities.Message) error
+	FindMessages(ctx context.Context, conversationID, beforeID string, limit int) ([]entities.Message, error)
+	MarkRead(ctx context.Context, conversationID, userID, messageID string) error
+}
+
+// NotificationRepository represents a repository for the notifications of
+// users.
+//
+// Create records that actorID did something of type typ to userID, about
+// tweetID unless it is empty. FindByUser returns at most limit notifications
+// of userID, grouped as described on entities.Notification and of type typ
+// unless it is empty, ordered by the ID of their newest notification,
+// descending, starting after beforeID. Notifications by deleted actors or about
+// deleted tweets are left out. CountUnread counts the groups of unread
+// notifications of userID, and MarkRead marks all of them read.
+type NotificationRepository interface {
+	Create(ctx context.Context, userID, actorID string, typ entities.NotificationType, tweetID string) error
+	FindByUser(
+		ctx context.Context,
+		userID string,
+		typ entities.NotificationType,
+		beforeID string,
+		limit int,
+	) ([]entities.Notification, error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	MarkRead(ctx context.Context, userID string) error
 }
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// BookmarkHandler is a memory implementation of the repository.BookmarkRepository interface.
+type BookmarkHandler struct {
+	db *memdb.MemDB
+}
+
+// NewBookmarkHandler returns a new BookmarkHandler backed by the given in-memory DB.
+func NewBookmarkHandler(db *memdb.MemDB) *BookmarkHandler {
+	return &BookmarkHandler{db: db}
+}
+
+// Create records that userID bookmarked tweetID.
+func (s *BookmarkHandler) Create(_ context.Context, userID, tweetID string) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate bookmark id: %w", err)
+	}
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableBookmarks, "user_tweet", userID, tweetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find bookmark: %w", err)
+	}
+	if existing != nil {
+		txn.Abort()
+		return repository.ErrAlreadyExists
+	}
+	record := &bookmarkRecord{
+		ID:        id.String(),
+		UserID:    userID,
+		TweetID:   tweetID,
+		CreatedAt: time.Now(),
+	}
+	if err = txn.Insert(tableBookmarks, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert bookmark: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// Delete removes the bookmark of tweetID by userID.
+func (s *BookmarkHandler) Delete(_ context.Context, userID, tweetID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableBookmarks, "user_tweet", userID, tweetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find bookmark: %w", err)
+	}
+	if existing == nil {
+		txn.Abort()
+		return repository.ErrNotFound
+	}
+	if err = txn.Delete(tableBookmarks, existing); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete bookmark: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindByUser returns at most limit bookmarks of live tweets by userID made
+// before the bookmark beforeID, most recent first, leaving out the tweets
+// written by excludedUserIDs.
+func (s *BookmarkHandler) FindByUser(
+	_ context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Bookmark, error) {
+	txn := s.db.Txn(false)
+	// entries of a non-unique index are ordered by ID within each value, and
+	// bookmark IDs sort by creation time
+	it, err := txn.GetReverse(tableBookmarks, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookmarks: %w", err)
+	}
+	bookmarks := make([]entities.Bookmark, 0, limit)
+	for obj := it.Next(); obj != nil && len(bookmarks) < limit; obj = it.Next() {
+		r, ok := obj.(*bookmarkRecord)
+		if !ok || (beforeID != "" && r.ID >= beforeID) {
+			continue
+		}
+		tweet, findErr := findLiveTweet(txn, r.TweetID)
+		if errors.Is(findErr, repository.ErrNotFound) {
+			continue
+		}
+		if findErr != nil {
+			return nil, findErr
+		}
+		if slices.Contains(excludedUserIDs, tweet.UserID) {
+			continue
+		}
+		bookmarks = append(bookmarks, entities.Bookmark{
+			ID:        uuid.MustParse(r.ID),
+			UserID:    uuid.MustParse(r.UserID),
+			Tweet:     tweet.toEntity(),
+			CreatedAt: r.CreatedAt,
+		})
+	}
+	return bookmarks, nil
+}
@@ -0,0 +1,71 @@
+package memory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestBookmarkHandler(t *testing.T) {
+	db := newTestDB(t)
+	bookmarkHandler := memory.NewBookmarkHandler(db)
+	tweetHandler := memory.NewTweetHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	userID := john.ID.String()
+
+	var tweetIDs []string
+	for _, content := range []string{"first", "second", "third"} {
+		tweet := &entities.Tweet{Content: content, UserID: john.ID}
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+		tweetIDs = append(tweetIDs, tweet.ID.String())
+	}
+
+	// Bookmark the tweets newest first, so bookmark order differs from tweet order
+	for i := len(tweetIDs) - 1; i >= 0; i-- {
+		if err := bookmarkHandler.Create(t.Context(), userID, tweetIDs[i]); err != nil {
+			t.Fatalf("Error creating bookmark: %v", err)
+		}
+	}
+	if err := bookmarkHandler.Create(t.Context(), userID, tweetIDs[0]); !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	// Most recent bookmark first, paged by bookmark ID
+	bookmarks, err := bookmarkHandler.FindByUser(t.Context(), userID, "", 2, nil)
+	if err != nil {
+		t.Fatalf("Error finding bookmarks: %v", err)
+	}
+	if len(bookmarks) != 2 || bookmarks[0].Tweet.Content != "first" || bookmarks[1].Tweet.Content != "second" {
+		t.Fatalf("Expected first then second, got %+v", bookmarks)
+	}
+	bookmarks, err = bookmarkHandler.FindByUser(t.Context(), userID, bookmarks[1].ID.String(), 2, nil)
+	if err != nil {
+		t.Fatalf("Error finding bookmarks: %v", err)
+	}
+	if len(bookmarks) != 1 || bookmarks[0].Tweet.Content != "third" {
+		t.Errorf("Expected third, got %+v", bookmarks)
+	}
+
+	// Deleted tweets drop out of the list
+	if err = tweetHandler.Delete(t.Context(), tweetIDs[0]); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	if err = bookmarkHandler.Delete(t.Context(), userID, tweetIDs[1]); err != nil {
+		t.Fatalf("Error deleting bookmark: %v", err)
+	}
+	if err = bookmarkHandler.Delete(t.Context(), userID, tweetIDs[1]); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	bookmarks, err = bookmarkHandler.FindByUser(t.Context(), userID, "", 10, nil)
+	if err != nil {
+		t.Fatalf("Error finding bookmarks: %v", err)
+	}
+	if len(bookmarks) != 1 || bookmarks[0].Tweet.Content != "third" {
+		t.Errorf("Expected third only, got %+v", bookmarks)
+	}
+}
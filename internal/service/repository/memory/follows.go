@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// FollowHandler is a memory implementation of the repository.FollowRepository interface.
+type FollowHandler struct {
+	db *memdb.MemDB
+}
+
+// NewFollowHandler returns a new FollowHandler backed by the given in-memory DB.
+func NewFollowHandler(db *memdb.MemDB) *FollowHandler {
+	return &FollowHandler{db: db}
+}
+
+// Create makes followerID follow followeeID.
+func (s *FollowHandler) Create(_ context.Context, followerID, followeeID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableFollows, "id", followerID, followeeID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find follow: %w", err)
+	}
+	if existing != nil {
+		txn.Abort()
+		return repository.ErrAlreadyExists
+	}
+	record := &followRecord{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		CreatedAt:  time.Now(),
+	}
+	if err = txn.Insert(tableFollows, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert follow: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// Delete makes followerID stop following followeeID.
+func (s *FollowHandler) Delete(_ context.Context, followerID, followeeID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableFollows, "id", followerID, followeeID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find follow: %w", err)
+	}
+	if existing == nil {
+		txn.Abort()
+		return repository.ErrNotFound
+	}
+	if err = txn.Delete(tableFollows, existing); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete follow: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindFollowers returns the users following userID, most recent first.
+func (s *FollowHandler) FindFollowers(_ context.Context, userID string) ([]entities.User, error) {
+	return s.findUsers("followee_id", userID, func(r *followRecord) string { return r.FollowerID })
+}
+
+// FindFollowing returns the users followed by userID, most recent first.
+func (s *FollowHandler) FindFollowing(_ context.Context, userID string) ([]entities.User, error) {
+	return s.findUsers("follower_id", userID, func(r *followRecord) string { return r.FolloweeID })
+}
+
+// findUsers looks up the follows matching userID on the given index and
+// resolves the user on the other side of each edge.
+func (s *FollowHandler) findUsers(
+	index, userID string,
+	other func(*followRecord) string,
+) ([]entities.User, error) {
+	txn := s.db.Txn(false)
+	it, err := txn.Get(tableFollows, index, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follows: %w", err)
+	}
+	var follows []*followRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*followRecord)
+		if !ok {
+			continue
+		}
+		follows = append(follows, r)
+	}
+	sort.SliceStable(follows, func(i, j int) bool {
+		return follows[i].CreatedAt.After(follows[j].CreatedAt)
+	})
+
+	users := make([]entities.User, 0, len(follows))
+	for _, f := range follows {
+		raw, findErr := txn.First(tableUsers, "id", other(f))
+		if findErr != nil {
+			return nil, fmt.Errorf("failed to find user: %w", findErr)
+		}
+		if raw == nil {
+			continue
+		}
+		r, ok := raw.(*userRecord)
+		if !ok {
+			return nil, errors.New(errUnexpectedUserRecord)
+		}
+		if r.DeletedAt != nil {
+			continue
+		}
+		users = append(users, r.toEntity())
+	}
+	return users, nil
+}
@@ -0,0 +1,127 @@
+package memory_test
+
+import (
+	"errors"
+	"testing"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func newTestDB(t *testing.T) *memdb.MemDB {
+	t.Helper()
+	db, err := memory.NewDB()
+	if err != nil {
+		t.Fatalf("failed to create in-memory DB: %v", err)
+	}
+	return db
+}
+
+func createTestUser(t *testing.T, db *memdb.MemDB, username string) *entities.User {
+	t.Helper()
+	user := &entities.User{
+		Username: username,
+		Email:    username + "@example.com",
+	}
+	if err := memory.NewUserHandler(db).Create(t.Context(), user); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	return user
+}
+
+func TestFollowHandlerCreate(t *testing.T) {
+	db := newTestDB(t)
+	followHandler := memory.NewFollowHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	// John follows Jane
+	err := followHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error creating follow: %v", err)
+	}
+
+	// Verify Jane has John as a follower
+	followers, err := followHandler.FindFollowers(t.Context(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error retrieving followers: %v", err)
+	}
+	if len(followers) != 1 {
+		t.Fatalf("Expected 1 follower, got %d", len(followers))
+	}
+	if followers[0].Username != john.Username {
+		t.Errorf("Expected follower %q, got %q", john.Username, followers[0].Username)
+	}
+
+	// Verify John is following Jane
+	following, err := followHandler.FindFollowing(t.Context(), john.ID.String())
+	if err != nil {
+		t.Errorf("Error retrieving following: %v", err)
+	}
+	if len(following) != 1 {
+		t.Fatalf("Expected 1 followed user, got %d", len(following))
+	}
+	if following[0].Username != jane.Username {
+		t.Errorf("Expected followed user %q, got %q", jane.Username, following[0].Username)
+	}
+}
+
+func TestFollowHandlerCreateDuplicate(t *testing.T) {
+	db := newTestDB(t)
+	followHandler := memory.NewFollowHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	err := followHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error creating follow: %v", err)
+	}
+
+	// Following twice is rejected
+	err = followHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+}
+
+func TestFollowHandlerDelete(t *testing.T) {
+	db := newTestDB(t)
+	followHandler := memory.NewFollowHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	err := followHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error creating follow: %v", err)
+	}
+
+	err = followHandler.Delete(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error deleting follow: %v", err)
+	}
+
+	// Verify Jane has no followers left
+	followers, err := followHandler.FindFollowers(t.Context(), jane.ID.String())
+	if err != nil {
+		t.Errorf("Error retrieving followers: %v", err)
+	}
+	if len(followers) != 0 {
+		t.Errorf("Expected 0 followers, got %d", len(followers))
+	}
+}
+
+func TestFollowHandlerDeleteNotFound(t *testing.T) {
+	db := newTestDB(t)
+	followHandler := memory.NewFollowHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	// Unfollowing someone not followed
+	err := followHandler.Delete(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
@@ -2,29 +2,218 @@ package memory
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
 )
 
 // userRecord is the internal storage format for users in go-memdb.
 type userRecord struct {
-	ID       string
-	Username string
-	Email    string
-	Name     string
+	ID           string
+	Username     string
+	Email        string
+	Name         string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+// toEntity converts the record to a domain user.
+func (r *userRecord) toEntity() entities.User {
+	return entities.User{
+		ID:           uuid.MustParse(r.ID),
+		Username:     r.Username,
+		Email:        r.Email,
+		Name:         r.Name,
+		PasswordHash: r.PasswordHash,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+		DeletedAt:    r.DeletedAt,
+	}
 }
 
 // tweetRecord is the internal storage format for tweets in go-memdb.
+// ReferencedTweetID is empty for plain tweets.
 type tweetRecord struct {
-	ID      string
-	Content string
+	ID                string
+	Content           string
+	UserID            string
+	Kind              string
+	ReferencedTweetID string
+	InReplyToTweetID  string
+	ConversationID    string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
+}
+
+// toEntity converts the record to a domain tweet.
+func (r *tweetRecord) toEntity() entities.Tweet {
+	t := entities.Tweet{
+		ID:             uuid.MustParse(r.ID),
+		Content:        r.Content,
+		UserID:         uuid.MustParse(r.UserID),
+		Kind:           entities.TweetKind(r.Kind),
+		ConversationID: uuid.MustParse(r.ConversationID),
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		DeletedAt:      r.DeletedAt,
+	}
+	if r.ReferencedTweetID != "" {
+		id := uuid.MustParse(r.ReferencedTweetID)
+		t.ReferencedTweetID = &id
+	}
+	if r.InReplyToTweetID != "" {
+		id := uuid.MustParse(r.InReplyToTweetID)
+		t.InReplyToTweetID = &id
+	}
+	return t
+}
+
+// followRecord is the internal storage format for follows in go-memdb.
+type followRecord struct {
+	FollowerID string
+	FolloweeID string
+	CreatedAt  time.Time
+}
+
+// likeRecord is the internal storage format for likes in go-memdb.
+type likeRecord struct {
+	UserID    string
+	TweetID   string
+	CreatedAt time.Time
+}
+
+// hashtagRecord is the internal storage format for hashtags in go-memdb.
+type hashtagRecord struct {
+	ID        string
+	Tag       string
+	CreatedAt time.Time
+}
+
+// tweetHashtagRecord is the internal storage format for the link between a
+// tweet and a hashtag in go-memdb.
+type tweetHashtagRecord struct {
+	TweetID   string
+	HashtagID string
+}
+
+// mentionRecord is the internal storage format for mentions in go-memdb.
+type mentionRecord struct {
+	TweetID string
 	UserID  string
+	Start   int
+	End     int
+}
+
+// bookmarkRecord is the internal storage format for bookmarks in go-memdb.
+type bookmarkRecord struct {
+	ID        string
+	UserID    string
+	TweetID   string
+	CreatedAt time.Time
+}
+
+// relationRecord is the internal storage format for blocks and mutes in
+// go-memdb: UserID blocks or mutes TargetID.
+type relationRecord struct {
+	UserID    string
+	TargetID  string
+	CreatedAt time.Time
+}
+
+// conversationRecord is the internal storage format for conversations in
+// go-memdb. LastMessageID is empty before the first message.
+type conversationRecord struct {
+	ID            string
+	LastMessageID string
+	CreatedAt     time.Time
+}
+
+// conversationMemberRecord is the internal storage format for the members of
+// conversations in go-memdb. LastReadMessageID is empty until the member reads
+// or sends a message.
+type conversationMemberRecord struct {
+	ConversationID    string
+	UserID            string
+	LastReadMessageID string
+	CreatedAt         time.Time
+}
+
+// messageRecord is the internal storage format for messages in go-memdb.
+type messageRecord struct {
+	ID             string
+	ConversationID string
+	SenderID       string
+	Content        string
+	CreatedAt      time.Time
+}
+
+// toEntity converts the record to a domain message.
+func (r *messageRecord) toEntity() entities.Message {
+	return entities.Message{
+		ID:             uuid.MustParse(r.ID),
+		ConversationID: uuid.MustParse(r.ConversationID),
+		SenderID:       uuid.MustParse(r.SenderID),
+		Content:        r.Content,
+		CreatedAt:      r.CreatedAt,
+	}
+}
+
+// notificationRecord is the internal storage format for notifications in
+// go-memdb. TweetID is empty for follows; GroupKey is shared by the
+// notifications listed as one.
+type notificationRecord struct {
+	ID        string
+	UserID    string
+	ActorID   string
+	Type      string
+	TweetID   string
+	GroupKey  string
+	Read      bool
+	CreatedAt time.Time
+}
+
+// toEntity converts the record to a domain notification without actors.
+func (r *notificationRecord) toEntity() entities.Notification {
+	n := entities.Notification{
+		ID:        uuid.MustParse(r.ID),
+		UserID:    uuid.MustParse(r.UserID),
+		Type:      entities.NotificationType(r.Type),
+		Read:      r.Read,
+		CreatedAt: r.CreatedAt,
+	}
+	if r.TweetID != "" {
+		tweetID := uuid.MustParse(r.TweetID)
+		n.TweetID = &tweetID
+	}
+	return n
 }
 
 // Table names used as keys throughout the memory store.
 const (
-	tableUsers  = "users"
-	tableTweets = "tweets"
+	tableUsers         = "users"
+	tableTweets        = "tweets"
+	tableFollows       = "follows"
+	tableLikes         = "likes"
+	tableHashtags      = "hashtags"
+	tableTweetHashtags = "tweet_hashtags"
+	tableMentions      = "mentions"
+	tableBookmarks     = "bookmarks"
+	tableBlocks        = "blocks"
+	tableMutes         = "mutes"
+
+	tableConversations       = "conversations"
+	tableConversationMembers = "conversation_members"
+	tableMessages            = "messages"
+
+	tableNotifications = "notifications"
 )
 
 // NewDB creates a new in-memory database with the twitter-clone schema.
@@ -40,9 +229,10 @@ func NewDB() (*memdb.MemDB, error) {
 						Indexer: &memdb.StringFieldIndex{Field: "ID"},
 					},
 					"username": {
-						Name:    "username",
-						Unique:  true,
-						Indexer: &memdb.StringFieldIndex{Field: "Username"},
+						Name:   "username",
+						Unique: true,
+						// usernames are unique and looked up regardless of case
+						Indexer: &memdb.StringFieldIndex{Field: "Username", Lowercase: true},
 					},
 					"email": {
 						Name:    "email",
@@ -59,6 +249,254 @@ func NewDB() (*memdb.MemDB, error) {
 						Unique:  true,
 						Indexer: &memdb.StringFieldIndex{Field: "ID"},
 					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+					"referenced_tweet_id": {
+						Name:         "referenced_tweet_id",
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "ReferencedTweetID"},
+					},
+					"in_reply_to_tweet_id": {
+						Name:         "in_reply_to_tweet_id",
+						AllowMissing: true,
+						Indexer:      &memdb.StringFieldIndex{Field: "InReplyToTweetID"},
+					},
+				},
+			},
+			tableFollows: {
+				Name: tableFollows,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "FollowerID"},
+								&memdb.StringFieldIndex{Field: "FolloweeID"},
+							},
+						},
+					},
+					"follower_id": {
+						Name:    "follower_id",
+						Indexer: &memdb.StringFieldIndex{Field: "FollowerID"},
+					},
+					"followee_id": {
+						Name:    "followee_id",
+						Indexer: &memdb.StringFieldIndex{Field: "FolloweeID"},
+					},
+				},
+			},
+			tableLikes: {
+				Name: tableLikes,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "UserID"},
+								&memdb.StringFieldIndex{Field: "TweetID"},
+							},
+						},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+					"tweet_id": {
+						Name:    "tweet_id",
+						Indexer: &memdb.StringFieldIndex{Field: "TweetID"},
+					},
+				},
+			},
+			tableHashtags: {
+				Name: tableHashtags,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"tag": {
+						Name:    "tag",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "Tag"},
+					},
+				},
+			},
+			tableTweetHashtags: {
+				Name: tableTweetHashtags,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "TweetID"},
+								&memdb.StringFieldIndex{Field: "HashtagID"},
+							},
+						},
+					},
+					"hashtag_id": {
+						Name:    "hashtag_id",
+						Indexer: &memdb.StringFieldIndex{Field: "HashtagID"},
+					},
+				},
+			},
+			tableMentions: {
+				Name: tableMentions,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "TweetID"},
+								&memdb.IntFieldIndex{Field: "Start"},
+							},
+						},
+					},
+					"tweet_id": {
+						Name:    "tweet_id",
+						Indexer: &memdb.StringFieldIndex{Field: "TweetID"},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+				},
+			},
+			tableBookmarks: {
+				Name: tableBookmarks,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"user_tweet": {
+						Name:   "user_tweet",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "UserID"},
+								&memdb.StringFieldIndex{Field: "TweetID"},
+							},
+						},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+				},
+			},
+			tableBlocks: {
+				Name: tableBlocks,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "UserID"},
+								&memdb.StringFieldIndex{Field: "TargetID"},
+							},
+						},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+					"target_id": {
+						Name:    "target_id",
+						Indexer: &memdb.StringFieldIndex{Field: "TargetID"},
+					},
+				},
+			},
+			tableMutes: {
+				Name: tableMutes,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "UserID"},
+								&memdb.StringFieldIndex{Field: "TargetID"},
+							},
+						},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+					"target_id": {
+						Name:    "target_id",
+						Indexer: &memdb.StringFieldIndex{Field: "TargetID"},
+					},
+				},
+			},
+			tableConversations: {
+				Name: tableConversations,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			tableConversationMembers: {
+				Name: tableConversationMembers,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:   "id",
+						Unique: true,
+						Indexer: &memdb.CompoundIndex{
+							Indexes: []memdb.Indexer{
+								&memdb.StringFieldIndex{Field: "ConversationID"},
+								&memdb.StringFieldIndex{Field: "UserID"},
+							},
+						},
+					},
+					"conversation_id": {
+						Name:    "conversation_id",
+						Indexer: &memdb.StringFieldIndex{Field: "ConversationID"},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
+				},
+			},
+			tableMessages: {
+				Name: tableMessages,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"conversation_id": {
+						Name:    "conversation_id",
+						Indexer: &memdb.StringFieldIndex{Field: "ConversationID"},
+					},
+				},
+			},
+			tableNotifications: {
+				Name: tableNotifications,
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"user_id": {
+						Name:    "user_id",
+						Indexer: &memdb.StringFieldIndex{Field: "UserID"},
+					},
 				},
 			},
 		},
@@ -69,3 +507,28 @@ func NewDB() (*memdb.MemDB, error) {
 	}
 	return db, nil
 }
+
+// reverseFrom iterates the records of table in descending ID order, starting at
+// beforeID when it is set. The record with ID beforeID itself is included and
+// must be skipped by the caller.
+func reverseFrom(txn *memdb.Txn, table, beforeID string) (memdb.ResultIterator, error) {
+	if beforeID == "" {
+		it, err := txn.GetReverse(table, "id")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %w", table, err)
+		}
+		return it, nil
+	}
+	it, err := txn.ReverseLowerBound(table, "id", beforeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", table, err)
+	}
+	return it, nil
+}
+
+// sortByDeletedAt sorts soft-deleted items, most recently deleted first.
+func sortByDeletedAt[T any](items []T, deletedAt func(T) time.Time) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return deletedAt(items[i]).After(deletedAt(items[j]))
+	})
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// HashtagHandler is a memory implementation of the repository.HashtagRepository interface.
+type HashtagHandler struct {
+	db *memdb.MemDB
+}
+
+// NewHashtagHandler returns a new HashtagHandler backed by the given in-memory DB.
+func NewHashtagHandler(db *memdb.MemDB) *HashtagHandler {
+	return &HashtagHandler{db: db}
+}
+
+// Attach links tweetID to each of tags, creating the missing hashtags.
+func (s *HashtagHandler) Attach(_ context.Context, tweetID string, tags []string) error {
+	txn := s.db.Txn(true)
+	for _, tag := range tags {
+		hashtagID, err := findOrCreateHashtag(txn, tag)
+		if err != nil {
+			txn.Abort()
+			return err
+		}
+		record := &tweetHashtagRecord{TweetID: tweetID, HashtagID: hashtagID}
+		if err = txn.Insert(tableTweetHashtags, record); err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to insert tweet hashtag: %w", err)
+		}
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindTweets returns at most limit live tweets tagged with tag created before
+// beforeID, newest first, leaving out those written by excludedUserIDs.
+func (s *HashtagHandler) FindTweets(
+	_ context.Context,
+	tag, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	raw, err := txn.First(tableHashtags, "tag", tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find hashtag: %w", err)
+	}
+	if raw == nil {
+		return []entities.Tweet{}, nil
+	}
+	hashtag, ok := raw.(*hashtagRecord)
+	if !ok {
+		return nil, errors.New("unexpected record type in hashtags table")
+	}
+
+	// entries of a non-unique index are ordered by ID within each value, and
+	// the ID of a tweet_hashtags row starts with the tweet ID
+	it, err := txn.GetReverse(tableTweetHashtags, "hashtag_id", hashtag.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweet hashtags: %w", err)
+	}
+	tweets := make([]entities.Tweet, 0, limit)
+	for obj := it.Next(); obj != nil && len(tweets) < limit; obj = it.Next() {
+		r, ok := obj.(*tweetHashtagRecord)
+		if !ok || (beforeID != "" && r.TweetID >= beforeID) {
+			continue
+		}
+		tweet, findErr := findLiveTweet(txn, r.TweetID)
+		if errors.Is(findErr, repository.ErrNotFound) {
+			continue
+		}
+		if findErr != nil {
+			return nil, findErr
+		}
+		if slices.Contains(excludedUserIDs, tweet.UserID) {
+			continue
+		}
+		tweets = append(tweets, tweet.toEntity())
+	}
+	return tweets, nil
+}
+
+// findOrCreateHashtag returns the ID of the hashtag tag, inserting it first if
+// it does not exist yet.
+func findOrCreateHashtag(txn *memdb.Txn, tag string) (string, error) {
+	raw, err := txn.First(tableHashtags, "tag", tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to find hashtag: %w", err)
+	}
+	if raw != nil {
+		r, ok := raw.(*hashtagRecord)
+		if !ok {
+			return "", errors.New("unexpected record type in hashtags table")
+		}
+		return r.ID, nil
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate hashtag id: %w", err)
+	}
+	record := &hashtagRecord{ID: id.String(), Tag: tag, CreatedAt: time.Now()}
+	if err = txn.Insert(tableHashtags, record); err != nil {
+		return "", fmt.Errorf("failed to insert hashtag: %w", err)
+	}
+	return record.ID, nil
+}
@@ -0,0 +1,60 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestHashtagHandlerAttachAndFindTweets(t *testing.T) {
+	db := newTestDB(t)
+	tweetHandler := memory.NewTweetHandler(db)
+	hashtagHandler := memory.NewHashtagHandler(db)
+
+	var ids []string
+	for _, tags := range [][]string{{"go"}, {"rust"}, {"go", "rust"}, {"go"}} {
+		tweet := &entities.Tweet{Content: "tagged"}
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+		if err := hashtagHandler.Attach(t.Context(), tweet.ID.String(), tags); err != nil {
+			t.Fatalf("Error attaching hashtags: %v", err)
+		}
+		ids = append(ids, tweet.ID.String())
+	}
+
+	// Tweets are listed newest first, one page at a time
+	tweets, err := hashtagHandler.FindTweets(t.Context(), "go", "", 2, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 2 || tweets[0].ID.String() != ids[3] || tweets[1].ID.String() != ids[2] {
+		t.Fatalf("Expected the two newest go tweets, got %+v", tweets)
+	}
+	tweets, err = hashtagHandler.FindTweets(t.Context(), "go", ids[2], 2, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 1 || tweets[0].ID.String() != ids[0] {
+		t.Errorf("Expected the oldest go tweet, got %+v", tweets)
+	}
+
+	// Deleted tweets are skipped
+	if err = tweetHandler.Delete(t.Context(), ids[2]); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	tweets, err = hashtagHandler.FindTweets(t.Context(), "rust", "", 10, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 1 || tweets[0].ID.String() != ids[1] {
+		t.Errorf("Expected only the first rust tweet, got %+v", tweets)
+	}
+
+	// Unknown hashtags have no tweets
+	tweets, err = hashtagHandler.FindTweets(t.Context(), "zig", "", 10, nil)
+	if err != nil || len(tweets) != 0 {
+		t.Errorf("Expected no tweets, got %+v, %v", tweets, err)
+	}
+}
@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// LikeHandler is a memory implementation of the repository.LikeRepository interface.
+type LikeHandler struct {
+	db *memdb.MemDB
+}
+
+// NewLikeHandler returns a new LikeHandler backed by the given in-memory DB.
+func NewLikeHandler(db *memdb.MemDB) *LikeHandler {
+	return &LikeHandler{db: db}
+}
+
+// Create records that userID likes tweetID.
+func (s *LikeHandler) Create(_ context.Context, userID, tweetID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableLikes, "id", userID, tweetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find like: %w", err)
+	}
+	if existing != nil {
+		txn.Abort()
+		return repository.ErrAlreadyExists
+	}
+	record := &likeRecord{
+		UserID:    userID,
+		TweetID:   tweetID,
+		CreatedAt: time.Now(),
+	}
+	if err = txn.Insert(tableLikes, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert like: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// Delete removes the like of tweetID by userID.
+func (s *LikeHandler) Delete(_ context.Context, userID, tweetID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(tableLikes, "id", userID, tweetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find like: %w", err)
+	}
+	if existing == nil {
+		txn.Abort()
+		return repository.ErrNotFound
+	}
+	if err = txn.Delete(tableLikes, existing); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete like: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindLikers returns the users who liked tweetID, most recent first.
+func (s *LikeHandler) FindLikers(_ context.Context, tweetID string) ([]entities.User, error) {
+	txn := s.db.Txn(false)
+	likes, err := liveLikes(txn, tweetID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(likes, func(i, j int) bool {
+		return likes[i].CreatedAt.After(likes[j].CreatedAt)
+	})
+
+	users := make([]entities.User, 0, len(likes))
+	for _, l := range likes {
+		user, findErr := liveUser(txn, l.UserID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		users = append(users, user.toEntity())
+	}
+	return users, nil
+}
+
+// CountByTweet returns the like count of each of the given tweets.
+func (s *LikeHandler) CountByTweet(_ context.Context, tweetIDs []string) (map[string]int, error) {
+	txn := s.db.Txn(false)
+	counts := make(map[string]int, len(tweetIDs))
+	for _, tweetID := range tweetIDs {
+		likes, err := liveLikes(txn, tweetID)
+		if err != nil {
+			return nil, err
+		}
+		if len(likes) > 0 {
+			counts[tweetID] = len(likes)
+		}
+	}
+	return counts, nil
+}
+
+// FindLikedByUser returns which of the given tweets userID likes.
+func (s *LikeHandler) FindLikedByUser(_ context.Context, userID string, tweetIDs []string) (map[string]bool, error) {
+	txn := s.db.Txn(false)
+	liked := make(map[string]bool)
+	for _, tweetID := range tweetIDs {
+		existing, err := txn.First(tableLikes, "id", userID, tweetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find like: %w", err)
+		}
+		if existing != nil {
+			liked[tweetID] = true
+		}
+	}
+	return liked, nil
+}
+
+// liveLikes returns the likes of tweetID by users that are not soft-deleted.
+func liveLikes(txn *memdb.Txn, tweetID string) ([]*likeRecord, error) {
+	it, err := txn.Get(tableLikes, "tweet_id", tweetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get likes: %w", err)
+	}
+	var likes []*likeRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*likeRecord)
+		if !ok {
+			continue
+		}
+		user, findErr := liveUser(txn, r.UserID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		if user == nil {
+			continue
+		}
+		likes = append(likes, r)
+	}
+	return likes, nil
+}
+
+// liveUser returns the record of userID, or nil if the user does not exist or
+// is soft-deleted.
+func liveUser(txn *memdb.Txn, userID string) (*userRecord, error) {
+	raw, err := txn.First(tableUsers, "id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if raw == nil {
+		return nil, nil //nolint:nilnil // a missing user is not an error here
+	}
+	r, ok := raw.(*userRecord)
+	if !ok {
+		return nil, errors.New(errUnexpectedUserRecord)
+	}
+	if r.DeletedAt != nil {
+		return nil, nil //nolint:nilnil // a deleted user is not an error here
+	}
+	return r, nil
+}
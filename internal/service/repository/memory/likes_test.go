@@ -0,0 +1,108 @@
+package memory_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestLikeHandlerCreateAndDelete(t *testing.T) {
+	db := newTestDB(t)
+	likeHandler := memory.NewLikeHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+	tweetID := uuid.NewString()
+
+	// John and Jane like the tweet
+	for _, userID := range []string{john.ID.String(), jane.ID.String()} {
+		if err := likeHandler.Create(t.Context(), userID, tweetID); err != nil {
+			t.Fatalf("Error creating like: %v", err)
+		}
+	}
+
+	// Liking twice is rejected
+	err := likeHandler.Create(t.Context(), john.ID.String(), tweetID)
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	// Likers are listed most recent first
+	likers, err := likeHandler.FindLikers(t.Context(), tweetID)
+	if err != nil {
+		t.Fatalf("Error retrieving likers: %v", err)
+	}
+	if len(likers) != 2 || likers[0].Username != jane.Username {
+		t.Errorf("Expected Jane then John, got %+v", likers)
+	}
+
+	// John unlikes the tweet, and cannot do it twice
+	if err = likeHandler.Delete(t.Context(), john.ID.String(), tweetID); err != nil {
+		t.Fatalf("Error deleting like: %v", err)
+	}
+	err = likeHandler.Delete(t.Context(), john.ID.String(), tweetID)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	liked, err := likeHandler.FindLikedByUser(t.Context(), jane.ID.String(), []string{tweetID, uuid.NewString()})
+	if err != nil {
+		t.Fatalf("Error retrieving liked tweets: %v", err)
+	}
+	if len(liked) != 1 || !liked[tweetID] {
+		t.Errorf("Expected only %s to be liked, got %v", tweetID, liked)
+	}
+}
+
+func TestLikeHandlerCountByTweet(t *testing.T) {
+	db := newTestDB(t)
+	likeHandler := memory.NewLikeHandler(db)
+	userHandler := memory.NewUserHandler(db)
+	popular, ignored := uuid.NewString(), uuid.NewString()
+
+	// Many users like the same tweet concurrently
+	const likers = 20
+	var wg sync.WaitGroup
+	for i := range likers {
+		user := createTestUser(t, db, "user_"+string(rune('a'+i)))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := likeHandler.Create(t.Context(), user.ID.String(), popular); err != nil {
+				t.Errorf("Error creating like: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	counts, err := likeHandler.CountByTweet(t.Context(), []string{popular, ignored})
+	if err != nil {
+		t.Fatalf("Error counting likes: %v", err)
+	}
+	if counts[popular] != likers {
+		t.Errorf("Expected %d likes, got %d", likers, counts[popular])
+	}
+	if _, ok := counts[ignored]; ok {
+		t.Errorf("Expected no count for a tweet without likes, got %v", counts)
+	}
+
+	// Likes of soft-deleted users are not counted
+	likersBefore, err := likeHandler.FindLikers(t.Context(), popular)
+	if err != nil {
+		t.Fatalf("Error retrieving likers: %v", err)
+	}
+	if err = userHandler.Delete(t.Context(), likersBefore[0].ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	counts, err = likeHandler.CountByTweet(t.Context(), []string{popular})
+	if err != nil {
+		t.Fatalf("Error counting likes: %v", err)
+	}
+	if counts[popular] != likers-1 {
+		t.Errorf("Expected %d likes, got %d", likers-1, counts[popular])
+	}
+}
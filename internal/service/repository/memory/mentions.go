@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// MentionHandler is a memory implementation of the repository.MentionRepository interface.
+type MentionHandler struct {
+	db *memdb.MemDB
+}
+
+// NewMentionHandler returns a new MentionHandler backed by the given in-memory DB.
+func NewMentionHandler(db *memdb.MemDB) *MentionHandler {
+	return &MentionHandler{db: db}
+}
+
+// Create records the mentions of tweetID.
+func (s *MentionHandler) Create(_ context.Context, tweetID string, mentions []entities.Mention) error {
+	txn := s.db.Txn(true)
+	for _, m := range mentions {
+		record := &mentionRecord{
+			TweetID: tweetID,
+			UserID:  m.UserID.String(),
+			Start:   m.Start,
+			End:     m.End,
+		}
+		if err := txn.Insert(tableMentions, record); err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to insert mention: %w", err)
+		}
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindByTweets returns the mentions of live users in each of the given tweets.
+func (s *MentionHandler) FindByTweets(_ context.Context, tweetIDs []string) (map[string][]entities.Mention, error) {
+	txn := s.db.Txn(false)
+	mentions := make(map[string][]entities.Mention)
+	for _, tweetID := range tweetIDs {
+		it, err := txn.Get(tableMentions, "tweet_id", tweetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mentions: %w", err)
+		}
+		var found []entities.Mention
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			r, ok := obj.(*mentionRecord)
+			if !ok {
+				continue
+			}
+			user, findErr := liveUser(txn, r.UserID)
+			if findErr != nil {
+				return nil, findErr
+			}
+			if user == nil {
+				continue
+			}
+			found = append(found, entities.Mention{
+				UserID:   uuid.MustParse(r.UserID),
+				Username: user.Username,
+				Start:    r.Start,
+				End:      r.End,
+			})
+		}
+		if len(found) > 0 {
+			sort.Slice(found, func(i, j int) bool { return found[i].Start < found[j].Start })
+			mentions[tweetID] = found
+		}
+	}
+	return mentions, nil
+}
+
+// FindTweets returns at most limit live tweets mentioning userID created before
+// beforeID, newest first, leaving out those written by excludedUserIDs.
+func (s *MentionHandler) FindTweets(
+	_ context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	// entries of a non-unique index are ordered by ID within each value, and
+	// the ID of a mention starts with the tweet ID
+	it, err := txn.GetReverse(tableMentions, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mentions: %w", err)
+	}
+	tweets := make([]entities.Tweet, 0, limit)
+	lastID := ""
+	for obj := it.Next(); obj != nil && len(tweets) < limit; obj = it.Next() {
+		r, ok := obj.(*mentionRecord)
+		// a tweet mentioning the user twice is listed once
+		if !ok || r.TweetID == lastID || (beforeID != "" && r.TweetID >= beforeID) {
+			continue
+		}
+		lastID = r.TweetID
+		tweet, findErr := findLiveTweet(txn, r.TweetID)
+		if errors.Is(findErr, repository.ErrNotFound) {
+			continue
+		}
+		if findErr != nil {
+			return nil, findErr
+		}
+		if slices.Contains(excludedUserIDs, tweet.UserID) {
+			continue
+		}
+		tweets = append(tweets, tweet.toEntity())
+	}
+	return tweets, nil
+}
@@ -0,0 +1,61 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestMentionHandlerCreateAndFind(t *testing.T) {
+	db := newTestDB(t)
+	tweetHandler := memory.NewTweetHandler(db)
+	mentionHandler := memory.NewMentionHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	var ids []string
+	for _, mentioned := range [][]*entities.User{{jane}, {john}, {jane, jane}} {
+		tweet := &entities.Tweet{Content: "mentioning"}
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+		// Offsets are stored as given, possibly out of order
+		var mentions []entities.Mention
+		for i, user := range mentioned {
+			mentions = append(mentions, entities.Mention{UserID: user.ID, Start: 20 - 10*i, End: 29 - 10*i})
+		}
+		if err := mentionHandler.Create(t.Context(), tweet.ID.String(), mentions); err != nil {
+			t.Fatalf("Error creating mentions: %v", err)
+		}
+		ids = append(ids, tweet.ID.String())
+	}
+
+	// Mentions come back ordered by offset, with the current username
+	found, err := mentionHandler.FindByTweets(t.Context(), ids)
+	if err != nil {
+		t.Fatalf("Error retrieving mentions: %v", err)
+	}
+	if len(found) != 3 || len(found[ids[2]]) != 2 || found[ids[2]][0].Start != 10 {
+		t.Fatalf("Unexpected mentions: %+v", found)
+	}
+	if found[ids[1]][0].Username != john.Username {
+		t.Errorf("Expected %s, got %+v", john.Username, found[ids[1]])
+	}
+
+	// A tweet mentioning a user twice is listed once, newest first
+	tweets, err := mentionHandler.FindTweets(t.Context(), jane.ID.String(), "", 10, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 2 || tweets[0].ID.String() != ids[2] || tweets[1].ID.String() != ids[0] {
+		t.Errorf("Expected the third then the first tweet, got %+v", tweets)
+	}
+	tweets, err = mentionHandler.FindTweets(t.Context(), jane.ID.String(), ids[2], 10, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 1 || tweets[0].ID.String() != ids[0] {
+		t.Errorf("Expected the first tweet, got %+v", tweets)
+	}
+}
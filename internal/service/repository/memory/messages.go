@@ -0,0 +1,322 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// MessageHandler is a memory implementation of the repository.MessageRepository interface.
+type MessageHandler struct {
+	db *memdb.MemDB
+}
+
+// NewMessageHandler returns a new MessageHandler backed by the given in-memory DB.
+func NewMessageHandler(db *memdb.MemDB) *MessageHandler {
+	return &MessageHandler{db: db}
+}
+
+// CreateConversation stores c with memberIDs as its members.
+func (s *MessageHandler) CreateConversation(
+	_ context.Context,
+	c *entities.Conversation,
+	memberIDs []string,
+) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate conversation id: %w", err)
+	}
+	now := time.Now()
+	record := &conversationRecord{
+		ID:        id.String(),
+		CreatedAt: now,
+	}
+	txn := s.db.Txn(true)
+	if err = txn.Insert(tableConversations, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert conversation: %w", err)
+	}
+	for _, userID := range memberIDs {
+		member := &conversationMemberRecord{
+			ConversationID: record.ID,
+			UserID:         userID,
+			CreatedAt:      now,
+		}
+		if err = txn.Insert(tableConversationMembers, member); err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to insert conversation member: %w", err)
+		}
+	}
+	created, err := toConversation(txn, record)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+
+	*c = created
+	return nil
+}
+
+// FindConversation returns a conversation by ID.
+func (s *MessageHandler) FindConversation(_ context.Context, id string) (*entities.Conversation, error) {
+	txn := s.db.Txn(false)
+	record, err := findConversation(txn, id)
+	if err != nil {
+		return nil, err
+	}
+	c, err := toConversation(txn, record)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// FindConversations returns at most limit of userID's conversations, most
+// recently active first. go-memdb cannot index the activity of a
+// conversation, so they are all loaded and sorted.
+func (s *MessageHandler) FindConversations(
+	_ context.Context,
+	userID, beforeID string,
+	limit int,
+) ([]entities.Conversation, error) {
+	txn := s.db.Txn(false)
+	it, err := txn.Get(tableConversationMembers, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation members: %w", err)
+	}
+	var records []*conversationRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		m, ok := obj.(*conversationMemberRecord)
+		if !ok {
+			continue
+		}
+		record, findErr := findConversation(txn, m.ConversationID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		if beforeID != "" && record.activityID() >= beforeID {
+			continue
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].activityID() > records[j].activityID()
+	})
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	conversations := make([]entities.Conversation, 0, len(records))
+	for _, record := range records {
+		c, convErr := toConversation(txn, record)
+		if convErr != nil {
+			return nil, convErr
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, nil
+}
+
+// Create stores m as the last message of its conversation, read by its sender.
+func (s *MessageHandler) Create(_ context.Context, m *entities.Message) error {
+	txn := s.db.Txn(true)
+	// generated under the write lock, so that the last message of a
+	// conversation is always its newest
+	id, err := uuid.NewV7()
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to generate message id: %w", err)
+	}
+	record := &messageRecord{
+		ID:             id.String(),
+		ConversationID: m.ConversationID.String(),
+		SenderID:       m.SenderID.String(),
+		Content:        m.Content,
+		CreatedAt:      time.Now(),
+	}
+	conversation, err := findConversation(txn, record.ConversationID)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	if err = txn.Insert(tableMessages, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert message: %w", err)
+	}
+	// go-memdb objects must not be mutated in place
+	updated := *conversation
+	updated.LastMessageID = record.ID
+	if err = txn.Insert(tableConversations, &updated); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+	if err = markRead(txn, record.ConversationID, record.SenderID, record.ID); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+
+	*m = record.toEntity()
+	return nil
+}
+
+// FindMessages returns at most limit messages of conversationID sent before
+// beforeID, newest first.
+func (s *MessageHandler) FindMessages(
+	_ context.Context,
+	conversationID, beforeID string,
+	limit int,
+) ([]entities.Message, error) {
+	txn := s.db.Txn(false)
+	// entries of a non-unique index are ordered by ID within each value, and
+	// message IDs sort by creation time
+	it, err := txn.GetReverse(tableMessages, "conversation_id", conversationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	messages := make([]entities.Message, 0, limit)
+	for obj := it.Next(); obj != nil && len(messages) < limit; obj = it.Next() {
+		r, ok := obj.(*messageRecord)
+		if !ok || (beforeID != "" && r.ID >= beforeID) {
+			continue
+		}
+		messages = append(messages, r.toEntity())
+	}
+	return messages, nil
+}
+
+// MarkRead marks the messages of conversationID up to messageID read by userID.
+func (s *MessageHandler) MarkRead(_ context.Context, conversationID, userID, messageID string) error {
+	txn := s.db.Txn(true)
+	if err := markRead(txn, conversationID, userID, messageID); err != nil {
+		txn.Abort()
+		return err
+	}
+	txn.Commit()
+	return nil
+}
+
+// activityID is the key ordering conversations by activity: the ID of the last
+// message, or the conversation's own ID before the first one.
+func (r *conversationRecord) activityID() string {
+	if r.LastMessageID != "" {
+		return r.LastMessageID
+	}
+	return r.ID
+}
+
+// findConversation returns the conversation id.
+func findConversation(txn *memdb.Txn, id string) (*conversationRecord, error) {
+	raw, err := txn.First(tableConversations, "id", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find conversation: %w", err)
+	}
+	if raw == nil {
+		return nil, repository.ErrNotFound
+	}
+	r, ok := raw.(*conversationRecord)
+	if !ok {
+		return nil, errors.New("unexpected record type in conversations table")
+	}
+	return r, nil
+}
+
+// toConversation converts the record to a domain conversation, loading its
+// live members with their unread counts and its last message.
+func toConversation(txn *memdb.Txn, r *conversationRecord) (entities.Conversation, error) {
+	c := entities.Conversation{
+		ID:        uuid.MustParse(r.ID),
+		CreatedAt: r.CreatedAt,
+	}
+	// members are ordered by user ID, the second part of the primary key
+	it, err := txn.Get(tableConversationMembers, "conversation_id", r.ID)
+	if err != nil {
+		return c, fmt.Errorf("failed to get conversation members: %w", err)
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		m, ok := obj.(*conversationMemberRecord)
+		if !ok {
+			continue
+		}
+		user, userErr := liveUser(txn, m.UserID)
+		if userErr != nil {
+			return c, userErr
+		}
+		if user == nil {
+			continue
+		}
+		unread, countErr := countUnread(txn, m)
+		if countErr != nil {
+			return c, countErr
+		}
+		c.Members = append(c.Members, entities.ConversationMember{
+			UserID:      uuid.MustParse(m.UserID),
+			Username:    user.Username,
+			UnreadCount: unread,
+		})
+	}
+
+	if r.LastMessageID != "" {
+		raw, findErr := txn.First(tableMessages, "id", r.LastMessageID)
+		if findErr != nil {
+			return c, fmt.Errorf("failed to find message: %w", findErr)
+		}
+		if last, ok := raw.(*messageRecord); ok {
+			msg := last.toEntity()
+			c.LastMessage = &msg
+		}
+	}
+	return c, nil
+}
+
+// countUnread counts the messages the other members of m's conversation sent
+// after the last one m read.
+func countUnread(txn *memdb.Txn, m *conversationMemberRecord) (int, error) {
+	it, err := txn.GetReverse(tableMessages, "conversation_id", m.ConversationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get messages: %w", err)
+	}
+	count := 0
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*messageRecord)
+		if !ok {
+			continue
+		}
+		if r.ID <= m.LastReadMessageID {
+			break
+		}
+		if r.SenderID != m.UserID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// markRead moves the read marker of userID in conversationID up to messageID.
+// It never moves it back, and does nothing for users who are not members.
+func markRead(txn *memdb.Txn, conversationID, userID, messageID string) error {
+	raw, err := txn.First(tableConversationMembers, "id", conversationID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to find conversation member: %w", err)
+	}
+	m, ok := raw.(*conversationMemberRecord)
+	if !ok || m.LastReadMessageID >= messageID {
+		return nil
+	}
+	updated := *m
+	updated.LastReadMessageID = messageID
+	if err = txn.Insert(tableConversationMembers, &updated); err != nil {
+		return fmt.Errorf("failed to update conversation member: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package memory_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestMessageHandler(t *testing.T) {
+	db := newTestDB(t)
+	messageHandler := memory.NewMessageHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+	members := []string{john.ID.String(), jane.ID.String()}
+
+	var first, second entities.Conversation
+	if err := messageHandler.CreateConversation(t.Context(), &first, members); err != nil {
+		t.Fatalf("Error creating conversation: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if err := messageHandler.CreateConversation(t.Context(), &second, members); err != nil {
+		t.Fatalf("Error creating conversation: %v", err)
+	}
+	if len(first.Members) != 2 || first.CreatedAt.IsZero() {
+		t.Fatalf("Expected two members, got %+v", first)
+	}
+
+	// A message moves its conversation to the top and is read by its sender
+	msg := &entities.Message{ConversationID: first.ID, SenderID: jane.ID, Content: "hello"}
+	if err := messageHandler.Create(t.Context(), msg); err != nil {
+		t.Fatalf("Error creating message: %v", err)
+	}
+	conversations, err := messageHandler.FindConversations(t.Context(), john.ID.String(), "", 10)
+	if err != nil {
+		t.Fatalf("Error finding conversations: %v", err)
+	}
+	if len(conversations) != 2 || conversations[0].ID != first.ID || conversations[0].LastMessage.ID != msg.ID {
+		t.Fatalf("Expected the first conversation on top, got %+v", conversations)
+	}
+	unread := map[string]int{}
+	for _, m := range conversations[0].Members {
+		unread[m.Username] = m.UnreadCount
+	}
+	if unread[john.Username] != 1 || unread[jane.Username] != 0 {
+		t.Errorf("Expected John to have one unread message, got %v", unread)
+	}
+	conversations, err = messageHandler.FindConversations(t.Context(), john.ID.String(), msg.ID.String(), 10)
+	if err != nil {
+		t.Fatalf("Error finding conversations: %v", err)
+	}
+	if len(conversations) != 1 || conversations[0].ID != second.ID {
+		t.Errorf("Expected the second conversation on the next page, got %+v", conversations)
+	}
+
+	// The read marker never moves back
+	if err = messageHandler.MarkRead(t.Context(), first.ID.String(), john.ID.String(), msg.ID.String()); err != nil {
+		t.Fatalf("Error marking messages read: %v", err)
+	}
+	if err = messageHandler.MarkRead(t.Context(), first.ID.String(), john.ID.String(), first.ID.String()); err != nil {
+		t.Fatalf("Error marking messages read: %v", err)
+	}
+	c, err := messageHandler.FindConversation(t.Context(), first.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding conversation: %v", err)
+	}
+	for _, m := range c.Members {
+		if m.UnreadCount != 0 {
+			t.Errorf("Expected no unread messages, got %+v", c.Members)
+		}
+	}
+
+	messages, err := messageHandler.FindMessages(t.Context(), first.ID.String(), "", 10)
+	if err != nil {
+		t.Fatalf("Error finding messages: %v", err)
+	}
+	if len(messages) != 1 || messages[0].Content != "hello" {
+		t.Errorf("Expected the message, got %+v", messages)
+	}
+
+	_, err = messageHandler.FindConversation(t.Context(), john.ID.String())
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
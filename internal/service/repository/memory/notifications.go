@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// NotificationHandler is a memory implementation of the repository.NotificationRepository interface.
+type NotificationHandler struct {
+	db *memdb.MemDB
+}
+
+// NewNotificationHandler returns a new NotificationHandler backed by the given in-memory DB.
+func NewNotificationHandler(db *memdb.MemDB) *NotificationHandler {
+	return &NotificationHandler{db: db}
+}
+
+// Create records that actorID did something of type typ to userID.
+func (s *NotificationHandler) Create(
+	_ context.Context,
+	userID, actorID string,
+	typ entities.NotificationType,
+	tweetID string,
+) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate notification id: %w", err)
+	}
+	record := &notificationRecord{
+		ID:        id.String(),
+		UserID:    userID,
+		ActorID:   actorID,
+		Type:      string(typ),
+		TweetID:   tweetID,
+		GroupKey:  notificationGroupKey(typ, tweetID, id.String()),
+		CreatedAt: time.Now(),
+	}
+	txn := s.db.Txn(true)
+	if err = txn.Insert(tableNotifications, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert notification: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindByUser returns at most limit groups of notifications of userID whose
+// newest notification comes before beforeID, newest first. go-memdb cannot
+// group, so all the notifications of the user are loaded.
+func (s *NotificationHandler) FindByUser(
+	_ context.Context,
+	userID string,
+	typ entities.NotificationType,
+	beforeID string,
+	limit int,
+) ([]entities.Notification, error) {
+	txn := s.db.Txn(false)
+	groups, err := groupNotifications(txn, userID, typ)
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]entities.Notification, 0, limit)
+	for _, n := range groups {
+		if len(notifications) == limit {
+			break
+		}
+		if beforeID != "" && n.ID.String() >= beforeID {
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+// CountUnread counts the groups of unread notifications of userID.
+func (s *NotificationHandler) CountUnread(_ context.Context, userID string) (int, error) {
+	txn := s.db.Txn(false)
+	groups, err := groupNotifications(txn, userID, "")
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, n := range groups {
+		if !n.Read {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// MarkRead marks every notification of userID read.
+func (s *NotificationHandler) MarkRead(_ context.Context, userID string) error {
+	txn := s.db.Txn(true)
+	it, err := txn.Get(tableNotifications, "user_id", userID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to get notifications: %w", err)
+	}
+	var unread []*notificationRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if r, ok := obj.(*notificationRecord); ok && !r.Read {
+			unread = append(unread, r)
+		}
+	}
+	// go-memdb objects must not be mutated in place
+	for _, r := range unread {
+		updated := *r
+		updated.Read = true
+		if err = txn.Insert(tableNotifications, &updated); err != nil {
+			txn.Abort()
+			return fmt.Errorf("failed to update notification: %w", err)
+		}
+	}
+	txn.Commit()
+	return nil
+}
+
+// groupNotifications returns the notifications of userID of type typ, or of
+// any type when it is empty, grouped by key, newest group first. A group is
+// read once all of its notifications are. Notifications by deleted actors or
+// about deleted tweets are left out.
+func groupNotifications(
+	txn *memdb.Txn,
+	userID string,
+	typ entities.NotificationType,
+) ([]entities.Notification, error) {
+	// entries of a non-unique index are ordered by ID within each value, and
+	// notification IDs sort by creation time
+	it, err := txn.GetReverse(tableNotifications, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	index := make(map[string]int)
+	var groups []entities.Notification
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*notificationRecord)
+		if !ok || (typ != "" && r.Type != string(typ)) {
+			continue
+		}
+		actor, findErr := liveUser(txn, r.ActorID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		if actor == nil {
+			continue
+		}
+		if r.TweetID != "" {
+			if _, findErr = findLiveTweet(txn, r.TweetID); findErr != nil {
+				if errors.Is(findErr, repository.ErrNotFound) {
+					continue
+				}
+				return nil, findErr
+			}
+		}
+
+		i, seen := index[r.GroupKey]
+		if !seen {
+			i = len(groups)
+			index[r.GroupKey] = i
+			groups = append(groups, r.toEntity())
+		}
+		n := &groups[i]
+		n.Read = n.Read && r.Read
+		isActor := slices.ContainsFunc(n.Actors, func(a entities.NotificationActor) bool {
+			return a.UserID.String() == r.ActorID
+		})
+		if !isActor {
+			n.Actors = append(n.Actors, entities.NotificationActor{
+				UserID:   uuid.MustParse(actor.ID),
+				Username: actor.Username,
+			})
+			n.ActorCount++
+		}
+	}
+	return groups, nil
+}
+
+// notificationGroupKey returns the key shared by the notifications listed as
+// one: those of a grouped type about the same tweet. Other notifications are
+// keyed by their own ID.
+func notificationGroupKey(typ entities.NotificationType, tweetID, id string) string {
+	if typ.Grouped() {
+		return string(typ) + ":" + tweetID
+	}
+	return id
+}
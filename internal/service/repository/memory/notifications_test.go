@@ -0,0 +1,126 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestNotificationHandler(t *testing.T) {
+	db := newTestDB(t)
+	notificationHandler := memory.NewNotificationHandler(db)
+	tweetHandler := memory.NewTweetHandler(db)
+	userHandler := memory.NewUserHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+	jim := createTestUser(t, db, "jim_doe")
+	userID := john.ID.String()
+
+	tweet := &entities.Tweet{Content: "hello", UserID: john.ID}
+	if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	tweetID := tweet.ID.String()
+
+	// Likes of one tweet are grouped, and so are follows; replies never are
+	notifications := []struct {
+		actorID string
+		typ     entities.NotificationType
+		tweetID string
+	}{
+		{jane.ID.String(), entities.NotificationFollow, ""},
+		{jane.ID.String(), entities.NotificationLike, tweetID},
+		{jim.ID.String(), entities.NotificationFollow, ""},
+		{jim.ID.String(), entities.NotificationLike, tweetID},
+		{jim.ID.String(), entities.NotificationReply, tweetID},
+	}
+	for _, n := range notifications {
+		if err := notificationHandler.Create(t.Context(), userID, n.actorID, n.typ, n.tweetID); err != nil {
+			t.Fatalf("Error creating notification: %v", err)
+		}
+	}
+
+	groups, err := notificationHandler.FindByUser(t.Context(), userID, "", "", 10)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %+v", groups)
+	}
+	if groups[0].Type != entities.NotificationReply || groups[1].Type != entities.NotificationLike {
+		t.Fatalf("Expected the reply then the likes, got %+v", groups)
+	}
+	likes := groups[1]
+	if likes.ActorCount != 2 || likes.Actors[0].Username != jim.Username || likes.Actors[1].Username != jane.Username {
+		t.Errorf("Expected likes by Jim then Jane, got %+v", likes)
+	}
+	if likes.TweetID == nil || *likes.TweetID != tweet.ID {
+		t.Errorf("Expected the likes to be about the tweet, got %+v", likes.TweetID)
+	}
+
+	// Groups are paged on their newest notification
+	page, err := notificationHandler.FindByUser(t.Context(), userID, "", likes.ID.String(), 10)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(page) != 1 || page[0].Type != entities.NotificationFollow || page[0].ActorCount != 2 {
+		t.Errorf("Expected the follows, got %+v", page)
+	}
+	page, err = notificationHandler.FindByUser(t.Context(), userID, entities.NotificationFollow, "", 1)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(page) != 1 || page[0].Type != entities.NotificationFollow {
+		t.Errorf("Expected one follow, got %+v", page)
+	}
+
+	count, err := notificationHandler.CountUnread(t.Context(), userID)
+	if err != nil {
+		t.Fatalf("Error counting unread notifications: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 unread groups, got %d", count)
+	}
+
+	// A new like after marking all read makes its group unread again
+	if err = notificationHandler.MarkRead(t.Context(), userID); err != nil {
+		t.Fatalf("Error marking notifications read: %v", err)
+	}
+	err = notificationHandler.Create(t.Context(), userID, jane.ID.String(), entities.NotificationLike, tweetID)
+	if err != nil {
+		t.Fatalf("Error creating notification: %v", err)
+	}
+	if count, err = notificationHandler.CountUnread(t.Context(), userID); err != nil || count != 1 {
+		t.Errorf("Expected 1 unread group, got %d, %v", count, err)
+	}
+	groups, err = notificationHandler.FindByUser(t.Context(), userID, entities.NotificationLike, "", 10)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(groups) != 1 || groups[0].Read || groups[0].ActorCount != 2 {
+		t.Errorf("Expected one unread group of likes, got %+v", groups)
+	}
+
+	// Deleted actors and tweets drop out
+	if err = userHandler.Delete(t.Context(), jim.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	groups, err = notificationHandler.FindByUser(t.Context(), userID, "", "", 10)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(groups) != 2 || groups[0].ActorCount != 1 || groups[1].ActorCount != 1 {
+		t.Errorf("Expected only Jane's notifications, got %+v", groups)
+	}
+	if err = tweetHandler.Delete(t.Context(), tweetID); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	groups, err = notificationHandler.FindByUser(t.Context(), userID, "", "", 10)
+	if err != nil {
+		t.Fatalf("Error finding notifications: %v", err)
+	}
+	if len(groups) != 1 || groups[0].Type != entities.NotificationFollow {
+		t.Errorf("Expected only Jane's follow, got %+v", groups)
+	}
+}
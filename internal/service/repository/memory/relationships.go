@@ -0,0 +1,169 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// relationTable holds the edges of one table of relationRecords. Blocks and
+// mutes differ only in what the service layer makes of them.
+type relationTable struct {
+	db    *memdb.MemDB
+	table string
+}
+
+// create inserts the edge from userID to targetID.
+func (s relationTable) create(userID, targetID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(s.table, "id", userID, targetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find %s: %w", s.table, err)
+	}
+	if existing != nil {
+		txn.Abort()
+		return repository.ErrAlreadyExists
+	}
+	record := &relationRecord{
+		UserID:    userID,
+		TargetID:  targetID,
+		CreatedAt: time.Now(),
+	}
+	if err = txn.Insert(s.table, record); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to insert %s: %w", s.table, err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// delete removes the edge from userID to targetID.
+func (s relationTable) delete(userID, targetID string) error {
+	txn := s.db.Txn(true)
+	existing, err := txn.First(s.table, "id", userID, targetID)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find %s: %w", s.table, err)
+	}
+	if existing == nil {
+		txn.Abort()
+		return repository.ErrNotFound
+	}
+	if err = txn.Delete(s.table, existing); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete %s: %w", s.table, err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// exists reports whether there is an edge from userID to targetID.
+func (s relationTable) exists(userID, targetID string) (bool, error) {
+	txn := s.db.Txn(false)
+	existing, err := txn.First(s.table, "id", userID, targetID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find %s: %w", s.table, err)
+	}
+	return existing != nil, nil
+}
+
+// findUsers looks up the edges matching userID on the given index and resolves
+// the live user on the other side of each, most recent first.
+func (s relationTable) findUsers(
+	index, userID string,
+	other func(*relationRecord) string,
+) ([]entities.User, error) {
+	txn := s.db.Txn(false)
+	it, err := txn.Get(s.table, index, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", s.table, err)
+	}
+	var records []*relationRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if r, ok := obj.(*relationRecord); ok {
+			records = append(records, r)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].CreatedAt.After(records[j].CreatedAt)
+	})
+
+	users := make([]entities.User, 0, len(records))
+	for _, r := range records {
+		user, findErr := liveUser(txn, other(r))
+		if findErr != nil {
+			return nil, findErr
+		}
+		if user != nil {
+			users = append(users, user.toEntity())
+		}
+	}
+	return users, nil
+}
+
+// BlockHandler is a memory implementation of the repository.BlockRepository interface.
+type BlockHandler struct {
+	relations relationTable
+}
+
+// NewBlockHandler returns a new BlockHandler backed by the given in-memory DB.
+func NewBlockHandler(db *memdb.MemDB) *BlockHandler {
+	return &BlockHandler{relations: relationTable{db: db, table: tableBlocks}}
+}
+
+// Create makes blockerID block blockedID.
+func (s *BlockHandler) Create(_ context.Context, blockerID, blockedID string) error {
+	return s.relations.create(blockerID, blockedID)
+}
+
+// Delete makes blockerID stop blocking blockedID.
+func (s *BlockHandler) Delete(_ context.Context, blockerID, blockedID string) error {
+	return s.relations.delete(blockerID, blockedID)
+}
+
+// Exists reports whether blockerID blocks blockedID.
+func (s *BlockHandler) Exists(_ context.Context, blockerID, blockedID string) (bool, error) {
+	return s.relations.exists(blockerID, blockedID)
+}
+
+// FindBlocked returns the users blocked by userID, most recent first.
+func (s *BlockHandler) FindBlocked(_ context.Context, userID string) ([]entities.User, error) {
+	return s.relations.findUsers("user_id", userID, func(r *relationRecord) string { return r.TargetID })
+}
+
+// FindBlockers returns the users blocking userID, most recent first.
+func (s *BlockHandler) FindBlockers(_ context.Context, userID string) ([]entities.User, error) {
+	return s.relations.findUsers("target_id", userID, func(r *relationRecord) string { return r.UserID })
+}
+
+// MuteHandler is a memory implementation of the repository.MuteRepository interface.
+type MuteHandler struct {
+	relations relationTable
+}
+
+// NewMuteHandler returns a new MuteHandler backed by the given in-memory DB.
+func NewMuteHandler(db *memdb.MemDB) *MuteHandler {
+	return &MuteHandler{relations: relationTable{db: db, table: tableMutes}}
+}
+
+// Create makes muterID mute mutedID.
+func (s *MuteHandler) Create(_ context.Context, muterID, mutedID string) error {
+	return s.relations.create(muterID, mutedID)
+}
+
+// Delete makes muterID stop muting mutedID.
+func (s *MuteHandler) Delete(_ context.Context, muterID, mutedID string) error {
+	return s.relations.delete(muterID, mutedID)
+}
+
+// FindMuted returns the users muted by userID, most recent first.
+func (s *MuteHandler) FindMuted(_ context.Context, userID string) ([]entities.User, error) {
+	return s.relations.findUsers("user_id", userID, func(r *relationRecord) string { return r.TargetID })
+}
@@ -0,0 +1,133 @@
+package memory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestBlockHandler(t *testing.T) {
+	db := newTestDB(t)
+	blockHandler := memory.NewBlockHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	if err := blockHandler.Create(t.Context(), john.ID.String(), jane.ID.String()); err != nil {
+		t.Fatalf("Error creating block: %v", err)
+	}
+	err := blockHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	// Blocks are one way
+	blocks, err := blockHandler.Exists(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil || !blocks {
+		t.Errorf("Expected John to block Jane, got %v, %v", blocks, err)
+	}
+	blocks, err = blockHandler.Exists(t.Context(), jane.ID.String(), john.ID.String())
+	if err != nil || blocks {
+		t.Errorf("Expected Jane not to block John, got %v, %v", blocks, err)
+	}
+
+	blocked, err := blockHandler.FindBlocked(t.Context(), john.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding blocked users: %v", err)
+	}
+	if len(blocked) != 1 || blocked[0].Username != jane.Username {
+		t.Errorf("Expected Jane to be blocked, got %+v", blocked)
+	}
+	blockers, err := blockHandler.FindBlockers(t.Context(), jane.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding blockers: %v", err)
+	}
+	if len(blockers) != 1 || blockers[0].Username != john.Username {
+		t.Errorf("Expected John to be the blocker, got %+v", blockers)
+	}
+
+	if err = blockHandler.Delete(t.Context(), john.ID.String(), jane.ID.String()); err != nil {
+		t.Fatalf("Error deleting block: %v", err)
+	}
+	err = blockHandler.Delete(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestMuteHandler(t *testing.T) {
+	db := newTestDB(t)
+	muteHandler := memory.NewMuteHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+
+	if err := muteHandler.Create(t.Context(), john.ID.String(), jane.ID.String()); err != nil {
+		t.Fatalf("Error creating mute: %v", err)
+	}
+	err := muteHandler.Create(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	// Mutes are kept apart from blocks
+	blocks, err := memory.NewBlockHandler(db).Exists(t.Context(), john.ID.String(), jane.ID.String())
+	if err != nil || blocks {
+		t.Errorf("Expected no block, got %v, %v", blocks, err)
+	}
+
+	muted, err := muteHandler.FindMuted(t.Context(), john.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding muted users: %v", err)
+	}
+	if len(muted) != 1 || muted[0].Username != jane.Username {
+		t.Errorf("Expected Jane to be muted, got %+v", muted)
+	}
+
+	if err = muteHandler.Delete(t.Context(), john.ID.String(), jane.ID.String()); err != nil {
+		t.Fatalf("Error deleting mute: %v", err)
+	}
+	err = muteHandler.Delete(t.Context(), john.ID.String(), jane.ID.String())
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestTweetHandlerExcludedUsers(t *testing.T) {
+	db := newTestDB(t)
+	tweetHandler := memory.NewTweetHandler(db)
+	john := createTestUser(t, db, "john_doe")
+	jane := createTestUser(t, db, "jane_doe")
+	excluded := []string{jane.ID.String()}
+
+	root := &entities.Tweet{Content: "root", UserID: john.ID}
+	if err := tweetHandler.Create(t.Context(), root); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	reply := &entities.Tweet{Content: "reply", UserID: jane.ID, InReplyToTweetID: &root.ID}
+	if err := tweetHandler.Create(t.Context(), reply); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	nested := &entities.Tweet{Content: "nested", UserID: john.ID, InReplyToTweetID: &reply.ID}
+	if err := tweetHandler.Create(t.Context(), nested); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+
+	page, err := tweetHandler.FindPage(t.Context(), "", 10, excluded)
+	if err != nil {
+		t.Fatalf("Error finding tweets: %v", err)
+	}
+	if len(page) != 2 || page[0].Content != "nested" || page[1].Content != "root" {
+		t.Errorf("Expected nested then root, got %+v", page)
+	}
+
+	// The excluded reply takes the replies below it along
+	thread, err := tweetHandler.FindThread(t.Context(), root.ID.String(), 10, excluded)
+	if err != nil {
+		t.Fatalf("Error finding thread: %v", err)
+	}
+	if len(thread) != 1 || thread[0].Content != "root" {
+		t.Errorf("Expected the root only, got %+v", thread)
+	}
+}
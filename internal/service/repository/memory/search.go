@@ -0,0 +1,224 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	memdb "github.com/hashicorp/go-memdb"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+)
+
+// Search returns the live tweets matching q. Content is split into lowercased
+// words of letters, marks, digits and underscores, and a tweet's relevance is
+// the number of times the words of q's terms and phrases appear in it. Tweets
+// written by excludedUserIDs are left out.
+func (s *TweetHandler) Search(
+	_ context.Context,
+	q entities.TweetQuery,
+	beforeID string,
+	offset, limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+
+	userID := ""
+	if q.FromUsername != "" {
+		user, err := findLiveUser(txn, "username", q.FromUsername)
+		if errors.Is(err, repository.ErrNotFound) {
+			return []entities.Tweet{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	}
+	tagged, err := taggedTweets(txn, q.Hashtags)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := txn.Get(tableTweets, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+	type match struct {
+		tweet *tweetRecord
+		score int
+	}
+	var matches []match
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*tweetRecord)
+		if !ok || r.DeletedAt != nil || (userID != "" && r.UserID != userID) {
+			continue
+		}
+		if slices.Contains(excludedUserIDs, r.UserID) {
+			continue
+		}
+		if tagged != nil && !tagged[r.ID] {
+			continue
+		}
+		if score, found := matchQuery(searchWords(r.Content), q); found {
+			matches = append(matches, match{tweet: r, score: score})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if q.Order == entities.SearchOrderRelevance && matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].tweet.ID > matches[j].tweet.ID
+	})
+	tweets := make([]entities.Tweet, 0, limit)
+	for _, m := range matches {
+		if q.Order == entities.SearchOrderRecency && beforeID != "" && m.tweet.ID >= beforeID {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if len(tweets) == limit {
+			break
+		}
+		tweets = append(tweets, m.tweet.toEntity())
+	}
+	return tweets, nil
+}
+
+// taggedTweets returns the IDs of the tweets using all of tags, or nil when
+// there are no tags to filter on.
+func taggedTweets(txn *memdb.Txn, tags []string) (map[string]bool, error) {
+	var ids map[string]bool
+	for _, tag := range tags {
+		raw, err := txn.First(tableHashtags, "tag", tag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find hashtag: %w", err)
+		}
+		found := make(map[string]bool)
+		if hashtag, ok := raw.(*hashtagRecord); ok {
+			it, getErr := txn.Get(tableTweetHashtags, "hashtag_id", hashtag.ID)
+			if getErr != nil {
+				return nil, fmt.Errorf("failed to get tweet hashtags: %w", getErr)
+			}
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				if r, isLink := obj.(*tweetHashtagRecord); isLink && (ids == nil || ids[r.TweetID]) {
+					found[r.TweetID] = true
+				}
+			}
+		}
+		ids = found
+	}
+	return ids, nil
+}
+
+// matchQuery reports whether words satisfy the terms, phrases and exclusions
+// of q, and how many times the words of its terms and phrases appear.
+func matchQuery(words []string, q entities.TweetQuery) (int, bool) {
+	for _, excluded := range q.Excluded {
+		if countPhrase(words, strings.Fields(excluded)) > 0 {
+			return 0, false
+		}
+	}
+	score := 0
+	for _, term := range q.Terms {
+		n := countPhrase(words, []string{term})
+		if n == 0 {
+			return 0, false
+		}
+		score += n
+	}
+	for _, phrase := range q.Phrases {
+		phraseWords := strings.Fields(phrase)
+		n := countPhrase(words, phraseWords)
+		if n == 0 {
+			return 0, false
+		}
+		score += n * len(phraseWords)
+	}
+	return score, true
+}
+
+// countPhrase returns how many times phrase appears in words.
+func countPhrase(words, phrase []string) int {
+	n := 0
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(phrase)], phrase) {
+			n++
+		}
+	}
+	return n
+}
+
+// searchWords splits content into lowercased words.
+func searchWords(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// Search returns the live users whose username or name contains query,
+// ignoring case. There is no typo tolerance: an exact username ranks first,
+// then usernames and names starting with query, then the other matches, newest
+// user first within each rank.
+func (s *UserHandler) Search(_ context.Context, query string, offset, limit int) ([]entities.User, error) {
+	txn := s.db.Txn(false)
+	it, err := txn.Get(tableUsers, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	query = strings.ToLower(query)
+	type match struct {
+		user *userRecord
+		rank int
+	}
+	var matches []match
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*userRecord)
+		if !ok || r.DeletedAt != nil {
+			continue
+		}
+		rank := max(matchRank(r.Username, query), matchRank(r.Name, query))
+		if rank > 0 && strings.EqualFold(r.Username, query) {
+			rank++
+		}
+		if rank > 0 {
+			matches = append(matches, match{user: r, rank: rank})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank > matches[j].rank
+		}
+		return matches[i].user.ID > matches[j].user.ID
+	})
+
+	users := make([]entities.User, 0, limit)
+	for _, m := range matches[min(offset, len(matches)):] {
+		if len(users) == limit {
+			break
+		}
+		users = append(users, m.user.toEntity())
+	}
+	return users, nil
+}
+
+// matchRank ranks how value matches the lowercased query: 2 when it starts
+// with it, 1 when it contains it elsewhere and 0 otherwise.
+func matchRank(value, query string) int {
+	value = strings.ToLower(value)
+	switch {
+	case strings.HasPrefix(value, query):
+		return 2
+	case strings.Contains(value, query):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,86 @@
+package memory_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/memory"
+)
+
+func TestTweetHandlerSearch(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+
+	var ids []string
+	for _, content := range []string{"Go, go!", "go big", "big GO go go", "nothing here"} {
+		tweet := &entities.Tweet{Content: content}
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+		ids = append(ids, tweet.ID.String())
+	}
+
+	// Relevance counts the occurrences of the query words, paged by offset
+	q := entities.TweetQuery{Terms: []string{"go"}, Order: entities.SearchOrderRelevance}
+	tweets, err := tweetHandler.Search(t.Context(), q, "", 1, 2, nil)
+	if err != nil {
+		t.Fatalf("Error searching tweets: %v", err)
+	}
+	if len(tweets) != 2 || tweets[0].ID.String() != ids[0] || tweets[1].ID.String() != ids[1] {
+		t.Errorf("Expected the first then the second tweet, got %+v", tweets)
+	}
+
+	// Recency is paged by ID
+	q = entities.TweetQuery{Phrases: []string{"go go"}, Order: entities.SearchOrderRecency}
+	tweets, err = tweetHandler.Search(t.Context(), q, ids[2], 0, 10, nil)
+	if err != nil {
+		t.Fatalf("Error searching tweets: %v", err)
+	}
+	if len(tweets) != 1 || tweets[0].ID.String() != ids[0] {
+		t.Errorf("Expected the first tweet, got %+v", tweets)
+	}
+}
+
+func TestUserHandlerSearch(t *testing.T) {
+	db := newTestDB(t)
+	userHandler := memory.NewUserHandler(db)
+
+	for _, u := range []entities.User{
+		{Username: "bob", Name: "Malice Bob"},
+		{Username: "alicent", Name: "Alicent Hightower"},
+		{Username: "alice", Name: "Alice Liddell"},
+		{Username: "carol", Name: "Carol"},
+		{Username: "alice_gone"},
+	} {
+		u.Email = u.Username + "@example.com"
+		if err := userHandler.Create(t.Context(), &u); err != nil {
+			t.Fatalf("Error creating user: %v", err)
+		}
+		if u.Username == "alice_gone" {
+			if err := userHandler.Delete(t.Context(), u.ID.String()); err != nil {
+				t.Fatalf("Error deleting user: %v", err)
+			}
+		}
+	}
+
+	// The exact username, then prefixes, then other substrings, ignoring case
+	users, err := userHandler.Search(t.Context(), "ALICE", 0, 10)
+	if err != nil {
+		t.Fatalf("Error searching users: %v", err)
+	}
+	var got []string
+	for _, u := range users {
+		got = append(got, u.Username)
+	}
+	if want := []string{"alice", "alicent", "bob"}; !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	users, err = userHandler.Search(t.Context(), "alice", 1, 1)
+	if err != nil {
+		t.Fatalf("Error searching users: %v", err)
+	}
+	if len(users) != 1 || users[0].Username != "alicent" {
+		t.Errorf("Expected alicent, got %+v", users)
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"time"
 
 	memdb "github.com/hashicorp/go-memdb"
 
@@ -25,18 +28,54 @@ func NewTweetHandler(db *memdb.MemDB) *TweetHandler {
 
 // Create creates a new tweet.
 func (s *TweetHandler) Create(_ context.Context, t *entities.Tweet) error {
-	txn := s.db.Txn(true)
-	t.ID = uuid.New()
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate tweet id: %w", err)
+	}
+	if t.Kind == "" {
+		t.Kind = entities.TweetKindTweet
+	}
 	record := &tweetRecord{
-		ID:      t.ID.String(),
+		ID:      id.String(),
 		Content: t.Content,
 		UserID:  t.UserID.String(),
+		Kind:    string(t.Kind),
+	}
+	if t.ReferencedTweetID != nil {
+		record.ReferencedTweetID = t.ReferencedTweetID.String()
 	}
-	if err := txn.Insert(tableTweets, record); err != nil {
+	if t.InReplyToTweetID != nil {
+		record.InReplyToTweetID = t.InReplyToTweetID.String()
+	}
+	if t.ConversationID == uuid.Nil {
+		t.ConversationID = id
+	}
+	record.ConversationID = t.ConversationID.String()
+
+	txn := s.db.Txn(true)
+	if t.Kind == entities.TweetKindRetweet {
+		// mirrors the partial unique index on live retweets in postgres
+		existing, findErr := findRetweet(txn, record.UserID, record.ReferencedTweetID)
+		if findErr != nil {
+			txn.Abort()
+			return findErr
+		}
+		if existing != nil {
+			txn.Abort()
+			return repository.ErrAlreadyExists
+		}
+	}
+	now := time.Now()
+	record.CreatedAt = now
+	record.UpdatedAt = now
+	if err = txn.Insert(tableTweets, record); err != nil {
 		txn.Abort()
 		return fmt.Errorf("failed to insert tweet: %w", err)
 	}
 	txn.Commit()
+	t.ID = id
+	t.CreatedAt = now
+	t.UpdatedAt = now
 	return nil
 }
 
@@ -50,21 +89,290 @@ func (s *TweetHandler) FindAll(_ context.Context) ([]entities.Tweet, error) {
 	var tweets []entities.Tweet //nolint:prealloc // iterator size is not known in advance
 	for obj := it.Next(); obj != nil; obj = it.Next() {
 		r, ok := obj.(*tweetRecord)
-		if !ok {
+		if !ok || r.DeletedAt != nil {
+			continue
+		}
+		tweets = append(tweets, r.toEntity())
+	}
+	return tweets, nil
+}
+
+// FindPage returns at most limit tweets created before beforeID, newest first,
+// leaving out those written by excludedUserIDs.
+func (s *TweetHandler) FindPage(
+	_ context.Context,
+	beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	it, err := reverseFrom(txn, tableTweets, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	tweets := make([]entities.Tweet, 0, limit)
+	for obj := it.Next(); obj != nil && len(tweets) < limit; obj = it.Next() {
+		r, ok := obj.(*tweetRecord)
+		if !ok || r.ID == beforeID || r.DeletedAt != nil || slices.Contains(excludedUserIDs, r.UserID) {
 			continue
 		}
-		tweets = append(tweets, entities.Tweet{
-			ID:      uuid.MustParse(r.ID),
-			Content: r.Content,
-			UserID:  uuid.MustParse(r.UserID),
-		})
+		tweets = append(tweets, r.toEntity())
+	}
+	return tweets, nil
+}
+
+// FindByUserID returns at most limit tweets by userID created before beforeID,
+// newest first.
+func (s *TweetHandler) FindByUserID(
+	_ context.Context,
+	userID, beforeID string,
+	limit int,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	// entries of a non-unique index are ordered by ID within each value
+	it, err := txn.GetReverse(tableTweets, "user_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+	tweets := make([]entities.Tweet, 0, limit)
+	for obj := it.Next(); obj != nil && len(tweets) < limit; obj = it.Next() {
+		r, ok := obj.(*tweetRecord)
+		if !ok || (beforeID != "" && r.ID >= beforeID) || r.DeletedAt != nil {
+			continue
+		}
+		tweets = append(tweets, r.toEntity())
 	}
 	return tweets, nil
 }
 
 // FindByID returns a tweet by ID.
 func (s *TweetHandler) FindByID(_ context.Context, id string) (*entities.Tweet, error) {
+	r, err := findLiveTweet(s.db.Txn(false), id)
+	if err != nil {
+		return nil, err
+	}
+	t := r.toEntity()
+	return &t, nil
+}
+
+// FindByIDs returns the live tweets among ids.
+func (s *TweetHandler) FindByIDs(_ context.Context, ids []string) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	tweets := make([]entities.Tweet, 0, len(ids))
+	for _, id := range ids {
+		r, err := findLiveTweet(txn, id)
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		tweets = append(tweets, r.toEntity())
+	}
+	return tweets, nil
+}
+
+// FindRetweet returns the live retweet of tweetID by userID.
+func (s *TweetHandler) FindRetweet(_ context.Context, userID, tweetID string) (*entities.Tweet, error) {
+	r, err := findRetweet(s.db.Txn(false), userID, tweetID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, repository.ErrNotFound
+	}
+	t := r.toEntity()
+	return &t, nil
+}
+
+// FindThread returns the live tweet rootID and its live replies at most
+// maxDepth levels below it, depth first with siblings oldest first. Replies
+// written by excludedUserIDs are left out with the replies below them.
+func (s *TweetHandler) FindThread(
+	_ context.Context,
+	rootID string,
+	maxDepth int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	root, err := findLiveTweet(txn, rootID)
+	if err != nil {
+		return nil, err
+	}
+	return appendReplies(txn, []entities.Tweet{root.toEntity()}, root.ID, maxDepth, excludedUserIDs)
+}
+
+// appendReplies appends the live replies to parentID not written by
+// excludedUserIDs and, recursively, their own replies down to depth levels.
+func appendReplies(
+	txn *memdb.Txn,
+	tweets []entities.Tweet,
+	parentID string,
+	depth int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	if depth <= 0 {
+		return tweets, nil
+	}
+	it, err := txn.Get(tableTweets, "in_reply_to_tweet_id", parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replies: %w", err)
+	}
+	var replies []*tweetRecord
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if r, ok := obj.(*tweetRecord); ok && r.DeletedAt == nil && !slices.Contains(excludedUserIDs, r.UserID) {
+			replies = append(replies, r)
+		}
+	}
+	// v7 IDs sort by creation time
+	sort.Slice(replies, func(i, j int) bool { return replies[i].ID < replies[j].ID })
+	for _, r := range replies {
+		tweets = append(tweets, r.toEntity())
+		if tweets, err = appendReplies(txn, tweets, r.ID, depth-1, excludedUserIDs); err != nil {
+			return nil, err
+		}
+	}
+	return tweets, nil
+}
+
+// CountReferences counts the live tweets of the given kind referencing each of tweetIDs.
+func (s *TweetHandler) CountReferences(
+	_ context.Context,
+	tweetIDs []string,
+	kind entities.TweetKind,
+) (map[string]int, error) {
+	txn := s.db.Txn(false)
+	counts := make(map[string]int, len(tweetIDs))
+	for _, tweetID := range tweetIDs {
+		it, err := txn.Get(tableTweets, "referenced_tweet_id", tweetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get referencing tweets: %w", err)
+		}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			if r, ok := obj.(*tweetRecord); ok && r.DeletedAt == nil && r.Kind == string(kind) {
+				counts[tweetID]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// FindTimeline returns at most limit tweets authored by userID and the users
+// they follow, other than excludedUserIDs, created before beforeID, newest
+// first.
+func (s *TweetHandler) FindTimeline(
+	_ context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	txn := s.db.Txn(false)
+	authors := []string{userID}
+	it, err := txn.Get(tableFollows, "follower_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follows: %w", err)
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		if r, ok := obj.(*followRecord); ok && !slices.Contains(excludedUserIDs, r.FolloweeID) {
+			authors = append(authors, r.FolloweeID)
+		}
+	}
+
+	// the page is among the newest limit tweets of each author
+	var records []*tweetRecord
+	for _, author := range authors {
+		tweetIt, getErr := txn.GetReverse(tableTweets, "user_id", author)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get tweets: %w", getErr)
+		}
+		n := 0
+		for obj := tweetIt.Next(); obj != nil && n < limit; obj = tweetIt.Next() {
+			r, ok := obj.(*tweetRecord)
+			if !ok || (beforeID != "" && r.ID >= beforeID) || r.DeletedAt != nil {
+				continue
+			}
+			records = append(records, r)
+			n++
+		}
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].ID > records[j].ID })
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	tweets := make([]entities.Tweet, 0, len(records))
+	for _, r := range records {
+		tweets = append(tweets, r.toEntity())
+	}
+	return tweets, nil
+}
+
+// Delete soft-deletes a tweet.
+func (s *TweetHandler) Delete(_ context.Context, id string) error {
+	txn := s.db.Txn(true)
+	r, err := findLiveTweet(txn, id)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	now := time.Now()
+	deleted := *r
+	deleted.DeletedAt = &now
+	deleted.UpdatedAt = now
+	if err = txn.Insert(tableTweets, &deleted); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete tweet: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindDeleted returns the soft-deleted tweets, most recently deleted first.
+func (s *TweetHandler) FindDeleted(_ context.Context) ([]entities.Tweet, error) {
 	txn := s.db.Txn(false)
+	it, err := txn.Get(tableTweets, "id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+	var tweets []entities.Tweet //nolint:prealloc // iterator size is not known in advance
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*tweetRecord)
+		if !ok || r.DeletedAt == nil {
+			continue
+		}
+		tweets = append(tweets, r.toEntity())
+	}
+	sortByDeletedAt(tweets, func(t entities.Tweet) time.Time { return *t.DeletedAt })
+	return tweets, nil
+}
+
+// Restore undoes the soft delete of a tweet.
+func (s *TweetHandler) Restore(_ context.Context, id string) error {
+	txn := s.db.Txn(true)
+	raw, err := txn.First(tableTweets, "id", id)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find tweet: %w", err)
+	}
+	r, ok := raw.(*tweetRecord)
+	if !ok || r.DeletedAt == nil {
+		txn.Abort()
+		return repository.ErrNotFound
+	}
+	restored := *r
+	restored.DeletedAt = nil
+	restored.UpdatedAt = time.Now()
+	if err = txn.Insert(tableTweets, &restored); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to restore tweet: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// findLiveTweet returns the tweet with the given ID, or repository.ErrNotFound
+// if there is none or it has been soft-deleted.
+func findLiveTweet(txn *memdb.Txn, id string) (*tweetRecord, error) {
 	raw, err := txn.First(tableTweets, "id", id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find tweet: %w", err)
@@ -76,9 +384,23 @@ func (s *TweetHandler) FindByID(_ context.Context, id string) (*entities.Tweet,
 	if !ok {
 		return nil, errors.New("unexpected record type in tweets table")
 	}
-	return &entities.Tweet{
-		ID:      uuid.MustParse(r.ID),
-		Content: r.Content,
-		UserID:  uuid.MustParse(r.UserID),
-	}, nil
+	if r.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	return r, nil
+}
+
+// findRetweet returns the live retweet of tweetID by userID, or nil if there is none.
+func findRetweet(txn *memdb.Txn, userID, tweetID string) (*tweetRecord, error) {
+	it, err := txn.Get(tableTweets, "referenced_tweet_id", tweetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retweets: %w", err)
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*tweetRecord)
+		if ok && r.DeletedAt == nil && r.UserID == userID && r.Kind == string(entities.TweetKindRetweet) {
+			return r, nil
+		}
+	}
+	return nil, nil //nolint:nilnil // no retweet is not an error here
 }
@@ -2,6 +2,7 @@ package memory_test
 
 import (
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -38,6 +39,11 @@ func TestTweetHandlerCreate(t *testing.T) {
 		t.Error("Invalid tweet ID")
 	}
 
+	// Verify the timestamps are set
+	if tweet.CreatedAt.IsZero() || !tweet.UpdatedAt.Equal(tweet.CreatedAt) {
+		t.Errorf("Expected matching timestamps, got %v and %v", tweet.CreatedAt, tweet.UpdatedAt)
+	}
+
 	// Verify the tweet is stored in the handler
 	tweets, err := tweetHandler.FindAll(t.Context())
 	if err != nil {
@@ -133,3 +139,260 @@ func TestTweetHandlerFindByIDNotFound(t *testing.T) {
 		t.Errorf("Expected ErrNotFound, got %v", err)
 	}
 }
+
+func TestTweetHandlerFindPage(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+
+	// Create some tweets
+	for _, content := range []string{"first", "second", "third"} {
+		if err := tweetHandler.Create(t.Context(), &entities.Tweet{Content: content}); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+	}
+
+	// First page starts with the newest tweet
+	page, err := tweetHandler.FindPage(t.Context(), "", 2, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page) != 2 {
+		t.Fatalf("Expected 2 tweets, got %d", len(page))
+	}
+	if page[0].Content != "third" || page[1].Content != "second" {
+		t.Errorf("Expected [third second], got [%s %s]", page[0].Content, page[1].Content)
+	}
+
+	// Next page starts after the last tweet of the previous one
+	page, err = tweetHandler.FindPage(t.Context(), page[1].ID.String(), 2, nil)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page) != 1 {
+		t.Fatalf("Expected 1 tweet, got %d", len(page))
+	}
+	if page[0].Content != "first" {
+		t.Errorf("Expected first, got %s", page[0].Content)
+	}
+}
+
+func TestTweetHandlerFindByUserID(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+	john, jane := uuid.New(), uuid.New()
+
+	// Interleave the tweets of two users
+	for _, tweet := range []*entities.Tweet{
+		{Content: "john 1", UserID: john},
+		{Content: "jane 1", UserID: jane},
+		{Content: "john 2", UserID: john},
+		{Content: "john 3", UserID: john},
+	} {
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+	}
+
+	page, err := tweetHandler.FindByUserID(t.Context(), john.String(), "", 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page) != 2 || page[0].Content != "john 3" || page[1].Content != "john 2" {
+		t.Fatalf("Expected [john 3, john 2], got %v", page)
+	}
+
+	page, err = tweetHandler.FindByUserID(t.Context(), john.String(), page[1].ID.String(), 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page) != 1 || page[0].Content != "john 1" {
+		t.Errorf("Expected [john 1], got %v", page)
+	}
+
+	page, err = tweetHandler.FindByUserID(t.Context(), uuid.NewString(), "", 2)
+	if err != nil {
+		t.Fatalf("Error retrieving page: %v", err)
+	}
+	if len(page) != 0 {
+		t.Errorf("Expected no tweets, got %v", page)
+	}
+}
+
+func TestTweetHandlerDeleteAndRestore(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+
+	tweet := &entities.Tweet{Content: "Hello, world!"}
+	if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+
+	if err := tweetHandler.Delete(t.Context(), tweet.ID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+
+	// Deleted tweets are hidden from the read paths
+	if _, err := tweetHandler.FindByID(t.Context(), tweet.ID.String()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	tweets, err := tweetHandler.FindAll(t.Context())
+	if err != nil {
+		t.Fatalf("Error retrieving tweets: %v", err)
+	}
+	if len(tweets) != 0 {
+		t.Errorf("Expected 0 tweets, got %d", len(tweets))
+	}
+
+	// Deleting twice is rejected
+	if err = tweetHandler.Delete(t.Context(), tweet.ID.String()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	// ... but they are listed as deleted
+	deleted, err := tweetHandler.FindDeleted(t.Context())
+	if err != nil {
+		t.Fatalf("Error retrieving deleted tweets: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].DeletedAt == nil {
+		t.Fatalf("Expected 1 deleted tweet, got %v", deleted)
+	}
+	if !deleted[0].UpdatedAt.After(tweet.UpdatedAt) || !deleted[0].CreatedAt.Equal(tweet.CreatedAt) {
+		t.Errorf("Expected only updated_at to advance, got %v", deleted[0])
+	}
+
+	if err = tweetHandler.Restore(t.Context(), tweet.ID.String()); err != nil {
+		t.Fatalf("Error restoring tweet: %v", err)
+	}
+	if _, err = tweetHandler.FindByID(t.Context(), tweet.ID.String()); err != nil {
+		t.Errorf("Error retrieving restored tweet: %v", err)
+	}
+
+	// Restoring a live tweet is rejected
+	if err = tweetHandler.Restore(t.Context(), tweet.ID.String()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestTweetHandlerRetweets(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+	userID := uuid.New()
+
+	original := &entities.Tweet{Content: "Hello, world!", UserID: userID}
+	if err := tweetHandler.Create(t.Context(), original); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	if original.Kind != entities.TweetKindTweet {
+		t.Errorf("Expected kind %q, got %q", entities.TweetKindTweet, original.Kind)
+	}
+	retweet := &entities.Tweet{UserID: userID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID}
+	quote := &entities.Tweet{
+		Content: "So true", UserID: userID, Kind: entities.TweetKindQuote, ReferencedTweetID: &original.ID,
+	}
+	for _, tweet := range []*entities.Tweet{retweet, quote} {
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+	}
+
+	// A second live retweet by the same user is rejected
+	err := tweetHandler.Create(t.Context(),
+		&entities.Tweet{UserID: userID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID})
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	found, err := tweetHandler.FindRetweet(t.Context(), userID.String(), original.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding retweet: %v", err)
+	}
+	if found.ID != retweet.ID || *found.ReferencedTweetID != original.ID {
+		t.Errorf("Unexpected retweet: %+v", found)
+	}
+
+	ids := []string{original.ID.String()}
+	retweets, err := tweetHandler.CountReferences(t.Context(), ids, entities.TweetKindRetweet)
+	if err != nil {
+		t.Fatalf("Error counting retweets: %v", err)
+	}
+	quotes, err := tweetHandler.CountReferences(t.Context(), ids, entities.TweetKindQuote)
+	if err != nil {
+		t.Fatalf("Error counting quotes: %v", err)
+	}
+	if retweets[original.ID.String()] != 1 || quotes[original.ID.String()] != 1 {
+		t.Errorf("Expected 1 retweet and 1 quote, got %v and %v", retweets, quotes)
+	}
+
+	// Once the retweet is deleted, the user may retweet again
+	if err = tweetHandler.Delete(t.Context(), retweet.ID.String()); err != nil {
+		t.Fatalf("Error deleting retweet: %v", err)
+	}
+	_, err = tweetHandler.FindRetweet(t.Context(), userID.String(), original.ID.String())
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	err = tweetHandler.Create(t.Context(),
+		&entities.Tweet{UserID: userID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID})
+	if err != nil {
+		t.Errorf("Error retweeting again: %v", err)
+	}
+}
+
+func TestTweetHandlerFindThread(t *testing.T) {
+	tweetHandler := newTestTweetHandler(t)
+	userID := uuid.New()
+
+	root := &entities.Tweet{Content: "root", UserID: userID}
+	if err := tweetHandler.Create(t.Context(), root); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	if root.ConversationID != root.ID {
+		t.Errorf("Expected conversation %s, got %s", root.ID, root.ConversationID)
+	}
+	reply := func(parent *entities.Tweet, content string) *entities.Tweet {
+		tweet := &entities.Tweet{
+			Content: content, UserID: userID, InReplyToTweetID: &parent.ID, ConversationID: parent.ConversationID,
+		}
+		if err := tweetHandler.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating reply: %v", err)
+		}
+		return tweet
+	}
+	first := reply(root, "first")
+	second := reply(root, "second")
+	firstReply := reply(first, "reply to first")
+	deepest := reply(firstReply, "deepest")
+
+	contents := func(maxDepth int) []string {
+		tweets, err := tweetHandler.FindThread(t.Context(), root.ID.String(), maxDepth, nil)
+		if err != nil {
+			t.Fatalf("Error finding thread: %v", err)
+		}
+		var got []string
+		for _, tweet := range tweets {
+			if tweet.ConversationID != root.ID {
+				t.Errorf("Expected conversation %s, got %s", root.ID, tweet.ConversationID)
+			}
+			got = append(got, tweet.Content)
+		}
+		return got
+	}
+	if got := contents(10); !slices.Equal(got, []string{"root", "first", "reply to first", "deepest", "second"}) {
+		t.Errorf("Unexpected thread: %v", got)
+	}
+	if got := contents(2); !slices.Equal(got, []string{"root", "first", "reply to first", "second"}) {
+		t.Errorf("Unexpected thread at depth 2: %v", got)
+	}
+	if *deepest.InReplyToTweetID != firstReply.ID || *second.InReplyToTweetID != root.ID {
+		t.Errorf("Unexpected parents: %v, %v", deepest.InReplyToTweetID, second.InReplyToTweetID)
+	}
+
+	// Deleting a reply hides the replies below it
+	if err := tweetHandler.Delete(t.Context(), first.ID.String()); err != nil {
+		t.Fatalf("Error deleting reply: %v", err)
+	}
+	if got := contents(10); !slices.Equal(got, []string{"root", "second"}) {
+		t.Errorf("Unexpected thread after delete: %v", got)
+	}
+
+	_, err := tweetHandler.FindThread(t.Context(), uuid.New().String(), 10, nil)
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
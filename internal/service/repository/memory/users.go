@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	memdb "github.com/hashicorp/go-memdb"
 
@@ -27,15 +28,33 @@ func NewUserHandler(db *memdb.MemDB) *UserHandler {
 
 // Create creates a new user.
 func (s *UserHandler) Create(_ context.Context, u *entities.User) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate user id: %w", err)
+	}
 	txn := s.db.Txn(true)
-	u.ID = uuid.New()
+	if err = checkUnique(txn, "username", u.Username, ""); err != nil {
+		txn.Abort()
+		return err
+	}
+	if err = checkUnique(txn, "email", u.Email, ""); err != nil {
+		txn.Abort()
+		return err
+	}
+	now := time.Now()
+	u.ID = id
+	u.CreatedAt = now
+	u.UpdatedAt = now
 	record := &userRecord{
-		ID:       u.ID.String(),
-		Username: u.Username,
-		Email:    u.Email,
-		Name:     u.Name,
+		ID:           u.ID.String(),
+		Username:     u.Username,
+		Email:        u.Email,
+		Name:         u.Name,
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
 	}
-	if err := txn.Insert(tableUsers, record); err != nil {
+	if err = txn.Insert(tableUsers, record); err != nil {
 		txn.Abort()
 		return fmt.Errorf("failed to insert user: %w", err)
 	}
@@ -53,69 +72,186 @@ func (s *UserHandler) FindAll(_ context.Context) ([]entities.User, error) {
 	var users []entities.User //nolint:prealloc // iterator size is not known in advance
 	for obj := it.Next(); obj != nil; obj = it.Next() {
 		r, ok := obj.(*userRecord)
-		if !ok {
+		if !ok || r.DeletedAt != nil {
 			continue
 		}
-		users = append(users, entities.User{
-			ID:       uuid.MustParse(r.ID),
-			Username: r.Username,
-			Email:    r.Email,
-			Name:     r.Name,
-		})
+		users = append(users, r.toEntity())
 	}
 	return users, nil
 }
 
-// FindByID returns a user by ID.
-func (s *UserHandler) FindByID(_ context.Context, id string) (*entities.User, error) {
+// FindPage returns at most limit users created before beforeID, newest first.
+func (s *UserHandler) FindPage(_ context.Context, beforeID string, limit int) ([]entities.User, error) {
 	txn := s.db.Txn(false)
-	raw, err := txn.First(tableUsers, "id", id)
+	it, err := reverseFrom(txn, tableUsers, beforeID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find user: %w", err)
+		return nil, err
 	}
-	if raw == nil {
-		return nil, repository.ErrNotFound
+	users := make([]entities.User, 0, limit)
+	for obj := it.Next(); obj != nil && len(users) < limit; obj = it.Next() {
+		r, ok := obj.(*userRecord)
+		if !ok || r.ID == beforeID || r.DeletedAt != nil {
+			continue
+		}
+		users = append(users, r.toEntity())
 	}
-	r, ok := raw.(*userRecord)
-	if !ok {
-		return nil, errors.New(errUnexpectedUserRecord)
+	return users, nil
+}
+
+// FindByID returns a user by ID.
+func (s *UserHandler) FindByID(_ context.Context, id string) (*entities.User, error) {
+	r, err := findLiveUser(s.db.Txn(false), "id", id)
+	if err != nil {
+		return nil, err
 	}
-	return &entities.User{
-		ID:       uuid.MustParse(r.ID),
-		Username: r.Username,
-		Email:    r.Email,
-		Name:     r.Name,
-	}, nil
+	u := r.toEntity()
+	return &u, nil
 }
 
-// FindByUsername returns a user by username.
+// FindByUsername returns a user by username, ignoring case.
 func (s *UserHandler) FindByUsername(_ context.Context, username string) (*entities.User, error) {
+	r, err := findLiveUser(s.db.Txn(false), "username", username)
+	if err != nil {
+		return nil, err
+	}
+	u := r.toEntity()
+	return &u, nil
+}
+
+// FindByEmail returns a user by email.
+func (s *UserHandler) FindByEmail(_ context.Context, email string) (*entities.User, error) {
+	r, err := findLiveUser(s.db.Txn(false), "email", email)
+	if err != nil {
+		return nil, err
+	}
+	u := r.toEntity()
+	return &u, nil
+}
+
+// Update applies a partial update to a user.
+func (s *UserHandler) Update(_ context.Context, id string, upd entities.UserUpdate) (*entities.User, error) {
+	txn := s.db.Txn(true)
+	r, err := findLiveUser(txn, "id", id)
+	if err != nil {
+		txn.Abort()
+		return nil, err
+	}
+	updated := *r
+	if upd.Username != nil {
+		if err = checkUnique(txn, "username", *upd.Username, id); err != nil {
+			txn.Abort()
+			return nil, err
+		}
+		updated.Username = *upd.Username
+	}
+	if upd.Email != nil {
+		if err = checkUnique(txn, "email", *upd.Email, id); err != nil {
+			txn.Abort()
+			return nil, err
+		}
+		updated.Email = *upd.Email
+	}
+	if upd.Name != nil {
+		updated.Name = *upd.Name
+	}
+	updated.UpdatedAt = time.Now()
+	if err = txn.Insert(tableUsers, &updated); err != nil {
+		txn.Abort()
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	txn.Commit()
+	u := updated.toEntity()
+	return &u, nil
+}
+
+// UpdatePasswordHash replaces the password hash of a user.
+func (s *UserHandler) UpdatePasswordHash(_ context.Context, id, hash string) error {
+	txn := s.db.Txn(true)
+	r, err := findLiveUser(txn, "id", id)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	updated := *r
+	updated.PasswordHash = hash
+	updated.UpdatedAt = time.Now()
+	if err = txn.Insert(tableUsers, &updated); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to update password hash: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// Delete soft-deletes a user.
+func (s *UserHandler) Delete(_ context.Context, id string) error {
+	txn := s.db.Txn(true)
+	r, err := findLiveUser(txn, "id", id)
+	if err != nil {
+		txn.Abort()
+		return err
+	}
+	now := time.Now()
+	deleted := *r
+	deleted.DeletedAt = &now
+	deleted.UpdatedAt = now
+	if err = txn.Insert(tableUsers, &deleted); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	txn.Commit()
+	return nil
+}
+
+// FindDeleted returns the soft-deleted users, most recently deleted first.
+func (s *UserHandler) FindDeleted(_ context.Context) ([]entities.User, error) {
 	txn := s.db.Txn(false)
-	raw, err := txn.First(tableUsers, "username", username)
+	it, err := txn.Get(tableUsers, "id")
 	if err != nil {
-		return nil, fmt.Errorf("failed to find user by username: %w", err)
+		return nil, fmt.Errorf("failed to get users: %w", err)
 	}
-	if raw == nil {
-		return nil, repository.ErrNotFound
+	var users []entities.User //nolint:prealloc // iterator size is not known in advance
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		r, ok := obj.(*userRecord)
+		if !ok || r.DeletedAt == nil {
+			continue
+		}
+		users = append(users, r.toEntity())
+	}
+	sortByDeletedAt(users, func(u entities.User) time.Time { return *u.DeletedAt })
+	return users, nil
+}
+
+// Restore undoes the soft delete of a user.
+func (s *UserHandler) Restore(_ context.Context, id string) error {
+	txn := s.db.Txn(true)
+	raw, err := txn.First(tableUsers, "id", id)
+	if err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to find user: %w", err)
 	}
 	r, ok := raw.(*userRecord)
-	if !ok {
-		return nil, errors.New(errUnexpectedUserRecord)
+	if !ok || r.DeletedAt == nil {
+		txn.Abort()
+		return repository.ErrNotFound
 	}
-	return &entities.User{
-		ID:       uuid.MustParse(r.ID),
-		Username: r.Username,
-		Email:    r.Email,
-		Name:     r.Name,
-	}, nil
+	restored := *r
+	restored.DeletedAt = nil
+	restored.UpdatedAt = time.Now()
+	if err = txn.Insert(tableUsers, &restored); err != nil {
+		txn.Abort()
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+	txn.Commit()
+	return nil
 }
 
-// FindByEmail returns a user by email.
-func (s *UserHandler) FindByEmail(_ context.Context, email string) (*entities.User, error) {
-	txn := s.db.Txn(false)
-	raw, err := txn.First(tableUsers, "email", email)
+// findLiveUser returns the user matching value on the given unique index,
+// or repository.ErrNotFound if there is none or it has been soft-deleted.
+func findLiveUser(txn *memdb.Txn, index, value string) (*userRecord, error) {
+	raw, err := txn.First(tableUsers, index, value)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find user by email: %w", err)
+		return nil, fmt.Errorf("failed to find user by %s: %w", index, err)
 	}
 	if raw == nil {
 		return nil, repository.ErrNotFound
@@ -124,10 +260,22 @@ func (s *UserHandler) FindByEmail(_ context.Context, email string) (*entities.Us
 	if !ok {
 		return nil, errors.New(errUnexpectedUserRecord)
 	}
-	return &entities.User{
-		ID:       uuid.MustParse(r.ID),
-		Username: r.Username,
-		Email:    r.Email,
-		Name:     r.Name,
-	}, nil
+	if r.DeletedAt != nil {
+		return nil, repository.ErrNotFound
+	}
+	return r, nil
+}
+
+// checkUnique returns a *repository.ConflictError if a user other than id
+// holds value on the given unique index. go-memdb does not enforce uniqueness
+// on secondary indexes, so the check has to be done by hand.
+func checkUnique(txn *memdb.Txn, index, value, id string) error {
+	raw, err := txn.First(tableUsers, index, value)
+	if err != nil {
+		return fmt.Errorf("failed to find user by %s: %w", index, err)
+	}
+	if r, ok := raw.(*userRecord); ok && r.ID != id {
+		return &repository.ConflictError{Field: index}
+	}
+	return nil
 }
@@ -52,6 +52,11 @@ func TestUserHandlerCreate(t *testing.T) {
 	if users[0].Username != user.Username {
 		t.Errorf("Expected username %q, got %q", user.Username, users[0].Username)
 	}
+
+	// Verify the timestamps are stored
+	if users[0].CreatedAt.IsZero() || !users[0].CreatedAt.Equal(user.CreatedAt) {
+		t.Errorf("Expected created_at %v, got %v", user.CreatedAt, users[0].CreatedAt)
+	}
 }
 
 func TestUserHandlerFindAll(t *testing.T) {
@@ -128,6 +133,31 @@ func TestUserHandlerFindByID(t *testing.T) {
 	}
 }
 
+func TestUserHandlerUsernameIgnoresCase(t *testing.T) {
+	userHandler := newTestUserHandler(t)
+
+	user := &entities.User{Username: "John_Doe", Email: "john.doe@example.com"}
+	if err := userHandler.Create(t.Context(), user); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+
+	// Lookups ignore case but return the username as it was stored
+	foundUser, err := userHandler.FindByUsername(t.Context(), "JOHN_doe")
+	if err != nil {
+		t.Fatalf("Error retrieving user: %v", err)
+	}
+	if foundUser.ID != user.ID || foundUser.Username != "John_Doe" {
+		t.Errorf("Expected %s (John_Doe), got %s (%s)", user.ID, foundUser.ID, foundUser.Username)
+	}
+
+	// ... and so does uniqueness
+	var conflict *repository.ConflictError
+	err = userHandler.Create(t.Context(), &entities.User{Username: "john_doe", Email: "other@example.com"})
+	if !errors.As(err, &conflict) || conflict.Field != "username" {
+		t.Errorf("Expected username conflict, got %v", err)
+	}
+}
+
 func TestUserHandlerFindByIDNotFound(t *testing.T) {
 	userHandler := newTestUserHandler(t)
 
@@ -209,3 +239,173 @@ func TestUserHandlerFindByEmailNotFound(t *testing.T) {
 		t.Errorf("Expected ErrNotFound, got %v", err)
 	}
 }
+
+func TestUserHandlerDeleteAndRestore(t *testing.T) {
+	userHandler := newTestUserHandler(t)
+
+	user := &entities.User{
+		Username: "john_doe",
+		Email:    "john.doe@example.com",
+	}
+	if err := userHandler.Create(t.Context(), user); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+
+	if err := userHandler.Delete(t.Context(), user.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+
+	// Deleted users are hidden from the read paths
+	if _, err := userHandler.FindByID(t.Context(), user.ID.String()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := userHandler.FindByUsername(t.Context(), user.Username); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err := userHandler.FindByEmail(t.Context(), user.Email); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	page, err := userHandler.FindPage(t.Context(), "", 10)
+	if err != nil {
+		t.Fatalf("Error retrieving users: %v", err)
+	}
+	if len(page) != 0 {
+		t.Errorf("Expected 0 users, got %d", len(page))
+	}
+
+	// ... but they are listed as deleted
+	deleted, err := userHandler.FindDeleted(t.Context())
+	if err != nil {
+		t.Fatalf("Error retrieving deleted users: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].Username != user.Username {
+		t.Fatalf("Expected 1 deleted user, got %v", deleted)
+	}
+
+	if err = userHandler.Restore(t.Context(), user.ID.String()); err != nil {
+		t.Fatalf("Error restoring user: %v", err)
+	}
+	if _, err = userHandler.FindByID(t.Context(), user.ID.String()); err != nil {
+		t.Errorf("Error retrieving restored user: %v", err)
+	}
+
+	// Restoring an unknown user is rejected
+	if err = userHandler.Restore(t.Context(), uuid.NewString()); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserHandlerUpdate(t *testing.T) {
+	userHandler := newTestUserHandler(t)
+
+	john := &entities.User{Username: "john_doe", Email: "john.doe@example.com"}
+	jane := &entities.User{Username: "jane_doe", Email: "jane.doe@example.com"}
+	for _, u := range []*entities.User{john, jane} {
+		if err := userHandler.Create(t.Context(), u); err != nil {
+			t.Fatalf("Error creating user: %v", err)
+		}
+	}
+
+	name := "John Doe"
+	username := "johnny"
+	updated, err := userHandler.Update(t.Context(), john.ID.String(), entities.UserUpdate{
+		Username: &username,
+		Name:     &name,
+	})
+	if err != nil {
+		t.Fatalf("Error updating user: %v", err)
+	}
+	if updated.Username != username || updated.Name != name || updated.Email != john.Email {
+		t.Errorf("Unexpected user after update: %+v", updated)
+	}
+
+	// The username index follows the update
+	if _, err = userHandler.FindByUsername(t.Context(), "john_doe"); !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err = userHandler.FindByUsername(t.Context(), username); err != nil {
+		t.Errorf("Error retrieving user by new username: %v", err)
+	}
+
+	// Taken usernames and emails are rejected, even when held by a deleted user
+	if err = userHandler.Delete(t.Context(), jane.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	var conflict *repository.ConflictError
+	_, err = userHandler.Update(t.Context(), john.ID.String(), entities.UserUpdate{Username: &jane.Username})
+	if !errors.As(err, &conflict) || conflict.Field != "username" {
+		t.Errorf("Expected username conflict, got %v", err)
+	}
+	_, err = userHandler.Update(t.Context(), john.ID.String(), entities.UserUpdate{Email: &jane.Email})
+	if !errors.As(err, &conflict) || conflict.Field != "email" {
+		t.Errorf("Expected email conflict, got %v", err)
+	}
+
+	// Deleted users cannot be updated
+	_, err = userHandler.Update(t.Context(), jane.ID.String(), entities.UserUpdate{Name: &name})
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserHandlerUpdatePasswordHash(t *testing.T) {
+	userHandler := newTestUserHandler(t)
+
+	john := &entities.User{Username: "john_doe", Email: "john.doe@example.com", PasswordHash: "old"}
+	if err := userHandler.Create(t.Context(), john); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	if err := userHandler.UpdatePasswordHash(t.Context(), john.ID.String(), "new"); err != nil {
+		t.Fatalf("Error updating password hash: %v", err)
+	}
+	got, err := userHandler.FindByUsername(t.Context(), "john_doe")
+	if err != nil {
+		t.Fatalf("Error retrieving user: %v", err)
+	}
+	if got.PasswordHash != "new" {
+		t.Errorf("Expected password hash %q, got %q", "new", got.PasswordHash)
+	}
+
+	// Deleted users cannot have their hash replaced
+	if err = userHandler.Delete(t.Context(), john.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	err = userHandler.UpdatePasswordHash(t.Context(), john.ID.String(), "newer")
+	if !errors.Is(err, repository.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserHandlerCreateDuplicate(t *testing.T) {
+	userHandler := newTestUserHandler(t)
+
+	if err := userHandler.Create(t.Context(), &entities.User{
+		Username: "john_doe",
+		Email:    "john.doe@example.com",
+	}); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+
+	// Duplicates are rejected with the offending field
+	var conflict *repository.ConflictError
+	err := userHandler.Create(t.Context(), &entities.User{Username: "john_doe", Email: "other@example.com"})
+	if !errors.As(err, &conflict) || conflict.Field != "username" {
+		t.Errorf("Expected username conflict, got %v", err)
+	}
+	err = userHandler.Create(t.Context(), &entities.User{Username: "other", Email: "john.doe@example.com"})
+	if !errors.As(err, &conflict) || conflict.Field != "email" {
+		t.Errorf("Expected email conflict, got %v", err)
+	}
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+
+	// ... and the original user is left alone
+	users, err := userHandler.FindAll(t.Context())
+	if err != nil {
+		t.Fatalf("Error retrieving users: %v", err)
+	}
+	if len(users) != 1 {
+		t.Errorf("Expected 1 user, got %d", len(users))
+	}
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// BookmarkStorage is a postgres implementation of the repository.BookmarkRepository interface.
+type BookmarkStorage struct {
+	dbConn bob.Executor
+}
+
+// NewBookmarkStorage returns a new BookmarkStorage.
+func NewBookmarkStorage(dbConn bob.Executor) *BookmarkStorage {
+	return &BookmarkStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create records that userID bookmarked tweetID. Concurrent bookmarks of the
+// same tweet by the same user are serialized by the unique constraint.
+func (s *BookmarkStorage) Create(ctx context.Context, userID, tweetID string) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate bookmark id: %w", err)
+	}
+	setter := &models.BookmarkSetter{
+		ID:      omit.From(id.String()),
+		UserID:  omit.From(userID),
+		TweetID: omit.From(tweetID),
+	}
+
+	if _, err = models.Bookmarks.Insert(setter).Exec(ctx, s.dbConn); err != nil {
+		if isUniqueViolation(err, dberrors.BookmarkErrors.ErrUniqueBookmarksUserIdTweetIdKey) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to insert bookmark: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the bookmark of tweetID by userID.
+func (s *BookmarkStorage) Delete(ctx context.Context, userID, tweetID string) error {
+	rows, err := models.Bookmarks.Delete(
+		dm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+		dm.Where(models.Bookmarks.Columns.TweetID.EQ(psql.Arg(tweetID))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete bookmark: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindByUser returns at most limit bookmarks of live tweets by userID made
+// before the bookmark beforeID, most recent first, leaving out the tweets
+// written by excludedUserIDs. The page is served by idx_bookmarks_user_id, and
+// the tweets are loaded by a second query.
+func (s *BookmarkStorage) FindByUser(
+	ctx context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Bookmark, error) {
+	mods := append(
+		pageMods(models.Bookmarks.Columns.ID, beforeID, limit),
+		models.SelectJoins.Bookmarks.InnerJoin.Tweet,
+		sm.Where(models.Bookmarks.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+		models.SelectThenLoad.Bookmark.Tweet(),
+	)
+	ormRows, err := models.Bookmarks.Query(append(mods, excludeAuthors(excludedUserIDs)...)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bookmarks: %w", err)
+	}
+
+	bookmarks := make([]entities.Bookmark, 0, len(ormRows))
+	for _, b := range ormRows {
+		bookmarks = append(bookmarks, entities.Bookmark{
+			ID:        uuid.MustParse(b.ID),
+			UserID:    uuid.MustParse(b.UserID),
+			Tweet:     toTweet(b.R.Tweet),
+			CreatedAt: b.CreatedAt.GetOrZero(),
+		})
+	}
+	return bookmarks, nil
+}
@@ -0,0 +1,71 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type BookmarksTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestBookmarksTestSuite(t *testing.T) {
+	suite.Run(t, new(BookmarksTestSuite))
+}
+
+func (ts *BookmarksTestSuite) TestBookmarks() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	b := postgres.NewBookmarkStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	userID := john.ID.String()
+	tweets := []*entities.Tweet{
+		{Content: "first", UserID: john.ID},
+		{Content: "second", UserID: john.ID},
+		{Content: "third", UserID: john.ID},
+	}
+	for _, tweet := range tweets {
+		ts.Require().NoError(t.Create(ctx, tweet))
+	}
+	// bookmarked newest first, so bookmark order is the reverse of tweet order
+	for i := len(tweets) - 1; i >= 0; i-- {
+		ts.Require().NoError(b.Create(ctx, userID, tweets[i].ID.String()))
+	}
+	ts.Require().ErrorIs(b.Create(ctx, userID, tweets[0].ID.String()), repository.ErrAlreadyExists)
+
+	contents := func(bookmarks []entities.Bookmark) []string {
+		found := make([]string, 0, len(bookmarks))
+		for _, bookmark := range bookmarks {
+			found = append(found, bookmark.Tweet.Content)
+		}
+		return found
+	}
+	page, err := b.FindByUser(ctx, userID, "", 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Equal([]string{"first", "second"}, contents(page))
+	page, err = b.FindByUser(ctx, userID, page[1].ID.String(), 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Equal([]string{"third"}, contents(page))
+
+	// Deleted tweets drop out of the list
+	ts.Require().NoError(t.Delete(ctx, tweets[0].ID.String()))
+	ts.Require().NoError(b.Delete(ctx, userID, tweets[1].ID.String()))
+	ts.Require().ErrorIs(b.Delete(ctx, userID, tweets[1].ID.String()), repository.ErrNotFound)
+	page, err = b.FindByUser(ctx, userID, "", 10, nil)
+	ts.Require().NoError(err)
+	ts.Require().Equal([]string{"third"}, contents(page))
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var BlockErrors = &blockErrors{
+	ErrUniqueBlocksPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "blocks",
+		columns: []string{"blocker_id", "blocked_id"},
+		s:       "blocks_pkey",
+	},
+}
+
+type blockErrors struct {
+	ErrUniqueBlocksPkey *UniqueConstraintError
+}
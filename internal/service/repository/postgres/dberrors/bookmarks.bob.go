@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var BookmarkErrors = &bookmarkErrors{
+	ErrUniqueBookmarksPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "bookmarks",
+		columns: []string{"id"},
+		s:       "bookmarks_pkey",
+	},
+
+	ErrUniqueBookmarksUserIdTweetIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "bookmarks",
+		columns: []string{"user_id", "tweet_id"},
+		s:       "bookmarks_user_id_tweet_id_key",
+	},
+}
+
+type bookmarkErrors struct {
+	ErrUniqueBookmarksPkey *UniqueConstraintError
+
+	ErrUniqueBookmarksUserIdTweetIdKey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ConversationMemberErrors = &conversationMemberErrors{
+	ErrUniqueConversationMembersPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "conversation_members",
+		columns: []string{"conversation_id", "user_id"},
+		s:       "conversation_members_pkey",
+	},
+}
+
+type conversationMemberErrors struct {
+	ErrUniqueConversationMembersPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var ConversationErrors = &conversationErrors{
+	ErrUniqueConversationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "conversations",
+		columns: []string{"id"},
+		s:       "conversations_pkey",
+	},
+}
+
+type conversationErrors struct {
+	ErrUniqueConversationsPkey *UniqueConstraintError
+}
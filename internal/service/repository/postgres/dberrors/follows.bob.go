@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var FollowErrors = &followErrors{
+	ErrUniqueFollowsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "follows",
+		columns: []string{"follower_id", "followee_id"},
+		s:       "follows_pkey",
+	},
+}
+
+type followErrors struct {
+	ErrUniqueFollowsPkey *UniqueConstraintError
+}
@@ -0,0 +1,26 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var HashtagErrors = &hashtagErrors{
+	ErrUniqueHashtagsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "hashtags",
+		columns: []string{"id"},
+		s:       "hashtags_pkey",
+	},
+
+	ErrUniqueHashtagsTagKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "hashtags",
+		columns: []string{"tag"},
+		s:       "hashtags_tag_key",
+	},
+}
+
+type hashtagErrors struct {
+	ErrUniqueHashtagsPkey *UniqueConstraintError
+
+	ErrUniqueHashtagsTagKey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var LikeErrors = &likeErrors{
+	ErrUniqueLikesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "likes",
+		columns: []string{"user_id", "tweet_id"},
+		s:       "likes_pkey",
+	},
+}
+
+type likeErrors struct {
+	ErrUniqueLikesPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var MentionErrors = &mentionErrors{
+	ErrUniqueMentionsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "mentions",
+		columns: []string{"tweet_id", "start_offset"},
+		s:       "mentions_pkey",
+	},
+}
+
+type mentionErrors struct {
+	ErrUniqueMentionsPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var MessageErrors = &messageErrors{
+	ErrUniqueMessagesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "messages",
+		columns: []string{"id"},
+		s:       "messages_pkey",
+	},
+}
+
+type messageErrors struct {
+	ErrUniqueMessagesPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var MuteErrors = &muteErrors{
+	ErrUniqueMutesPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "mutes",
+		columns: []string{"muter_id", "muted_id"},
+		s:       "mutes_pkey",
+	},
+}
+
+type muteErrors struct {
+	ErrUniqueMutesPkey *UniqueConstraintError
+}
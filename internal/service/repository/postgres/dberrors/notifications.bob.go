@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var NotificationErrors = &notificationErrors{
+	ErrUniqueNotificationsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "notifications",
+		columns: []string{"id"},
+		s:       "notifications_pkey",
+	},
+}
+
+type notificationErrors struct {
+	ErrUniqueNotificationsPkey *UniqueConstraintError
+}
@@ -0,0 +1,17 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dberrors
+
+var TweetHashtagErrors = &tweetHashtagErrors{
+	ErrUniqueTweetHashtagsPkey: &UniqueConstraintError{
+		schema:  "",
+		table:   "tweet_hashtags",
+		columns: []string{"tweet_id", "hashtag_id"},
+		s:       "tweet_hashtags_pkey",
+	},
+}
+
+type tweetHashtagErrors struct {
+	ErrUniqueTweetHashtagsPkey *UniqueConstraintError
+}
@@ -0,0 +1,142 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Blocks = Table[
+	blockColumns,
+	blockIndexes,
+	blockForeignKeys,
+	blockUniques,
+	blockChecks,
+]{
+	Schema: "",
+	Name:   "blocks",
+	Columns: blockColumns{
+		BlockerID: column{
+			Name:      "blocker_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		BlockedID: column{
+			Name:      "blocked_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: blockIndexes{
+		BlocksPkey: index{
+			Type: "btree",
+			Name: "blocks_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "blocker_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "blocked_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "blocks_pkey",
+		Columns: []string{"blocker_id", "blocked_id"},
+		Comment: "",
+	},
+	ForeignKeys: blockForeignKeys{
+		BlocksBlocksBlockedIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "blocks.blocks_blocked_id_fkey",
+				Columns: []string{"blocked_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		BlocksBlocksBlockerIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "blocks.blocks_blocker_id_fkey",
+				Columns: []string{"blocker_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type blockColumns struct {
+	BlockerID column
+	BlockedID column
+	CreatedAt column
+}
+
+func (c blockColumns) AsSlice() []column {
+	return []column{
+		c.BlockerID, c.BlockedID, c.CreatedAt,
+	}
+}
+
+type blockIndexes struct {
+	BlocksPkey index
+}
+
+func (i blockIndexes) AsSlice() []index {
+	return []index{
+		i.BlocksPkey,
+	}
+}
+
+type blockForeignKeys struct {
+	BlocksBlocksBlockedIDFkey foreignKey
+	BlocksBlocksBlockerIDFkey foreignKey
+}
+
+func (f blockForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.BlocksBlocksBlockedIDFkey, f.BlocksBlocksBlockerIDFkey,
+	}
+}
+
+type blockUniques struct{}
+
+func (u blockUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type blockChecks struct{}
+
+func (c blockChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,181 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Bookmarks = Table[
+	bookmarkColumns,
+	bookmarkIndexes,
+	bookmarkForeignKeys,
+	bookmarkUniques,
+	bookmarkChecks,
+]{
+	Schema: "",
+	Name:   "bookmarks",
+	Columns: bookmarkColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TweetID: column{
+			Name:      "tweet_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: bookmarkIndexes{
+		BookmarksPkey: index{
+			Type: "btree",
+			Name: "bookmarks_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		BookmarksUserIDTweetIDKey: index{
+			Type: "btree",
+			Name: "bookmarks_user_id_tweet_id_key",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "tweet_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "bookmarks_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: bookmarkForeignKeys{
+		BookmarksBookmarksTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "bookmarks.bookmarks_tweet_id_fkey",
+				Columns: []string{"tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+		BookmarksBookmarksUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "bookmarks.bookmarks_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: bookmarkUniques{
+		BookmarksUserIDTweetIDKey: constraint{
+			Name:    "bookmarks_user_id_tweet_id_key",
+			Columns: []string{"user_id", "tweet_id"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type bookmarkColumns struct {
+	ID        column
+	UserID    column
+	TweetID   column
+	CreatedAt column
+}
+
+func (c bookmarkColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.TweetID, c.CreatedAt,
+	}
+}
+
+type bookmarkIndexes struct {
+	BookmarksPkey             index
+	BookmarksUserIDTweetIDKey index
+}
+
+func (i bookmarkIndexes) AsSlice() []index {
+	return []index{
+		i.BookmarksPkey, i.BookmarksUserIDTweetIDKey,
+	}
+}
+
+type bookmarkForeignKeys struct {
+	BookmarksBookmarksTweetIDFkey foreignKey
+	BookmarksBookmarksUserIDFkey  foreignKey
+}
+
+func (f bookmarkForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.BookmarksBookmarksTweetIDFkey, f.BookmarksBookmarksUserIDFkey,
+	}
+}
+
+type bookmarkUniques struct {
+	BookmarksUserIDTweetIDKey constraint
+}
+
+func (u bookmarkUniques) AsSlice() []constraint {
+	return []constraint{
+		u.BookmarksUserIDTweetIDKey,
+	}
+}
+
+type bookmarkChecks struct{}
+
+func (c bookmarkChecks) AsSlice() []check {
+	return []check{}
+}
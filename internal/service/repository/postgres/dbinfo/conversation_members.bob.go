@@ -0,0 +1,162 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var ConversationMembers = Table[
+	conversationMemberColumns,
+	conversationMemberIndexes,
+	conversationMemberForeignKeys,
+	conversationMemberUniques,
+	conversationMemberChecks,
+]{
+	Schema: "",
+	Name:   "conversation_members",
+	Columns: conversationMemberColumns{
+		ConversationID: column{
+			Name:      "conversation_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastReadMessageID: column{
+			Name:      "last_read_message_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: conversationMemberIndexes{
+		ConversationMembersPkey: index{
+			Type: "btree",
+			Name: "conversation_members_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "conversation_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "conversation_members_pkey",
+		Columns: []string{"conversation_id", "user_id"},
+		Comment: "",
+	},
+	ForeignKeys: conversationMemberForeignKeys{
+		ConversationMembersConversationMembersConversationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "conversation_members.conversation_members_conversation_id_fkey",
+				Columns: []string{"conversation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "conversations",
+			ForeignColumns: []string{"id"},
+		},
+		ConversationMembersConversationMembersLastReadMessageIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "conversation_members.conversation_members_last_read_message_id_fkey",
+				Columns: []string{"last_read_message_id"},
+				Comment: "",
+			},
+			ForeignTable:   "messages",
+			ForeignColumns: []string{"id"},
+		},
+		ConversationMembersConversationMembersUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "conversation_members.conversation_members_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type conversationMemberColumns struct {
+	ConversationID    column
+	UserID            column
+	LastReadMessageID column
+	CreatedAt         column
+}
+
+func (c conversationMemberColumns) AsSlice() []column {
+	return []column{
+		c.ConversationID, c.UserID, c.LastReadMessageID, c.CreatedAt,
+	}
+}
+
+type conversationMemberIndexes struct {
+	ConversationMembersPkey index
+}
+
+func (i conversationMemberIndexes) AsSlice() []index {
+	return []index{
+		i.ConversationMembersPkey,
+	}
+}
+
+type conversationMemberForeignKeys struct {
+	ConversationMembersConversationMembersConversationIDFkey    foreignKey
+	ConversationMembersConversationMembersLastReadMessageIDFkey foreignKey
+	ConversationMembersConversationMembersUserIDFkey            foreignKey
+}
+
+func (f conversationMemberForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.ConversationMembersConversationMembersConversationIDFkey, f.ConversationMembersConversationMembersLastReadMessageIDFkey, f.ConversationMembersConversationMembersUserIDFkey,
+	}
+}
+
+type conversationMemberUniques struct{}
+
+func (u conversationMemberUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type conversationMemberChecks struct{}
+
+func (c conversationMemberChecks) AsSlice() []check {
+	return []check{}
+}
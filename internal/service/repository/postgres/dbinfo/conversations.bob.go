@@ -0,0 +1,127 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Conversations = Table[
+	conversationColumns,
+	conversationIndexes,
+	conversationForeignKeys,
+	conversationUniques,
+	conversationChecks,
+]{
+	Schema: "",
+	Name:   "conversations",
+	Columns: conversationColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LastMessageID: column{
+			Name:      "last_message_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: conversationIndexes{
+		ConversationsPkey: index{
+			Type: "btree",
+			Name: "conversations_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "conversations_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: conversationForeignKeys{
+		ConversationsConversationsLastMessageIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "conversations.conversations_last_message_id_fkey",
+				Columns: []string{"last_message_id"},
+				Comment: "",
+			},
+			ForeignTable:   "messages",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type conversationColumns struct {
+	ID            column
+	CreatedAt     column
+	LastMessageID column
+}
+
+func (c conversationColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.CreatedAt, c.LastMessageID,
+	}
+}
+
+type conversationIndexes struct {
+	ConversationsPkey index
+}
+
+func (i conversationIndexes) AsSlice() []index {
+	return []index{
+		i.ConversationsPkey,
+	}
+}
+
+type conversationForeignKeys struct {
+	ConversationsConversationsLastMessageIDFkey foreignKey
+}
+
+func (f conversationForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.ConversationsConversationsLastMessageIDFkey,
+	}
+}
+
+type conversationUniques struct{}
+
+func (u conversationUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type conversationChecks struct{}
+
+func (c conversationChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,142 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Follows = Table[
+	followColumns,
+	followIndexes,
+	followForeignKeys,
+	followUniques,
+	followChecks,
+]{
+	Schema: "",
+	Name:   "follows",
+	Columns: followColumns{
+		FollowerID: column{
+			Name:      "follower_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		FolloweeID: column{
+			Name:      "followee_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: followIndexes{
+		FollowsPkey: index{
+			Type: "btree",
+			Name: "follows_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "follower_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "followee_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "follows_pkey",
+		Columns: []string{"follower_id", "followee_id"},
+		Comment: "",
+	},
+	ForeignKeys: followForeignKeys{
+		FollowsFollowsFolloweeIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "follows.follows_followee_id_fkey",
+				Columns: []string{"followee_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		FollowsFollowsFollowerIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "follows.follows_follower_id_fkey",
+				Columns: []string{"follower_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type followColumns struct {
+	FollowerID column
+	FolloweeID column
+	CreatedAt  column
+}
+
+func (c followColumns) AsSlice() []column {
+	return []column{
+		c.FollowerID, c.FolloweeID, c.CreatedAt,
+	}
+}
+
+type followIndexes struct {
+	FollowsPkey index
+}
+
+func (i followIndexes) AsSlice() []index {
+	return []index{
+		i.FollowsPkey,
+	}
+}
+
+type followForeignKeys struct {
+	FollowsFollowsFolloweeIDFkey foreignKey
+	FollowsFollowsFollowerIDFkey foreignKey
+}
+
+func (f followForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.FollowsFollowsFolloweeIDFkey, f.FollowsFollowsFollowerIDFkey,
+	}
+}
+
+type followUniques struct{}
+
+func (u followUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type followChecks struct{}
+
+func (c followChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,142 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Hashtags = Table[
+	hashtagColumns,
+	hashtagIndexes,
+	hashtagForeignKeys,
+	hashtagUniques,
+	hashtagChecks,
+]{
+	Schema: "",
+	Name:   "hashtags",
+	Columns: hashtagColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Tag: column{
+			Name:      "tag",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: hashtagIndexes{
+		HashtagsPkey: index{
+			Type: "btree",
+			Name: "hashtags_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		HashtagsTagKey: index{
+			Type: "btree",
+			Name: "hashtags_tag_key",
+			Columns: []indexColumn{
+				{
+					Name:         "tag",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "hashtags_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+
+	Uniques: hashtagUniques{
+		HashtagsTagKey: constraint{
+			Name:    "hashtags_tag_key",
+			Columns: []string{"tag"},
+			Comment: "",
+		},
+	},
+
+	Comment: "",
+}
+
+type hashtagColumns struct {
+	ID        column
+	Tag       column
+	CreatedAt column
+}
+
+func (c hashtagColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.Tag, c.CreatedAt,
+	}
+}
+
+type hashtagIndexes struct {
+	HashtagsPkey   index
+	HashtagsTagKey index
+}
+
+func (i hashtagIndexes) AsSlice() []index {
+	return []index{
+		i.HashtagsPkey, i.HashtagsTagKey,
+	}
+}
+
+type hashtagForeignKeys struct{}
+
+func (f hashtagForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{}
+}
+
+type hashtagUniques struct {
+	HashtagsTagKey constraint
+}
+
+func (u hashtagUniques) AsSlice() []constraint {
+	return []constraint{
+		u.HashtagsTagKey,
+	}
+}
+
+type hashtagChecks struct{}
+
+func (c hashtagChecks) AsSlice() []check {
+	return []check{}
+}
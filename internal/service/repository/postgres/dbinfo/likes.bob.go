@@ -0,0 +1,142 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Likes = Table[
+	likeColumns,
+	likeIndexes,
+	likeForeignKeys,
+	likeUniques,
+	likeChecks,
+]{
+	Schema: "",
+	Name:   "likes",
+	Columns: likeColumns{
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TweetID: column{
+			Name:      "tweet_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: likeIndexes{
+		LikesPkey: index{
+			Type: "btree",
+			Name: "likes_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "user_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "tweet_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "likes_pkey",
+		Columns: []string{"user_id", "tweet_id"},
+		Comment: "",
+	},
+	ForeignKeys: likeForeignKeys{
+		LikesLikesTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "likes.likes_tweet_id_fkey",
+				Columns: []string{"tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+		LikesLikesUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "likes.likes_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type likeColumns struct {
+	UserID    column
+	TweetID   column
+	CreatedAt column
+}
+
+func (c likeColumns) AsSlice() []column {
+	return []column{
+		c.UserID, c.TweetID, c.CreatedAt,
+	}
+}
+
+type likeIndexes struct {
+	LikesPkey index
+}
+
+func (i likeIndexes) AsSlice() []index {
+	return []index{
+		i.LikesPkey,
+	}
+}
+
+type likeForeignKeys struct {
+	LikesLikesTweetIDFkey foreignKey
+	LikesLikesUserIDFkey  foreignKey
+}
+
+func (f likeForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.LikesLikesTweetIDFkey, f.LikesLikesUserIDFkey,
+	}
+}
+
+type likeUniques struct{}
+
+func (u likeUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type likeChecks struct{}
+
+func (c likeChecks) AsSlice() []check {
+	return []check{}
+}
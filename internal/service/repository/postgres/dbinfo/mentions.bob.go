@@ -0,0 +1,152 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Mentions = Table[
+	mentionColumns,
+	mentionIndexes,
+	mentionForeignKeys,
+	mentionUniques,
+	mentionChecks,
+]{
+	Schema: "",
+	Name:   "mentions",
+	Columns: mentionColumns{
+		TweetID: column{
+			Name:      "tweet_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		StartOffset: column{
+			Name:      "start_offset",
+			DBType:    "integer",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		EndOffset: column{
+			Name:      "end_offset",
+			DBType:    "integer",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: mentionIndexes{
+		MentionsPkey: index{
+			Type: "btree",
+			Name: "mentions_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "tweet_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "start_offset",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "mentions_pkey",
+		Columns: []string{"tweet_id", "start_offset"},
+		Comment: "",
+	},
+	ForeignKeys: mentionForeignKeys{
+		MentionsMentionsTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "mentions.mentions_tweet_id_fkey",
+				Columns: []string{"tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+		MentionsMentionsUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "mentions.mentions_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type mentionColumns struct {
+	TweetID     column
+	UserID      column
+	StartOffset column
+	EndOffset   column
+}
+
+func (c mentionColumns) AsSlice() []column {
+	return []column{
+		c.TweetID, c.UserID, c.StartOffset, c.EndOffset,
+	}
+}
+
+type mentionIndexes struct {
+	MentionsPkey index
+}
+
+func (i mentionIndexes) AsSlice() []index {
+	return []index{
+		i.MentionsPkey,
+	}
+}
+
+type mentionForeignKeys struct {
+	MentionsMentionsTweetIDFkey foreignKey
+	MentionsMentionsUserIDFkey  foreignKey
+}
+
+func (f mentionForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.MentionsMentionsTweetIDFkey, f.MentionsMentionsUserIDFkey,
+	}
+}
+
+type mentionUniques struct{}
+
+func (u mentionUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type mentionChecks struct{}
+
+func (c mentionChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,157 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Messages = Table[
+	messageColumns,
+	messageIndexes,
+	messageForeignKeys,
+	messageUniques,
+	messageChecks,
+]{
+	Schema: "",
+	Name:   "messages",
+	Columns: messageColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ConversationID: column{
+			Name:      "conversation_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SenderID: column{
+			Name:      "sender_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Content: column{
+			Name:      "content",
+			DBType:    "text",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: messageIndexes{
+		MessagesPkey: index{
+			Type: "btree",
+			Name: "messages_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "messages_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: messageForeignKeys{
+		MessagesMessagesConversationIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "messages.messages_conversation_id_fkey",
+				Columns: []string{"conversation_id"},
+				Comment: "",
+			},
+			ForeignTable:   "conversations",
+			ForeignColumns: []string{"id"},
+		},
+		MessagesMessagesSenderIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "messages.messages_sender_id_fkey",
+				Columns: []string{"sender_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type messageColumns struct {
+	ID             column
+	ConversationID column
+	SenderID       column
+	Content        column
+	CreatedAt      column
+}
+
+func (c messageColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.ConversationID, c.SenderID, c.Content, c.CreatedAt,
+	}
+}
+
+type messageIndexes struct {
+	MessagesPkey index
+}
+
+func (i messageIndexes) AsSlice() []index {
+	return []index{
+		i.MessagesPkey,
+	}
+}
+
+type messageForeignKeys struct {
+	MessagesMessagesConversationIDFkey foreignKey
+	MessagesMessagesSenderIDFkey       foreignKey
+}
+
+func (f messageForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.MessagesMessagesConversationIDFkey, f.MessagesMessagesSenderIDFkey,
+	}
+}
+
+type messageUniques struct{}
+
+func (u messageUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type messageChecks struct{}
+
+func (c messageChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,142 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Mutes = Table[
+	muteColumns,
+	muteIndexes,
+	muteForeignKeys,
+	muteUniques,
+	muteChecks,
+]{
+	Schema: "",
+	Name:   "mutes",
+	Columns: muteColumns{
+		MuterID: column{
+			Name:      "muter_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MutedID: column{
+			Name:      "muted_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: muteIndexes{
+		MutesPkey: index{
+			Type: "btree",
+			Name: "mutes_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "muter_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "muted_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "mutes_pkey",
+		Columns: []string{"muter_id", "muted_id"},
+		Comment: "",
+	},
+	ForeignKeys: muteForeignKeys{
+		MutesMutesMutedIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "mutes.mutes_muted_id_fkey",
+				Columns: []string{"muted_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		MutesMutesMuterIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "mutes.mutes_muter_id_fkey",
+				Columns: []string{"muter_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type muteColumns struct {
+	MuterID   column
+	MutedID   column
+	CreatedAt column
+}
+
+func (c muteColumns) AsSlice() []column {
+	return []column{
+		c.MuterID, c.MutedID, c.CreatedAt,
+	}
+}
+
+type muteIndexes struct {
+	MutesPkey index
+}
+
+func (i muteIndexes) AsSlice() []index {
+	return []index{
+		i.MutesPkey,
+	}
+}
+
+type muteForeignKeys struct {
+	MutesMutesMutedIDFkey foreignKey
+	MutesMutesMuterIDFkey foreignKey
+}
+
+func (f muteForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.MutesMutesMutedIDFkey, f.MutesMutesMuterIDFkey,
+	}
+}
+
+type muteUniques struct{}
+
+func (u muteUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type muteChecks struct{}
+
+func (c muteChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,197 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var Notifications = Table[
+	notificationColumns,
+	notificationIndexes,
+	notificationForeignKeys,
+	notificationUniques,
+	notificationChecks,
+]{
+	Schema: "",
+	Name:   "notifications",
+	Columns: notificationColumns{
+		ID: column{
+			Name:      "id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		UserID: column{
+			Name:      "user_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ActorID: column{
+			Name:      "actor_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Type: column{
+			Name:      "type",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		TweetID: column{
+			Name:      "tweet_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		GroupKey: column{
+			Name:      "group_key",
+			DBType:    "text",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		Read: column{
+			Name:      "read",
+			DBType:    "boolean",
+			Default:   "false",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		CreatedAt: column{
+			Name:      "created_at",
+			DBType:    "timestamp with time zone",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: notificationIndexes{
+		NotificationsPkey: index{
+			Type: "btree",
+			Name: "notifications_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "notifications_pkey",
+		Columns: []string{"id"},
+		Comment: "",
+	},
+	ForeignKeys: notificationForeignKeys{
+		NotificationsNotificationsActorIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "notifications.notifications_actor_id_fkey",
+				Columns: []string{"actor_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+		NotificationsNotificationsTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "notifications.notifications_tweet_id_fkey",
+				Columns: []string{"tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+		NotificationsNotificationsUserIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "notifications.notifications_user_id_fkey",
+				Columns: []string{"user_id"},
+				Comment: "",
+			},
+			ForeignTable:   "users",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type notificationColumns struct {
+	ID        column
+	UserID    column
+	ActorID   column
+	Type      column
+	TweetID   column
+	GroupKey  column
+	Read      column
+	CreatedAt column
+}
+
+func (c notificationColumns) AsSlice() []column {
+	return []column{
+		c.ID, c.UserID, c.ActorID, c.Type, c.TweetID, c.GroupKey, c.Read, c.CreatedAt,
+	}
+}
+
+type notificationIndexes struct {
+	NotificationsPkey index
+}
+
+func (i notificationIndexes) AsSlice() []index {
+	return []index{
+		i.NotificationsPkey,
+	}
+}
+
+type notificationForeignKeys struct {
+	NotificationsNotificationsActorIDFkey foreignKey
+	NotificationsNotificationsTweetIDFkey foreignKey
+	NotificationsNotificationsUserIDFkey  foreignKey
+}
+
+func (f notificationForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.NotificationsNotificationsActorIDFkey, f.NotificationsNotificationsTweetIDFkey, f.NotificationsNotificationsUserIDFkey,
+	}
+}
+
+type notificationUniques struct{}
+
+func (u notificationUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type notificationChecks struct{}
+
+func (c notificationChecks) AsSlice() []check {
+	return []check{}
+}
@@ -0,0 +1,132 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbinfo
+
+import "github.com/aarondl/opt/null"
+
+var TweetHashtags = Table[
+	tweetHashtagColumns,
+	tweetHashtagIndexes,
+	tweetHashtagForeignKeys,
+	tweetHashtagUniques,
+	tweetHashtagChecks,
+]{
+	Schema: "",
+	Name:   "tweet_hashtags",
+	Columns: tweetHashtagColumns{
+		TweetID: column{
+			Name:      "tweet_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		HashtagID: column{
+			Name:      "hashtag_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+	},
+	Indexes: tweetHashtagIndexes{
+		TweetHashtagsPkey: index{
+			Type: "btree",
+			Name: "tweet_hashtags_pkey",
+			Columns: []indexColumn{
+				{
+					Name:         "tweet_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "hashtag_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+	},
+	PrimaryKey: &constraint{
+		Name:    "tweet_hashtags_pkey",
+		Columns: []string{"tweet_id", "hashtag_id"},
+		Comment: "",
+	},
+	ForeignKeys: tweetHashtagForeignKeys{
+		TweetHashtagsTweetHashtagsHashtagIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "tweet_hashtags.tweet_hashtags_hashtag_id_fkey",
+				Columns: []string{"hashtag_id"},
+				Comment: "",
+			},
+			ForeignTable:   "hashtags",
+			ForeignColumns: []string{"id"},
+		},
+		TweetHashtagsTweetHashtagsTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "tweet_hashtags.tweet_hashtags_tweet_id_fkey",
+				Columns: []string{"tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+	},
+
+	Comment: "",
+}
+
+type tweetHashtagColumns struct {
+	TweetID   column
+	HashtagID column
+}
+
+func (c tweetHashtagColumns) AsSlice() []column {
+	return []column{
+		c.TweetID, c.HashtagID,
+	}
+}
+
+type tweetHashtagIndexes struct {
+	TweetHashtagsPkey index
+}
+
+func (i tweetHashtagIndexes) AsSlice() []index {
+	return []index{
+		i.TweetHashtagsPkey,
+	}
+}
+
+type tweetHashtagForeignKeys struct {
+	TweetHashtagsTweetHashtagsHashtagIDFkey foreignKey
+	TweetHashtagsTweetHashtagsTweetIDFkey   foreignKey
+}
+
+func (f tweetHashtagForeignKeys) AsSlice() []foreignKey {
+	return []foreignKey{
+		f.TweetHashtagsTweetHashtagsHashtagIDFkey, f.TweetHashtagsTweetHashtagsTweetIDFkey,
+	}
+}
+
+type tweetHashtagUniques struct{}
+
+func (u tweetHashtagUniques) AsSlice() []constraint {
+	return []constraint{}
+}
+
+type tweetHashtagChecks struct{}
+
+func (c tweetHashtagChecks) AsSlice() []check {
+	return []check{}
+}
@@ -69,6 +69,51 @@ var Tweets = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		Kind: column{
+			Name:      "kind",
+			DBType:    "character varying",
+			Default:   "'tweet'::character varying",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ReferencedTweetID: column{
+			Name:      "referenced_tweet_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		InReplyToTweetID: column{
+			Name:      "in_reply_to_tweet_id",
+			DBType:    "character varying",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		ConversationID: column{
+			Name:      "conversation_id",
+			DBType:    "character varying",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		SearchVector: column{
+			Name:      "search_vector",
+			DBType:    "tsvector",
+			Default:   "to_tsvector('simple'::regconfig, content)",
+			Comment:   "",
+			Nullable:  true,
+			Generated: true,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: tweetIndexes{
 		TweetsPkey: index{
@@ -104,23 +149,46 @@ var Tweets = Table[
 			ForeignTable:   "users",
 			ForeignColumns: []string{"id"},
 		},
+		TweetsTweetsReferencedTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "tweets.tweets_referenced_tweet_id_fkey",
+				Columns: []string{"referenced_tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
+		TweetsTweetsInReplyToTweetIDFkey: foreignKey{
+			constraint: constraint{
+				Name:    "tweets.tweets_in_reply_to_tweet_id_fkey",
+				Columns: []string{"in_reply_to_tweet_id"},
+				Comment: "",
+			},
+			ForeignTable:   "tweets",
+			ForeignColumns: []string{"id"},
+		},
 	},
 
 	Comment: "",
 }
 
 type tweetColumns struct {
-	ID        column
-	UserID    column
-	Content   column
-	DeletedAt column
-	CreatedAt column
-	UpdatedAt column
+	ID                column
+	UserID            column
+	Content           column
+	DeletedAt         column
+	CreatedAt         column
+	UpdatedAt         column
+	Kind              column
+	ReferencedTweetID column
+	InReplyToTweetID  column
+	ConversationID    column
+	SearchVector      column
 }
 
 func (c tweetColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.UserID, c.Content, c.DeletedAt, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.UserID, c.Content, c.DeletedAt, c.CreatedAt, c.UpdatedAt, c.Kind, c.ReferencedTweetID, c.InReplyToTweetID, c.ConversationID, c.SearchVector,
 	}
 }
 
@@ -135,12 +203,14 @@ func (i tweetIndexes) AsSlice() []index {
 }
 
 type tweetForeignKeys struct {
-	TweetsTweetsUserIDFkey foreignKey
+	TweetsTweetsUserIDFkey            foreignKey
+	TweetsTweetsReferencedTweetIDFkey foreignKey
+	TweetsTweetsInReplyToTweetIDFkey  foreignKey
 }
 
 func (f tweetForeignKeys) AsSlice() []foreignKey {
 	return []foreignKey{
-		f.TweetsTweetsUserIDFkey,
+		f.TweetsTweetsUserIDFkey, f.TweetsTweetsReferencedTweetIDFkey, f.TweetsTweetsInReplyToTweetIDFkey,
 	}
 }
 
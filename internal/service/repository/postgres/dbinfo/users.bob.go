@@ -78,6 +78,15 @@ var Users = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		PasswordHash: column{
+			Name:      "password_hash",
+			DBType:    "text",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: userIndexes{
 		UsersPkey: index{
@@ -155,18 +164,19 @@ var Users = Table[
 }
 
 type userColumns struct {
-	ID        column
-	Username  column
-	Email     column
-	Name      column
-	DeletedAt column
-	CreatedAt column
-	UpdatedAt column
+	ID           column
+	Username     column
+	Email        column
+	Name         column
+	DeletedAt    column
+	CreatedAt    column
+	UpdatedAt    column
+	PasswordHash column
 }
 
 func (c userColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Username, c.Email, c.Name, c.DeletedAt, c.CreatedAt, c.UpdatedAt,
+		c.ID, c.Username, c.Email, c.Name, c.DeletedAt, c.CreatedAt, c.UpdatedAt, c.PasswordHash,
 	}
 }
 
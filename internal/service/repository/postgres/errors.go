@@ -0,0 +1,18 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
+)
+
+// isUniqueViolation reports whether err was caused by the given unique constraint.
+func isUniqueViolation(err error, constraint *dberrors.UniqueConstraintError) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return errors.Is(constraint, pqErr)
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// FollowStorage is a postgres implementation of the repository.FollowRepository interface.
+type FollowStorage struct {
+	dbConn bob.Executor
+}
+
+// NewFollowStorage returns a new FollowStorage.
+func NewFollowStorage(dbConn bob.Executor) *FollowStorage {
+	return &FollowStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create makes followerID follow followeeID.
+func (s *FollowStorage) Create(ctx context.Context, followerID, followeeID string) error {
+	setter := &models.FollowSetter{
+		FollowerID: omit.From(followerID),
+		FolloweeID: omit.From(followeeID),
+	}
+
+	_, err := models.Follows.Insert(setter).Exec(ctx, s.dbConn)
+	if err != nil {
+		if isUniqueViolation(err, dberrors.FollowErrors.ErrUniqueFollowsPkey) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to insert follow: %w", err)
+	}
+
+	return nil
+}
+
+// Delete makes followerID stop following followeeID.
+func (s *FollowStorage) Delete(ctx context.Context, followerID, followeeID string) error {
+	rows, err := models.Follows.Delete(
+		dm.Where(models.Follows.Columns.FollowerID.EQ(psql.Arg(followerID))),
+		dm.Where(models.Follows.Columns.FolloweeID.EQ(psql.Arg(followeeID))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete follow: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindFollowers returns the users following userID, most recent first.
+func (s *FollowStorage) FindFollowers(ctx context.Context, userID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.FollowerFollows,
+		sm.Where(models.Follows.Columns.FolloweeID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Follows.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find followers: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
+
+// FindFollowing returns the users followed by userID, most recent first.
+func (s *FollowStorage) FindFollowing(ctx context.Context, userID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.FolloweeFollows,
+		sm.Where(models.Follows.Columns.FollowerID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Follows.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find following: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
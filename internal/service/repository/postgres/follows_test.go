@@ -0,0 +1,87 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type FollowsTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestFollowsTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowsTestSuite))
+}
+
+func (ts *FollowsTestSuite) TestData() {
+	ctx := context.Background()
+	f := postgres.NewFollowStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	var johnID, janeID string
+	// Create users
+	{
+		err := u.Create(ctx, &entities.User{Username: "john", Email: "john@test.com"})
+		ts.Require().NoError(err)
+		err = u.Create(ctx, &entities.User{Username: "jane", Email: "jane@test.com"})
+		ts.Require().NoError(err)
+		john, err := u.FindByUsername(ctx, "john")
+		ts.Require().NoError(err)
+		jane, err := u.FindByUsername(ctx, "jane")
+		ts.Require().NoError(err)
+		johnID, janeID = john.ID.String(), jane.ID.String()
+	}
+	// No followers yet
+	{
+		followers, err := f.FindFollowers(ctx, janeID)
+		ts.Require().NoError(err)
+		ts.Require().Len(followers, 0)
+	}
+	// John follows Jane
+	{
+		err := f.Create(ctx, johnID, janeID)
+		ts.Require().NoError(err)
+	}
+	// Following twice is rejected
+	{
+		err := f.Create(ctx, johnID, janeID)
+		ts.Require().ErrorIs(err, repository.ErrAlreadyExists)
+	}
+	// Jane's followers
+	{
+		followers, err := f.FindFollowers(ctx, janeID)
+		ts.Require().NoError(err)
+		ts.Require().Len(followers, 1)
+		ts.Require().Equal("john", followers[0].Username)
+	}
+	// John's following
+	{
+		following, err := f.FindFollowing(ctx, johnID)
+		ts.Require().NoError(err)
+		ts.Require().Len(following, 1)
+		ts.Require().Equal("jane", following[0].Username)
+	}
+	// John unfollows Jane
+	{
+		err := f.Delete(ctx, johnID, janeID)
+		ts.Require().NoError(err)
+		err = f.Delete(ctx, johnID, janeID)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+	}
+	// Self follows are rejected by the database
+	{
+		err := f.Create(ctx, johnID, johnID)
+		ts.Require().Error(err)
+	}
+}
@@ -4,23 +4,14 @@
 package postgres_test
 
 import (
-	"context"
-	"io"
-	"log/slog"
 	"testing"
 
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	testcontainers "github.com/testcontainers/testcontainers-go/modules/postgres"
-
-	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
-	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/test"
 )
 
 type PostgresTestSuite struct {
-	suite.Suite
-	container *testcontainers.PostgresContainer
+	storageSuite
 }
 
 // In order for 'go test' to run this suite, we need to create
@@ -29,30 +20,14 @@ func TestPostgresTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresTestSuite))
 }
 
-func (ts *PostgresTestSuite) SetupTest() {
-	var err error
-	ctx := context.Background()
-	ts.container, err = test.SetupDB(ctx)
-	require.NoError(ts.T(), err)
-}
-
-func (ts *PostgresTestSuite) TearDownTest() {
-	ctx := context.Background()
-	err := test.TeardownDB(ctx, ts.container)
-	require.NoError(ts.T(), err)
-}
-
 // This tests only that the connection to the DB is working and also the migrations.
 func (ts *PostgresTestSuite) TestPostgres() {
-	ctx := context.Background()
-	s, err := postgres.NewStorage(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)))
-	require.NoError(ts.T(), err)
-	require.NotNil(ts.T(), s.DB())
-	err = s.DB().Ping()
+	require.NotNil(ts.T(), ts.s.DB())
+	err := ts.s.DB().Ping()
 	require.NoError(ts.T(), err)
 
 	// check the existing tables
-	rows, err := s.DB().Query("SELECT table_name FROM information_schema.tables WHERE table_schema = 'public'")
+	rows, err := ts.s.DB().Query("SELECT table_name FROM information_schema.tables WHERE table_schema = 'public'")
 	require.NoError(ts.T(), err)
 	require.NoError(ts.T(), rows.Err())
 	defer rows.Close()
@@ -66,5 +41,5 @@ func (ts *PostgresTestSuite) TestPostgres() {
 	require.Contains(ts.T(), tables, "schema_migrations")
 	require.Contains(ts.T(), tables, "users")
 	require.Contains(ts.T(), tables, "tweets")
-	s.Close()
+	require.Contains(ts.T(), tables, "follows")
 }
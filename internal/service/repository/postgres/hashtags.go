@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// HashtagStorage is a postgres implementation of the repository.HashtagRepository interface.
+type HashtagStorage struct {
+	dbConn bob.Executor
+}
+
+// NewHashtagStorage returns a new HashtagStorage.
+func NewHashtagStorage(dbConn bob.Executor) *HashtagStorage {
+	return &HashtagStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Attach links tweetID to each of tags, creating the missing hashtags. Tags
+// created concurrently by another tweet are picked up by the ON CONFLICT clause.
+func (s *HashtagStorage) Attach(ctx context.Context, tweetID string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	hashtagMods := make([]bob.Mod[*dialect.InsertQuery], 0, len(tags)+1)
+	for _, tag := range tags {
+		hashtagMods = append(hashtagMods, &models.HashtagSetter{Tag: omit.From(tag)})
+	}
+	hashtagMods = append(hashtagMods, im.OnConflict("tag").DoNothing())
+	if _, err := models.Hashtags.Insert(hashtagMods...).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert hashtags: %w", err)
+	}
+
+	hashtags, err := models.Hashtags.Query(
+		sm.Where(models.Hashtags.Columns.Tag.In(stringArgs(tags)...)),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to find hashtags: %w", err)
+	}
+
+	linkMods := make([]bob.Mod[*dialect.InsertQuery], 0, len(hashtags)+1)
+	for _, h := range hashtags {
+		linkMods = append(linkMods, &models.TweetHashtagSetter{
+			TweetID:   omit.From(tweetID),
+			HashtagID: omit.From(h.ID),
+		})
+	}
+	linkMods = append(linkMods, im.OnConflict().DoNothing())
+	if _, err = models.TweetHashtags.Insert(linkMods...).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert tweet hashtags: %w", err)
+	}
+
+	return nil
+}
+
+// FindTweets returns at most limit live tweets tagged with tag created before
+// beforeID, newest first, leaving out those written by excludedUserIDs.
+func (s *HashtagStorage) FindTweets(
+	ctx context.Context,
+	tag, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	mods := append(
+		pageMods(models.Tweets.Columns.ID, beforeID, limit),
+		sm.Where(models.Tweets.Columns.ID.OP("IN", taggedTweetIDs(tag))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	ormRows, err := models.Tweets.Query(append(mods, excludeAuthors(excludedUserIDs)...)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tweets by hashtag: %w", err)
+	}
+
+	return toTweets(ormRows), nil
+}
+
+// taggedTweetIDs returns the subquery selecting the IDs of the tweets tagged
+// with tag.
+func taggedTweetIDs(tag string) bob.Query {
+	return psql.Select(
+		sm.Columns(models.TweetHashtags.Columns.TweetID),
+		sm.From(models.TweetHashtags.Name()),
+		sm.InnerJoin(models.Hashtags.Name()).On(
+			models.Hashtags.Columns.ID.EQ(models.TweetHashtags.Columns.HashtagID),
+		),
+		sm.Where(models.Hashtags.Columns.Tag.EQ(psql.Arg(tag))),
+	)
+}
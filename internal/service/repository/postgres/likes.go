@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// LikeStorage is a postgres implementation of the repository.LikeRepository interface.
+type LikeStorage struct {
+	dbConn bob.Executor
+}
+
+// NewLikeStorage returns a new LikeStorage.
+func NewLikeStorage(dbConn bob.Executor) *LikeStorage {
+	return &LikeStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create records that userID likes tweetID. Concurrent likes of the same tweet
+// by the same user are serialized by the primary key.
+func (s *LikeStorage) Create(ctx context.Context, userID, tweetID string) error {
+	setter := &models.LikeSetter{
+		UserID:  omit.From(userID),
+		TweetID: omit.From(tweetID),
+	}
+
+	_, err := models.Likes.Insert(setter).Exec(ctx, s.dbConn)
+	if err != nil {
+		if isUniqueViolation(err, dberrors.LikeErrors.ErrUniqueLikesPkey) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to insert like: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the like of tweetID by userID.
+func (s *LikeStorage) Delete(ctx context.Context, userID, tweetID string) error {
+	rows, err := models.Likes.Delete(
+		dm.Where(models.Likes.Columns.UserID.EQ(psql.Arg(userID))),
+		dm.Where(models.Likes.Columns.TweetID.EQ(psql.Arg(tweetID))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete like: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindLikers returns the users who liked tweetID, most recent first.
+func (s *LikeStorage) FindLikers(ctx context.Context, tweetID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.Likes,
+		sm.Where(models.Likes.Columns.TweetID.EQ(psql.Arg(tweetID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Likes.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find likers: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
+
+// likeCount is a row of the per-tweet like count query.
+type likeCount struct {
+	TweetID string `db:"tweet_id"`
+	Count   int    `db:"count"`
+}
+
+// CountByTweet returns the like count of each of the given tweets. Counts are
+// aggregated on read rather than kept in a counter column, so concurrent likes
+// never lose updates.
+func (s *LikeStorage) CountByTweet(ctx context.Context, tweetIDs []string) (map[string]int, error) {
+	counts := make(map[string]int, len(tweetIDs))
+	if len(tweetIDs) == 0 {
+		return counts, nil
+	}
+
+	q := psql.Select(
+		sm.Columns(models.Likes.Columns.TweetID, psql.F("count", psql.Raw("*"))().As("count")),
+		sm.From(models.Likes.Name()),
+		models.SelectJoins.Likes.InnerJoin.User,
+		sm.Where(models.Likes.Columns.TweetID.In(stringArgs(tweetIDs)...)),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.GroupBy(models.Likes.Columns.TweetID),
+	)
+	rows, err := bob.All(ctx, s.dbConn, q, scan.StructMapper[likeCount]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[row.TweetID] = row.Count
+	}
+	return counts, nil
+}
+
+// FindLikedByUser returns which of the given tweets userID likes.
+func (s *LikeStorage) FindLikedByUser(ctx context.Context, userID string, tweetIDs []string) (map[string]bool, error) {
+	liked := make(map[string]bool)
+	if len(tweetIDs) == 0 {
+		return liked, nil
+	}
+
+	ormLikes, err := models.Likes.Query(
+		sm.Where(models.Likes.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Likes.Columns.TweetID.In(stringArgs(tweetIDs)...)),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find likes: %w", err)
+	}
+
+	for _, l := range ormLikes {
+		liked[l.TweetID] = true
+	}
+	return liked, nil
+}
+
+// stringArgs converts values to query arguments, e.g. for an IN list.
+func stringArgs(values []string) []bob.Expression {
+	args := make([]bob.Expression, 0, len(values))
+	for _, v := range values {
+		args = append(args, psql.Arg(v))
+	}
+	return args
+}
@@ -0,0 +1,119 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type LikesTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestLikesTestSuite(t *testing.T) {
+	suite.Run(t, new(LikesTestSuite))
+}
+
+func (ts *LikesTestSuite) TestData() {
+	ctx := context.Background()
+	l := postgres.NewLikeStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	tw := postgres.NewTweetStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	ts.Require().NoError(u.Create(ctx, jane))
+	tweet := &entities.Tweet{Content: "Hello", UserID: john.ID}
+	ts.Require().NoError(tw.Create(ctx, tweet))
+	johnID, janeID, tweetID := john.ID.String(), jane.ID.String(), tweet.ID.String()
+
+	// No likes yet
+	{
+		counts, err := l.CountByTweet(ctx, []string{tweetID})
+		ts.Require().NoError(err)
+		ts.Require().Empty(counts)
+	}
+	// John and Jane like the tweet
+	{
+		ts.Require().NoError(l.Create(ctx, johnID, tweetID))
+		ts.Require().NoError(l.Create(ctx, janeID, tweetID))
+	}
+	// Liking twice is rejected
+	{
+		err := l.Create(ctx, johnID, tweetID)
+		ts.Require().ErrorIs(err, repository.ErrAlreadyExists)
+	}
+	// Likers and counts
+	{
+		likers, err := l.FindLikers(ctx, tweetID)
+		ts.Require().NoError(err)
+		ts.Require().Len(likers, 2)
+		counts, err := l.CountByTweet(ctx, []string{tweetID})
+		ts.Require().NoError(err)
+		ts.Require().Equal(2, counts[tweetID])
+		liked, err := l.FindLikedByUser(ctx, janeID, []string{tweetID})
+		ts.Require().NoError(err)
+		ts.Require().True(liked[tweetID])
+	}
+	// Likes of deleted users are not counted
+	{
+		ts.Require().NoError(u.Delete(ctx, janeID))
+		counts, err := l.CountByTweet(ctx, []string{tweetID})
+		ts.Require().NoError(err)
+		ts.Require().Equal(1, counts[tweetID])
+	}
+	// John unlikes the tweet
+	{
+		ts.Require().NoError(l.Delete(ctx, johnID, tweetID))
+		err := l.Delete(ctx, johnID, tweetID)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+	}
+}
+
+func (ts *LikesTestSuite) TestConcurrentLikes() {
+	ctx := context.Background()
+	l := postgres.NewLikeStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	tw := postgres.NewTweetStorage(ts.s.DB())
+
+	const likers = 20
+	users := make([]*entities.User, likers)
+	for i := range users {
+		users[i] = &entities.User{Username: fmt.Sprintf("user%d", i), Email: fmt.Sprintf("user%d@test.com", i)}
+		ts.Require().NoError(u.Create(ctx, users[i]))
+	}
+	tweet := &entities.Tweet{Content: "Popular", UserID: users[0].ID}
+	ts.Require().NoError(tw.Create(ctx, tweet))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, likers)
+	for _, user := range users {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- l.Create(ctx, user.ID.String(), tweet.ID.String())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		ts.Require().NoError(err)
+	}
+
+	counts, err := l.CountByTweet(ctx, []string{tweet.ID.String()})
+	ts.Require().NoError(err)
+	ts.Require().Equal(likers, counts[tweet.ID.String()])
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// MentionStorage is a postgres implementation of the repository.MentionRepository interface.
+type MentionStorage struct {
+	dbConn bob.Executor
+}
+
+// NewMentionStorage returns a new MentionStorage.
+func NewMentionStorage(dbConn bob.Executor) *MentionStorage {
+	return &MentionStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create records the mentions of tweetID.
+func (s *MentionStorage) Create(ctx context.Context, tweetID string, mentions []entities.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	setters := make([]bob.Mod[*dialect.InsertQuery], 0, len(mentions))
+	for _, m := range mentions {
+		setters = append(setters, &models.MentionSetter{
+			TweetID:     omit.From(tweetID),
+			UserID:      omit.From(m.UserID.String()),
+			StartOffset: omit.From(int32(m.Start)), //nolint:gosec // offsets are bounded by the tweet length
+			EndOffset:   omit.From(int32(m.End)),   //nolint:gosec // offsets are bounded by the tweet length
+		})
+	}
+	if _, err := models.Mentions.Insert(setters...).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert mentions: %w", err)
+	}
+
+	return nil
+}
+
+// mentionRow is a row of the mentions query, with the current username of the
+// mentioned user.
+type mentionRow struct {
+	TweetID     string `db:"tweet_id"`
+	UserID      string `db:"user_id"`
+	Username    string `db:"username"`
+	StartOffset int    `db:"start_offset"`
+	EndOffset   int    `db:"end_offset"`
+}
+
+// FindByTweets returns the mentions of live users in each of the given tweets.
+func (s *MentionStorage) FindByTweets(ctx context.Context, tweetIDs []string) (map[string][]entities.Mention, error) {
+	mentions := make(map[string][]entities.Mention)
+	if len(tweetIDs) == 0 {
+		return mentions, nil
+	}
+
+	q := psql.Select(
+		sm.Columns(
+			models.Mentions.Columns.TweetID,
+			models.Mentions.Columns.UserID,
+			models.Users.Columns.Username,
+			models.Mentions.Columns.StartOffset,
+			models.Mentions.Columns.EndOffset,
+		),
+		sm.From(models.Mentions.Name()),
+		models.SelectJoins.Mentions.InnerJoin.User,
+		sm.Where(models.Mentions.Columns.TweetID.In(stringArgs(tweetIDs)...)),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Mentions.Columns.StartOffset),
+	)
+	rows, err := bob.All(ctx, s.dbConn, q, scan.StructMapper[mentionRow]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find mentions: %w", err)
+	}
+
+	for _, row := range rows {
+		mentions[row.TweetID] = append(mentions[row.TweetID], entities.Mention{
+			UserID:   uuid.MustParse(row.UserID),
+			Username: row.Username,
+			Start:    row.StartOffset,
+			End:      row.EndOffset,
+		})
+	}
+	return mentions, nil
+}
+
+// FindTweets returns at most limit live tweets mentioning userID created before
+// beforeID, newest first, leaving out those written by excludedUserIDs.
+func (s *MentionStorage) FindTweets(
+	ctx context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	mentioning := psql.Select(
+		sm.Columns(models.Mentions.Columns.TweetID),
+		sm.From(models.Mentions.Name()),
+		sm.Where(models.Mentions.Columns.UserID.EQ(psql.Arg(userID))),
+	)
+	mods := append(
+		pageMods(models.Tweets.Columns.ID, beforeID, limit),
+		sm.Where(models.Tweets.Columns.ID.OP("IN", mentioning)),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	ormRows, err := models.Tweets.Query(append(mods, excludeAuthors(excludedUserIDs)...)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tweets mentioning user: %w", err)
+	}
+
+	return toTweets(ormRows), nil
+}
@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// MessageStorage is a postgres implementation of the repository.MessageRepository interface.
+type MessageStorage struct {
+	dbConn bob.Executor
+}
+
+// NewMessageStorage returns a new MessageStorage.
+func NewMessageStorage(dbConn bob.Executor) *MessageStorage {
+	return &MessageStorage{
+		dbConn: dbConn,
+	}
+}
+
+// CreateConversation stores c with memberIDs as its members. It runs several
+// statements, so callers run it in a transaction.
+func (s *MessageStorage) CreateConversation(
+	ctx context.Context,
+	c *entities.Conversation,
+	memberIDs []string,
+) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate conversation id: %w", err)
+	}
+	setter := &models.ConversationSetter{ID: omit.From(id.String())}
+	if _, err = models.Conversations.Insert(setter).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert conversation: %w", err)
+	}
+
+	memberMods := make([]bob.Mod[*dialect.InsertQuery], 0, len(memberIDs))
+	for _, userID := range memberIDs {
+		memberMods = append(memberMods, &models.ConversationMemberSetter{
+			ConversationID: omit.From(id.String()),
+			UserID:         omit.From(userID),
+		})
+	}
+	if _, err = models.ConversationMembers.Insert(memberMods...).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert conversation members: %w", err)
+	}
+
+	created, err := s.FindConversation(ctx, id.String())
+	if err != nil {
+		return err
+	}
+	*c = *created
+	return nil
+}
+
+// FindConversation returns a conversation by ID.
+func (s *MessageStorage) FindConversation(ctx context.Context, id string) (*entities.Conversation, error) {
+	ormConversation, err := models.Conversations.Query(
+		sm.Where(models.Conversations.Columns.ID.EQ(psql.Arg(id))),
+		models.SelectThenLoad.Conversation.LastMessageMessage(),
+	).One(ctx, s.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find conversation: %w", err)
+	}
+
+	conversations, err := s.toConversations(ctx, models.ConversationSlice{ormConversation})
+	if err != nil {
+		return nil, err
+	}
+	return &conversations[0], nil
+}
+
+// FindConversations returns at most limit of userID's conversations, most
+// recently active first. The activity key is the last message ID, which is
+// kept on the conversation so that pages need no aggregate over messages.
+func (s *MessageStorage) FindConversations(
+	ctx context.Context,
+	userID, beforeID string,
+	limit int,
+) ([]entities.Conversation, error) {
+	cols := models.Conversations.Columns
+	memberOf := psql.Select(
+		sm.Columns(models.ConversationMembers.Columns.ConversationID),
+		sm.From(models.ConversationMembers.Name()),
+		sm.Where(models.ConversationMembers.Columns.UserID.EQ(psql.Arg(userID))),
+	)
+	activity := psql.Group(psql.F("coalesce", cols.LastMessageID, cols.ID)())
+	mods := append(
+		pageMods(activity, beforeID, limit),
+		sm.Where(cols.ID.OP("IN", memberOf)),
+		models.SelectThenLoad.Conversation.LastMessageMessage(),
+	)
+	ormConversations, err := models.Conversations.Query(mods...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find conversations: %w", err)
+	}
+
+	return s.toConversations(ctx, ormConversations)
+}
+
+// Create stores m as the last message of its conversation, unless a newer one
+// got there first, read by its sender. It runs several statements, so callers
+// run it in a transaction.
+func (s *MessageStorage) Create(ctx context.Context, m *entities.Message) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate message id: %w", err)
+	}
+	setter := &models.MessageSetter{
+		ID:             omit.From(id.String()),
+		ConversationID: omit.From(m.ConversationID.String()),
+		SenderID:       omit.From(m.SenderID.String()),
+		Content:        omit.From(m.Content),
+	}
+	row, err := models.Messages.Insert(setter).One(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to insert message: %w", err)
+	}
+
+	// Like the read markers, the last message never moves back: a concurrent
+	// Send with a newer ID may commit first. The insert above already failed
+	// if the conversation does not exist.
+	cols := models.Conversations.Columns
+	_, err = models.Conversations.Update(
+		models.ConversationSetter{LastMessageID: omitnull.From(id.String())}.UpdateMod(),
+		um.Where(cols.ID.EQ(psql.Arg(m.ConversationID.String()))),
+		um.Where(psql.Or(cols.LastMessageID.IsNull(), cols.LastMessageID.LT(psql.Arg(id.String())))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to update conversation: %w", err)
+	}
+	if err = s.MarkRead(ctx, m.ConversationID.String(), m.SenderID.String(), id.String()); err != nil {
+		return err
+	}
+
+	// created_at is filled in by the database
+	*m = toMessage(row)
+	return nil
+}
+
+// FindMessages returns at most limit messages of conversationID sent before
+// beforeID, newest first. The page is served by idx_messages_conversation_id.
+func (s *MessageStorage) FindMessages(
+	ctx context.Context,
+	conversationID, beforeID string,
+	limit int,
+) ([]entities.Message, error) {
+	mods := append(
+		pageMods(models.Messages.Columns.ID, beforeID, limit),
+		sm.Where(models.Messages.Columns.ConversationID.EQ(psql.Arg(conversationID))),
+	)
+	ormMessages, err := models.Messages.Query(mods...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find messages: %w", err)
+	}
+
+	messages := make([]entities.Message, 0, len(ormMessages))
+	for _, m := range ormMessages {
+		messages = append(messages, toMessage(m))
+	}
+	return messages, nil
+}
+
+// MarkRead marks the messages of conversationID up to messageID read by
+// userID. The read marker never moves back, so a late request cannot undo a
+// newer one.
+func (s *MessageStorage) MarkRead(ctx context.Context, conversationID, userID, messageID string) error {
+	cols := models.ConversationMembers.Columns
+	_, err := models.ConversationMembers.Update(
+		models.ConversationMemberSetter{LastReadMessageID: omitnull.From(messageID)}.UpdateMod(),
+		um.Where(cols.ConversationID.EQ(psql.Arg(conversationID))),
+		um.Where(cols.UserID.EQ(psql.Arg(userID))),
+		um.Where(psql.Or(cols.LastReadMessageID.IsNull(), cols.LastReadMessageID.LT(psql.Arg(messageID)))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to mark messages read: %w", err)
+	}
+
+	return nil
+}
+
+// memberRow is a row of the conversation members query.
+type memberRow struct {
+	ConversationID string `db:"conversation_id"`
+	UserID         string `db:"user_id"`
+	Username       string `db:"username"`
+	UnreadCount    int    `db:"unread_count"`
+}
+
+// toConversations converts bob conversation models, loaded with their last
+// message, to domain conversations. Their live members are loaded by a second
+// query, which counts the messages each member has not read: the ones the
+// other members sent after the member's read marker.
+func (s *MessageStorage) toConversations(
+	ctx context.Context,
+	ormConversations models.ConversationSlice,
+) ([]entities.Conversation, error) {
+	conversations := make([]entities.Conversation, 0, len(ormConversations))
+	if len(ormConversations) == 0 {
+		return conversations, nil
+	}
+	ids := make([]string, 0, len(ormConversations))
+	for _, c := range ormConversations {
+		ids = append(ids, c.ID)
+	}
+
+	members := models.ConversationMembers.Columns
+	messages := models.Messages.Columns
+	q := psql.Select(
+		sm.Columns(
+			members.ConversationID,
+			members.UserID,
+			models.Users.Columns.Username,
+			psql.F("count", messages.ID)().As("unread_count"),
+		),
+		sm.From(models.ConversationMembers.Name()),
+		models.SelectJoins.ConversationMembers.InnerJoin.User,
+		sm.LeftJoin(models.Messages.Name()).On(psql.And(
+			messages.ConversationID.EQ(members.ConversationID),
+			messages.SenderID.NE(members.UserID),
+			psql.Or(members.LastReadMessageID.IsNull(), messages.ID.GT(members.LastReadMessageID)),
+		)),
+		sm.Where(members.ConversationID.In(stringArgs(ids)...)),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.GroupBy(members.ConversationID),
+		sm.GroupBy(members.UserID),
+		sm.GroupBy(models.Users.Columns.Username),
+		sm.OrderBy(members.UserID),
+	)
+	rows, err := bob.All(ctx, s.dbConn, q, scan.StructMapper[memberRow]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find conversation members: %w", err)
+	}
+	byConversation := make(map[string][]entities.ConversationMember, len(ids))
+	for _, row := range rows {
+		byConversation[row.ConversationID] = append(byConversation[row.ConversationID], entities.ConversationMember{
+			UserID:      uuid.MustParse(row.UserID),
+			Username:    row.Username,
+			UnreadCount: row.UnreadCount,
+		})
+	}
+
+	for _, c := range ormConversations {
+		conversation := entities.Conversation{
+			ID:        uuid.MustParse(c.ID),
+			Members:   byConversation[c.ID],
+			CreatedAt: c.CreatedAt.GetOrZero(),
+		}
+		if c.R.LastMessageMessage != nil {
+			last := toMessage(c.R.LastMessageMessage)
+			conversation.LastMessage = &last
+		}
+		conversations = append(conversations, conversation)
+	}
+	return conversations, nil
+}
+
+// toMessage converts a bob message model to a domain message.
+func toMessage(m *models.Message) entities.Message {
+	return entities.Message{
+		ID:             uuid.MustParse(m.ID),
+		ConversationID: uuid.MustParse(m.ConversationID),
+		SenderID:       uuid.MustParse(m.SenderID),
+		Content:        m.Content,
+		CreatedAt:      m.CreatedAt.GetOrZero(),
+	}
+}
@@ -0,0 +1,111 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type MessagesTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestMessagesTestSuite(t *testing.T) {
+	suite.Run(t, new(MessagesTestSuite))
+}
+
+func (ts *MessagesTestSuite) TestMessages() {
+	ctx := context.Background()
+	u := postgres.NewUserStorage(ts.s.DB())
+	m := postgres.NewMessageStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	ts.Require().NoError(u.Create(ctx, jane))
+	members := []string{john.ID.String(), jane.ID.String()}
+
+	var first, second entities.Conversation
+	ts.Require().NoError(m.CreateConversation(ctx, &first, members))
+	ts.Require().NoError(m.CreateConversation(ctx, &second, members))
+	ts.Require().Len(first.Members, 2)
+	ts.Require().Nil(first.LastMessage)
+	_, err := m.FindConversation(ctx, uuid.NewString())
+	ts.Require().ErrorIs(err, repository.ErrNotFound)
+
+	// A message moves its conversation to the top and is read by its sender
+	msg := &entities.Message{ConversationID: first.ID, SenderID: jane.ID, Content: "hello"}
+	ts.Require().NoError(m.Create(ctx, msg))
+	ts.Require().False(msg.CreatedAt.IsZero())
+	conversations, err := m.FindConversations(ctx, john.ID.String(), "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(conversations, 2)
+	ts.Require().Equal(first.ID, conversations[0].ID)
+	ts.Require().Equal(msg.ID, conversations[0].LastMessage.ID)
+	unread := map[uuid.UUID]int{}
+	for _, member := range conversations[0].Members {
+		unread[member.UserID] = member.UnreadCount
+	}
+	ts.Require().Equal(map[uuid.UUID]int{john.ID: 1, jane.ID: 0}, unread)
+	conversations, err = m.FindConversations(ctx, john.ID.String(), msg.ID.String(), 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(conversations, 1)
+	ts.Require().Equal(second.ID, conversations[0].ID)
+
+	// The read marker never moves back
+	ts.Require().NoError(m.MarkRead(ctx, first.ID.String(), john.ID.String(), msg.ID.String()))
+	ts.Require().NoError(m.MarkRead(ctx, first.ID.String(), john.ID.String(), first.ID.String()))
+	c, err := m.FindConversation(ctx, first.ID.String())
+	ts.Require().NoError(err)
+	for _, member := range c.Members {
+		ts.Require().Zero(member.UnreadCount)
+	}
+
+	messages, err := m.FindMessages(ctx, first.ID.String(), "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(messages, 1)
+	ts.Require().Equal("hello", messages[0].Content)
+}
+
+func (ts *MessagesTestSuite) TestLastMessageNeverMovesBack() {
+	ctx := context.Background()
+	u := postgres.NewUserStorage(ts.s.DB())
+	m := postgres.NewMessageStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	ts.Require().NoError(u.Create(ctx, jane))
+	var c entities.Conversation
+	ts.Require().NoError(m.CreateConversation(ctx, &c, []string{john.ID.String(), jane.ID.String()}))
+
+	// A concurrent Send committed first with a newer ID than the one the
+	// next Create generates
+	newer, err := uuid.NewV7()
+	ts.Require().NoError(err)
+	newer[0]++ // decades ahead
+	_, err = ts.s.DB().ExecContext(ctx,
+		`INSERT INTO messages (id, conversation_id, sender_id, content) VALUES ($1, $2, $3, 'newer')`,
+		newer.String(), c.ID.String(), john.ID.String())
+	ts.Require().NoError(err)
+	_, err = ts.s.DB().ExecContext(ctx,
+		`UPDATE conversations SET last_message_id = $1 WHERE id = $2`, newer.String(), c.ID.String())
+	ts.Require().NoError(err)
+
+	late := &entities.Message{ConversationID: c.ID, SenderID: jane.ID, Content: "late"}
+	ts.Require().NoError(m.Create(ctx, late))
+	found, err := m.FindConversation(ctx, c.ID.String())
+	ts.Require().NoError(err)
+	ts.Require().Equal(newer, found.LastMessage.ID)
+}
@@ -0,0 +1,816 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Block is an object representing the database table.
+type Block struct {
+	BlockerID string              `db:"blocker_id,pk" `
+	BlockedID string              `db:"blocked_id,pk" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R blockR `db:"-" `
+}
+
+// BlockSlice is an alias for a slice of pointers to Block.
+// This should almost always be used instead of []*Block.
+type BlockSlice []*Block
+
+// Blocks contains methods to work with the blocks table
+var Blocks = psql.NewTablex[*Block, BlockSlice, *BlockSetter]("", "blocks", buildBlockColumns("blocks"))
+
+// BlocksQuery is a query on the blocks table
+type BlocksQuery = *psql.ViewQuery[*Block, BlockSlice]
+
+// blockR is where relationships are stored.
+type blockR struct {
+	BlockedUser *User // blocks.blocks_blocked_id_fkey
+	BlockerUser *User // blocks.blocks_blocker_id_fkey
+}
+
+func buildBlockColumns(alias string) blockColumns {
+	return blockColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"blocker_id", "blocked_id", "created_at",
+		).WithParent("blocks"),
+		tableAlias: alias,
+		BlockerID:  psql.Quote(alias, "blocker_id"),
+		BlockedID:  psql.Quote(alias, "blocked_id"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type blockColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	BlockerID  psql.Expression
+	BlockedID  psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c blockColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (blockColumns) AliasedAs(alias string) blockColumns {
+	return buildBlockColumns(alias)
+}
+
+// BlockSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type BlockSetter struct {
+	BlockerID omit.Val[string]        `db:"blocker_id,pk" `
+	BlockedID omit.Val[string]        `db:"blocked_id,pk" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s BlockSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.BlockerID.IsValue() {
+		vals = append(vals, "blocker_id")
+	}
+	if s.BlockedID.IsValue() {
+		vals = append(vals, "blocked_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s BlockSetter) Overwrite(t *Block) {
+	if s.BlockerID.IsValue() {
+		t.BlockerID = s.BlockerID.MustGet()
+	}
+	if s.BlockedID.IsValue() {
+		t.BlockedID = s.BlockedID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *BlockSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Blocks.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.BlockerID.IsValue() {
+			vals[0] = psql.Arg(s.BlockerID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.BlockedID.IsValue() {
+			vals[1] = psql.Arg(s.BlockedID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s BlockSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s BlockSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.BlockerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "blocker_id")...),
+			psql.Arg(s.BlockerID),
+		}})
+	}
+
+	if s.BlockedID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "blocked_id")...),
+			psql.Arg(s.BlockedID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindBlock retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindBlock(ctx context.Context, exec bob.Executor, BlockerIDPK string, BlockedIDPK string, cols ...string) (*Block, error) {
+	if len(cols) == 0 {
+		return Blocks.Query(
+			sm.Where(Blocks.Columns.BlockerID.EQ(psql.Arg(BlockerIDPK))),
+			sm.Where(Blocks.Columns.BlockedID.EQ(psql.Arg(BlockedIDPK))),
+		).One(ctx, exec)
+	}
+
+	return Blocks.Query(
+		sm.Where(Blocks.Columns.BlockerID.EQ(psql.Arg(BlockerIDPK))),
+		sm.Where(Blocks.Columns.BlockedID.EQ(psql.Arg(BlockedIDPK))),
+		sm.Columns(Blocks.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// BlockExists checks the presence of a single record by primary key
+func BlockExists(ctx context.Context, exec bob.Executor, BlockerIDPK string, BlockedIDPK string) (bool, error) {
+	return Blocks.Query(
+		sm.Where(Blocks.Columns.BlockerID.EQ(psql.Arg(BlockerIDPK))),
+		sm.Where(Blocks.Columns.BlockedID.EQ(psql.Arg(BlockedIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Block is retrieved from the database
+func (o *Block) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Blocks.AfterSelectHooks.RunHooks(ctx, exec, BlockSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Blocks.AfterInsertHooks.RunHooks(ctx, exec, BlockSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Blocks.AfterUpdateHooks.RunHooks(ctx, exec, BlockSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Blocks.AfterDeleteHooks.RunHooks(ctx, exec, BlockSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Block
+func (o *Block) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.BlockerID,
+		o.BlockedID,
+	)
+}
+
+func (o *Block) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("blocks", "blocker_id"), psql.Quote("blocks", "blocked_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Block
+func (o *Block) Update(ctx context.Context, exec bob.Executor, s *BlockSetter) error {
+	v, err := Blocks.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Block record with an executor
+func (o *Block) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Blocks.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Block using the executor
+func (o *Block) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Blocks.Query(
+		sm.Where(Blocks.Columns.BlockerID.EQ(psql.Arg(o.BlockerID))),
+		sm.Where(Blocks.Columns.BlockedID.EQ(psql.Arg(o.BlockedID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after BlockSlice is retrieved from the database
+func (o BlockSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Blocks.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Blocks.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Blocks.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Blocks.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o BlockSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("blocks", "blocker_id"), psql.Quote("blocks", "blocked_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o BlockSlice) copyMatchingRows(from ...*Block) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.BlockerID != old.BlockerID {
+				continue
+			}
+			if new.BlockedID != old.BlockedID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o BlockSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Blocks.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Block:
+				o.copyMatchingRows(retrieved)
+			case []*Block:
+				o.copyMatchingRows(retrieved...)
+			case BlockSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Block or a slice of Block
+				// then run the AfterUpdateHooks on the slice
+				_, err = Blocks.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o BlockSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Blocks.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Block:
+				o.copyMatchingRows(retrieved)
+			case []*Block:
+				o.copyMatchingRows(retrieved...)
+			case BlockSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Block or a slice of Block
+				// then run the AfterDeleteHooks on the slice
+				_, err = Blocks.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o BlockSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals BlockSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Blocks.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o BlockSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Blocks.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o BlockSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Blocks.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// BlockedUser starts a query for related objects on users
+func (o *Block) BlockedUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.BlockedID))),
+	)...)
+}
+
+func (os BlockSlice) BlockedUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkBlockedID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkBlockedID = append(pkBlockedID, o.BlockedID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkBlockedID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// BlockerUser starts a query for related objects on users
+func (o *Block) BlockerUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.BlockerID))),
+	)...)
+}
+
+func (os BlockSlice) BlockerUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkBlockerID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkBlockerID = append(pkBlockerID, o.BlockerID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkBlockerID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachBlockBlockedUser0(ctx context.Context, exec bob.Executor, count int, block0 *Block, user1 *User) (*Block, error) {
+	setter := &BlockSetter{
+		BlockedID: omit.From(user1.ID),
+	}
+
+	err := block0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBlockBlockedUser0: %w", err)
+	}
+
+	return block0, nil
+}
+
+func (block0 *Block) InsertBlockedUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachBlockBlockedUser0(ctx, exec, 1, block0, user1)
+	if err != nil {
+		return err
+	}
+
+	block0.R.BlockedUser = user1
+
+	user1.R.BlockedBlocks = append(user1.R.BlockedBlocks, block0)
+
+	return nil
+}
+
+func (block0 *Block) AttachBlockedUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachBlockBlockedUser0(ctx, exec, 1, block0, user1)
+	if err != nil {
+		return err
+	}
+
+	block0.R.BlockedUser = user1
+
+	user1.R.BlockedBlocks = append(user1.R.BlockedBlocks, block0)
+
+	return nil
+}
+
+func attachBlockBlockerUser0(ctx context.Context, exec bob.Executor, count int, block0 *Block, user1 *User) (*Block, error) {
+	setter := &BlockSetter{
+		BlockerID: omit.From(user1.ID),
+	}
+
+	err := block0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBlockBlockerUser0: %w", err)
+	}
+
+	return block0, nil
+}
+
+func (block0 *Block) InsertBlockerUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachBlockBlockerUser0(ctx, exec, 1, block0, user1)
+	if err != nil {
+		return err
+	}
+
+	block0.R.BlockerUser = user1
+
+	user1.R.BlockerBlocks = append(user1.R.BlockerBlocks, block0)
+
+	return nil
+}
+
+func (block0 *Block) AttachBlockerUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachBlockBlockerUser0(ctx, exec, 1, block0, user1)
+	if err != nil {
+		return err
+	}
+
+	block0.R.BlockerUser = user1
+
+	user1.R.BlockerBlocks = append(user1.R.BlockerBlocks, block0)
+
+	return nil
+}
+
+type blockWhere[Q psql.Filterable] struct {
+	BlockerID psql.WhereMod[Q, string]
+	BlockedID psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (blockWhere[Q]) AliasedAs(alias string) blockWhere[Q] {
+	return buildBlockWhere[Q](buildBlockColumns(alias))
+}
+
+func buildBlockWhere[Q psql.Filterable](cols blockColumns) blockWhere[Q] {
+	return blockWhere[Q]{
+		BlockerID: psql.Where[Q, string](cols.BlockerID),
+		BlockedID: psql.Where[Q, string](cols.BlockedID),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Block) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "BlockedUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("block cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BlockedUser = rel
+
+		if rel != nil {
+			rel.R.BlockedBlocks = BlockSlice{o}
+		}
+		return nil
+	case "BlockerUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("block cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BlockerUser = rel
+
+		if rel != nil {
+			rel.R.BlockerBlocks = BlockSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("block has no relationship %q", name)
+	}
+}
+
+type blockPreloader struct {
+	BlockedUser func(...psql.PreloadOption) psql.Preloader
+	BlockerUser func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildBlockPreloader() blockPreloader {
+	return blockPreloader{
+		BlockedUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "BlockedUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Blocks,
+						To:          Users,
+						FromColumns: []string{"blocked_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		BlockerUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "BlockerUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Blocks,
+						To:          Users,
+						FromColumns: []string{"blocker_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type blockThenLoader[Q orm.Loadable] struct {
+	BlockedUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	BlockerUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildBlockThenLoader[Q orm.Loadable]() blockThenLoader[Q] {
+	type BlockedUserLoadInterface interface {
+		LoadBlockedUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BlockerUserLoadInterface interface {
+		LoadBlockerUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return blockThenLoader[Q]{
+		BlockedUser: thenLoadBuilder[Q](
+			"BlockedUser",
+			func(ctx context.Context, exec bob.Executor, retrieved BlockedUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBlockedUser(ctx, exec, mods...)
+			},
+		),
+		BlockerUser: thenLoadBuilder[Q](
+			"BlockerUser",
+			func(ctx context.Context, exec bob.Executor, retrieved BlockerUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBlockerUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadBlockedUser loads the block's BlockedUser into the .R struct
+func (o *Block) LoadBlockedUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BlockedUser = nil
+
+	related, err := o.BlockedUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.BlockedBlocks = BlockSlice{o}
+
+	o.R.BlockedUser = related
+	return nil
+}
+
+// LoadBlockedUser loads the block's BlockedUser into the .R struct
+func (os BlockSlice) LoadBlockedUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.BlockedUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.BlockedID == rel.ID) {
+				continue
+			}
+
+			rel.R.BlockedBlocks = append(rel.R.BlockedBlocks, o)
+
+			o.R.BlockedUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockerUser loads the block's BlockerUser into the .R struct
+func (o *Block) LoadBlockerUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BlockerUser = nil
+
+	related, err := o.BlockerUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.BlockerBlocks = BlockSlice{o}
+
+	o.R.BlockerUser = related
+	return nil
+}
+
+// LoadBlockerUser loads the block's BlockerUser into the .R struct
+func (os BlockSlice) LoadBlockerUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.BlockerUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.BlockerID == rel.ID) {
+				continue
+			}
+
+			rel.R.BlockerBlocks = append(rel.R.BlockerBlocks, o)
+
+			o.R.BlockerUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type blockJoins[Q dialect.Joinable] struct {
+	typ         string
+	BlockedUser modAs[Q, userColumns]
+	BlockerUser modAs[Q, userColumns]
+}
+
+func (j blockJoins[Q]) aliasedAs(alias string) blockJoins[Q] {
+	return buildBlockJoins[Q](buildBlockColumns(alias), j.typ)
+}
+
+func buildBlockJoins[Q dialect.Joinable](cols blockColumns, typ string) blockJoins[Q] {
+	return blockJoins[Q]{
+		typ: typ,
+		BlockedUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.BlockedID),
+					))
+				}
+
+				return mods
+			},
+		},
+		BlockerUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.BlockerID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
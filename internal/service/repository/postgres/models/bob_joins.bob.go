@@ -32,8 +32,20 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	Tweets joinSet[tweetJoins[Q]]
-	Users  joinSet[userJoins[Q]]
+	Blocks              joinSet[blockJoins[Q]]
+	Bookmarks           joinSet[bookmarkJoins[Q]]
+	ConversationMembers joinSet[conversationMemberJoins[Q]]
+	Conversations       joinSet[conversationJoins[Q]]
+	Follows             joinSet[followJoins[Q]]
+	Hashtags            joinSet[hashtagJoins[Q]]
+	Likes               joinSet[likeJoins[Q]]
+	Mentions            joinSet[mentionJoins[Q]]
+	Messages            joinSet[messageJoins[Q]]
+	Mutes               joinSet[muteJoins[Q]]
+	Notifications       joinSet[notificationJoins[Q]]
+	TweetHashtags       joinSet[tweetHashtagJoins[Q]]
+	Tweets              joinSet[tweetJoins[Q]]
+	Users               joinSet[userJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -46,8 +58,20 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		Tweets: buildJoinSet[tweetJoins[Q]](Tweets.Columns, buildTweetJoins),
-		Users:  buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		Blocks:              buildJoinSet[blockJoins[Q]](Blocks.Columns, buildBlockJoins),
+		Bookmarks:           buildJoinSet[bookmarkJoins[Q]](Bookmarks.Columns, buildBookmarkJoins),
+		ConversationMembers: buildJoinSet[conversationMemberJoins[Q]](ConversationMembers.Columns, buildConversationMemberJoins),
+		Conversations:       buildJoinSet[conversationJoins[Q]](Conversations.Columns, buildConversationJoins),
+		Follows:             buildJoinSet[followJoins[Q]](Follows.Columns, buildFollowJoins),
+		Hashtags:            buildJoinSet[hashtagJoins[Q]](Hashtags.Columns, buildHashtagJoins),
+		Likes:               buildJoinSet[likeJoins[Q]](Likes.Columns, buildLikeJoins),
+		Mentions:            buildJoinSet[mentionJoins[Q]](Mentions.Columns, buildMentionJoins),
+		Messages:            buildJoinSet[messageJoins[Q]](Messages.Columns, buildMessageJoins),
+		Mutes:               buildJoinSet[muteJoins[Q]](Mutes.Columns, buildMuteJoins),
+		Notifications:       buildJoinSet[notificationJoins[Q]](Notifications.Columns, buildNotificationJoins),
+		TweetHashtags:       buildJoinSet[tweetHashtagJoins[Q]](TweetHashtags.Columns, buildTweetHashtagJoins),
+		Tweets:              buildJoinSet[tweetJoins[Q]](Tweets.Columns, buildTweetJoins),
+		Users:               buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
 	}
 }
 
@@ -17,14 +17,38 @@ import (
 var Preload = getPreloaders()
 
 type preloaders struct {
-	Tweet tweetPreloader
-	User  userPreloader
+	Block              blockPreloader
+	Bookmark           bookmarkPreloader
+	ConversationMember conversationMemberPreloader
+	Conversation       conversationPreloader
+	Follow             followPreloader
+	Hashtag            hashtagPreloader
+	Like               likePreloader
+	Mention            mentionPreloader
+	Message            messagePreloader
+	Mute               mutePreloader
+	Notification       notificationPreloader
+	TweetHashtag       tweetHashtagPreloader
+	Tweet              tweetPreloader
+	User               userPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		Tweet: buildTweetPreloader(),
-		User:  buildUserPreloader(),
+		Block:              buildBlockPreloader(),
+		Bookmark:           buildBookmarkPreloader(),
+		ConversationMember: buildConversationMemberPreloader(),
+		Conversation:       buildConversationPreloader(),
+		Follow:             buildFollowPreloader(),
+		Hashtag:            buildHashtagPreloader(),
+		Like:               buildLikePreloader(),
+		Mention:            buildMentionPreloader(),
+		Message:            buildMessagePreloader(),
+		Mute:               buildMutePreloader(),
+		Notification:       buildNotificationPreloader(),
+		TweetHashtag:       buildTweetHashtagPreloader(),
+		Tweet:              buildTweetPreloader(),
+		User:               buildUserPreloader(),
 	}
 }
 
@@ -35,14 +59,38 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	Tweet tweetThenLoader[Q]
-	User  userThenLoader[Q]
+	Block              blockThenLoader[Q]
+	Bookmark           bookmarkThenLoader[Q]
+	ConversationMember conversationMemberThenLoader[Q]
+	Conversation       conversationThenLoader[Q]
+	Follow             followThenLoader[Q]
+	Hashtag            hashtagThenLoader[Q]
+	Like               likeThenLoader[Q]
+	Mention            mentionThenLoader[Q]
+	Message            messageThenLoader[Q]
+	Mute               muteThenLoader[Q]
+	Notification       notificationThenLoader[Q]
+	TweetHashtag       tweetHashtagThenLoader[Q]
+	Tweet              tweetThenLoader[Q]
+	User               userThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		Tweet: buildTweetThenLoader[Q](),
-		User:  buildUserThenLoader[Q](),
+		Block:              buildBlockThenLoader[Q](),
+		Bookmark:           buildBookmarkThenLoader[Q](),
+		ConversationMember: buildConversationMemberThenLoader[Q](),
+		Conversation:       buildConversationThenLoader[Q](),
+		Follow:             buildFollowThenLoader[Q](),
+		Hashtag:            buildHashtagThenLoader[Q](),
+		Like:               buildLikeThenLoader[Q](),
+		Mention:            buildMentionThenLoader[Q](),
+		Message:            buildMessageThenLoader[Q](),
+		Mute:               buildMuteThenLoader[Q](),
+		Notification:       buildNotificationThenLoader[Q](),
+		TweetHashtag:       buildTweetHashtagThenLoader[Q](),
+		Tweet:              buildTweetThenLoader[Q](),
+		User:               buildUserThenLoader[Q](),
 	}
 }
 
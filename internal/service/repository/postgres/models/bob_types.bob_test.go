@@ -8,9 +8,45 @@ import "github.com/stephenafamo/bob"
 // Set the testDB to enable tests that use the database
 var testDB bob.Transactor[bob.Tx]
 
+// Make sure the type Block runs hooks after queries
+var _ bob.HookableType = &Block{}
+
+// Make sure the type Bookmark runs hooks after queries
+var _ bob.HookableType = &Bookmark{}
+
+// Make sure the type ConversationMember runs hooks after queries
+var _ bob.HookableType = &ConversationMember{}
+
+// Make sure the type Conversation runs hooks after queries
+var _ bob.HookableType = &Conversation{}
+
+// Make sure the type Follow runs hooks after queries
+var _ bob.HookableType = &Follow{}
+
+// Make sure the type Hashtag runs hooks after queries
+var _ bob.HookableType = &Hashtag{}
+
+// Make sure the type Like runs hooks after queries
+var _ bob.HookableType = &Like{}
+
+// Make sure the type Mention runs hooks after queries
+var _ bob.HookableType = &Mention{}
+
+// Make sure the type Message runs hooks after queries
+var _ bob.HookableType = &Message{}
+
+// Make sure the type Mute runs hooks after queries
+var _ bob.HookableType = &Mute{}
+
+// Make sure the type Notification runs hooks after queries
+var _ bob.HookableType = &Notification{}
+
 // Make sure the type SchemaMigration runs hooks after queries
 var _ bob.HookableType = &SchemaMigration{}
 
+// Make sure the type TweetHashtag runs hooks after queries
+var _ bob.HookableType = &TweetHashtag{}
+
 // Make sure the type Tweet runs hooks after queries
 var _ bob.HookableType = &Tweet{}
 
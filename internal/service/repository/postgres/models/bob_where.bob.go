@@ -17,17 +17,53 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	SchemaMigrations schemaMigrationWhere[Q]
-	Tweets           tweetWhere[Q]
-	Users            userWhere[Q]
+	Blocks              blockWhere[Q]
+	Bookmarks           bookmarkWhere[Q]
+	ConversationMembers conversationMemberWhere[Q]
+	Conversations       conversationWhere[Q]
+	Follows             followWhere[Q]
+	Hashtags            hashtagWhere[Q]
+	Likes               likeWhere[Q]
+	Mentions            mentionWhere[Q]
+	Messages            messageWhere[Q]
+	Mutes               muteWhere[Q]
+	Notifications       notificationWhere[Q]
+	SchemaMigrations    schemaMigrationWhere[Q]
+	TweetHashtags       tweetHashtagWhere[Q]
+	Tweets              tweetWhere[Q]
+	Users               userWhere[Q]
 } {
 	return struct {
-		SchemaMigrations schemaMigrationWhere[Q]
-		Tweets           tweetWhere[Q]
-		Users            userWhere[Q]
+		Blocks              blockWhere[Q]
+		Bookmarks           bookmarkWhere[Q]
+		ConversationMembers conversationMemberWhere[Q]
+		Conversations       conversationWhere[Q]
+		Follows             followWhere[Q]
+		Hashtags            hashtagWhere[Q]
+		Likes               likeWhere[Q]
+		Mentions            mentionWhere[Q]
+		Messages            messageWhere[Q]
+		Mutes               muteWhere[Q]
+		Notifications       notificationWhere[Q]
+		SchemaMigrations    schemaMigrationWhere[Q]
+		TweetHashtags       tweetHashtagWhere[Q]
+		Tweets              tweetWhere[Q]
+		Users               userWhere[Q]
 	}{
-		SchemaMigrations: buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
-		Tweets:           buildTweetWhere[Q](Tweets.Columns),
-		Users:            buildUserWhere[Q](Users.Columns),
+		Blocks:              buildBlockWhere[Q](Blocks.Columns),
+		Bookmarks:           buildBookmarkWhere[Q](Bookmarks.Columns),
+		ConversationMembers: buildConversationMemberWhere[Q](ConversationMembers.Columns),
+		Conversations:       buildConversationWhere[Q](Conversations.Columns),
+		Follows:             buildFollowWhere[Q](Follows.Columns),
+		Hashtags:            buildHashtagWhere[Q](Hashtags.Columns),
+		Likes:               buildLikeWhere[Q](Likes.Columns),
+		Mentions:            buildMentionWhere[Q](Mentions.Columns),
+		Messages:            buildMessageWhere[Q](Messages.Columns),
+		Mutes:               buildMuteWhere[Q](Mutes.Columns),
+		Notifications:       buildNotificationWhere[Q](Notifications.Columns),
+		SchemaMigrations:    buildSchemaMigrationWhere[Q](SchemaMigrations.Columns),
+		TweetHashtags:       buildTweetHashtagWhere[Q](TweetHashtags.Columns),
+		Tweets:              buildTweetWhere[Q](Tweets.Columns),
+		Users:               buildUserWhere[Q](Users.Columns),
 	}
 }
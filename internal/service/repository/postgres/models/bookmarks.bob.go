@@ -0,0 +1,831 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Bookmark is an object representing the database table.
+type Bookmark struct {
+	ID        string              `db:"id,pk" `
+	UserID    string              `db:"user_id" `
+	TweetID   string              `db:"tweet_id" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R bookmarkR `db:"-" `
+}
+
+// BookmarkSlice is an alias for a slice of pointers to Bookmark.
+// This should almost always be used instead of []*Bookmark.
+type BookmarkSlice []*Bookmark
+
+// Bookmarks contains methods to work with the bookmarks table
+var Bookmarks = psql.NewTablex[*Bookmark, BookmarkSlice, *BookmarkSetter]("", "bookmarks", buildBookmarkColumns("bookmarks"))
+
+// BookmarksQuery is a query on the bookmarks table
+type BookmarksQuery = *psql.ViewQuery[*Bookmark, BookmarkSlice]
+
+// bookmarkR is where relationships are stored.
+type bookmarkR struct {
+	Tweet *Tweet // bookmarks.bookmarks_tweet_id_fkey
+	User  *User  // bookmarks.bookmarks_user_id_fkey
+}
+
+func buildBookmarkColumns(alias string) bookmarkColumns {
+	return bookmarkColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "tweet_id", "created_at",
+		).WithParent("bookmarks"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		TweetID:    psql.Quote(alias, "tweet_id"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type bookmarkColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	TweetID    psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c bookmarkColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (bookmarkColumns) AliasedAs(alias string) bookmarkColumns {
+	return buildBookmarkColumns(alias)
+}
+
+// BookmarkSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type BookmarkSetter struct {
+	ID        omit.Val[string]        `db:"id,pk" `
+	UserID    omit.Val[string]        `db:"user_id" `
+	TweetID   omit.Val[string]        `db:"tweet_id" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s BookmarkSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.TweetID.IsValue() {
+		vals = append(vals, "tweet_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s BookmarkSetter) Overwrite(t *Bookmark) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.TweetID.IsValue() {
+		t.TweetID = s.TweetID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *BookmarkSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Bookmarks.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.TweetID.IsValue() {
+			vals[2] = psql.Arg(s.TweetID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s BookmarkSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s BookmarkSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.TweetID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tweet_id")...),
+			psql.Arg(s.TweetID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindBookmark retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindBookmark(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Bookmark, error) {
+	if len(cols) == 0 {
+		return Bookmarks.Query(
+			sm.Where(Bookmarks.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Bookmarks.Query(
+		sm.Where(Bookmarks.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Bookmarks.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// BookmarkExists checks the presence of a single record by primary key
+func BookmarkExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Bookmarks.Query(
+		sm.Where(Bookmarks.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Bookmark is retrieved from the database
+func (o *Bookmark) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Bookmarks.AfterSelectHooks.RunHooks(ctx, exec, BookmarkSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Bookmarks.AfterInsertHooks.RunHooks(ctx, exec, BookmarkSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Bookmarks.AfterUpdateHooks.RunHooks(ctx, exec, BookmarkSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Bookmarks.AfterDeleteHooks.RunHooks(ctx, exec, BookmarkSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Bookmark
+func (o *Bookmark) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Bookmark) pkEQ() dialect.Expression {
+	return psql.Quote("bookmarks", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Bookmark
+func (o *Bookmark) Update(ctx context.Context, exec bob.Executor, s *BookmarkSetter) error {
+	v, err := Bookmarks.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Bookmark record with an executor
+func (o *Bookmark) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Bookmarks.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Bookmark using the executor
+func (o *Bookmark) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Bookmarks.Query(
+		sm.Where(Bookmarks.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after BookmarkSlice is retrieved from the database
+func (o BookmarkSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Bookmarks.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Bookmarks.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Bookmarks.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Bookmarks.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o BookmarkSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("bookmarks", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o BookmarkSlice) copyMatchingRows(from ...*Bookmark) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o BookmarkSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Bookmarks.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Bookmark:
+				o.copyMatchingRows(retrieved)
+			case []*Bookmark:
+				o.copyMatchingRows(retrieved...)
+			case BookmarkSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Bookmark or a slice of Bookmark
+				// then run the AfterUpdateHooks on the slice
+				_, err = Bookmarks.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o BookmarkSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Bookmarks.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Bookmark:
+				o.copyMatchingRows(retrieved)
+			case []*Bookmark:
+				o.copyMatchingRows(retrieved...)
+			case BookmarkSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Bookmark or a slice of Bookmark
+				// then run the AfterDeleteHooks on the slice
+				_, err = Bookmarks.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o BookmarkSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals BookmarkSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Bookmarks.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o BookmarkSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Bookmarks.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o BookmarkSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Bookmarks.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Tweet starts a query for related objects on tweets
+func (o *Bookmark) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.TweetID))),
+	)...)
+}
+
+func (os BookmarkSlice) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkTweetID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkTweetID = append(pkTweetID, o.TweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Bookmark) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os BookmarkSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachBookmarkTweet0(ctx context.Context, exec bob.Executor, count int, bookmark0 *Bookmark, tweet1 *Tweet) (*Bookmark, error) {
+	setter := &BookmarkSetter{
+		TweetID: omit.From(tweet1.ID),
+	}
+
+	err := bookmark0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBookmarkTweet0: %w", err)
+	}
+
+	return bookmark0, nil
+}
+
+func (bookmark0 *Bookmark) InsertTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachBookmarkTweet0(ctx, exec, 1, bookmark0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	bookmark0.R.Tweet = tweet1
+
+	tweet1.R.Bookmarks = append(tweet1.R.Bookmarks, bookmark0)
+
+	return nil
+}
+
+func (bookmark0 *Bookmark) AttachTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachBookmarkTweet0(ctx, exec, 1, bookmark0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	bookmark0.R.Tweet = tweet1
+
+	tweet1.R.Bookmarks = append(tweet1.R.Bookmarks, bookmark0)
+
+	return nil
+}
+
+func attachBookmarkUser0(ctx context.Context, exec bob.Executor, count int, bookmark0 *Bookmark, user1 *User) (*Bookmark, error) {
+	setter := &BookmarkSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := bookmark0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachBookmarkUser0: %w", err)
+	}
+
+	return bookmark0, nil
+}
+
+func (bookmark0 *Bookmark) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachBookmarkUser0(ctx, exec, 1, bookmark0, user1)
+	if err != nil {
+		return err
+	}
+
+	bookmark0.R.User = user1
+
+	user1.R.Bookmarks = append(user1.R.Bookmarks, bookmark0)
+
+	return nil
+}
+
+func (bookmark0 *Bookmark) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachBookmarkUser0(ctx, exec, 1, bookmark0, user1)
+	if err != nil {
+		return err
+	}
+
+	bookmark0.R.User = user1
+
+	user1.R.Bookmarks = append(user1.R.Bookmarks, bookmark0)
+
+	return nil
+}
+
+type bookmarkWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, string]
+	UserID    psql.WhereMod[Q, string]
+	TweetID   psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (bookmarkWhere[Q]) AliasedAs(alias string) bookmarkWhere[Q] {
+	return buildBookmarkWhere[Q](buildBookmarkColumns(alias))
+}
+
+func buildBookmarkWhere[Q psql.Filterable](cols bookmarkColumns) bookmarkWhere[Q] {
+	return bookmarkWhere[Q]{
+		ID:        psql.Where[Q, string](cols.ID),
+		UserID:    psql.Where[Q, string](cols.UserID),
+		TweetID:   psql.Where[Q, string](cols.TweetID),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Bookmark) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Tweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("bookmark cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweet = rel
+
+		if rel != nil {
+			rel.R.Bookmarks = BookmarkSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("bookmark cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Bookmarks = BookmarkSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("bookmark has no relationship %q", name)
+	}
+}
+
+type bookmarkPreloader struct {
+	Tweet func(...psql.PreloadOption) psql.Preloader
+	User  func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildBookmarkPreloader() bookmarkPreloader {
+	return bookmarkPreloader{
+		Tweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "Tweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Bookmarks,
+						To:          Tweets,
+						FromColumns: []string{"tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Bookmarks,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type bookmarkThenLoader[Q orm.Loadable] struct {
+	Tweet func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildBookmarkThenLoader[Q orm.Loadable]() bookmarkThenLoader[Q] {
+	type TweetLoadInterface interface {
+		LoadTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return bookmarkThenLoader[Q]{
+		Tweet: thenLoadBuilder[Q](
+			"Tweet",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweet(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTweet loads the bookmark's Tweet into the .R struct
+func (o *Bookmark) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweet = nil
+
+	related, err := o.Tweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Bookmarks = BookmarkSlice{o}
+
+	o.R.Tweet = related
+	return nil
+}
+
+// LoadTweet loads the bookmark's Tweet into the .R struct
+func (os BookmarkSlice) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !(o.TweetID == rel.ID) {
+				continue
+			}
+
+			rel.R.Bookmarks = append(rel.R.Bookmarks, o)
+
+			o.R.Tweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the bookmark's User into the .R struct
+func (o *Bookmark) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Bookmarks = BookmarkSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the bookmark's User into the .R struct
+func (os BookmarkSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Bookmarks = append(rel.R.Bookmarks, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type bookmarkJoins[Q dialect.Joinable] struct {
+	typ   string
+	Tweet modAs[Q, tweetColumns]
+	User  modAs[Q, userColumns]
+}
+
+func (j bookmarkJoins[Q]) aliasedAs(alias string) bookmarkJoins[Q] {
+	return buildBookmarkJoins[Q](buildBookmarkColumns(alias), j.typ)
+}
+
+func buildBookmarkJoins[Q dialect.Joinable](cols bookmarkColumns, typ string) bookmarkJoins[Q] {
+	return bookmarkJoins[Q]{
+		typ: typ,
+		Tweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
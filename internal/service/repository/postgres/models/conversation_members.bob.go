@@ -0,0 +1,1020 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// ConversationMember is an object representing the database table.
+type ConversationMember struct {
+	ConversationID    string              `db:"conversation_id,pk" `
+	UserID            string              `db:"user_id,pk" `
+	LastReadMessageID null.Val[string]    `db:"last_read_message_id" `
+	CreatedAt         null.Val[time.Time] `db:"created_at" `
+
+	R conversationMemberR `db:"-" `
+}
+
+// ConversationMemberSlice is an alias for a slice of pointers to ConversationMember.
+// This should almost always be used instead of []*ConversationMember.
+type ConversationMemberSlice []*ConversationMember
+
+// ConversationMembers contains methods to work with the conversation_members table
+var ConversationMembers = psql.NewTablex[*ConversationMember, ConversationMemberSlice, *ConversationMemberSetter]("", "conversation_members", buildConversationMemberColumns("conversation_members"))
+
+// ConversationMembersQuery is a query on the conversation_members table
+type ConversationMembersQuery = *psql.ViewQuery[*ConversationMember, ConversationMemberSlice]
+
+// conversationMemberR is where relationships are stored.
+type conversationMemberR struct {
+	Conversation           *Conversation // conversation_members.conversation_members_conversation_id_fkey
+	LastReadMessageMessage *Message      // conversation_members.conversation_members_last_read_message_id_fkey
+	User                   *User         // conversation_members.conversation_members_user_id_fkey
+}
+
+func buildConversationMemberColumns(alias string) conversationMemberColumns {
+	return conversationMemberColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"conversation_id", "user_id", "last_read_message_id", "created_at",
+		).WithParent("conversation_members"),
+		tableAlias:        alias,
+		ConversationID:    psql.Quote(alias, "conversation_id"),
+		UserID:            psql.Quote(alias, "user_id"),
+		LastReadMessageID: psql.Quote(alias, "last_read_message_id"),
+		CreatedAt:         psql.Quote(alias, "created_at"),
+	}
+}
+
+type conversationMemberColumns struct {
+	expr.ColumnsExpr
+	tableAlias        string
+	ConversationID    psql.Expression
+	UserID            psql.Expression
+	LastReadMessageID psql.Expression
+	CreatedAt         psql.Expression
+}
+
+func (c conversationMemberColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (conversationMemberColumns) AliasedAs(alias string) conversationMemberColumns {
+	return buildConversationMemberColumns(alias)
+}
+
+// ConversationMemberSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ConversationMemberSetter struct {
+	ConversationID    omit.Val[string]        `db:"conversation_id,pk" `
+	UserID            omit.Val[string]        `db:"user_id,pk" `
+	LastReadMessageID omitnull.Val[string]    `db:"last_read_message_id" `
+	CreatedAt         omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s ConversationMemberSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.ConversationID.IsValue() {
+		vals = append(vals, "conversation_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if !s.LastReadMessageID.IsUnset() {
+		vals = append(vals, "last_read_message_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s ConversationMemberSetter) Overwrite(t *ConversationMember) {
+	if s.ConversationID.IsValue() {
+		t.ConversationID = s.ConversationID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if !s.LastReadMessageID.IsUnset() {
+		t.LastReadMessageID = s.LastReadMessageID.MustGetNull()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *ConversationMemberSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return ConversationMembers.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.ConversationID.IsValue() {
+			vals[0] = psql.Arg(s.ConversationID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastReadMessageID.IsUnset() {
+			vals[2] = psql.Arg(s.LastReadMessageID.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[3] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ConversationMemberSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ConversationMemberSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.ConversationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "conversation_id")...),
+			psql.Arg(s.ConversationID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if !s.LastReadMessageID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_read_message_id")...),
+			psql.Arg(s.LastReadMessageID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindConversationMember retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindConversationMember(ctx context.Context, exec bob.Executor, ConversationIDPK string, UserIDPK string, cols ...string) (*ConversationMember, error) {
+	if len(cols) == 0 {
+		return ConversationMembers.Query(
+			sm.Where(ConversationMembers.Columns.ConversationID.EQ(psql.Arg(ConversationIDPK))),
+			sm.Where(ConversationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		).One(ctx, exec)
+	}
+
+	return ConversationMembers.Query(
+		sm.Where(ConversationMembers.Columns.ConversationID.EQ(psql.Arg(ConversationIDPK))),
+		sm.Where(ConversationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		sm.Columns(ConversationMembers.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ConversationMemberExists checks the presence of a single record by primary key
+func ConversationMemberExists(ctx context.Context, exec bob.Executor, ConversationIDPK string, UserIDPK string) (bool, error) {
+	return ConversationMembers.Query(
+		sm.Where(ConversationMembers.Columns.ConversationID.EQ(psql.Arg(ConversationIDPK))),
+		sm.Where(ConversationMembers.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after ConversationMember is retrieved from the database
+func (o *ConversationMember) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ConversationMembers.AfterSelectHooks.RunHooks(ctx, exec, ConversationMemberSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = ConversationMembers.AfterInsertHooks.RunHooks(ctx, exec, ConversationMemberSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = ConversationMembers.AfterUpdateHooks.RunHooks(ctx, exec, ConversationMemberSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = ConversationMembers.AfterDeleteHooks.RunHooks(ctx, exec, ConversationMemberSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the ConversationMember
+func (o *ConversationMember) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.ConversationID,
+		o.UserID,
+	)
+}
+
+func (o *ConversationMember) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("conversation_members", "conversation_id"), psql.Quote("conversation_members", "user_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the ConversationMember
+func (o *ConversationMember) Update(ctx context.Context, exec bob.Executor, s *ConversationMemberSetter) error {
+	v, err := ConversationMembers.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single ConversationMember record with an executor
+func (o *ConversationMember) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := ConversationMembers.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the ConversationMember using the executor
+func (o *ConversationMember) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := ConversationMembers.Query(
+		sm.Where(ConversationMembers.Columns.ConversationID.EQ(psql.Arg(o.ConversationID))),
+		sm.Where(ConversationMembers.Columns.UserID.EQ(psql.Arg(o.UserID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ConversationMemberSlice is retrieved from the database
+func (o ConversationMemberSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = ConversationMembers.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = ConversationMembers.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = ConversationMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = ConversationMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ConversationMemberSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("conversation_members", "conversation_id"), psql.Quote("conversation_members", "user_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ConversationMemberSlice) copyMatchingRows(from ...*ConversationMember) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ConversationID != old.ConversationID {
+				continue
+			}
+			if new.UserID != old.UserID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ConversationMemberSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ConversationMembers.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ConversationMember:
+				o.copyMatchingRows(retrieved)
+			case []*ConversationMember:
+				o.copyMatchingRows(retrieved...)
+			case ConversationMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ConversationMember or a slice of ConversationMember
+				// then run the AfterUpdateHooks on the slice
+				_, err = ConversationMembers.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ConversationMemberSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return ConversationMembers.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *ConversationMember:
+				o.copyMatchingRows(retrieved)
+			case []*ConversationMember:
+				o.copyMatchingRows(retrieved...)
+			case ConversationMemberSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a ConversationMember or a slice of ConversationMember
+				// then run the AfterDeleteHooks on the slice
+				_, err = ConversationMembers.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ConversationMemberSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ConversationMemberSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ConversationMembers.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ConversationMemberSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := ConversationMembers.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ConversationMemberSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := ConversationMembers.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Conversation starts a query for related objects on conversations
+func (o *ConversationMember) Conversation(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	return Conversations.Query(append(mods,
+		sm.Where(Conversations.Columns.ID.EQ(psql.Arg(o.ConversationID))),
+	)...)
+}
+
+func (os ConversationMemberSlice) Conversation(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	pkConversationID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkConversationID = append(pkConversationID, o.ConversationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkConversationID), "character varying[]")),
+	))
+
+	return Conversations.Query(append(mods,
+		sm.Where(psql.Group(Conversations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// LastReadMessageMessage starts a query for related objects on messages
+func (o *ConversationMember) LastReadMessageMessage(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(Messages.Columns.ID.EQ(psql.Arg(o.LastReadMessageID))),
+	)...)
+}
+
+func (os ConversationMemberSlice) LastReadMessageMessage(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	pkLastReadMessageID := make(pgtypes.Array[null.Val[string]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLastReadMessageID = append(pkLastReadMessageID, o.LastReadMessageID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLastReadMessageID), "character varying[]")),
+	))
+
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(Messages.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *ConversationMember) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os ConversationMemberSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachConversationMemberConversation0(ctx context.Context, exec bob.Executor, count int, conversationMember0 *ConversationMember, conversation1 *Conversation) (*ConversationMember, error) {
+	setter := &ConversationMemberSetter{
+		ConversationID: omit.From(conversation1.ID),
+	}
+
+	err := conversationMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationMemberConversation0: %w", err)
+	}
+
+	return conversationMember0, nil
+}
+
+func (conversationMember0 *ConversationMember) InsertConversation(ctx context.Context, exec bob.Executor, related *ConversationSetter) error {
+	var err error
+
+	conversation1, err := Conversations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachConversationMemberConversation0(ctx, exec, 1, conversationMember0, conversation1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.Conversation = conversation1
+
+	conversation1.R.ConversationMembers = append(conversation1.R.ConversationMembers, conversationMember0)
+
+	return nil
+}
+
+func (conversationMember0 *ConversationMember) AttachConversation(ctx context.Context, exec bob.Executor, conversation1 *Conversation) error {
+	var err error
+
+	_, err = attachConversationMemberConversation0(ctx, exec, 1, conversationMember0, conversation1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.Conversation = conversation1
+
+	conversation1.R.ConversationMembers = append(conversation1.R.ConversationMembers, conversationMember0)
+
+	return nil
+}
+
+func attachConversationMemberLastReadMessageMessage0(ctx context.Context, exec bob.Executor, count int, conversationMember0 *ConversationMember, message1 *Message) (*ConversationMember, error) {
+	setter := &ConversationMemberSetter{
+		LastReadMessageID: omitnull.From(message1.ID),
+	}
+
+	err := conversationMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationMemberLastReadMessageMessage0: %w", err)
+	}
+
+	return conversationMember0, nil
+}
+
+func (conversationMember0 *ConversationMember) InsertLastReadMessageMessage(ctx context.Context, exec bob.Executor, related *MessageSetter) error {
+	var err error
+
+	message1, err := Messages.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachConversationMemberLastReadMessageMessage0(ctx, exec, 1, conversationMember0, message1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.LastReadMessageMessage = message1
+
+	message1.R.LastReadMessageConversationMembers = append(message1.R.LastReadMessageConversationMembers, conversationMember0)
+
+	return nil
+}
+
+func (conversationMember0 *ConversationMember) AttachLastReadMessageMessage(ctx context.Context, exec bob.Executor, message1 *Message) error {
+	var err error
+
+	_, err = attachConversationMemberLastReadMessageMessage0(ctx, exec, 1, conversationMember0, message1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.LastReadMessageMessage = message1
+
+	message1.R.LastReadMessageConversationMembers = append(message1.R.LastReadMessageConversationMembers, conversationMember0)
+
+	return nil
+}
+
+func attachConversationMemberUser0(ctx context.Context, exec bob.Executor, count int, conversationMember0 *ConversationMember, user1 *User) (*ConversationMember, error) {
+	setter := &ConversationMemberSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := conversationMember0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationMemberUser0: %w", err)
+	}
+
+	return conversationMember0, nil
+}
+
+func (conversationMember0 *ConversationMember) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachConversationMemberUser0(ctx, exec, 1, conversationMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.User = user1
+
+	user1.R.ConversationMembers = append(user1.R.ConversationMembers, conversationMember0)
+
+	return nil
+}
+
+func (conversationMember0 *ConversationMember) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachConversationMemberUser0(ctx, exec, 1, conversationMember0, user1)
+	if err != nil {
+		return err
+	}
+
+	conversationMember0.R.User = user1
+
+	user1.R.ConversationMembers = append(user1.R.ConversationMembers, conversationMember0)
+
+	return nil
+}
+
+type conversationMemberWhere[Q psql.Filterable] struct {
+	ConversationID    psql.WhereMod[Q, string]
+	UserID            psql.WhereMod[Q, string]
+	LastReadMessageID psql.WhereNullMod[Q, string]
+	CreatedAt         psql.WhereNullMod[Q, time.Time]
+}
+
+func (conversationMemberWhere[Q]) AliasedAs(alias string) conversationMemberWhere[Q] {
+	return buildConversationMemberWhere[Q](buildConversationMemberColumns(alias))
+}
+
+func buildConversationMemberWhere[Q psql.Filterable](cols conversationMemberColumns) conversationMemberWhere[Q] {
+	return conversationMemberWhere[Q]{
+		ConversationID:    psql.Where[Q, string](cols.ConversationID),
+		UserID:            psql.Where[Q, string](cols.UserID),
+		LastReadMessageID: psql.WhereNull[Q, string](cols.LastReadMessageID),
+		CreatedAt:         psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *ConversationMember) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Conversation":
+		rel, ok := retrieved.(*Conversation)
+		if !ok {
+			return fmt.Errorf("conversationMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Conversation = rel
+
+		if rel != nil {
+			rel.R.ConversationMembers = ConversationMemberSlice{o}
+		}
+		return nil
+	case "LastReadMessageMessage":
+		rel, ok := retrieved.(*Message)
+		if !ok {
+			return fmt.Errorf("conversationMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LastReadMessageMessage = rel
+
+		if rel != nil {
+			rel.R.LastReadMessageConversationMembers = ConversationMemberSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("conversationMember cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.ConversationMembers = ConversationMemberSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("conversationMember has no relationship %q", name)
+	}
+}
+
+type conversationMemberPreloader struct {
+	Conversation           func(...psql.PreloadOption) psql.Preloader
+	LastReadMessageMessage func(...psql.PreloadOption) psql.Preloader
+	User                   func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildConversationMemberPreloader() conversationMemberPreloader {
+	return conversationMemberPreloader{
+		Conversation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Conversation, ConversationSlice](psql.PreloadRel{
+				Name: "Conversation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        ConversationMembers,
+						To:          Conversations,
+						FromColumns: []string{"conversation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Conversations.Columns.Names(), opts...)
+		},
+		LastReadMessageMessage: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Message, MessageSlice](psql.PreloadRel{
+				Name: "LastReadMessageMessage",
+				Sides: []psql.PreloadSide{
+					{
+						From:        ConversationMembers,
+						To:          Messages,
+						FromColumns: []string{"last_read_message_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Messages.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        ConversationMembers,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type conversationMemberThenLoader[Q orm.Loadable] struct {
+	Conversation           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	LastReadMessageMessage func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User                   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildConversationMemberThenLoader[Q orm.Loadable]() conversationMemberThenLoader[Q] {
+	type ConversationLoadInterface interface {
+		LoadConversation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LastReadMessageMessageLoadInterface interface {
+		LoadLastReadMessageMessage(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return conversationMemberThenLoader[Q]{
+		Conversation: thenLoadBuilder[Q](
+			"Conversation",
+			func(ctx context.Context, exec bob.Executor, retrieved ConversationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadConversation(ctx, exec, mods...)
+			},
+		),
+		LastReadMessageMessage: thenLoadBuilder[Q](
+			"LastReadMessageMessage",
+			func(ctx context.Context, exec bob.Executor, retrieved LastReadMessageMessageLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLastReadMessageMessage(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadConversation loads the conversationMember's Conversation into the .R struct
+func (o *ConversationMember) LoadConversation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Conversation = nil
+
+	related, err := o.Conversation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ConversationMembers = ConversationMemberSlice{o}
+
+	o.R.Conversation = related
+	return nil
+}
+
+// LoadConversation loads the conversationMember's Conversation into the .R struct
+func (os ConversationMemberSlice) LoadConversation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversations, err := os.Conversation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversations {
+
+			if !(o.ConversationID == rel.ID) {
+				continue
+			}
+
+			rel.R.ConversationMembers = append(rel.R.ConversationMembers, o)
+
+			o.R.Conversation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadLastReadMessageMessage loads the conversationMember's LastReadMessageMessage into the .R struct
+func (o *ConversationMember) LoadLastReadMessageMessage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.LastReadMessageMessage = nil
+
+	related, err := o.LastReadMessageMessage(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.LastReadMessageConversationMembers = ConversationMemberSlice{o}
+
+	o.R.LastReadMessageMessage = related
+	return nil
+}
+
+// LoadLastReadMessageMessage loads the conversationMember's LastReadMessageMessage into the .R struct
+func (os ConversationMemberSlice) LoadLastReadMessageMessage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.LastReadMessageMessage(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range messages {
+			if !o.LastReadMessageID.IsValue() {
+				continue
+			}
+
+			if !(o.LastReadMessageID.IsValue() && o.LastReadMessageID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.LastReadMessageConversationMembers = append(rel.R.LastReadMessageConversationMembers, o)
+
+			o.R.LastReadMessageMessage = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the conversationMember's User into the .R struct
+func (o *ConversationMember) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ConversationMembers = ConversationMemberSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the conversationMember's User into the .R struct
+func (os ConversationMemberSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.ConversationMembers = append(rel.R.ConversationMembers, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type conversationMemberJoins[Q dialect.Joinable] struct {
+	typ                    string
+	Conversation           modAs[Q, conversationColumns]
+	LastReadMessageMessage modAs[Q, messageColumns]
+	User                   modAs[Q, userColumns]
+}
+
+func (j conversationMemberJoins[Q]) aliasedAs(alias string) conversationMemberJoins[Q] {
+	return buildConversationMemberJoins[Q](buildConversationMemberColumns(alias), j.typ)
+}
+
+func buildConversationMemberJoins[Q dialect.Joinable](cols conversationMemberColumns, typ string) conversationMemberJoins[Q] {
+	return conversationMemberJoins[Q]{
+		typ: typ,
+		Conversation: modAs[Q, conversationColumns]{
+			c: Conversations.Columns,
+			f: func(to conversationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Conversations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ConversationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		LastReadMessageMessage: modAs[Q, messageColumns]{
+			c: Messages.Columns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.LastReadMessageID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
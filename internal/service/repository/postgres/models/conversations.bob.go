@@ -0,0 +1,1019 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Conversation is an object representing the database table.
+type Conversation struct {
+	ID            string              `db:"id,pk" `
+	CreatedAt     null.Val[time.Time] `db:"created_at" `
+	LastMessageID null.Val[string]    `db:"last_message_id" `
+
+	R conversationR `db:"-" `
+}
+
+// ConversationSlice is an alias for a slice of pointers to Conversation.
+// This should almost always be used instead of []*Conversation.
+type ConversationSlice []*Conversation
+
+// Conversations contains methods to work with the conversations table
+var Conversations = psql.NewTablex[*Conversation, ConversationSlice, *ConversationSetter]("", "conversations", buildConversationColumns("conversations"))
+
+// ConversationsQuery is a query on the conversations table
+type ConversationsQuery = *psql.ViewQuery[*Conversation, ConversationSlice]
+
+// conversationR is where relationships are stored.
+type conversationR struct {
+	ConversationMembers ConversationMemberSlice // conversation_members.conversation_members_conversation_id_fkey
+	LastMessageMessage  *Message                // conversations.conversations_last_message_id_fkey
+	Messages            MessageSlice            // messages.messages_conversation_id_fkey
+}
+
+func buildConversationColumns(alias string) conversationColumns {
+	return conversationColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "created_at", "last_message_id",
+		).WithParent("conversations"),
+		tableAlias:    alias,
+		ID:            psql.Quote(alias, "id"),
+		CreatedAt:     psql.Quote(alias, "created_at"),
+		LastMessageID: psql.Quote(alias, "last_message_id"),
+	}
+}
+
+type conversationColumns struct {
+	expr.ColumnsExpr
+	tableAlias    string
+	ID            psql.Expression
+	CreatedAt     psql.Expression
+	LastMessageID psql.Expression
+}
+
+func (c conversationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (conversationColumns) AliasedAs(alias string) conversationColumns {
+	return buildConversationColumns(alias)
+}
+
+// ConversationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type ConversationSetter struct {
+	ID            omit.Val[string]        `db:"id,pk" `
+	CreatedAt     omitnull.Val[time.Time] `db:"created_at" `
+	LastMessageID omitnull.Val[string]    `db:"last_message_id" `
+}
+
+func (s ConversationSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	if !s.LastMessageID.IsUnset() {
+		vals = append(vals, "last_message_id")
+	}
+	return vals
+}
+
+func (s ConversationSetter) Overwrite(t *Conversation) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+	if !s.LastMessageID.IsUnset() {
+		t.LastMessageID = s.LastMessageID.MustGetNull()
+	}
+}
+
+func (s *ConversationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Conversations.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[1] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.LastMessageID.IsUnset() {
+			vals[2] = psql.Arg(s.LastMessageID.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s ConversationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s ConversationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	if !s.LastMessageID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "last_message_id")...),
+			psql.Arg(s.LastMessageID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindConversation retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindConversation(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Conversation, error) {
+	if len(cols) == 0 {
+		return Conversations.Query(
+			sm.Where(Conversations.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Conversations.Query(
+		sm.Where(Conversations.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Conversations.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// ConversationExists checks the presence of a single record by primary key
+func ConversationExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Conversations.Query(
+		sm.Where(Conversations.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Conversation is retrieved from the database
+func (o *Conversation) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Conversations.AfterSelectHooks.RunHooks(ctx, exec, ConversationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Conversations.AfterInsertHooks.RunHooks(ctx, exec, ConversationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Conversations.AfterUpdateHooks.RunHooks(ctx, exec, ConversationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Conversations.AfterDeleteHooks.RunHooks(ctx, exec, ConversationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Conversation
+func (o *Conversation) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Conversation) pkEQ() dialect.Expression {
+	return psql.Quote("conversations", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Conversation
+func (o *Conversation) Update(ctx context.Context, exec bob.Executor, s *ConversationSetter) error {
+	v, err := Conversations.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Conversation record with an executor
+func (o *Conversation) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Conversations.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Conversation using the executor
+func (o *Conversation) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Conversations.Query(
+		sm.Where(Conversations.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after ConversationSlice is retrieved from the database
+func (o ConversationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Conversations.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Conversations.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Conversations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Conversations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o ConversationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("conversations", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o ConversationSlice) copyMatchingRows(from ...*Conversation) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o ConversationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Conversations.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Conversation:
+				o.copyMatchingRows(retrieved)
+			case []*Conversation:
+				o.copyMatchingRows(retrieved...)
+			case ConversationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Conversation or a slice of Conversation
+				// then run the AfterUpdateHooks on the slice
+				_, err = Conversations.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o ConversationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Conversations.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Conversation:
+				o.copyMatchingRows(retrieved)
+			case []*Conversation:
+				o.copyMatchingRows(retrieved...)
+			case ConversationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Conversation or a slice of Conversation
+				// then run the AfterDeleteHooks on the slice
+				_, err = Conversations.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o ConversationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals ConversationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Conversations.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o ConversationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Conversations.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o ConversationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Conversations.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ConversationMembers starts a query for related objects on conversation_members
+func (o *Conversation) ConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	return ConversationMembers.Query(append(mods,
+		sm.Where(ConversationMembers.Columns.ConversationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os ConversationSlice) ConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return ConversationMembers.Query(append(mods,
+		sm.Where(psql.Group(ConversationMembers.Columns.ConversationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// LastMessageMessage starts a query for related objects on messages
+func (o *Conversation) LastMessageMessage(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(Messages.Columns.ID.EQ(psql.Arg(o.LastMessageID))),
+	)...)
+}
+
+func (os ConversationSlice) LastMessageMessage(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	pkLastMessageID := make(pgtypes.Array[null.Val[string]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLastMessageID = append(pkLastMessageID, o.LastMessageID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLastMessageID), "character varying[]")),
+	))
+
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(Messages.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Messages starts a query for related objects on messages
+func (o *Conversation) Messages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(Messages.Columns.ConversationID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os ConversationSlice) Messages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(Messages.Columns.ConversationID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertConversationConversationMembers0(ctx context.Context, exec bob.Executor, conversationMembers1 []*ConversationMemberSetter, conversation0 *Conversation) (ConversationMemberSlice, error) {
+	for i := range conversationMembers1 {
+		conversationMembers1[i].ConversationID = omit.From(conversation0.ID)
+	}
+
+	ret, err := ConversationMembers.Insert(bob.ToMods(conversationMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertConversationConversationMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachConversationConversationMembers0(ctx context.Context, exec bob.Executor, count int, conversationMembers1 ConversationMemberSlice, conversation0 *Conversation) (ConversationMemberSlice, error) {
+	setter := &ConversationMemberSetter{
+		ConversationID: omit.From(conversation0.ID),
+	}
+
+	err := conversationMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationConversationMembers0: %w", err)
+	}
+
+	return conversationMembers1, nil
+}
+
+func (conversation0 *Conversation) InsertConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	conversationMembers1, err := insertConversationConversationMembers0(ctx, exec, related, conversation0)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.ConversationMembers = append(conversation0.R.ConversationMembers, conversationMembers1...)
+
+	for _, rel := range conversationMembers1 {
+		rel.R.Conversation = conversation0
+	}
+	return nil
+}
+
+func (conversation0 *Conversation) AttachConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	conversationMembers1 := ConversationMemberSlice(related)
+
+	_, err = attachConversationConversationMembers0(ctx, exec, len(related), conversationMembers1, conversation0)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.ConversationMembers = append(conversation0.R.ConversationMembers, conversationMembers1...)
+
+	for _, rel := range related {
+		rel.R.Conversation = conversation0
+	}
+
+	return nil
+}
+
+func attachConversationLastMessageMessage0(ctx context.Context, exec bob.Executor, count int, conversation0 *Conversation, message1 *Message) (*Conversation, error) {
+	setter := &ConversationSetter{
+		LastMessageID: omitnull.From(message1.ID),
+	}
+
+	err := conversation0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationLastMessageMessage0: %w", err)
+	}
+
+	return conversation0, nil
+}
+
+func (conversation0 *Conversation) InsertLastMessageMessage(ctx context.Context, exec bob.Executor, related *MessageSetter) error {
+	var err error
+
+	message1, err := Messages.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachConversationLastMessageMessage0(ctx, exec, 1, conversation0, message1)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.LastMessageMessage = message1
+
+	message1.R.LastMessageConversations = append(message1.R.LastMessageConversations, conversation0)
+
+	return nil
+}
+
+func (conversation0 *Conversation) AttachLastMessageMessage(ctx context.Context, exec bob.Executor, message1 *Message) error {
+	var err error
+
+	_, err = attachConversationLastMessageMessage0(ctx, exec, 1, conversation0, message1)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.LastMessageMessage = message1
+
+	message1.R.LastMessageConversations = append(message1.R.LastMessageConversations, conversation0)
+
+	return nil
+}
+
+func insertConversationMessages0(ctx context.Context, exec bob.Executor, messages1 []*MessageSetter, conversation0 *Conversation) (MessageSlice, error) {
+	for i := range messages1 {
+		messages1[i].ConversationID = omit.From(conversation0.ID)
+	}
+
+	ret, err := Messages.Insert(bob.ToMods(messages1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertConversationMessages0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachConversationMessages0(ctx context.Context, exec bob.Executor, count int, messages1 MessageSlice, conversation0 *Conversation) (MessageSlice, error) {
+	setter := &MessageSetter{
+		ConversationID: omit.From(conversation0.ID),
+	}
+
+	err := messages1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachConversationMessages0: %w", err)
+	}
+
+	return messages1, nil
+}
+
+func (conversation0 *Conversation) InsertMessages(ctx context.Context, exec bob.Executor, related ...*MessageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	messages1, err := insertConversationMessages0(ctx, exec, related, conversation0)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.Messages = append(conversation0.R.Messages, messages1...)
+
+	for _, rel := range messages1 {
+		rel.R.Conversation = conversation0
+	}
+	return nil
+}
+
+func (conversation0 *Conversation) AttachMessages(ctx context.Context, exec bob.Executor, related ...*Message) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	messages1 := MessageSlice(related)
+
+	_, err = attachConversationMessages0(ctx, exec, len(related), messages1, conversation0)
+	if err != nil {
+		return err
+	}
+
+	conversation0.R.Messages = append(conversation0.R.Messages, messages1...)
+
+	for _, rel := range related {
+		rel.R.Conversation = conversation0
+	}
+
+	return nil
+}
+
+type conversationWhere[Q psql.Filterable] struct {
+	ID            psql.WhereMod[Q, string]
+	CreatedAt     psql.WhereNullMod[Q, time.Time]
+	LastMessageID psql.WhereNullMod[Q, string]
+}
+
+func (conversationWhere[Q]) AliasedAs(alias string) conversationWhere[Q] {
+	return buildConversationWhere[Q](buildConversationColumns(alias))
+}
+
+func buildConversationWhere[Q psql.Filterable](cols conversationColumns) conversationWhere[Q] {
+	return conversationWhere[Q]{
+		ID:            psql.Where[Q, string](cols.ID),
+		CreatedAt:     psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		LastMessageID: psql.WhereNull[Q, string](cols.LastMessageID),
+	}
+}
+
+func (o *Conversation) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ConversationMembers":
+		rels, ok := retrieved.(ConversationMemberSlice)
+		if !ok {
+			return fmt.Errorf("conversation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ConversationMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Conversation = o
+			}
+		}
+		return nil
+	case "LastMessageMessage":
+		rel, ok := retrieved.(*Message)
+		if !ok {
+			return fmt.Errorf("conversation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LastMessageMessage = rel
+
+		if rel != nil {
+			rel.R.LastMessageConversations = ConversationSlice{o}
+		}
+		return nil
+	case "Messages":
+		rels, ok := retrieved.(MessageSlice)
+		if !ok {
+			return fmt.Errorf("conversation cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Messages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Conversation = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("conversation has no relationship %q", name)
+	}
+}
+
+type conversationPreloader struct {
+	LastMessageMessage func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildConversationPreloader() conversationPreloader {
+	return conversationPreloader{
+		LastMessageMessage: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Message, MessageSlice](psql.PreloadRel{
+				Name: "LastMessageMessage",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Conversations,
+						To:          Messages,
+						FromColumns: []string{"last_message_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Messages.Columns.Names(), opts...)
+		},
+	}
+}
+
+type conversationThenLoader[Q orm.Loadable] struct {
+	ConversationMembers func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	LastMessageMessage  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Messages            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildConversationThenLoader[Q orm.Loadable]() conversationThenLoader[Q] {
+	type ConversationMembersLoadInterface interface {
+		LoadConversationMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LastMessageMessageLoadInterface interface {
+		LoadLastMessageMessage(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MessagesLoadInterface interface {
+		LoadMessages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return conversationThenLoader[Q]{
+		ConversationMembers: thenLoadBuilder[Q](
+			"ConversationMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved ConversationMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadConversationMembers(ctx, exec, mods...)
+			},
+		),
+		LastMessageMessage: thenLoadBuilder[Q](
+			"LastMessageMessage",
+			func(ctx context.Context, exec bob.Executor, retrieved LastMessageMessageLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLastMessageMessage(ctx, exec, mods...)
+			},
+		),
+		Messages: thenLoadBuilder[Q](
+			"Messages",
+			func(ctx context.Context, exec bob.Executor, retrieved MessagesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMessages(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadConversationMembers loads the conversation's ConversationMembers into the .R struct
+func (o *Conversation) LoadConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ConversationMembers = nil
+
+	related, err := o.ConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Conversation = o
+	}
+
+	o.R.ConversationMembers = related
+	return nil
+}
+
+// LoadConversationMembers loads the conversation's ConversationMembers into the .R struct
+func (os ConversationSlice) LoadConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversationMembers, err := os.ConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ConversationMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversationMembers {
+
+			if !(o.ID == rel.ConversationID) {
+				continue
+			}
+
+			rel.R.Conversation = o
+
+			o.R.ConversationMembers = append(o.R.ConversationMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLastMessageMessage loads the conversation's LastMessageMessage into the .R struct
+func (o *Conversation) LoadLastMessageMessage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.LastMessageMessage = nil
+
+	related, err := o.LastMessageMessage(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.LastMessageConversations = ConversationSlice{o}
+
+	o.R.LastMessageMessage = related
+	return nil
+}
+
+// LoadLastMessageMessage loads the conversation's LastMessageMessage into the .R struct
+func (os ConversationSlice) LoadLastMessageMessage(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.LastMessageMessage(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range messages {
+			if !o.LastMessageID.IsValue() {
+				continue
+			}
+
+			if !(o.LastMessageID.IsValue() && o.LastMessageID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.LastMessageConversations = append(rel.R.LastMessageConversations, o)
+
+			o.R.LastMessageMessage = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadMessages loads the conversation's Messages into the .R struct
+func (o *Conversation) LoadMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Messages = nil
+
+	related, err := o.Messages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Conversation = o
+	}
+
+	o.R.Messages = related
+	return nil
+}
+
+// LoadMessages loads the conversation's Messages into the .R struct
+func (os ConversationSlice) LoadMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.Messages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Messages = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range messages {
+
+			if !(o.ID == rel.ConversationID) {
+				continue
+			}
+
+			rel.R.Conversation = o
+
+			o.R.Messages = append(o.R.Messages, rel)
+		}
+	}
+
+	return nil
+}
+
+type conversationJoins[Q dialect.Joinable] struct {
+	typ                 string
+	ConversationMembers modAs[Q, conversationMemberColumns]
+	LastMessageMessage  modAs[Q, messageColumns]
+	Messages            modAs[Q, messageColumns]
+}
+
+func (j conversationJoins[Q]) aliasedAs(alias string) conversationJoins[Q] {
+	return buildConversationJoins[Q](buildConversationColumns(alias), j.typ)
+}
+
+func buildConversationJoins[Q dialect.Joinable](cols conversationColumns, typ string) conversationJoins[Q] {
+	return conversationJoins[Q]{
+		typ: typ,
+		ConversationMembers: modAs[Q, conversationMemberColumns]{
+			c: ConversationMembers.Columns,
+			f: func(to conversationMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ConversationMembers.Name().As(to.Alias())).On(
+						to.ConversationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		LastMessageMessage: modAs[Q, messageColumns]{
+			c: Messages.Columns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.LastMessageID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Messages: modAs[Q, messageColumns]{
+			c: Messages.Columns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.ConversationID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,498 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type BlockMod interface {
+	Apply(context.Context, *BlockTemplate)
+}
+
+type BlockModFunc func(context.Context, *BlockTemplate)
+
+func (f BlockModFunc) Apply(ctx context.Context, n *BlockTemplate) {
+	f(ctx, n)
+}
+
+type BlockModSlice []BlockMod
+
+func (mods BlockModSlice) Apply(ctx context.Context, n *BlockTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// BlockTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type BlockTemplate struct {
+	BlockerID func() string
+	BlockedID func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r blockR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type blockR struct {
+	BlockedUser *blockRBlockedUserR
+	BlockerUser *blockRBlockerUserR
+}
+
+type blockRBlockedUserR struct {
+	o *UserTemplate
+}
+type blockRBlockerUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the BlockTemplate
+func (o *BlockTemplate) Apply(ctx context.Context, mods ...BlockMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Block
+// according to the relationships in the template. Nothing is inserted into the db
+func (t BlockTemplate) setModelRels(o *models.Block) {
+	if t.r.BlockedUser != nil {
+		rel := t.r.BlockedUser.o.Build()
+		rel.R.BlockedBlocks = append(rel.R.BlockedBlocks, o)
+		o.BlockedID = rel.ID // h2
+		o.R.BlockedUser = rel
+	}
+
+	if t.r.BlockerUser != nil {
+		rel := t.r.BlockerUser.o.Build()
+		rel.R.BlockerBlocks = append(rel.R.BlockerBlocks, o)
+		o.BlockerID = rel.ID // h2
+		o.R.BlockerUser = rel
+	}
+}
+
+// BuildSetter returns an *models.BlockSetter
+// this does nothing with the relationship templates
+func (o BlockTemplate) BuildSetter() *models.BlockSetter {
+	m := &models.BlockSetter{}
+
+	if o.BlockerID != nil {
+		val := o.BlockerID()
+		m.BlockerID = omit.From(val)
+	}
+	if o.BlockedID != nil {
+		val := o.BlockedID()
+		m.BlockedID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.BlockSetter
+// this does nothing with the relationship templates
+func (o BlockTemplate) BuildManySetter(number int) []*models.BlockSetter {
+	m := make([]*models.BlockSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Block
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use BlockTemplate.Create
+func (o BlockTemplate) Build() *models.Block {
+	m := &models.Block{}
+
+	if o.BlockerID != nil {
+		m.BlockerID = o.BlockerID()
+	}
+	if o.BlockedID != nil {
+		m.BlockedID = o.BlockedID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.BlockSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use BlockTemplate.CreateMany
+func (o BlockTemplate) BuildMany(number int) models.BlockSlice {
+	m := make(models.BlockSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableBlock(m *models.BlockSetter) {
+	if !(m.BlockerID.IsValue()) {
+		val := random_string(nil, "36")
+		m.BlockerID = omit.From(val)
+	}
+	if !(m.BlockedID.IsValue()) {
+		val := random_string(nil, "36")
+		m.BlockedID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Block
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *BlockTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Block) error {
+	var err error
+
+	return err
+}
+
+// Create builds a block and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *BlockTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Block, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableBlock(opt)
+
+	if o.r.BlockedUser == nil {
+		BlockMods.WithNewBlockedUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.BlockedUser.o.alreadyPersisted {
+		rel0 = o.r.BlockedUser.o.Build()
+	} else {
+		rel0, err = o.r.BlockedUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.BlockedID = omit.From(rel0.ID)
+
+	if o.r.BlockerUser == nil {
+		BlockMods.WithNewBlockerUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.BlockerUser.o.alreadyPersisted {
+		rel1 = o.r.BlockerUser.o.Build()
+	} else {
+		rel1, err = o.r.BlockerUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.BlockerID = omit.From(rel1.ID)
+
+	m, err := models.Blocks.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.BlockedUser = rel0
+	m.R.BlockerUser = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a block and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *BlockTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Block {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a block and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *BlockTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Block {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple blocks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o BlockTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.BlockSlice, error) {
+	var err error
+	m := make(models.BlockSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple blocks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o BlockTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.BlockSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple blocks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o BlockTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.BlockSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Block has methods that act as mods for the BlockTemplate
+var BlockMods blockMods
+
+type blockMods struct{}
+
+func (m blockMods) RandomizeAllColumns(f *faker.Faker) BlockMod {
+	return BlockModSlice{
+		BlockMods.RandomBlockerID(f),
+		BlockMods.RandomBlockedID(f),
+		BlockMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m blockMods) BlockerID(val string) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockerID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m blockMods) BlockerIDFunc(f func() string) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockerID = f
+	})
+}
+
+// Clear any values for the column
+func (m blockMods) UnsetBlockerID() BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockerID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m blockMods) RandomBlockerID(f *faker.Faker) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockerID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m blockMods) BlockedID(val string) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockedID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m blockMods) BlockedIDFunc(f func() string) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockedID = f
+	})
+}
+
+// Clear any values for the column
+func (m blockMods) UnsetBlockedID() BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockedID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m blockMods) RandomBlockedID(f *faker.Faker) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.BlockedID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m blockMods) CreatedAt(val null.Val[time.Time]) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m blockMods) CreatedAtFunc(f func() null.Val[time.Time]) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m blockMods) UnsetCreatedAt() BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m blockMods) RandomCreatedAt(f *faker.Faker) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m blockMods) RandomCreatedAtNotNull(f *faker.Faker) BlockMod {
+	return BlockModFunc(func(_ context.Context, o *BlockTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m blockMods) WithParentsCascading() BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		if isDone, _ := blockWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = blockWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithBlockedUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithBlockerUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m blockMods) WithBlockedUser(rel *UserTemplate) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockedUser = &blockRBlockedUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m blockMods) WithNewBlockedUser(mods ...UserMod) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithBlockedUser(related).Apply(ctx, o)
+	})
+}
+
+func (m blockMods) WithExistingBlockedUser(em *models.User) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockedUser = &blockRBlockedUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m blockMods) WithoutBlockedUser() BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockedUser = nil
+	})
+}
+
+func (m blockMods) WithBlockerUser(rel *UserTemplate) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockerUser = &blockRBlockerUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m blockMods) WithNewBlockerUser(mods ...UserMod) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithBlockerUser(related).Apply(ctx, o)
+	})
+}
+
+func (m blockMods) WithExistingBlockerUser(em *models.User) BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockerUser = &blockRBlockerUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m blockMods) WithoutBlockerUser() BlockMod {
+	return BlockModFunc(func(ctx context.Context, o *BlockTemplate) {
+		o.r.BlockerUser = nil
+	})
+}
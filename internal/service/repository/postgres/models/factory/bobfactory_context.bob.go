@@ -8,16 +8,102 @@ import "context"
 type contextKey string
 
 var (
+	// Relationship Contexts for blocks
+	blockWithParentsCascadingCtx = newContextual[bool]("blockWithParentsCascading")
+	blockRelBlockedUserCtx       = newContextual[bool]("blocks.users.blocks.blocks_blocked_id_fkey")
+	blockRelBlockerUserCtx       = newContextual[bool]("blocks.users.blocks.blocks_blocker_id_fkey")
+
+	// Relationship Contexts for bookmarks
+	bookmarkWithParentsCascadingCtx = newContextual[bool]("bookmarkWithParentsCascading")
+	bookmarkRelTweetCtx             = newContextual[bool]("bookmarks.tweets.bookmarks.bookmarks_tweet_id_fkey")
+	bookmarkRelUserCtx              = newContextual[bool]("bookmarks.users.bookmarks.bookmarks_user_id_fkey")
+
+	// Relationship Contexts for conversation_members
+	conversationMemberWithParentsCascadingCtx      = newContextual[bool]("conversationMemberWithParentsCascading")
+	conversationMemberRelConversationCtx           = newContextual[bool]("conversation_members.conversations.conversation_members.conversation_members_conversation_id_fkey")
+	conversationMemberRelLastReadMessageMessageCtx = newContextual[bool]("conversation_members.messages.conversation_members.conversation_members_last_read_message_id_fkey")
+	conversationMemberRelUserCtx                   = newContextual[bool]("conversation_members.users.conversation_members.conversation_members_user_id_fkey")
+
+	// Relationship Contexts for conversations
+	conversationWithParentsCascadingCtx   = newContextual[bool]("conversationWithParentsCascading")
+	conversationRelConversationMembersCtx = newContextual[bool]("conversation_members.conversations.conversation_members.conversation_members_conversation_id_fkey")
+	conversationRelLastMessageMessageCtx  = newContextual[bool]("conversations.messages.conversations.conversations_last_message_id_fkey")
+	conversationRelMessagesCtx            = newContextual[bool]("conversations.messages.messages.messages_conversation_id_fkey")
+
+	// Relationship Contexts for follows
+	followWithParentsCascadingCtx = newContextual[bool]("followWithParentsCascading")
+	followRelFolloweeUserCtx      = newContextual[bool]("follows.users.follows.follows_followee_id_fkey")
+	followRelFollowerUserCtx      = newContextual[bool]("follows.users.follows.follows_follower_id_fkey")
+
+	// Relationship Contexts for hashtags
+	hashtagWithParentsCascadingCtx = newContextual[bool]("hashtagWithParentsCascading")
+	hashtagRelTweetsCtx            = newContextual[bool]("hashtags.tweets.tweet_hashtags.tweet_hashtags_hashtag_id_fkeytweet_hashtags.tweet_hashtags_tweet_id_fkey")
+
+	// Relationship Contexts for likes
+	likeWithParentsCascadingCtx = newContextual[bool]("likeWithParentsCascading")
+	likeRelTweetCtx             = newContextual[bool]("likes.tweets.likes.likes_tweet_id_fkey")
+	likeRelUserCtx              = newContextual[bool]("likes.users.likes.likes_user_id_fkey")
+
+	// Relationship Contexts for mentions
+	mentionWithParentsCascadingCtx = newContextual[bool]("mentionWithParentsCascading")
+	mentionRelTweetCtx             = newContextual[bool]("mentions.tweets.mentions.mentions_tweet_id_fkey")
+	mentionRelUserCtx              = newContextual[bool]("mentions.users.mentions.mentions_user_id_fkey")
+
+	// Relationship Contexts for messages
+	messageWithParentsCascadingCtx                  = newContextual[bool]("messageWithParentsCascading")
+	messageRelLastReadMessageConversationMembersCtx = newContextual[bool]("conversation_members.messages.conversation_members.conversation_members_last_read_message_id_fkey")
+	messageRelLastMessageConversationsCtx           = newContextual[bool]("conversations.messages.conversations.conversations_last_message_id_fkey")
+	messageRelConversationCtx                       = newContextual[bool]("conversations.messages.messages.messages_conversation_id_fkey")
+	messageRelSenderUserCtx                         = newContextual[bool]("messages.users.messages.messages_sender_id_fkey")
+
+	// Relationship Contexts for mutes
+	muteWithParentsCascadingCtx = newContextual[bool]("muteWithParentsCascading")
+	muteRelMutedUserCtx         = newContextual[bool]("mutes.users.mutes.mutes_muted_id_fkey")
+	muteRelMuterUserCtx         = newContextual[bool]("mutes.users.mutes.mutes_muter_id_fkey")
+
+	// Relationship Contexts for notifications
+	notificationWithParentsCascadingCtx = newContextual[bool]("notificationWithParentsCascading")
+	notificationRelActorUserCtx         = newContextual[bool]("notifications.users.notifications.notifications_actor_id_fkey")
+	notificationRelTweetCtx             = newContextual[bool]("notifications.tweets.notifications.notifications_tweet_id_fkey")
+	notificationRelUserCtx              = newContextual[bool]("notifications.users.notifications.notifications_user_id_fkey")
+
 	// Relationship Contexts for schema_migrations
 	schemaMigrationWithParentsCascadingCtx = newContextual[bool]("schemaMigrationWithParentsCascading")
 
+	// Relationship Contexts for tweet_hashtags
+	tweetHashtagWithParentsCascadingCtx = newContextual[bool]("tweetHashtagWithParentsCascading")
+	tweetHashtagRelHashtagCtx           = newContextual[bool]("hashtags.tweet_hashtags.tweet_hashtags.tweet_hashtags_hashtag_id_fkey")
+	tweetHashtagRelTweetCtx             = newContextual[bool]("tweet_hashtags.tweets.tweet_hashtags.tweet_hashtags_tweet_id_fkey")
+
 	// Relationship Contexts for tweets
-	tweetWithParentsCascadingCtx = newContextual[bool]("tweetWithParentsCascading")
-	tweetRelUserCtx              = newContextual[bool]("tweets.users.tweets.tweets_user_id_fkey")
+	tweetWithParentsCascadingCtx       = newContextual[bool]("tweetWithParentsCascading")
+	tweetRelBookmarksCtx               = newContextual[bool]("bookmarks.tweets.bookmarks.bookmarks_tweet_id_fkey")
+	tweetRelLikesCtx                   = newContextual[bool]("likes.tweets.likes.likes_tweet_id_fkey")
+	tweetRelMentionsCtx                = newContextual[bool]("mentions.tweets.mentions.mentions_tweet_id_fkey")
+	tweetRelNotificationsCtx           = newContextual[bool]("notifications.tweets.notifications.notifications_tweet_id_fkey")
+	tweetRelHashtagsCtx                = newContextual[bool]("hashtags.tweets.tweet_hashtags.tweet_hashtags_hashtag_id_fkeytweet_hashtags.tweet_hashtags_tweet_id_fkey")
+	tweetRelInReplyToTweetCtx          = newContextual[bool]("tweets.tweets.tweets.tweets_in_reply_to_tweet_id_fkey")
+	tweetRelReverseInReplyToTweetsCtx  = newContextual[bool]("tweets.tweets.tweets.tweets_in_reply_to_tweet_id_fkey")
+	tweetRelReferencedTweetCtx         = newContextual[bool]("tweets.tweets.tweets.tweets_referenced_tweet_id_fkey")
+	tweetRelReverseReferencedTweetsCtx = newContextual[bool]("tweets.tweets.tweets.tweets_referenced_tweet_id_fkey")
+	tweetRelUserCtx                    = newContextual[bool]("tweets.users.tweets.tweets_user_id_fkey")
 
 	// Relationship Contexts for users
-	userWithParentsCascadingCtx = newContextual[bool]("userWithParentsCascading")
-	userRelTweetsCtx            = newContextual[bool]("tweets.users.tweets.tweets_user_id_fkey")
+	userWithParentsCascadingCtx   = newContextual[bool]("userWithParentsCascading")
+	userRelBlockedBlocksCtx       = newContextual[bool]("blocks.users.blocks.blocks_blocked_id_fkey")
+	userRelBlockerBlocksCtx       = newContextual[bool]("blocks.users.blocks.blocks_blocker_id_fkey")
+	userRelBookmarksCtx           = newContextual[bool]("bookmarks.users.bookmarks.bookmarks_user_id_fkey")
+	userRelConversationMembersCtx = newContextual[bool]("conversation_members.users.conversation_members.conversation_members_user_id_fkey")
+	userRelFolloweeFollowsCtx     = newContextual[bool]("follows.users.follows.follows_followee_id_fkey")
+	userRelFollowerFollowsCtx     = newContextual[bool]("follows.users.follows.follows_follower_id_fkey")
+	userRelLikesCtx               = newContextual[bool]("likes.users.likes.likes_user_id_fkey")
+	userRelMentionsCtx            = newContextual[bool]("mentions.users.mentions.mentions_user_id_fkey")
+	userRelSenderMessagesCtx      = newContextual[bool]("messages.users.messages.messages_sender_id_fkey")
+	userRelMutedMutesCtx          = newContextual[bool]("mutes.users.mutes.mutes_muted_id_fkey")
+	userRelMuterMutesCtx          = newContextual[bool]("mutes.users.mutes.mutes_muter_id_fkey")
+	userRelActorNotificationsCtx  = newContextual[bool]("notifications.users.notifications.notifications_actor_id_fkey")
+	userRelNotificationsCtx       = newContextual[bool]("notifications.users.notifications.notifications_user_id_fkey")
+	userRelTweetsCtx              = newContextual[bool]("tweets.users.tweets.tweets_user_id_fkey")
 )
 
 // Contextual is a convienience wrapper around context.WithValue and context.Value
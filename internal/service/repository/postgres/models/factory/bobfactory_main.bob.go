@@ -12,15 +12,423 @@ import (
 )
 
 type Factory struct {
-	baseSchemaMigrationMods SchemaMigrationModSlice
-	baseTweetMods           TweetModSlice
-	baseUserMods            UserModSlice
+	baseBlockMods              BlockModSlice
+	baseBookmarkMods           BookmarkModSlice
+	baseConversationMemberMods ConversationMemberModSlice
+	baseConversationMods       ConversationModSlice
+	baseFollowMods             FollowModSlice
+	baseHashtagMods            HashtagModSlice
+	baseLikeMods               LikeModSlice
+	baseMentionMods            MentionModSlice
+	baseMessageMods            MessageModSlice
+	baseMuteMods               MuteModSlice
+	baseNotificationMods       NotificationModSlice
+	baseSchemaMigrationMods    SchemaMigrationModSlice
+	baseTweetHashtagMods       TweetHashtagModSlice
+	baseTweetMods              TweetModSlice
+	baseUserMods               UserModSlice
 }
 
 func New() *Factory {
 	return &Factory{}
 }
 
+func (f *Factory) NewBlock(mods ...BlockMod) *BlockTemplate {
+	return f.NewBlockWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBlockWithContext(ctx context.Context, mods ...BlockMod) *BlockTemplate {
+	o := &BlockTemplate{f: f}
+
+	if f != nil {
+		f.baseBlockMods.Apply(ctx, o)
+	}
+
+	BlockModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBlock(m *models.Block) *BlockTemplate {
+	o := &BlockTemplate{f: f, alreadyPersisted: true}
+
+	o.BlockerID = func() string { return m.BlockerID }
+	o.BlockedID = func() string { return m.BlockedID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.BlockedUser != nil {
+		BlockMods.WithExistingBlockedUser(m.R.BlockedUser).Apply(ctx, o)
+	}
+	if m.R.BlockerUser != nil {
+		BlockMods.WithExistingBlockerUser(m.R.BlockerUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewBookmark(mods ...BookmarkMod) *BookmarkTemplate {
+	return f.NewBookmarkWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewBookmarkWithContext(ctx context.Context, mods ...BookmarkMod) *BookmarkTemplate {
+	o := &BookmarkTemplate{f: f}
+
+	if f != nil {
+		f.baseBookmarkMods.Apply(ctx, o)
+	}
+
+	BookmarkModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingBookmark(m *models.Bookmark) *BookmarkTemplate {
+	o := &BookmarkTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.TweetID = func() string { return m.TweetID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Tweet != nil {
+		BookmarkMods.WithExistingTweet(m.R.Tweet).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		BookmarkMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewConversationMember(mods ...ConversationMemberMod) *ConversationMemberTemplate {
+	return f.NewConversationMemberWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewConversationMemberWithContext(ctx context.Context, mods ...ConversationMemberMod) *ConversationMemberTemplate {
+	o := &ConversationMemberTemplate{f: f}
+
+	if f != nil {
+		f.baseConversationMemberMods.Apply(ctx, o)
+	}
+
+	ConversationMemberModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingConversationMember(m *models.ConversationMember) *ConversationMemberTemplate {
+	o := &ConversationMemberTemplate{f: f, alreadyPersisted: true}
+
+	o.ConversationID = func() string { return m.ConversationID }
+	o.UserID = func() string { return m.UserID }
+	o.LastReadMessageID = func() null.Val[string] { return m.LastReadMessageID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Conversation != nil {
+		ConversationMemberMods.WithExistingConversation(m.R.Conversation).Apply(ctx, o)
+	}
+	if m.R.LastReadMessageMessage != nil {
+		ConversationMemberMods.WithExistingLastReadMessageMessage(m.R.LastReadMessageMessage).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		ConversationMemberMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewConversation(mods ...ConversationMod) *ConversationTemplate {
+	return f.NewConversationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewConversationWithContext(ctx context.Context, mods ...ConversationMod) *ConversationTemplate {
+	o := &ConversationTemplate{f: f}
+
+	if f != nil {
+		f.baseConversationMods.Apply(ctx, o)
+	}
+
+	ConversationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingConversation(m *models.Conversation) *ConversationTemplate {
+	o := &ConversationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+	o.LastMessageID = func() null.Val[string] { return m.LastMessageID }
+
+	ctx := context.Background()
+	if len(m.R.ConversationMembers) > 0 {
+		ConversationMods.AddExistingConversationMembers(m.R.ConversationMembers...).Apply(ctx, o)
+	}
+	if m.R.LastMessageMessage != nil {
+		ConversationMods.WithExistingLastMessageMessage(m.R.LastMessageMessage).Apply(ctx, o)
+	}
+	if len(m.R.Messages) > 0 {
+		ConversationMods.AddExistingMessages(m.R.Messages...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewFollow(mods ...FollowMod) *FollowTemplate {
+	return f.NewFollowWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewFollowWithContext(ctx context.Context, mods ...FollowMod) *FollowTemplate {
+	o := &FollowTemplate{f: f}
+
+	if f != nil {
+		f.baseFollowMods.Apply(ctx, o)
+	}
+
+	FollowModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingFollow(m *models.Follow) *FollowTemplate {
+	o := &FollowTemplate{f: f, alreadyPersisted: true}
+
+	o.FollowerID = func() string { return m.FollowerID }
+	o.FolloweeID = func() string { return m.FolloweeID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.FolloweeUser != nil {
+		FollowMods.WithExistingFolloweeUser(m.R.FolloweeUser).Apply(ctx, o)
+	}
+	if m.R.FollowerUser != nil {
+		FollowMods.WithExistingFollowerUser(m.R.FollowerUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewHashtag(mods ...HashtagMod) *HashtagTemplate {
+	return f.NewHashtagWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewHashtagWithContext(ctx context.Context, mods ...HashtagMod) *HashtagTemplate {
+	o := &HashtagTemplate{f: f}
+
+	if f != nil {
+		f.baseHashtagMods.Apply(ctx, o)
+	}
+
+	HashtagModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingHashtag(m *models.Hashtag) *HashtagTemplate {
+	o := &HashtagTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.Tag = func() string { return m.Tag }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.Tweets) > 0 {
+		HashtagMods.AddExistingTweets(m.R.Tweets...).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewLike(mods ...LikeMod) *LikeTemplate {
+	return f.NewLikeWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewLikeWithContext(ctx context.Context, mods ...LikeMod) *LikeTemplate {
+	o := &LikeTemplate{f: f}
+
+	if f != nil {
+		f.baseLikeMods.Apply(ctx, o)
+	}
+
+	LikeModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingLike(m *models.Like) *LikeTemplate {
+	o := &LikeTemplate{f: f, alreadyPersisted: true}
+
+	o.UserID = func() string { return m.UserID }
+	o.TweetID = func() string { return m.TweetID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.Tweet != nil {
+		LikeMods.WithExistingTweet(m.R.Tweet).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		LikeMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewMention(mods ...MentionMod) *MentionTemplate {
+	return f.NewMentionWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewMentionWithContext(ctx context.Context, mods ...MentionMod) *MentionTemplate {
+	o := &MentionTemplate{f: f}
+
+	if f != nil {
+		f.baseMentionMods.Apply(ctx, o)
+	}
+
+	MentionModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingMention(m *models.Mention) *MentionTemplate {
+	o := &MentionTemplate{f: f, alreadyPersisted: true}
+
+	o.TweetID = func() string { return m.TweetID }
+	o.UserID = func() string { return m.UserID }
+	o.StartOffset = func() int32 { return m.StartOffset }
+	o.EndOffset = func() int32 { return m.EndOffset }
+
+	ctx := context.Background()
+	if m.R.Tweet != nil {
+		MentionMods.WithExistingTweet(m.R.Tweet).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		MentionMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewMessage(mods ...MessageMod) *MessageTemplate {
+	return f.NewMessageWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewMessageWithContext(ctx context.Context, mods ...MessageMod) *MessageTemplate {
+	o := &MessageTemplate{f: f}
+
+	if f != nil {
+		f.baseMessageMods.Apply(ctx, o)
+	}
+
+	MessageModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingMessage(m *models.Message) *MessageTemplate {
+	o := &MessageTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.ConversationID = func() string { return m.ConversationID }
+	o.SenderID = func() string { return m.SenderID }
+	o.Content = func() string { return m.Content }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if len(m.R.LastReadMessageConversationMembers) > 0 {
+		MessageMods.AddExistingLastReadMessageConversationMembers(m.R.LastReadMessageConversationMembers...).Apply(ctx, o)
+	}
+	if len(m.R.LastMessageConversations) > 0 {
+		MessageMods.AddExistingLastMessageConversations(m.R.LastMessageConversations...).Apply(ctx, o)
+	}
+	if m.R.Conversation != nil {
+		MessageMods.WithExistingConversation(m.R.Conversation).Apply(ctx, o)
+	}
+	if m.R.SenderUser != nil {
+		MessageMods.WithExistingSenderUser(m.R.SenderUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewMute(mods ...MuteMod) *MuteTemplate {
+	return f.NewMuteWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewMuteWithContext(ctx context.Context, mods ...MuteMod) *MuteTemplate {
+	o := &MuteTemplate{f: f}
+
+	if f != nil {
+		f.baseMuteMods.Apply(ctx, o)
+	}
+
+	MuteModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingMute(m *models.Mute) *MuteTemplate {
+	o := &MuteTemplate{f: f, alreadyPersisted: true}
+
+	o.MuterID = func() string { return m.MuterID }
+	o.MutedID = func() string { return m.MutedID }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.MutedUser != nil {
+		MuteMods.WithExistingMutedUser(m.R.MutedUser).Apply(ctx, o)
+	}
+	if m.R.MuterUser != nil {
+		MuteMods.WithExistingMuterUser(m.R.MuterUser).Apply(ctx, o)
+	}
+
+	return o
+}
+
+func (f *Factory) NewNotification(mods ...NotificationMod) *NotificationTemplate {
+	return f.NewNotificationWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewNotificationWithContext(ctx context.Context, mods ...NotificationMod) *NotificationTemplate {
+	o := &NotificationTemplate{f: f}
+
+	if f != nil {
+		f.baseNotificationMods.Apply(ctx, o)
+	}
+
+	NotificationModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingNotification(m *models.Notification) *NotificationTemplate {
+	o := &NotificationTemplate{f: f, alreadyPersisted: true}
+
+	o.ID = func() string { return m.ID }
+	o.UserID = func() string { return m.UserID }
+	o.ActorID = func() string { return m.ActorID }
+	o.Type = func() string { return m.Type }
+	o.TweetID = func() null.Val[string] { return m.TweetID }
+	o.GroupKey = func() string { return m.GroupKey }
+	o.Read = func() bool { return m.Read }
+	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
+
+	ctx := context.Background()
+	if m.R.ActorUser != nil {
+		NotificationMods.WithExistingActorUser(m.R.ActorUser).Apply(ctx, o)
+	}
+	if m.R.Tweet != nil {
+		NotificationMods.WithExistingTweet(m.R.Tweet).Apply(ctx, o)
+	}
+	if m.R.User != nil {
+		NotificationMods.WithExistingUser(m.R.User).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewSchemaMigration(mods ...SchemaMigrationMod) *SchemaMigrationTemplate {
 	return f.NewSchemaMigrationWithContext(context.Background(), mods...)
 }
@@ -46,6 +454,39 @@ func (f *Factory) FromExistingSchemaMigration(m *models.SchemaMigration) *Schema
 	return o
 }
 
+func (f *Factory) NewTweetHashtag(mods ...TweetHashtagMod) *TweetHashtagTemplate {
+	return f.NewTweetHashtagWithContext(context.Background(), mods...)
+}
+
+func (f *Factory) NewTweetHashtagWithContext(ctx context.Context, mods ...TweetHashtagMod) *TweetHashtagTemplate {
+	o := &TweetHashtagTemplate{f: f}
+
+	if f != nil {
+		f.baseTweetHashtagMods.Apply(ctx, o)
+	}
+
+	TweetHashtagModSlice(mods).Apply(ctx, o)
+
+	return o
+}
+
+func (f *Factory) FromExistingTweetHashtag(m *models.TweetHashtag) *TweetHashtagTemplate {
+	o := &TweetHashtagTemplate{f: f, alreadyPersisted: true}
+
+	o.TweetID = func() string { return m.TweetID }
+	o.HashtagID = func() string { return m.HashtagID }
+
+	ctx := context.Background()
+	if m.R.Hashtag != nil {
+		TweetHashtagMods.WithExistingHashtag(m.R.Hashtag).Apply(ctx, o)
+	}
+	if m.R.Tweet != nil {
+		TweetHashtagMods.WithExistingTweet(m.R.Tweet).Apply(ctx, o)
+	}
+
+	return o
+}
+
 func (f *Factory) NewTweet(mods ...TweetMod) *TweetTemplate {
 	return f.NewTweetWithContext(context.Background(), mods...)
 }
@@ -71,8 +512,40 @@ func (f *Factory) FromExistingTweet(m *models.Tweet) *TweetTemplate {
 	o.DeletedAt = func() null.Val[time.Time] { return m.DeletedAt }
 	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
 	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+	o.Kind = func() string { return m.Kind }
+	o.ReferencedTweetID = func() null.Val[string] { return m.ReferencedTweetID }
+	o.InReplyToTweetID = func() null.Val[string] { return m.InReplyToTweetID }
+	o.ConversationID = func() string { return m.ConversationID }
+	o.SearchVector = func() null.Val[string] { return m.SearchVector }
 
 	ctx := context.Background()
+	if len(m.R.Bookmarks) > 0 {
+		TweetMods.AddExistingBookmarks(m.R.Bookmarks...).Apply(ctx, o)
+	}
+	if len(m.R.Likes) > 0 {
+		TweetMods.AddExistingLikes(m.R.Likes...).Apply(ctx, o)
+	}
+	if len(m.R.Mentions) > 0 {
+		TweetMods.AddExistingMentions(m.R.Mentions...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		TweetMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
+	if len(m.R.Hashtags) > 0 {
+		TweetMods.AddExistingHashtags(m.R.Hashtags...).Apply(ctx, o)
+	}
+	if m.R.InReplyToTweet != nil {
+		TweetMods.WithExistingInReplyToTweet(m.R.InReplyToTweet).Apply(ctx, o)
+	}
+	if len(m.R.ReverseInReplyToTweets) > 0 {
+		TweetMods.AddExistingReverseInReplyToTweets(m.R.ReverseInReplyToTweets...).Apply(ctx, o)
+	}
+	if m.R.ReferencedTweet != nil {
+		TweetMods.WithExistingReferencedTweet(m.R.ReferencedTweet).Apply(ctx, o)
+	}
+	if len(m.R.ReverseReferencedTweets) > 0 {
+		TweetMods.AddExistingReverseReferencedTweets(m.R.ReverseReferencedTweets...).Apply(ctx, o)
+	}
 	if m.R.User != nil {
 		TweetMods.WithExistingUser(m.R.User).Apply(ctx, o)
 	}
@@ -106,8 +579,48 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	o.DeletedAt = func() null.Val[time.Time] { return m.DeletedAt }
 	o.CreatedAt = func() null.Val[time.Time] { return m.CreatedAt }
 	o.UpdatedAt = func() null.Val[time.Time] { return m.UpdatedAt }
+	o.PasswordHash = func() null.Val[string] { return m.PasswordHash }
 
 	ctx := context.Background()
+	if len(m.R.BlockedBlocks) > 0 {
+		UserMods.AddExistingBlockedBlocks(m.R.BlockedBlocks...).Apply(ctx, o)
+	}
+	if len(m.R.BlockerBlocks) > 0 {
+		UserMods.AddExistingBlockerBlocks(m.R.BlockerBlocks...).Apply(ctx, o)
+	}
+	if len(m.R.Bookmarks) > 0 {
+		UserMods.AddExistingBookmarks(m.R.Bookmarks...).Apply(ctx, o)
+	}
+	if len(m.R.ConversationMembers) > 0 {
+		UserMods.AddExistingConversationMembers(m.R.ConversationMembers...).Apply(ctx, o)
+	}
+	if len(m.R.FolloweeFollows) > 0 {
+		UserMods.AddExistingFolloweeFollows(m.R.FolloweeFollows...).Apply(ctx, o)
+	}
+	if len(m.R.FollowerFollows) > 0 {
+		UserMods.AddExistingFollowerFollows(m.R.FollowerFollows...).Apply(ctx, o)
+	}
+	if len(m.R.Likes) > 0 {
+		UserMods.AddExistingLikes(m.R.Likes...).Apply(ctx, o)
+	}
+	if len(m.R.Mentions) > 0 {
+		UserMods.AddExistingMentions(m.R.Mentions...).Apply(ctx, o)
+	}
+	if len(m.R.SenderMessages) > 0 {
+		UserMods.AddExistingSenderMessages(m.R.SenderMessages...).Apply(ctx, o)
+	}
+	if len(m.R.MutedMutes) > 0 {
+		UserMods.AddExistingMutedMutes(m.R.MutedMutes...).Apply(ctx, o)
+	}
+	if len(m.R.MuterMutes) > 0 {
+		UserMods.AddExistingMuterMutes(m.R.MuterMutes...).Apply(ctx, o)
+	}
+	if len(m.R.ActorNotifications) > 0 {
+		UserMods.AddExistingActorNotifications(m.R.ActorNotifications...).Apply(ctx, o)
+	}
+	if len(m.R.Notifications) > 0 {
+		UserMods.AddExistingNotifications(m.R.Notifications...).Apply(ctx, o)
+	}
 	if len(m.R.Tweets) > 0 {
 		UserMods.AddExistingTweets(m.R.Tweets...).Apply(ctx, o)
 	}
@@ -115,6 +628,94 @@ func (f *Factory) FromExistingUser(m *models.User) *UserTemplate {
 	return o
 }
 
+func (f *Factory) ClearBaseBlockMods() {
+	f.baseBlockMods = nil
+}
+
+func (f *Factory) AddBaseBlockMod(mods ...BlockMod) {
+	f.baseBlockMods = append(f.baseBlockMods, mods...)
+}
+
+func (f *Factory) ClearBaseBookmarkMods() {
+	f.baseBookmarkMods = nil
+}
+
+func (f *Factory) AddBaseBookmarkMod(mods ...BookmarkMod) {
+	f.baseBookmarkMods = append(f.baseBookmarkMods, mods...)
+}
+
+func (f *Factory) ClearBaseConversationMemberMods() {
+	f.baseConversationMemberMods = nil
+}
+
+func (f *Factory) AddBaseConversationMemberMod(mods ...ConversationMemberMod) {
+	f.baseConversationMemberMods = append(f.baseConversationMemberMods, mods...)
+}
+
+func (f *Factory) ClearBaseConversationMods() {
+	f.baseConversationMods = nil
+}
+
+func (f *Factory) AddBaseConversationMod(mods ...ConversationMod) {
+	f.baseConversationMods = append(f.baseConversationMods, mods...)
+}
+
+func (f *Factory) ClearBaseFollowMods() {
+	f.baseFollowMods = nil
+}
+
+func (f *Factory) AddBaseFollowMod(mods ...FollowMod) {
+	f.baseFollowMods = append(f.baseFollowMods, mods...)
+}
+
+func (f *Factory) ClearBaseHashtagMods() {
+	f.baseHashtagMods = nil
+}
+
+func (f *Factory) AddBaseHashtagMod(mods ...HashtagMod) {
+	f.baseHashtagMods = append(f.baseHashtagMods, mods...)
+}
+
+func (f *Factory) ClearBaseLikeMods() {
+	f.baseLikeMods = nil
+}
+
+func (f *Factory) AddBaseLikeMod(mods ...LikeMod) {
+	f.baseLikeMods = append(f.baseLikeMods, mods...)
+}
+
+func (f *Factory) ClearBaseMentionMods() {
+	f.baseMentionMods = nil
+}
+
+func (f *Factory) AddBaseMentionMod(mods ...MentionMod) {
+	f.baseMentionMods = append(f.baseMentionMods, mods...)
+}
+
+func (f *Factory) ClearBaseMessageMods() {
+	f.baseMessageMods = nil
+}
+
+func (f *Factory) AddBaseMessageMod(mods ...MessageMod) {
+	f.baseMessageMods = append(f.baseMessageMods, mods...)
+}
+
+func (f *Factory) ClearBaseMuteMods() {
+	f.baseMuteMods = nil
+}
+
+func (f *Factory) AddBaseMuteMod(mods ...MuteMod) {
+	f.baseMuteMods = append(f.baseMuteMods, mods...)
+}
+
+func (f *Factory) ClearBaseNotificationMods() {
+	f.baseNotificationMods = nil
+}
+
+func (f *Factory) AddBaseNotificationMod(mods ...NotificationMod) {
+	f.baseNotificationMods = append(f.baseNotificationMods, mods...)
+}
+
 func (f *Factory) ClearBaseSchemaMigrationMods() {
 	f.baseSchemaMigrationMods = nil
 }
@@ -123,6 +724,14 @@ func (f *Factory) AddBaseSchemaMigrationMod(mods ...SchemaMigrationMod) {
 	f.baseSchemaMigrationMods = append(f.baseSchemaMigrationMods, mods...)
 }
 
+func (f *Factory) ClearBaseTweetHashtagMods() {
+	f.baseTweetHashtagMods = nil
+}
+
+func (f *Factory) AddBaseTweetHashtagMod(mods ...TweetHashtagMod) {
+	f.baseTweetHashtagMods = append(f.baseTweetHashtagMods, mods...)
+}
+
 func (f *Factory) ClearBaseTweetMods() {
 	f.baseTweetMods = nil
 }
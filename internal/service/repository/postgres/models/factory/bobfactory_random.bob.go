@@ -21,6 +21,14 @@ func random_bool(f *faker.Faker, limits ...string) bool {
 	return f.Bool()
 }
 
+func random_int32(f *faker.Faker, limits ...string) int32 {
+	if f == nil {
+		f = &defaultFaker
+	}
+
+	return f.Int32()
+}
+
 func random_int64(f *faker.Faker, limits ...string) int64 {
 	if f == nil {
 		f = &defaultFaker
@@ -0,0 +1,542 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type BookmarkMod interface {
+	Apply(context.Context, *BookmarkTemplate)
+}
+
+type BookmarkModFunc func(context.Context, *BookmarkTemplate)
+
+func (f BookmarkModFunc) Apply(ctx context.Context, n *BookmarkTemplate) {
+	f(ctx, n)
+}
+
+type BookmarkModSlice []BookmarkMod
+
+func (mods BookmarkModSlice) Apply(ctx context.Context, n *BookmarkTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// BookmarkTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type BookmarkTemplate struct {
+	ID        func() string
+	UserID    func() string
+	TweetID   func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r bookmarkR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type bookmarkR struct {
+	Tweet *bookmarkRTweetR
+	User  *bookmarkRUserR
+}
+
+type bookmarkRTweetR struct {
+	o *TweetTemplate
+}
+type bookmarkRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the BookmarkTemplate
+func (o *BookmarkTemplate) Apply(ctx context.Context, mods ...BookmarkMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Bookmark
+// according to the relationships in the template. Nothing is inserted into the db
+func (t BookmarkTemplate) setModelRels(o *models.Bookmark) {
+	if t.r.Tweet != nil {
+		rel := t.r.Tweet.o.Build()
+		rel.R.Bookmarks = append(rel.R.Bookmarks, o)
+		o.TweetID = rel.ID // h2
+		o.R.Tweet = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Bookmarks = append(rel.R.Bookmarks, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.BookmarkSetter
+// this does nothing with the relationship templates
+func (o BookmarkTemplate) BuildSetter() *models.BookmarkSetter {
+	m := &models.BookmarkSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.TweetID != nil {
+		val := o.TweetID()
+		m.TweetID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.BookmarkSetter
+// this does nothing with the relationship templates
+func (o BookmarkTemplate) BuildManySetter(number int) []*models.BookmarkSetter {
+	m := make([]*models.BookmarkSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Bookmark
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use BookmarkTemplate.Create
+func (o BookmarkTemplate) Build() *models.Bookmark {
+	m := &models.Bookmark{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.TweetID != nil {
+		m.TweetID = o.TweetID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.BookmarkSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use BookmarkTemplate.CreateMany
+func (o BookmarkTemplate) BuildMany(number int) models.BookmarkSlice {
+	m := make(models.BookmarkSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableBookmark(m *models.BookmarkSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_string(nil, "36")
+		m.UserID = omit.From(val)
+	}
+	if !(m.TweetID.IsValue()) {
+		val := random_string(nil, "36")
+		m.TweetID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Bookmark
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *BookmarkTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Bookmark) error {
+	var err error
+
+	return err
+}
+
+// Create builds a bookmark and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *BookmarkTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Bookmark, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableBookmark(opt)
+
+	if o.r.Tweet == nil {
+		BookmarkMods.WithNewTweet().Apply(ctx, o)
+	}
+
+	var rel0 *models.Tweet
+
+	if o.r.Tweet.o.alreadyPersisted {
+		rel0 = o.r.Tweet.o.Build()
+	} else {
+		rel0, err = o.r.Tweet.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TweetID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		BookmarkMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.Bookmarks.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Tweet = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a bookmark and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *BookmarkTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Bookmark {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a bookmark and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *BookmarkTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Bookmark {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple bookmarks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o BookmarkTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.BookmarkSlice, error) {
+	var err error
+	m := make(models.BookmarkSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple bookmarks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o BookmarkTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.BookmarkSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple bookmarks and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o BookmarkTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.BookmarkSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Bookmark has methods that act as mods for the BookmarkTemplate
+var BookmarkMods bookmarkMods
+
+type bookmarkMods struct{}
+
+func (m bookmarkMods) RandomizeAllColumns(f *faker.Faker) BookmarkMod {
+	return BookmarkModSlice{
+		BookmarkMods.RandomID(f),
+		BookmarkMods.RandomUserID(f),
+		BookmarkMods.RandomTweetID(f),
+		BookmarkMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m bookmarkMods) ID(val string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m bookmarkMods) IDFunc(f func() string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m bookmarkMods) UnsetID() BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m bookmarkMods) RandomID(f *faker.Faker) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.ID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m bookmarkMods) UserID(val string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.UserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m bookmarkMods) UserIDFunc(f func() string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m bookmarkMods) UnsetUserID() BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m bookmarkMods) RandomUserID(f *faker.Faker) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.UserID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m bookmarkMods) TweetID(val string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.TweetID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m bookmarkMods) TweetIDFunc(f func() string) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.TweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m bookmarkMods) UnsetTweetID() BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.TweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m bookmarkMods) RandomTweetID(f *faker.Faker) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.TweetID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m bookmarkMods) CreatedAt(val null.Val[time.Time]) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m bookmarkMods) CreatedAtFunc(f func() null.Val[time.Time]) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m bookmarkMods) UnsetCreatedAt() BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m bookmarkMods) RandomCreatedAt(f *faker.Faker) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m bookmarkMods) RandomCreatedAtNotNull(f *faker.Faker) BookmarkMod {
+	return BookmarkModFunc(func(_ context.Context, o *BookmarkTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m bookmarkMods) WithParentsCascading() BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		if isDone, _ := bookmarkWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = bookmarkWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithTweet(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m bookmarkMods) WithTweet(rel *TweetTemplate) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.Tweet = &bookmarkRTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m bookmarkMods) WithNewTweet(mods ...TweetMod) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m bookmarkMods) WithExistingTweet(em *models.Tweet) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.Tweet = &bookmarkRTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m bookmarkMods) WithoutTweet() BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.Tweet = nil
+	})
+}
+
+func (m bookmarkMods) WithUser(rel *UserTemplate) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.User = &bookmarkRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m bookmarkMods) WithNewUser(mods ...UserMod) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m bookmarkMods) WithExistingUser(em *models.User) BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.User = &bookmarkRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m bookmarkMods) WithoutUser() BookmarkMod {
+	return BookmarkModFunc(func(ctx context.Context, o *BookmarkTemplate) {
+		o.r.User = nil
+	})
+}
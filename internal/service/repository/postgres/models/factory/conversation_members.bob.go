@@ -0,0 +1,625 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ConversationMemberMod interface {
+	Apply(context.Context, *ConversationMemberTemplate)
+}
+
+type ConversationMemberModFunc func(context.Context, *ConversationMemberTemplate)
+
+func (f ConversationMemberModFunc) Apply(ctx context.Context, n *ConversationMemberTemplate) {
+	f(ctx, n)
+}
+
+type ConversationMemberModSlice []ConversationMemberMod
+
+func (mods ConversationMemberModSlice) Apply(ctx context.Context, n *ConversationMemberTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ConversationMemberTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ConversationMemberTemplate struct {
+	ConversationID    func() string
+	UserID            func() string
+	LastReadMessageID func() null.Val[string]
+	CreatedAt         func() null.Val[time.Time]
+
+	r conversationMemberR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type conversationMemberR struct {
+	Conversation           *conversationMemberRConversationR
+	LastReadMessageMessage *conversationMemberRLastReadMessageMessageR
+	User                   *conversationMemberRUserR
+}
+
+type conversationMemberRConversationR struct {
+	o *ConversationTemplate
+}
+type conversationMemberRLastReadMessageMessageR struct {
+	o *MessageTemplate
+}
+type conversationMemberRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the ConversationMemberTemplate
+func (o *ConversationMemberTemplate) Apply(ctx context.Context, mods ...ConversationMemberMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.ConversationMember
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ConversationMemberTemplate) setModelRels(o *models.ConversationMember) {
+	if t.r.Conversation != nil {
+		rel := t.r.Conversation.o.Build()
+		rel.R.ConversationMembers = append(rel.R.ConversationMembers, o)
+		o.ConversationID = rel.ID // h2
+		o.R.Conversation = rel
+	}
+
+	if t.r.LastReadMessageMessage != nil {
+		rel := t.r.LastReadMessageMessage.o.Build()
+		rel.R.LastReadMessageConversationMembers = append(rel.R.LastReadMessageConversationMembers, o)
+		o.LastReadMessageID = null.From(rel.ID) // h2
+		o.R.LastReadMessageMessage = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.ConversationMembers = append(rel.R.ConversationMembers, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.ConversationMemberSetter
+// this does nothing with the relationship templates
+func (o ConversationMemberTemplate) BuildSetter() *models.ConversationMemberSetter {
+	m := &models.ConversationMemberSetter{}
+
+	if o.ConversationID != nil {
+		val := o.ConversationID()
+		m.ConversationID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.LastReadMessageID != nil {
+		val := o.LastReadMessageID()
+		m.LastReadMessageID = omitnull.FromNull(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ConversationMemberSetter
+// this does nothing with the relationship templates
+func (o ConversationMemberTemplate) BuildManySetter(number int) []*models.ConversationMemberSetter {
+	m := make([]*models.ConversationMemberSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.ConversationMember
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ConversationMemberTemplate.Create
+func (o ConversationMemberTemplate) Build() *models.ConversationMember {
+	m := &models.ConversationMember{}
+
+	if o.ConversationID != nil {
+		m.ConversationID = o.ConversationID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.LastReadMessageID != nil {
+		m.LastReadMessageID = o.LastReadMessageID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ConversationMemberSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ConversationMemberTemplate.CreateMany
+func (o ConversationMemberTemplate) BuildMany(number int) models.ConversationMemberSlice {
+	m := make(models.ConversationMemberSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableConversationMember(m *models.ConversationMemberSetter) {
+	if !(m.ConversationID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ConversationID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_string(nil, "36")
+		m.UserID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.ConversationMember
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ConversationMemberTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.ConversationMember) error {
+	var err error
+
+	isLastReadMessageMessageDone, _ := conversationMemberRelLastReadMessageMessageCtx.Value(ctx)
+	if !isLastReadMessageMessageDone && o.r.LastReadMessageMessage != nil {
+		ctx = conversationMemberRelLastReadMessageMessageCtx.WithValue(ctx, true)
+		if o.r.LastReadMessageMessage.o.alreadyPersisted {
+			m.R.LastReadMessageMessage = o.r.LastReadMessageMessage.o.Build()
+		} else {
+			var rel1 *models.Message
+			rel1, err = o.r.LastReadMessageMessage.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachLastReadMessageMessage(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a conversationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ConversationMemberTemplate) Create(ctx context.Context, exec bob.Executor) (*models.ConversationMember, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableConversationMember(opt)
+
+	if o.r.Conversation == nil {
+		ConversationMemberMods.WithNewConversation().Apply(ctx, o)
+	}
+
+	var rel0 *models.Conversation
+
+	if o.r.Conversation.o.alreadyPersisted {
+		rel0 = o.r.Conversation.o.Build()
+	} else {
+		rel0, err = o.r.Conversation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ConversationID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		ConversationMemberMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel2 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel2 = o.r.User.o.Build()
+	} else {
+		rel2, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel2.ID)
+
+	m, err := models.ConversationMembers.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Conversation = rel0
+	m.R.User = rel2
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a conversationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ConversationMemberTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.ConversationMember {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a conversationMember and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ConversationMemberTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.ConversationMember {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple conversationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ConversationMemberTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ConversationMemberSlice, error) {
+	var err error
+	m := make(models.ConversationMemberSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple conversationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ConversationMemberTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ConversationMemberSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple conversationMembers and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ConversationMemberTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ConversationMemberSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// ConversationMember has methods that act as mods for the ConversationMemberTemplate
+var ConversationMemberMods conversationMemberMods
+
+type conversationMemberMods struct{}
+
+func (m conversationMemberMods) RandomizeAllColumns(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModSlice{
+		ConversationMemberMods.RandomConversationID(f),
+		ConversationMemberMods.RandomUserID(f),
+		ConversationMemberMods.RandomLastReadMessageID(f),
+		ConversationMemberMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m conversationMemberMods) ConversationID(val string) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.ConversationID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMemberMods) ConversationIDFunc(f func() string) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.ConversationID = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMemberMods) UnsetConversationID() ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.ConversationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m conversationMemberMods) RandomConversationID(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.ConversationID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m conversationMemberMods) UserID(val string) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.UserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMemberMods) UserIDFunc(f func() string) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMemberMods) UnsetUserID() ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m conversationMemberMods) RandomUserID(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.UserID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m conversationMemberMods) LastReadMessageID(val null.Val[string]) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.LastReadMessageID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMemberMods) LastReadMessageIDFunc(f func() null.Val[string]) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.LastReadMessageID = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMemberMods) UnsetLastReadMessageID() ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.LastReadMessageID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m conversationMemberMods) RandomLastReadMessageID(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.LastReadMessageID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m conversationMemberMods) RandomLastReadMessageIDNotNull(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.LastReadMessageID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m conversationMemberMods) CreatedAt(val null.Val[time.Time]) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMemberMods) CreatedAtFunc(f func() null.Val[time.Time]) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMemberMods) UnsetCreatedAt() ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m conversationMemberMods) RandomCreatedAt(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m conversationMemberMods) RandomCreatedAtNotNull(f *faker.Faker) ConversationMemberMod {
+	return ConversationMemberModFunc(func(_ context.Context, o *ConversationMemberTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m conversationMemberMods) WithParentsCascading() ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		if isDone, _ := conversationMemberWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = conversationMemberWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewConversationWithContext(ctx, ConversationMods.WithParentsCascading())
+			m.WithConversation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewMessageWithContext(ctx, MessageMods.WithParentsCascading())
+			m.WithLastReadMessageMessage(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m conversationMemberMods) WithConversation(rel *ConversationTemplate) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.Conversation = &conversationMemberRConversationR{
+			o: rel,
+		}
+	})
+}
+
+func (m conversationMemberMods) WithNewConversation(mods ...ConversationMod) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		related := o.f.NewConversationWithContext(ctx, mods...)
+
+		m.WithConversation(related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMemberMods) WithExistingConversation(em *models.Conversation) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.Conversation = &conversationMemberRConversationR{
+			o: o.f.FromExistingConversation(em),
+		}
+	})
+}
+
+func (m conversationMemberMods) WithoutConversation() ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.Conversation = nil
+	})
+}
+
+func (m conversationMemberMods) WithLastReadMessageMessage(rel *MessageTemplate) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.LastReadMessageMessage = &conversationMemberRLastReadMessageMessageR{
+			o: rel,
+		}
+	})
+}
+
+func (m conversationMemberMods) WithNewLastReadMessageMessage(mods ...MessageMod) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+
+		m.WithLastReadMessageMessage(related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMemberMods) WithExistingLastReadMessageMessage(em *models.Message) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.LastReadMessageMessage = &conversationMemberRLastReadMessageMessageR{
+			o: o.f.FromExistingMessage(em),
+		}
+	})
+}
+
+func (m conversationMemberMods) WithoutLastReadMessageMessage() ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.LastReadMessageMessage = nil
+	})
+}
+
+func (m conversationMemberMods) WithUser(rel *UserTemplate) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.User = &conversationMemberRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m conversationMemberMods) WithNewUser(mods ...UserMod) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMemberMods) WithExistingUser(em *models.User) ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.User = &conversationMemberRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m conversationMemberMods) WithoutUser() ConversationMemberMod {
+	return ConversationMemberModFunc(func(ctx context.Context, o *ConversationMemberTemplate) {
+		o.r.User = nil
+	})
+}
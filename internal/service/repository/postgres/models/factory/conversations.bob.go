@@ -0,0 +1,624 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type ConversationMod interface {
+	Apply(context.Context, *ConversationTemplate)
+}
+
+type ConversationModFunc func(context.Context, *ConversationTemplate)
+
+func (f ConversationModFunc) Apply(ctx context.Context, n *ConversationTemplate) {
+	f(ctx, n)
+}
+
+type ConversationModSlice []ConversationMod
+
+func (mods ConversationModSlice) Apply(ctx context.Context, n *ConversationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// ConversationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type ConversationTemplate struct {
+	ID            func() string
+	CreatedAt     func() null.Val[time.Time]
+	LastMessageID func() null.Val[string]
+
+	r conversationR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type conversationR struct {
+	ConversationMembers []*conversationRConversationMembersR
+	LastMessageMessage  *conversationRLastMessageMessageR
+	Messages            []*conversationRMessagesR
+}
+
+type conversationRConversationMembersR struct {
+	number int
+	o      *ConversationMemberTemplate
+}
+type conversationRLastMessageMessageR struct {
+	o *MessageTemplate
+}
+type conversationRMessagesR struct {
+	number int
+	o      *MessageTemplate
+}
+
+// Apply mods to the ConversationTemplate
+func (o *ConversationTemplate) Apply(ctx context.Context, mods ...ConversationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Conversation
+// according to the relationships in the template. Nothing is inserted into the db
+func (t ConversationTemplate) setModelRels(o *models.Conversation) {
+	if t.r.ConversationMembers != nil {
+		rel := models.ConversationMemberSlice{}
+		for _, r := range t.r.ConversationMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ConversationID = o.ID // h2
+				rel.R.Conversation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ConversationMembers = rel
+	}
+
+	if t.r.LastMessageMessage != nil {
+		rel := t.r.LastMessageMessage.o.Build()
+		rel.R.LastMessageConversations = append(rel.R.LastMessageConversations, o)
+		o.LastMessageID = null.From(rel.ID) // h2
+		o.R.LastMessageMessage = rel
+	}
+
+	if t.r.Messages != nil {
+		rel := models.MessageSlice{}
+		for _, r := range t.r.Messages {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ConversationID = o.ID // h2
+				rel.R.Conversation = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Messages = rel
+	}
+}
+
+// BuildSetter returns an *models.ConversationSetter
+// this does nothing with the relationship templates
+func (o ConversationTemplate) BuildSetter() *models.ConversationSetter {
+	m := &models.ConversationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+	if o.LastMessageID != nil {
+		val := o.LastMessageID()
+		m.LastMessageID = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.ConversationSetter
+// this does nothing with the relationship templates
+func (o ConversationTemplate) BuildManySetter(number int) []*models.ConversationSetter {
+	m := make([]*models.ConversationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Conversation
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ConversationTemplate.Create
+func (o ConversationTemplate) Build() *models.Conversation {
+	m := &models.Conversation{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+	if o.LastMessageID != nil {
+		m.LastMessageID = o.LastMessageID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.ConversationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use ConversationTemplate.CreateMany
+func (o ConversationTemplate) BuildMany(number int) models.ConversationSlice {
+	m := make(models.ConversationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableConversation(m *models.ConversationSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Conversation
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *ConversationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Conversation) error {
+	var err error
+
+	isConversationMembersDone, _ := conversationRelConversationMembersCtx.Value(ctx)
+	if !isConversationMembersDone && o.r.ConversationMembers != nil {
+		ctx = conversationRelConversationMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.ConversationMembers {
+			if r.o.alreadyPersisted {
+				m.R.ConversationMembers = append(m.R.ConversationMembers, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachConversationMembers(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isLastMessageMessageDone, _ := conversationRelLastMessageMessageCtx.Value(ctx)
+	if !isLastMessageMessageDone && o.r.LastMessageMessage != nil {
+		ctx = conversationRelLastMessageMessageCtx.WithValue(ctx, true)
+		if o.r.LastMessageMessage.o.alreadyPersisted {
+			m.R.LastMessageMessage = o.r.LastMessageMessage.o.Build()
+		} else {
+			var rel1 *models.Message
+			rel1, err = o.r.LastMessageMessage.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachLastMessageMessage(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isMessagesDone, _ := conversationRelMessagesCtx.Value(ctx)
+	if !isMessagesDone && o.r.Messages != nil {
+		ctx = conversationRelMessagesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Messages {
+			if r.o.alreadyPersisted {
+				m.R.Messages = append(m.R.Messages, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMessages(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a conversation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *ConversationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Conversation, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableConversation(opt)
+
+	m, err := models.Conversations.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a conversation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *ConversationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Conversation {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a conversation and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *ConversationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Conversation {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple conversations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o ConversationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.ConversationSlice, error) {
+	var err error
+	m := make(models.ConversationSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple conversations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o ConversationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.ConversationSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple conversations and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o ConversationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.ConversationSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Conversation has methods that act as mods for the ConversationTemplate
+var ConversationMods conversationMods
+
+type conversationMods struct{}
+
+func (m conversationMods) RandomizeAllColumns(f *faker.Faker) ConversationMod {
+	return ConversationModSlice{
+		ConversationMods.RandomID(f),
+		ConversationMods.RandomCreatedAt(f),
+		ConversationMods.RandomLastMessageID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m conversationMods) ID(val string) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMods) IDFunc(f func() string) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMods) UnsetID() ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m conversationMods) RandomID(f *faker.Faker) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.ID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m conversationMods) CreatedAt(val null.Val[time.Time]) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMods) CreatedAtFunc(f func() null.Val[time.Time]) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMods) UnsetCreatedAt() ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m conversationMods) RandomCreatedAt(f *faker.Faker) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m conversationMods) RandomCreatedAtNotNull(f *faker.Faker) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m conversationMods) LastMessageID(val null.Val[string]) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.LastMessageID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m conversationMods) LastMessageIDFunc(f func() null.Val[string]) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.LastMessageID = f
+	})
+}
+
+// Clear any values for the column
+func (m conversationMods) UnsetLastMessageID() ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.LastMessageID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m conversationMods) RandomLastMessageID(f *faker.Faker) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.LastMessageID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m conversationMods) RandomLastMessageIDNotNull(f *faker.Faker) ConversationMod {
+	return ConversationModFunc(func(_ context.Context, o *ConversationTemplate) {
+		o.LastMessageID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+func (m conversationMods) WithParentsCascading() ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		if isDone, _ := conversationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = conversationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewMessageWithContext(ctx, MessageMods.WithParentsCascading())
+			m.WithLastMessageMessage(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m conversationMods) WithLastMessageMessage(rel *MessageTemplate) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.LastMessageMessage = &conversationRLastMessageMessageR{
+			o: rel,
+		}
+	})
+}
+
+func (m conversationMods) WithNewLastMessageMessage(mods ...MessageMod) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+
+		m.WithLastMessageMessage(related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMods) WithExistingLastMessageMessage(em *models.Message) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.LastMessageMessage = &conversationRLastMessageMessageR{
+			o: o.f.FromExistingMessage(em),
+		}
+	})
+}
+
+func (m conversationMods) WithoutLastMessageMessage() ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.LastMessageMessage = nil
+	})
+}
+
+func (m conversationMods) WithConversationMembers(number int, related *ConversationMemberTemplate) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.ConversationMembers = []*conversationRConversationMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m conversationMods) WithNewConversationMembers(number int, mods ...ConversationMemberMod) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.WithConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMods) AddConversationMembers(number int, related *ConversationMemberTemplate) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.ConversationMembers = append(o.r.ConversationMembers, &conversationRConversationMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m conversationMods) AddNewConversationMembers(number int, mods ...ConversationMemberMod) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.AddConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMods) AddExistingConversationMembers(existingModels ...*models.ConversationMember) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		for _, em := range existingModels {
+			o.r.ConversationMembers = append(o.r.ConversationMembers, &conversationRConversationMembersR{
+				o: o.f.FromExistingConversationMember(em),
+			})
+		}
+	})
+}
+
+func (m conversationMods) WithoutConversationMembers() ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.ConversationMembers = nil
+	})
+}
+
+func (m conversationMods) WithMessages(number int, related *MessageTemplate) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.Messages = []*conversationRMessagesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m conversationMods) WithNewMessages(number int, mods ...MessageMod) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+		m.WithMessages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMods) AddMessages(number int, related *MessageTemplate) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.Messages = append(o.r.Messages, &conversationRMessagesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m conversationMods) AddNewMessages(number int, mods ...MessageMod) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+		m.AddMessages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m conversationMods) AddExistingMessages(existingModels ...*models.Message) ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		for _, em := range existingModels {
+			o.r.Messages = append(o.r.Messages, &conversationRMessagesR{
+				o: o.f.FromExistingMessage(em),
+			})
+		}
+	})
+}
+
+func (m conversationMods) WithoutMessages() ConversationMod {
+	return ConversationModFunc(func(ctx context.Context, o *ConversationTemplate) {
+		o.r.Messages = nil
+	})
+}
@@ -0,0 +1,498 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type FollowMod interface {
+	Apply(context.Context, *FollowTemplate)
+}
+
+type FollowModFunc func(context.Context, *FollowTemplate)
+
+func (f FollowModFunc) Apply(ctx context.Context, n *FollowTemplate) {
+	f(ctx, n)
+}
+
+type FollowModSlice []FollowMod
+
+func (mods FollowModSlice) Apply(ctx context.Context, n *FollowTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// FollowTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type FollowTemplate struct {
+	FollowerID func() string
+	FolloweeID func() string
+	CreatedAt  func() null.Val[time.Time]
+
+	r followR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type followR struct {
+	FolloweeUser *followRFolloweeUserR
+	FollowerUser *followRFollowerUserR
+}
+
+type followRFolloweeUserR struct {
+	o *UserTemplate
+}
+type followRFollowerUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the FollowTemplate
+func (o *FollowTemplate) Apply(ctx context.Context, mods ...FollowMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Follow
+// according to the relationships in the template. Nothing is inserted into the db
+func (t FollowTemplate) setModelRels(o *models.Follow) {
+	if t.r.FolloweeUser != nil {
+		rel := t.r.FolloweeUser.o.Build()
+		rel.R.FolloweeFollows = append(rel.R.FolloweeFollows, o)
+		o.FolloweeID = rel.ID // h2
+		o.R.FolloweeUser = rel
+	}
+
+	if t.r.FollowerUser != nil {
+		rel := t.r.FollowerUser.o.Build()
+		rel.R.FollowerFollows = append(rel.R.FollowerFollows, o)
+		o.FollowerID = rel.ID // h2
+		o.R.FollowerUser = rel
+	}
+}
+
+// BuildSetter returns an *models.FollowSetter
+// this does nothing with the relationship templates
+func (o FollowTemplate) BuildSetter() *models.FollowSetter {
+	m := &models.FollowSetter{}
+
+	if o.FollowerID != nil {
+		val := o.FollowerID()
+		m.FollowerID = omit.From(val)
+	}
+	if o.FolloweeID != nil {
+		val := o.FolloweeID()
+		m.FolloweeID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.FollowSetter
+// this does nothing with the relationship templates
+func (o FollowTemplate) BuildManySetter(number int) []*models.FollowSetter {
+	m := make([]*models.FollowSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Follow
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use FollowTemplate.Create
+func (o FollowTemplate) Build() *models.Follow {
+	m := &models.Follow{}
+
+	if o.FollowerID != nil {
+		m.FollowerID = o.FollowerID()
+	}
+	if o.FolloweeID != nil {
+		m.FolloweeID = o.FolloweeID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.FollowSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use FollowTemplate.CreateMany
+func (o FollowTemplate) BuildMany(number int) models.FollowSlice {
+	m := make(models.FollowSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableFollow(m *models.FollowSetter) {
+	if !(m.FollowerID.IsValue()) {
+		val := random_string(nil, "36")
+		m.FollowerID = omit.From(val)
+	}
+	if !(m.FolloweeID.IsValue()) {
+		val := random_string(nil, "36")
+		m.FolloweeID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Follow
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *FollowTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Follow) error {
+	var err error
+
+	return err
+}
+
+// Create builds a follow and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *FollowTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Follow, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableFollow(opt)
+
+	if o.r.FolloweeUser == nil {
+		FollowMods.WithNewFolloweeUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.FolloweeUser.o.alreadyPersisted {
+		rel0 = o.r.FolloweeUser.o.Build()
+	} else {
+		rel0, err = o.r.FolloweeUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.FolloweeID = omit.From(rel0.ID)
+
+	if o.r.FollowerUser == nil {
+		FollowMods.WithNewFollowerUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.FollowerUser.o.alreadyPersisted {
+		rel1 = o.r.FollowerUser.o.Build()
+	} else {
+		rel1, err = o.r.FollowerUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.FollowerID = omit.From(rel1.ID)
+
+	m, err := models.Follows.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.FolloweeUser = rel0
+	m.R.FollowerUser = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a follow and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *FollowTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Follow {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a follow and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *FollowTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Follow {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple follows and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o FollowTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.FollowSlice, error) {
+	var err error
+	m := make(models.FollowSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple follows and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o FollowTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.FollowSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple follows and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o FollowTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.FollowSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Follow has methods that act as mods for the FollowTemplate
+var FollowMods followMods
+
+type followMods struct{}
+
+func (m followMods) RandomizeAllColumns(f *faker.Faker) FollowMod {
+	return FollowModSlice{
+		FollowMods.RandomFollowerID(f),
+		FollowMods.RandomFolloweeID(f),
+		FollowMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m followMods) FollowerID(val string) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FollowerID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m followMods) FollowerIDFunc(f func() string) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FollowerID = f
+	})
+}
+
+// Clear any values for the column
+func (m followMods) UnsetFollowerID() FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FollowerID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m followMods) RandomFollowerID(f *faker.Faker) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FollowerID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m followMods) FolloweeID(val string) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FolloweeID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m followMods) FolloweeIDFunc(f func() string) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FolloweeID = f
+	})
+}
+
+// Clear any values for the column
+func (m followMods) UnsetFolloweeID() FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FolloweeID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m followMods) RandomFolloweeID(f *faker.Faker) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.FolloweeID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m followMods) CreatedAt(val null.Val[time.Time]) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m followMods) CreatedAtFunc(f func() null.Val[time.Time]) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m followMods) UnsetCreatedAt() FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m followMods) RandomCreatedAt(f *faker.Faker) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m followMods) RandomCreatedAtNotNull(f *faker.Faker) FollowMod {
+	return FollowModFunc(func(_ context.Context, o *FollowTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m followMods) WithParentsCascading() FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		if isDone, _ := followWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = followWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithFolloweeUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithFollowerUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m followMods) WithFolloweeUser(rel *UserTemplate) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FolloweeUser = &followRFolloweeUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m followMods) WithNewFolloweeUser(mods ...UserMod) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithFolloweeUser(related).Apply(ctx, o)
+	})
+}
+
+func (m followMods) WithExistingFolloweeUser(em *models.User) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FolloweeUser = &followRFolloweeUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m followMods) WithoutFolloweeUser() FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FolloweeUser = nil
+	})
+}
+
+func (m followMods) WithFollowerUser(rel *UserTemplate) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FollowerUser = &followRFollowerUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m followMods) WithNewFollowerUser(mods ...UserMod) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithFollowerUser(related).Apply(ctx, o)
+	})
+}
+
+func (m followMods) WithExistingFollowerUser(em *models.User) FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FollowerUser = &followRFollowerUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m followMods) WithoutFollowerUser() FollowMod {
+	return FollowModFunc(func(ctx context.Context, o *FollowTemplate) {
+		o.r.FollowerUser = nil
+	})
+}
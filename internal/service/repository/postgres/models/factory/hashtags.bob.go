@@ -0,0 +1,454 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type HashtagMod interface {
+	Apply(context.Context, *HashtagTemplate)
+}
+
+type HashtagModFunc func(context.Context, *HashtagTemplate)
+
+func (f HashtagModFunc) Apply(ctx context.Context, n *HashtagTemplate) {
+	f(ctx, n)
+}
+
+type HashtagModSlice []HashtagMod
+
+func (mods HashtagModSlice) Apply(ctx context.Context, n *HashtagTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// HashtagTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type HashtagTemplate struct {
+	ID        func() string
+	Tag       func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r hashtagR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type hashtagR struct {
+	Tweets []*hashtagRTweetsR
+}
+
+type hashtagRTweetsR struct {
+	number int
+	o      *TweetTemplate
+}
+
+// Apply mods to the HashtagTemplate
+func (o *HashtagTemplate) Apply(ctx context.Context, mods ...HashtagMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Hashtag
+// according to the relationships in the template. Nothing is inserted into the db
+func (t HashtagTemplate) setModelRels(o *models.Hashtag) {
+	if t.r.Tweets != nil {
+		rel := models.TweetSlice{}
+		for _, r := range t.r.Tweets {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Hashtags = append(rel.R.Hashtags, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Tweets = rel
+	}
+}
+
+// BuildSetter returns an *models.HashtagSetter
+// this does nothing with the relationship templates
+func (o HashtagTemplate) BuildSetter() *models.HashtagSetter {
+	m := &models.HashtagSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.Tag != nil {
+		val := o.Tag()
+		m.Tag = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.HashtagSetter
+// this does nothing with the relationship templates
+func (o HashtagTemplate) BuildManySetter(number int) []*models.HashtagSetter {
+	m := make([]*models.HashtagSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Hashtag
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use HashtagTemplate.Create
+func (o HashtagTemplate) Build() *models.Hashtag {
+	m := &models.Hashtag{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.Tag != nil {
+		m.Tag = o.Tag()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.HashtagSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use HashtagTemplate.CreateMany
+func (o HashtagTemplate) BuildMany(number int) models.HashtagSlice {
+	m := make(models.HashtagSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableHashtag(m *models.HashtagSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ID = omit.From(val)
+	}
+	if !(m.Tag.IsValue()) {
+		val := random_string(nil, "280")
+		m.Tag = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Hashtag
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *HashtagTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Hashtag) error {
+	var err error
+
+	isTweetsDone, _ := hashtagRelTweetsCtx.Value(ctx)
+	if !isTweetsDone && o.r.Tweets != nil {
+		ctx = hashtagRelTweetsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Tweets {
+			if r.o.alreadyPersisted {
+				m.R.Tweets = append(m.R.Tweets, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTweets(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a hashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *HashtagTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Hashtag, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableHashtag(opt)
+
+	m, err := models.Hashtags.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a hashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *HashtagTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Hashtag {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a hashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *HashtagTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Hashtag {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple hashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o HashtagTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.HashtagSlice, error) {
+	var err error
+	m := make(models.HashtagSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple hashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o HashtagTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.HashtagSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple hashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o HashtagTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.HashtagSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Hashtag has methods that act as mods for the HashtagTemplate
+var HashtagMods hashtagMods
+
+type hashtagMods struct{}
+
+func (m hashtagMods) RandomizeAllColumns(f *faker.Faker) HashtagMod {
+	return HashtagModSlice{
+		HashtagMods.RandomID(f),
+		HashtagMods.RandomTag(f),
+		HashtagMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m hashtagMods) ID(val string) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m hashtagMods) IDFunc(f func() string) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m hashtagMods) UnsetID() HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m hashtagMods) RandomID(f *faker.Faker) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.ID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m hashtagMods) Tag(val string) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.Tag = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m hashtagMods) TagFunc(f func() string) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.Tag = f
+	})
+}
+
+// Clear any values for the column
+func (m hashtagMods) UnsetTag() HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.Tag = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m hashtagMods) RandomTag(f *faker.Faker) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.Tag = func() string {
+			return random_string(f, "280")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m hashtagMods) CreatedAt(val null.Val[time.Time]) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m hashtagMods) CreatedAtFunc(f func() null.Val[time.Time]) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m hashtagMods) UnsetCreatedAt() HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m hashtagMods) RandomCreatedAt(f *faker.Faker) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m hashtagMods) RandomCreatedAtNotNull(f *faker.Faker) HashtagMod {
+	return HashtagModFunc(func(_ context.Context, o *HashtagTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m hashtagMods) WithParentsCascading() HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		if isDone, _ := hashtagWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = hashtagWithParentsCascadingCtx.WithValue(ctx, true)
+	})
+}
+
+func (m hashtagMods) WithTweets(number int, related *TweetTemplate) HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		o.r.Tweets = []*hashtagRTweetsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m hashtagMods) WithNewTweets(number int, mods ...TweetMod) HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.WithTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m hashtagMods) AddTweets(number int, related *TweetTemplate) HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		o.r.Tweets = append(o.r.Tweets, &hashtagRTweetsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m hashtagMods) AddNewTweets(number int, mods ...TweetMod) HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.AddTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m hashtagMods) AddExistingTweets(existingModels ...*models.Tweet) HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		for _, em := range existingModels {
+			o.r.Tweets = append(o.r.Tweets, &hashtagRTweetsR{
+				o: o.f.FromExistingTweet(em),
+			})
+		}
+	})
+}
+
+func (m hashtagMods) WithoutTweets() HashtagMod {
+	return HashtagModFunc(func(ctx context.Context, o *HashtagTemplate) {
+		o.r.Tweets = nil
+	})
+}
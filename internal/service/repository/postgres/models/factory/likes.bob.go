@@ -0,0 +1,498 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type LikeMod interface {
+	Apply(context.Context, *LikeTemplate)
+}
+
+type LikeModFunc func(context.Context, *LikeTemplate)
+
+func (f LikeModFunc) Apply(ctx context.Context, n *LikeTemplate) {
+	f(ctx, n)
+}
+
+type LikeModSlice []LikeMod
+
+func (mods LikeModSlice) Apply(ctx context.Context, n *LikeTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// LikeTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type LikeTemplate struct {
+	UserID    func() string
+	TweetID   func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r likeR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type likeR struct {
+	Tweet *likeRTweetR
+	User  *likeRUserR
+}
+
+type likeRTweetR struct {
+	o *TweetTemplate
+}
+type likeRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the LikeTemplate
+func (o *LikeTemplate) Apply(ctx context.Context, mods ...LikeMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Like
+// according to the relationships in the template. Nothing is inserted into the db
+func (t LikeTemplate) setModelRels(o *models.Like) {
+	if t.r.Tweet != nil {
+		rel := t.r.Tweet.o.Build()
+		rel.R.Likes = append(rel.R.Likes, o)
+		o.TweetID = rel.ID // h2
+		o.R.Tweet = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Likes = append(rel.R.Likes, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.LikeSetter
+// this does nothing with the relationship templates
+func (o LikeTemplate) BuildSetter() *models.LikeSetter {
+	m := &models.LikeSetter{}
+
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.TweetID != nil {
+		val := o.TweetID()
+		m.TweetID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.LikeSetter
+// this does nothing with the relationship templates
+func (o LikeTemplate) BuildManySetter(number int) []*models.LikeSetter {
+	m := make([]*models.LikeSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Like
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LikeTemplate.Create
+func (o LikeTemplate) Build() *models.Like {
+	m := &models.Like{}
+
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.TweetID != nil {
+		m.TweetID = o.TweetID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.LikeSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use LikeTemplate.CreateMany
+func (o LikeTemplate) BuildMany(number int) models.LikeSlice {
+	m := make(models.LikeSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableLike(m *models.LikeSetter) {
+	if !(m.UserID.IsValue()) {
+		val := random_string(nil, "36")
+		m.UserID = omit.From(val)
+	}
+	if !(m.TweetID.IsValue()) {
+		val := random_string(nil, "36")
+		m.TweetID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Like
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *LikeTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Like) error {
+	var err error
+
+	return err
+}
+
+// Create builds a like and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *LikeTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Like, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableLike(opt)
+
+	if o.r.Tweet == nil {
+		LikeMods.WithNewTweet().Apply(ctx, o)
+	}
+
+	var rel0 *models.Tweet
+
+	if o.r.Tweet.o.alreadyPersisted {
+		rel0 = o.r.Tweet.o.Build()
+	} else {
+		rel0, err = o.r.Tweet.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TweetID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		LikeMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.Likes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Tweet = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a like and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *LikeTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Like {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a like and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *LikeTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Like {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple likes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o LikeTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.LikeSlice, error) {
+	var err error
+	m := make(models.LikeSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple likes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o LikeTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.LikeSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple likes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o LikeTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.LikeSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Like has methods that act as mods for the LikeTemplate
+var LikeMods likeMods
+
+type likeMods struct{}
+
+func (m likeMods) RandomizeAllColumns(f *faker.Faker) LikeMod {
+	return LikeModSlice{
+		LikeMods.RandomUserID(f),
+		LikeMods.RandomTweetID(f),
+		LikeMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m likeMods) UserID(val string) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.UserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m likeMods) UserIDFunc(f func() string) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m likeMods) UnsetUserID() LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m likeMods) RandomUserID(f *faker.Faker) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.UserID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m likeMods) TweetID(val string) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.TweetID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m likeMods) TweetIDFunc(f func() string) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.TweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m likeMods) UnsetTweetID() LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.TweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m likeMods) RandomTweetID(f *faker.Faker) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.TweetID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m likeMods) CreatedAt(val null.Val[time.Time]) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m likeMods) CreatedAtFunc(f func() null.Val[time.Time]) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m likeMods) UnsetCreatedAt() LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m likeMods) RandomCreatedAt(f *faker.Faker) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m likeMods) RandomCreatedAtNotNull(f *faker.Faker) LikeMod {
+	return LikeModFunc(func(_ context.Context, o *LikeTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m likeMods) WithParentsCascading() LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		if isDone, _ := likeWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = likeWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithTweet(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m likeMods) WithTweet(rel *TweetTemplate) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.Tweet = &likeRTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m likeMods) WithNewTweet(mods ...TweetMod) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m likeMods) WithExistingTweet(em *models.Tweet) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.Tweet = &likeRTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m likeMods) WithoutTweet() LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.Tweet = nil
+	})
+}
+
+func (m likeMods) WithUser(rel *UserTemplate) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.User = &likeRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m likeMods) WithNewUser(mods ...UserMod) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m likeMods) WithExistingUser(em *models.User) LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.User = &likeRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m likeMods) WithoutUser() LikeMod {
+	return LikeModFunc(func(ctx context.Context, o *LikeTemplate) {
+		o.r.User = nil
+	})
+}
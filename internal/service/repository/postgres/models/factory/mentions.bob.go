@@ -0,0 +1,521 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type MentionMod interface {
+	Apply(context.Context, *MentionTemplate)
+}
+
+type MentionModFunc func(context.Context, *MentionTemplate)
+
+func (f MentionModFunc) Apply(ctx context.Context, n *MentionTemplate) {
+	f(ctx, n)
+}
+
+type MentionModSlice []MentionMod
+
+func (mods MentionModSlice) Apply(ctx context.Context, n *MentionTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// MentionTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type MentionTemplate struct {
+	TweetID     func() string
+	UserID      func() string
+	StartOffset func() int32
+	EndOffset   func() int32
+
+	r mentionR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type mentionR struct {
+	Tweet *mentionRTweetR
+	User  *mentionRUserR
+}
+
+type mentionRTweetR struct {
+	o *TweetTemplate
+}
+type mentionRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the MentionTemplate
+func (o *MentionTemplate) Apply(ctx context.Context, mods ...MentionMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Mention
+// according to the relationships in the template. Nothing is inserted into the db
+func (t MentionTemplate) setModelRels(o *models.Mention) {
+	if t.r.Tweet != nil {
+		rel := t.r.Tweet.o.Build()
+		rel.R.Mentions = append(rel.R.Mentions, o)
+		o.TweetID = rel.ID // h2
+		o.R.Tweet = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Mentions = append(rel.R.Mentions, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.MentionSetter
+// this does nothing with the relationship templates
+func (o MentionTemplate) BuildSetter() *models.MentionSetter {
+	m := &models.MentionSetter{}
+
+	if o.TweetID != nil {
+		val := o.TweetID()
+		m.TweetID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.StartOffset != nil {
+		val := o.StartOffset()
+		m.StartOffset = omit.From(val)
+	}
+	if o.EndOffset != nil {
+		val := o.EndOffset()
+		m.EndOffset = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.MentionSetter
+// this does nothing with the relationship templates
+func (o MentionTemplate) BuildManySetter(number int) []*models.MentionSetter {
+	m := make([]*models.MentionSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Mention
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MentionTemplate.Create
+func (o MentionTemplate) Build() *models.Mention {
+	m := &models.Mention{}
+
+	if o.TweetID != nil {
+		m.TweetID = o.TweetID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.StartOffset != nil {
+		m.StartOffset = o.StartOffset()
+	}
+	if o.EndOffset != nil {
+		m.EndOffset = o.EndOffset()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.MentionSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MentionTemplate.CreateMany
+func (o MentionTemplate) BuildMany(number int) models.MentionSlice {
+	m := make(models.MentionSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableMention(m *models.MentionSetter) {
+	if !(m.TweetID.IsValue()) {
+		val := random_string(nil, "36")
+		m.TweetID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_string(nil, "36")
+		m.UserID = omit.From(val)
+	}
+	if !(m.StartOffset.IsValue()) {
+		val := random_int32(nil)
+		m.StartOffset = omit.From(val)
+	}
+	if !(m.EndOffset.IsValue()) {
+		val := random_int32(nil)
+		m.EndOffset = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Mention
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *MentionTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Mention) error {
+	var err error
+
+	return err
+}
+
+// Create builds a mention and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *MentionTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Mention, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableMention(opt)
+
+	if o.r.Tweet == nil {
+		MentionMods.WithNewTweet().Apply(ctx, o)
+	}
+
+	var rel0 *models.Tweet
+
+	if o.r.Tweet.o.alreadyPersisted {
+		rel0 = o.r.Tweet.o.Build()
+	} else {
+		rel0, err = o.r.Tweet.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TweetID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		MentionMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel1 = o.r.User.o.Build()
+	} else {
+		rel1, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel1.ID)
+
+	m, err := models.Mentions.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Tweet = rel0
+	m.R.User = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a mention and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *MentionTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Mention {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a mention and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *MentionTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Mention {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple mentions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o MentionTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.MentionSlice, error) {
+	var err error
+	m := make(models.MentionSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple mentions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o MentionTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.MentionSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple mentions and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o MentionTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.MentionSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Mention has methods that act as mods for the MentionTemplate
+var MentionMods mentionMods
+
+type mentionMods struct{}
+
+func (m mentionMods) RandomizeAllColumns(f *faker.Faker) MentionMod {
+	return MentionModSlice{
+		MentionMods.RandomTweetID(f),
+		MentionMods.RandomUserID(f),
+		MentionMods.RandomStartOffset(f),
+		MentionMods.RandomEndOffset(f),
+	}
+}
+
+// Set the model columns to this value
+func (m mentionMods) TweetID(val string) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.TweetID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mentionMods) TweetIDFunc(f func() string) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.TweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m mentionMods) UnsetTweetID() MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.TweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mentionMods) RandomTweetID(f *faker.Faker) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.TweetID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mentionMods) UserID(val string) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.UserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mentionMods) UserIDFunc(f func() string) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m mentionMods) UnsetUserID() MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mentionMods) RandomUserID(f *faker.Faker) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.UserID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mentionMods) StartOffset(val int32) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.StartOffset = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mentionMods) StartOffsetFunc(f func() int32) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.StartOffset = f
+	})
+}
+
+// Clear any values for the column
+func (m mentionMods) UnsetStartOffset() MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.StartOffset = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mentionMods) RandomStartOffset(f *faker.Faker) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.StartOffset = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m mentionMods) EndOffset(val int32) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.EndOffset = func() int32 { return val }
+	})
+}
+
+// Set the Column from the function
+func (m mentionMods) EndOffsetFunc(f func() int32) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.EndOffset = f
+	})
+}
+
+// Clear any values for the column
+func (m mentionMods) UnsetEndOffset() MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.EndOffset = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m mentionMods) RandomEndOffset(f *faker.Faker) MentionMod {
+	return MentionModFunc(func(_ context.Context, o *MentionTemplate) {
+		o.EndOffset = func() int32 {
+			return random_int32(f)
+		}
+	})
+}
+
+func (m mentionMods) WithParentsCascading() MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		if isDone, _ := mentionWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = mentionWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithTweet(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m mentionMods) WithTweet(rel *TweetTemplate) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.Tweet = &mentionRTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m mentionMods) WithNewTweet(mods ...TweetMod) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m mentionMods) WithExistingTweet(em *models.Tweet) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.Tweet = &mentionRTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m mentionMods) WithoutTweet() MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.Tweet = nil
+	})
+}
+
+func (m mentionMods) WithUser(rel *UserTemplate) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.User = &mentionRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m mentionMods) WithNewUser(mods ...UserMod) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m mentionMods) WithExistingUser(em *models.User) MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.User = &mentionRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m mentionMods) WithoutUser() MentionMod {
+	return MentionModFunc(func(ctx context.Context, o *MentionTemplate) {
+		o.r.User = nil
+	})
+}
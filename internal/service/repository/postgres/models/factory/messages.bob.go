@@ -0,0 +1,758 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type MessageMod interface {
+	Apply(context.Context, *MessageTemplate)
+}
+
+type MessageModFunc func(context.Context, *MessageTemplate)
+
+func (f MessageModFunc) Apply(ctx context.Context, n *MessageTemplate) {
+	f(ctx, n)
+}
+
+type MessageModSlice []MessageMod
+
+func (mods MessageModSlice) Apply(ctx context.Context, n *MessageTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// MessageTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type MessageTemplate struct {
+	ID             func() string
+	ConversationID func() string
+	SenderID       func() string
+	Content        func() string
+	CreatedAt      func() null.Val[time.Time]
+
+	r messageR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type messageR struct {
+	LastReadMessageConversationMembers []*messageRLastReadMessageConversationMembersR
+	LastMessageConversations           []*messageRLastMessageConversationsR
+	Conversation                       *messageRConversationR
+	SenderUser                         *messageRSenderUserR
+}
+
+type messageRLastReadMessageConversationMembersR struct {
+	number int
+	o      *ConversationMemberTemplate
+}
+type messageRLastMessageConversationsR struct {
+	number int
+	o      *ConversationTemplate
+}
+type messageRConversationR struct {
+	o *ConversationTemplate
+}
+type messageRSenderUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the MessageTemplate
+func (o *MessageTemplate) Apply(ctx context.Context, mods ...MessageMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Message
+// according to the relationships in the template. Nothing is inserted into the db
+func (t MessageTemplate) setModelRels(o *models.Message) {
+	if t.r.LastReadMessageConversationMembers != nil {
+		rel := models.ConversationMemberSlice{}
+		for _, r := range t.r.LastReadMessageConversationMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.LastReadMessageID = null.From(o.ID) // h2
+				rel.R.LastReadMessageMessage = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.LastReadMessageConversationMembers = rel
+	}
+
+	if t.r.LastMessageConversations != nil {
+		rel := models.ConversationSlice{}
+		for _, r := range t.r.LastMessageConversations {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.LastMessageID = null.From(o.ID) // h2
+				rel.R.LastMessageMessage = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.LastMessageConversations = rel
+	}
+
+	if t.r.Conversation != nil {
+		rel := t.r.Conversation.o.Build()
+		rel.R.Messages = append(rel.R.Messages, o)
+		o.ConversationID = rel.ID // h2
+		o.R.Conversation = rel
+	}
+
+	if t.r.SenderUser != nil {
+		rel := t.r.SenderUser.o.Build()
+		rel.R.SenderMessages = append(rel.R.SenderMessages, o)
+		o.SenderID = rel.ID // h2
+		o.R.SenderUser = rel
+	}
+}
+
+// BuildSetter returns an *models.MessageSetter
+// this does nothing with the relationship templates
+func (o MessageTemplate) BuildSetter() *models.MessageSetter {
+	m := &models.MessageSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.ConversationID != nil {
+		val := o.ConversationID()
+		m.ConversationID = omit.From(val)
+	}
+	if o.SenderID != nil {
+		val := o.SenderID()
+		m.SenderID = omit.From(val)
+	}
+	if o.Content != nil {
+		val := o.Content()
+		m.Content = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.MessageSetter
+// this does nothing with the relationship templates
+func (o MessageTemplate) BuildManySetter(number int) []*models.MessageSetter {
+	m := make([]*models.MessageSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Message
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MessageTemplate.Create
+func (o MessageTemplate) Build() *models.Message {
+	m := &models.Message{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.ConversationID != nil {
+		m.ConversationID = o.ConversationID()
+	}
+	if o.SenderID != nil {
+		m.SenderID = o.SenderID()
+	}
+	if o.Content != nil {
+		m.Content = o.Content()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.MessageSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MessageTemplate.CreateMany
+func (o MessageTemplate) BuildMany(number int) models.MessageSlice {
+	m := make(models.MessageSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableMessage(m *models.MessageSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ID = omit.From(val)
+	}
+	if !(m.ConversationID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ConversationID = omit.From(val)
+	}
+	if !(m.SenderID.IsValue()) {
+		val := random_string(nil, "36")
+		m.SenderID = omit.From(val)
+	}
+	if !(m.Content.IsValue()) {
+		val := random_string(nil)
+		m.Content = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Message
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *MessageTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Message) error {
+	var err error
+
+	isLastReadMessageConversationMembersDone, _ := messageRelLastReadMessageConversationMembersCtx.Value(ctx)
+	if !isLastReadMessageConversationMembersDone && o.r.LastReadMessageConversationMembers != nil {
+		ctx = messageRelLastReadMessageConversationMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.LastReadMessageConversationMembers {
+			if r.o.alreadyPersisted {
+				m.R.LastReadMessageConversationMembers = append(m.R.LastReadMessageConversationMembers, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLastReadMessageConversationMembers(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isLastMessageConversationsDone, _ := messageRelLastMessageConversationsCtx.Value(ctx)
+	if !isLastMessageConversationsDone && o.r.LastMessageConversations != nil {
+		ctx = messageRelLastMessageConversationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.LastMessageConversations {
+			if r.o.alreadyPersisted {
+				m.R.LastMessageConversations = append(m.R.LastMessageConversations, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLastMessageConversations(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a message and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *MessageTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Message, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableMessage(opt)
+
+	if o.r.Conversation == nil {
+		MessageMods.WithNewConversation().Apply(ctx, o)
+	}
+
+	var rel2 *models.Conversation
+
+	if o.r.Conversation.o.alreadyPersisted {
+		rel2 = o.r.Conversation.o.Build()
+	} else {
+		rel2, err = o.r.Conversation.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ConversationID = omit.From(rel2.ID)
+
+	if o.r.SenderUser == nil {
+		MessageMods.WithNewSenderUser().Apply(ctx, o)
+	}
+
+	var rel3 *models.User
+
+	if o.r.SenderUser.o.alreadyPersisted {
+		rel3 = o.r.SenderUser.o.Build()
+	} else {
+		rel3, err = o.r.SenderUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.SenderID = omit.From(rel3.ID)
+
+	m, err := models.Messages.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Conversation = rel2
+	m.R.SenderUser = rel3
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a message and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *MessageTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Message {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a message and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *MessageTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Message {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple messages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o MessageTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.MessageSlice, error) {
+	var err error
+	m := make(models.MessageSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple messages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o MessageTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.MessageSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple messages and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o MessageTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.MessageSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Message has methods that act as mods for the MessageTemplate
+var MessageMods messageMods
+
+type messageMods struct{}
+
+func (m messageMods) RandomizeAllColumns(f *faker.Faker) MessageMod {
+	return MessageModSlice{
+		MessageMods.RandomID(f),
+		MessageMods.RandomConversationID(f),
+		MessageMods.RandomSenderID(f),
+		MessageMods.RandomContent(f),
+		MessageMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m messageMods) ID(val string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m messageMods) IDFunc(f func() string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m messageMods) UnsetID() MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m messageMods) RandomID(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m messageMods) ConversationID(val string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ConversationID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m messageMods) ConversationIDFunc(f func() string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ConversationID = f
+	})
+}
+
+// Clear any values for the column
+func (m messageMods) UnsetConversationID() MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ConversationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m messageMods) RandomConversationID(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.ConversationID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m messageMods) SenderID(val string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.SenderID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m messageMods) SenderIDFunc(f func() string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.SenderID = f
+	})
+}
+
+// Clear any values for the column
+func (m messageMods) UnsetSenderID() MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.SenderID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m messageMods) RandomSenderID(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.SenderID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m messageMods) Content(val string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.Content = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m messageMods) ContentFunc(f func() string) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.Content = f
+	})
+}
+
+// Clear any values for the column
+func (m messageMods) UnsetContent() MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.Content = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m messageMods) RandomContent(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.Content = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m messageMods) CreatedAt(val null.Val[time.Time]) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m messageMods) CreatedAtFunc(f func() null.Val[time.Time]) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m messageMods) UnsetCreatedAt() MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m messageMods) RandomCreatedAt(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m messageMods) RandomCreatedAtNotNull(f *faker.Faker) MessageMod {
+	return MessageModFunc(func(_ context.Context, o *MessageTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m messageMods) WithParentsCascading() MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		if isDone, _ := messageWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = messageWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewConversationWithContext(ctx, ConversationMods.WithParentsCascading())
+			m.WithConversation(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithSenderUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m messageMods) WithConversation(rel *ConversationTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.Conversation = &messageRConversationR{
+			o: rel,
+		}
+	})
+}
+
+func (m messageMods) WithNewConversation(mods ...ConversationMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewConversationWithContext(ctx, mods...)
+
+		m.WithConversation(related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) WithExistingConversation(em *models.Conversation) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.Conversation = &messageRConversationR{
+			o: o.f.FromExistingConversation(em),
+		}
+	})
+}
+
+func (m messageMods) WithoutConversation() MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.Conversation = nil
+	})
+}
+
+func (m messageMods) WithSenderUser(rel *UserTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.SenderUser = &messageRSenderUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m messageMods) WithNewSenderUser(mods ...UserMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithSenderUser(related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) WithExistingSenderUser(em *models.User) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.SenderUser = &messageRSenderUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m messageMods) WithoutSenderUser() MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.SenderUser = nil
+	})
+}
+
+func (m messageMods) WithLastReadMessageConversationMembers(number int, related *ConversationMemberTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastReadMessageConversationMembers = []*messageRLastReadMessageConversationMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m messageMods) WithNewLastReadMessageConversationMembers(number int, mods ...ConversationMemberMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.WithLastReadMessageConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) AddLastReadMessageConversationMembers(number int, related *ConversationMemberTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastReadMessageConversationMembers = append(o.r.LastReadMessageConversationMembers, &messageRLastReadMessageConversationMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m messageMods) AddNewLastReadMessageConversationMembers(number int, mods ...ConversationMemberMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.AddLastReadMessageConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) AddExistingLastReadMessageConversationMembers(existingModels ...*models.ConversationMember) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		for _, em := range existingModels {
+			o.r.LastReadMessageConversationMembers = append(o.r.LastReadMessageConversationMembers, &messageRLastReadMessageConversationMembersR{
+				o: o.f.FromExistingConversationMember(em),
+			})
+		}
+	})
+}
+
+func (m messageMods) WithoutLastReadMessageConversationMembers() MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastReadMessageConversationMembers = nil
+	})
+}
+
+func (m messageMods) WithLastMessageConversations(number int, related *ConversationTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastMessageConversations = []*messageRLastMessageConversationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m messageMods) WithNewLastMessageConversations(number int, mods ...ConversationMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewConversationWithContext(ctx, mods...)
+		m.WithLastMessageConversations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) AddLastMessageConversations(number int, related *ConversationTemplate) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastMessageConversations = append(o.r.LastMessageConversations, &messageRLastMessageConversationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m messageMods) AddNewLastMessageConversations(number int, mods ...ConversationMod) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		related := o.f.NewConversationWithContext(ctx, mods...)
+		m.AddLastMessageConversations(number, related).Apply(ctx, o)
+	})
+}
+
+func (m messageMods) AddExistingLastMessageConversations(existingModels ...*models.Conversation) MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		for _, em := range existingModels {
+			o.r.LastMessageConversations = append(o.r.LastMessageConversations, &messageRLastMessageConversationsR{
+				o: o.f.FromExistingConversation(em),
+			})
+		}
+	})
+}
+
+func (m messageMods) WithoutLastMessageConversations() MessageMod {
+	return MessageModFunc(func(ctx context.Context, o *MessageTemplate) {
+		o.r.LastMessageConversations = nil
+	})
+}
@@ -0,0 +1,498 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type MuteMod interface {
+	Apply(context.Context, *MuteTemplate)
+}
+
+type MuteModFunc func(context.Context, *MuteTemplate)
+
+func (f MuteModFunc) Apply(ctx context.Context, n *MuteTemplate) {
+	f(ctx, n)
+}
+
+type MuteModSlice []MuteMod
+
+func (mods MuteModSlice) Apply(ctx context.Context, n *MuteTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// MuteTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type MuteTemplate struct {
+	MuterID   func() string
+	MutedID   func() string
+	CreatedAt func() null.Val[time.Time]
+
+	r muteR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type muteR struct {
+	MutedUser *muteRMutedUserR
+	MuterUser *muteRMuterUserR
+}
+
+type muteRMutedUserR struct {
+	o *UserTemplate
+}
+type muteRMuterUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the MuteTemplate
+func (o *MuteTemplate) Apply(ctx context.Context, mods ...MuteMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Mute
+// according to the relationships in the template. Nothing is inserted into the db
+func (t MuteTemplate) setModelRels(o *models.Mute) {
+	if t.r.MutedUser != nil {
+		rel := t.r.MutedUser.o.Build()
+		rel.R.MutedMutes = append(rel.R.MutedMutes, o)
+		o.MutedID = rel.ID // h2
+		o.R.MutedUser = rel
+	}
+
+	if t.r.MuterUser != nil {
+		rel := t.r.MuterUser.o.Build()
+		rel.R.MuterMutes = append(rel.R.MuterMutes, o)
+		o.MuterID = rel.ID // h2
+		o.R.MuterUser = rel
+	}
+}
+
+// BuildSetter returns an *models.MuteSetter
+// this does nothing with the relationship templates
+func (o MuteTemplate) BuildSetter() *models.MuteSetter {
+	m := &models.MuteSetter{}
+
+	if o.MuterID != nil {
+		val := o.MuterID()
+		m.MuterID = omit.From(val)
+	}
+	if o.MutedID != nil {
+		val := o.MutedID()
+		m.MutedID = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.MuteSetter
+// this does nothing with the relationship templates
+func (o MuteTemplate) BuildManySetter(number int) []*models.MuteSetter {
+	m := make([]*models.MuteSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Mute
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MuteTemplate.Create
+func (o MuteTemplate) Build() *models.Mute {
+	m := &models.Mute{}
+
+	if o.MuterID != nil {
+		m.MuterID = o.MuterID()
+	}
+	if o.MutedID != nil {
+		m.MutedID = o.MutedID()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.MuteSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use MuteTemplate.CreateMany
+func (o MuteTemplate) BuildMany(number int) models.MuteSlice {
+	m := make(models.MuteSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableMute(m *models.MuteSetter) {
+	if !(m.MuterID.IsValue()) {
+		val := random_string(nil, "36")
+		m.MuterID = omit.From(val)
+	}
+	if !(m.MutedID.IsValue()) {
+		val := random_string(nil, "36")
+		m.MutedID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Mute
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *MuteTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Mute) error {
+	var err error
+
+	return err
+}
+
+// Create builds a mute and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *MuteTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Mute, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableMute(opt)
+
+	if o.r.MutedUser == nil {
+		MuteMods.WithNewMutedUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.MutedUser.o.alreadyPersisted {
+		rel0 = o.r.MutedUser.o.Build()
+	} else {
+		rel0, err = o.r.MutedUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.MutedID = omit.From(rel0.ID)
+
+	if o.r.MuterUser == nil {
+		MuteMods.WithNewMuterUser().Apply(ctx, o)
+	}
+
+	var rel1 *models.User
+
+	if o.r.MuterUser.o.alreadyPersisted {
+		rel1 = o.r.MuterUser.o.Build()
+	} else {
+		rel1, err = o.r.MuterUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.MuterID = omit.From(rel1.ID)
+
+	m, err := models.Mutes.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.MutedUser = rel0
+	m.R.MuterUser = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a mute and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *MuteTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Mute {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a mute and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *MuteTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Mute {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple mutes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o MuteTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.MuteSlice, error) {
+	var err error
+	m := make(models.MuteSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple mutes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o MuteTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.MuteSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple mutes and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o MuteTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.MuteSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Mute has methods that act as mods for the MuteTemplate
+var MuteMods muteMods
+
+type muteMods struct{}
+
+func (m muteMods) RandomizeAllColumns(f *faker.Faker) MuteMod {
+	return MuteModSlice{
+		MuteMods.RandomMuterID(f),
+		MuteMods.RandomMutedID(f),
+		MuteMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m muteMods) MuterID(val string) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MuterID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m muteMods) MuterIDFunc(f func() string) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MuterID = f
+	})
+}
+
+// Clear any values for the column
+func (m muteMods) UnsetMuterID() MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MuterID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m muteMods) RandomMuterID(f *faker.Faker) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MuterID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m muteMods) MutedID(val string) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MutedID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m muteMods) MutedIDFunc(f func() string) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MutedID = f
+	})
+}
+
+// Clear any values for the column
+func (m muteMods) UnsetMutedID() MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MutedID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m muteMods) RandomMutedID(f *faker.Faker) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.MutedID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m muteMods) CreatedAt(val null.Val[time.Time]) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m muteMods) CreatedAtFunc(f func() null.Val[time.Time]) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m muteMods) UnsetCreatedAt() MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m muteMods) RandomCreatedAt(f *faker.Faker) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m muteMods) RandomCreatedAtNotNull(f *faker.Faker) MuteMod {
+	return MuteModFunc(func(_ context.Context, o *MuteTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m muteMods) WithParentsCascading() MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		if isDone, _ := muteWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = muteWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithMutedUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithMuterUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m muteMods) WithMutedUser(rel *UserTemplate) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MutedUser = &muteRMutedUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m muteMods) WithNewMutedUser(mods ...UserMod) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithMutedUser(related).Apply(ctx, o)
+	})
+}
+
+func (m muteMods) WithExistingMutedUser(em *models.User) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MutedUser = &muteRMutedUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m muteMods) WithoutMutedUser() MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MutedUser = nil
+	})
+}
+
+func (m muteMods) WithMuterUser(rel *UserTemplate) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MuterUser = &muteRMuterUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m muteMods) WithNewMuterUser(mods ...UserMod) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithMuterUser(related).Apply(ctx, o)
+	})
+}
+
+func (m muteMods) WithExistingMuterUser(em *models.User) MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MuterUser = &muteRMuterUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m muteMods) WithoutMuterUser() MuteMod {
+	return MuteModFunc(func(ctx context.Context, o *MuteTemplate) {
+		o.r.MuterUser = nil
+	})
+}
@@ -0,0 +1,797 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type NotificationMod interface {
+	Apply(context.Context, *NotificationTemplate)
+}
+
+type NotificationModFunc func(context.Context, *NotificationTemplate)
+
+func (f NotificationModFunc) Apply(ctx context.Context, n *NotificationTemplate) {
+	f(ctx, n)
+}
+
+type NotificationModSlice []NotificationMod
+
+func (mods NotificationModSlice) Apply(ctx context.Context, n *NotificationTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// NotificationTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type NotificationTemplate struct {
+	ID        func() string
+	UserID    func() string
+	ActorID   func() string
+	Type      func() string
+	TweetID   func() null.Val[string]
+	GroupKey  func() string
+	Read      func() bool
+	CreatedAt func() null.Val[time.Time]
+
+	r notificationR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type notificationR struct {
+	ActorUser *notificationRActorUserR
+	Tweet     *notificationRTweetR
+	User      *notificationRUserR
+}
+
+type notificationRActorUserR struct {
+	o *UserTemplate
+}
+type notificationRTweetR struct {
+	o *TweetTemplate
+}
+type notificationRUserR struct {
+	o *UserTemplate
+}
+
+// Apply mods to the NotificationTemplate
+func (o *NotificationTemplate) Apply(ctx context.Context, mods ...NotificationMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.Notification
+// according to the relationships in the template. Nothing is inserted into the db
+func (t NotificationTemplate) setModelRels(o *models.Notification) {
+	if t.r.ActorUser != nil {
+		rel := t.r.ActorUser.o.Build()
+		rel.R.ActorNotifications = append(rel.R.ActorNotifications, o)
+		o.ActorID = rel.ID // h2
+		o.R.ActorUser = rel
+	}
+
+	if t.r.Tweet != nil {
+		rel := t.r.Tweet.o.Build()
+		rel.R.Notifications = append(rel.R.Notifications, o)
+		o.TweetID = null.From(rel.ID) // h2
+		o.R.Tweet = rel
+	}
+
+	if t.r.User != nil {
+		rel := t.r.User.o.Build()
+		rel.R.Notifications = append(rel.R.Notifications, o)
+		o.UserID = rel.ID // h2
+		o.R.User = rel
+	}
+}
+
+// BuildSetter returns an *models.NotificationSetter
+// this does nothing with the relationship templates
+func (o NotificationTemplate) BuildSetter() *models.NotificationSetter {
+	m := &models.NotificationSetter{}
+
+	if o.ID != nil {
+		val := o.ID()
+		m.ID = omit.From(val)
+	}
+	if o.UserID != nil {
+		val := o.UserID()
+		m.UserID = omit.From(val)
+	}
+	if o.ActorID != nil {
+		val := o.ActorID()
+		m.ActorID = omit.From(val)
+	}
+	if o.Type != nil {
+		val := o.Type()
+		m.Type = omit.From(val)
+	}
+	if o.TweetID != nil {
+		val := o.TweetID()
+		m.TweetID = omitnull.FromNull(val)
+	}
+	if o.GroupKey != nil {
+		val := o.GroupKey()
+		m.GroupKey = omit.From(val)
+	}
+	if o.Read != nil {
+		val := o.Read()
+		m.Read = omit.From(val)
+	}
+	if o.CreatedAt != nil {
+		val := o.CreatedAt()
+		m.CreatedAt = omitnull.FromNull(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.NotificationSetter
+// this does nothing with the relationship templates
+func (o NotificationTemplate) BuildManySetter(number int) []*models.NotificationSetter {
+	m := make([]*models.NotificationSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.Notification
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use NotificationTemplate.Create
+func (o NotificationTemplate) Build() *models.Notification {
+	m := &models.Notification{}
+
+	if o.ID != nil {
+		m.ID = o.ID()
+	}
+	if o.UserID != nil {
+		m.UserID = o.UserID()
+	}
+	if o.ActorID != nil {
+		m.ActorID = o.ActorID()
+	}
+	if o.Type != nil {
+		m.Type = o.Type()
+	}
+	if o.TweetID != nil {
+		m.TweetID = o.TweetID()
+	}
+	if o.GroupKey != nil {
+		m.GroupKey = o.GroupKey()
+	}
+	if o.Read != nil {
+		m.Read = o.Read()
+	}
+	if o.CreatedAt != nil {
+		m.CreatedAt = o.CreatedAt()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.NotificationSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use NotificationTemplate.CreateMany
+func (o NotificationTemplate) BuildMany(number int) models.NotificationSlice {
+	m := make(models.NotificationSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableNotification(m *models.NotificationSetter) {
+	if !(m.ID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ID = omit.From(val)
+	}
+	if !(m.UserID.IsValue()) {
+		val := random_string(nil, "36")
+		m.UserID = omit.From(val)
+	}
+	if !(m.ActorID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ActorID = omit.From(val)
+	}
+	if !(m.Type.IsValue()) {
+		val := random_string(nil, "16")
+		m.Type = omit.From(val)
+	}
+	if !(m.GroupKey.IsValue()) {
+		val := random_string(nil)
+		m.GroupKey = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.Notification
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *NotificationTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Notification) error {
+	var err error
+
+	isTweetDone, _ := notificationRelTweetCtx.Value(ctx)
+	if !isTweetDone && o.r.Tweet != nil {
+		ctx = notificationRelTweetCtx.WithValue(ctx, true)
+		if o.r.Tweet.o.alreadyPersisted {
+			m.R.Tweet = o.r.Tweet.o.Build()
+		} else {
+			var rel1 *models.Tweet
+			rel1, err = o.r.Tweet.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachTweet(ctx, exec, rel1)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return err
+}
+
+// Create builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *NotificationTemplate) Create(ctx context.Context, exec bob.Executor) (*models.Notification, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableNotification(opt)
+
+	if o.r.ActorUser == nil {
+		NotificationMods.WithNewActorUser().Apply(ctx, o)
+	}
+
+	var rel0 *models.User
+
+	if o.r.ActorUser.o.alreadyPersisted {
+		rel0 = o.r.ActorUser.o.Build()
+	} else {
+		rel0, err = o.r.ActorUser.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.ActorID = omit.From(rel0.ID)
+
+	if o.r.User == nil {
+		NotificationMods.WithNewUser().Apply(ctx, o)
+	}
+
+	var rel2 *models.User
+
+	if o.r.User.o.alreadyPersisted {
+		rel2 = o.r.User.o.Build()
+	} else {
+		rel2, err = o.r.User.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.UserID = omit.From(rel2.ID)
+
+	m, err := models.Notifications.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.ActorUser = rel0
+	m.R.User = rel2
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *NotificationTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.Notification {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a notification and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *NotificationTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.Notification {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o NotificationTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.NotificationSlice, error) {
+	var err error
+	m := make(models.NotificationSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o NotificationTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.NotificationSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple notifications and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o NotificationTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.NotificationSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// Notification has methods that act as mods for the NotificationTemplate
+var NotificationMods notificationMods
+
+type notificationMods struct{}
+
+func (m notificationMods) RandomizeAllColumns(f *faker.Faker) NotificationMod {
+	return NotificationModSlice{
+		NotificationMods.RandomID(f),
+		NotificationMods.RandomUserID(f),
+		NotificationMods.RandomActorID(f),
+		NotificationMods.RandomType(f),
+		NotificationMods.RandomTweetID(f),
+		NotificationMods.RandomGroupKey(f),
+		NotificationMods.RandomRead(f),
+		NotificationMods.RandomCreatedAt(f),
+	}
+}
+
+// Set the model columns to this value
+func (m notificationMods) ID(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) IDFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) UserID(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) UserIDFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetUserID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomUserID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.UserID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) ActorID(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) ActorIDFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetActorID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomActorID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.ActorID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) Type(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Type = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) TypeFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Type = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetType() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Type = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomType(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Type = func() string {
+			return random_string(f, "16")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) TweetID(val null.Val[string]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TweetID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) TweetIDFunc(f func() null.Val[string]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetTweetID() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m notificationMods) RandomTweetID(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m notificationMods) RandomTweetIDNotNull(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.TweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) GroupKey(val string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.GroupKey = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) GroupKeyFunc(f func() string) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.GroupKey = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetGroupKey() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.GroupKey = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomGroupKey(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.GroupKey = func() string {
+			return random_string(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) Read(val bool) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Read = func() bool { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) ReadFunc(f func() bool) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Read = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetRead() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Read = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m notificationMods) RandomRead(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.Read = func() bool {
+			return random_bool(f)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m notificationMods) CreatedAt(val null.Val[time.Time]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m notificationMods) CreatedAtFunc(f func() null.Val[time.Time]) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = f
+	})
+}
+
+// Clear any values for the column
+func (m notificationMods) UnsetCreatedAt() NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m notificationMods) RandomCreatedAt(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m notificationMods) RandomCreatedAtNotNull(f *faker.Faker) NotificationMod {
+	return NotificationModFunc(func(_ context.Context, o *NotificationTemplate) {
+		o.CreatedAt = func() null.Val[time.Time] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_time_Time(f)
+			return null.From(val)
+		}
+	})
+}
+
+func (m notificationMods) WithParentsCascading() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		if isDone, _ := notificationWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = notificationWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithActorUser(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithTweet(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
+			m.WithUser(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m notificationMods) WithActorUser(rel *UserTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.ActorUser = &notificationRActorUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewActorUser(mods ...UserMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithActorUser(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingActorUser(em *models.User) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.ActorUser = &notificationRActorUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutActorUser() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.ActorUser = nil
+	})
+}
+
+func (m notificationMods) WithTweet(rel *TweetTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Tweet = &notificationRTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewTweet(mods ...TweetMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingTweet(em *models.Tweet) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Tweet = &notificationRTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutTweet() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.Tweet = nil
+	})
+}
+
+func (m notificationMods) WithUser(rel *UserTemplate) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = &notificationRUserR{
+			o: rel,
+		}
+	})
+}
+
+func (m notificationMods) WithNewUser(mods ...UserMod) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		related := o.f.NewUserWithContext(ctx, mods...)
+
+		m.WithUser(related).Apply(ctx, o)
+	})
+}
+
+func (m notificationMods) WithExistingUser(em *models.User) NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = &notificationRUserR{
+			o: o.f.FromExistingUser(em),
+		}
+	})
+}
+
+func (m notificationMods) WithoutUser() NotificationMod {
+	return NotificationModFunc(func(ctx context.Context, o *NotificationTemplate) {
+		o.r.User = nil
+	})
+}
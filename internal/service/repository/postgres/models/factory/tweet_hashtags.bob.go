@@ -0,0 +1,431 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package factory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/jaswdr/faker/v2"
+	models "github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+	"github.com/stephenafamo/bob"
+)
+
+type TweetHashtagMod interface {
+	Apply(context.Context, *TweetHashtagTemplate)
+}
+
+type TweetHashtagModFunc func(context.Context, *TweetHashtagTemplate)
+
+func (f TweetHashtagModFunc) Apply(ctx context.Context, n *TweetHashtagTemplate) {
+	f(ctx, n)
+}
+
+type TweetHashtagModSlice []TweetHashtagMod
+
+func (mods TweetHashtagModSlice) Apply(ctx context.Context, n *TweetHashtagTemplate) {
+	for _, f := range mods {
+		f.Apply(ctx, n)
+	}
+}
+
+// TweetHashtagTemplate is an object representing the database table.
+// all columns are optional and should be set by mods
+type TweetHashtagTemplate struct {
+	TweetID   func() string
+	HashtagID func() string
+
+	r tweetHashtagR
+	f *Factory
+
+	alreadyPersisted bool
+}
+
+type tweetHashtagR struct {
+	Hashtag *tweetHashtagRHashtagR
+	Tweet   *tweetHashtagRTweetR
+}
+
+type tweetHashtagRHashtagR struct {
+	o *HashtagTemplate
+}
+type tweetHashtagRTweetR struct {
+	o *TweetTemplate
+}
+
+// Apply mods to the TweetHashtagTemplate
+func (o *TweetHashtagTemplate) Apply(ctx context.Context, mods ...TweetHashtagMod) {
+	for _, mod := range mods {
+		mod.Apply(ctx, o)
+	}
+}
+
+// setModelRels creates and sets the relationships on *models.TweetHashtag
+// according to the relationships in the template. Nothing is inserted into the db
+func (t TweetHashtagTemplate) setModelRels(o *models.TweetHashtag) {
+	if t.r.Hashtag != nil {
+		rel := t.r.Hashtag.o.Build()
+		o.HashtagID = rel.ID // h2
+		o.R.Hashtag = rel
+	}
+
+	if t.r.Tweet != nil {
+		rel := t.r.Tweet.o.Build()
+		o.TweetID = rel.ID // h2
+		o.R.Tweet = rel
+	}
+}
+
+// BuildSetter returns an *models.TweetHashtagSetter
+// this does nothing with the relationship templates
+func (o TweetHashtagTemplate) BuildSetter() *models.TweetHashtagSetter {
+	m := &models.TweetHashtagSetter{}
+
+	if o.TweetID != nil {
+		val := o.TweetID()
+		m.TweetID = omit.From(val)
+	}
+	if o.HashtagID != nil {
+		val := o.HashtagID()
+		m.HashtagID = omit.From(val)
+	}
+
+	return m
+}
+
+// BuildManySetter returns an []*models.TweetHashtagSetter
+// this does nothing with the relationship templates
+func (o TweetHashtagTemplate) BuildManySetter(number int) []*models.TweetHashtagSetter {
+	m := make([]*models.TweetHashtagSetter, number)
+
+	for i := range m {
+		m[i] = o.BuildSetter()
+	}
+
+	return m
+}
+
+// Build returns an *models.TweetHashtag
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TweetHashtagTemplate.Create
+func (o TweetHashtagTemplate) Build() *models.TweetHashtag {
+	m := &models.TweetHashtag{}
+
+	if o.TweetID != nil {
+		m.TweetID = o.TweetID()
+	}
+	if o.HashtagID != nil {
+		m.HashtagID = o.HashtagID()
+	}
+
+	o.setModelRels(m)
+
+	return m
+}
+
+// BuildMany returns an models.TweetHashtagSlice
+// Related objects are also created and placed in the .R field
+// NOTE: Objects are not inserted into the database. Use TweetHashtagTemplate.CreateMany
+func (o TweetHashtagTemplate) BuildMany(number int) models.TweetHashtagSlice {
+	m := make(models.TweetHashtagSlice, number)
+
+	for i := range m {
+		m[i] = o.Build()
+	}
+
+	return m
+}
+
+func ensureCreatableTweetHashtag(m *models.TweetHashtagSetter) {
+	if !(m.TweetID.IsValue()) {
+		val := random_string(nil, "36")
+		m.TweetID = omit.From(val)
+	}
+	if !(m.HashtagID.IsValue()) {
+		val := random_string(nil, "36")
+		m.HashtagID = omit.From(val)
+	}
+}
+
+// insertOptRels creates and inserts any optional the relationships on *models.TweetHashtag
+// according to the relationships in the template.
+// any required relationship should have already exist on the model
+func (o *TweetHashtagTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.TweetHashtag) error {
+	var err error
+
+	return err
+}
+
+// Create builds a tweetHashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *TweetHashtagTemplate) Create(ctx context.Context, exec bob.Executor) (*models.TweetHashtag, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableTweetHashtag(opt)
+
+	if o.r.Hashtag == nil {
+		TweetHashtagMods.WithNewHashtag().Apply(ctx, o)
+	}
+
+	var rel0 *models.Hashtag
+
+	if o.r.Hashtag.o.alreadyPersisted {
+		rel0 = o.r.Hashtag.o.Build()
+	} else {
+		rel0, err = o.r.Hashtag.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.HashtagID = omit.From(rel0.ID)
+
+	if o.r.Tweet == nil {
+		TweetHashtagMods.WithNewTweet().Apply(ctx, o)
+	}
+
+	var rel1 *models.Tweet
+
+	if o.r.Tweet.o.alreadyPersisted {
+		rel1 = o.r.Tweet.o.Build()
+	} else {
+		rel1, err = o.r.Tweet.o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	opt.TweetID = omit.From(rel1.ID)
+
+	m, err := models.TweetHashtags.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	m.R.Hashtag = rel0
+	m.R.Tweet = rel1
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a tweetHashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *TweetHashtagTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.TweetHashtag {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a tweetHashtag and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *TweetHashtagTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.TweetHashtag {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple tweetHashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o TweetHashtagTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.TweetHashtagSlice, error) {
+	var err error
+	m := make(models.TweetHashtagSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple tweetHashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o TweetHashtagTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.TweetHashtagSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple tweetHashtags and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o TweetHashtagTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.TweetHashtagSlice {
+	tb.Helper()
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// TweetHashtag has methods that act as mods for the TweetHashtagTemplate
+var TweetHashtagMods tweetHashtagMods
+
+type tweetHashtagMods struct{}
+
+func (m tweetHashtagMods) RandomizeAllColumns(f *faker.Faker) TweetHashtagMod {
+	return TweetHashtagModSlice{
+		TweetHashtagMods.RandomTweetID(f),
+		TweetHashtagMods.RandomHashtagID(f),
+	}
+}
+
+// Set the model columns to this value
+func (m tweetHashtagMods) TweetID(val string) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.TweetID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetHashtagMods) TweetIDFunc(f func() string) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.TweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetHashtagMods) UnsetTweetID() TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.TweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tweetHashtagMods) RandomTweetID(f *faker.Faker) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.TweetID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tweetHashtagMods) HashtagID(val string) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.HashtagID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetHashtagMods) HashtagIDFunc(f func() string) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.HashtagID = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetHashtagMods) UnsetHashtagID() TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.HashtagID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tweetHashtagMods) RandomHashtagID(f *faker.Faker) TweetHashtagMod {
+	return TweetHashtagModFunc(func(_ context.Context, o *TweetHashtagTemplate) {
+		o.HashtagID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithParentsCascading() TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		if isDone, _ := tweetHashtagWithParentsCascadingCtx.Value(ctx); isDone {
+			return
+		}
+		ctx = tweetHashtagWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewHashtagWithContext(ctx, HashtagMods.WithParentsCascading())
+			m.WithHashtag(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithTweet(related).Apply(ctx, o)
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithHashtag(rel *HashtagTemplate) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Hashtag = &tweetHashtagRHashtagR{
+			o: rel,
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithNewHashtag(mods ...HashtagMod) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		related := o.f.NewHashtagWithContext(ctx, mods...)
+
+		m.WithHashtag(related).Apply(ctx, o)
+	})
+}
+
+func (m tweetHashtagMods) WithExistingHashtag(em *models.Hashtag) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Hashtag = &tweetHashtagRHashtagR{
+			o: o.f.FromExistingHashtag(em),
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithoutHashtag() TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Hashtag = nil
+	})
+}
+
+func (m tweetHashtagMods) WithTweet(rel *TweetTemplate) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Tweet = &tweetHashtagRTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithNewTweet(mods ...TweetMod) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m tweetHashtagMods) WithExistingTweet(em *models.Tweet) TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Tweet = &tweetHashtagRTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m tweetHashtagMods) WithoutTweet() TweetHashtagMod {
+	return TweetHashtagModFunc(func(ctx context.Context, o *TweetHashtagTemplate) {
+		o.r.Tweet = nil
+	})
+}
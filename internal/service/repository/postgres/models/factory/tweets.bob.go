@@ -37,12 +37,17 @@ func (mods TweetModSlice) Apply(ctx context.Context, n *TweetTemplate) {
 // TweetTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type TweetTemplate struct {
-	ID        func() string
-	UserID    func() string
-	Content   func() string
-	DeletedAt func() null.Val[time.Time]
-	CreatedAt func() null.Val[time.Time]
-	UpdatedAt func() null.Val[time.Time]
+	ID                func() string
+	UserID            func() string
+	Content           func() string
+	DeletedAt         func() null.Val[time.Time]
+	CreatedAt         func() null.Val[time.Time]
+	UpdatedAt         func() null.Val[time.Time]
+	Kind              func() string
+	ReferencedTweetID func() null.Val[string]
+	InReplyToTweetID  func() null.Val[string]
+	ConversationID    func() string
+	SearchVector      func() null.Val[string]
 
 	r tweetR
 	f *Factory
@@ -51,9 +56,52 @@ type TweetTemplate struct {
 }
 
 type tweetR struct {
-	User *tweetRUserR
+	Bookmarks               []*tweetRBookmarksR
+	Likes                   []*tweetRLikesR
+	Mentions                []*tweetRMentionsR
+	Notifications           []*tweetRNotificationsR
+	Hashtags                []*tweetRHashtagsR
+	InReplyToTweet          *tweetRInReplyToTweetR
+	ReverseInReplyToTweets  []*tweetRReverseInReplyToTweetsR
+	ReferencedTweet         *tweetRReferencedTweetR
+	ReverseReferencedTweets []*tweetRReverseReferencedTweetsR
+	User                    *tweetRUserR
 }
 
+type tweetRBookmarksR struct {
+	number int
+	o      *BookmarkTemplate
+}
+type tweetRLikesR struct {
+	number int
+	o      *LikeTemplate
+}
+type tweetRMentionsR struct {
+	number int
+	o      *MentionTemplate
+}
+type tweetRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type tweetRHashtagsR struct {
+	number int
+	o      *HashtagTemplate
+}
+type tweetRInReplyToTweetR struct {
+	o *TweetTemplate
+}
+type tweetRReverseInReplyToTweetsR struct {
+	number int
+	o      *TweetTemplate
+}
+type tweetRReferencedTweetR struct {
+	o *TweetTemplate
+}
+type tweetRReverseReferencedTweetsR struct {
+	number int
+	o      *TweetTemplate
+}
 type tweetRUserR struct {
 	o *UserTemplate
 }
@@ -68,6 +116,110 @@ func (o *TweetTemplate) Apply(ctx context.Context, mods ...TweetMod) {
 // setModelRels creates and sets the relationships on *models.Tweet
 // according to the relationships in the template. Nothing is inserted into the db
 func (t TweetTemplate) setModelRels(o *models.Tweet) {
+	if t.r.Bookmarks != nil {
+		rel := models.BookmarkSlice{}
+		for _, r := range t.r.Bookmarks {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TweetID = o.ID // h2
+				rel.R.Tweet = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Bookmarks = rel
+	}
+
+	if t.r.Likes != nil {
+		rel := models.LikeSlice{}
+		for _, r := range t.r.Likes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TweetID = o.ID // h2
+				rel.R.Tweet = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Likes = rel
+	}
+
+	if t.r.Mentions != nil {
+		rel := models.MentionSlice{}
+		for _, r := range t.r.Mentions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TweetID = o.ID // h2
+				rel.R.Tweet = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Mentions = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.TweetID = null.From(o.ID) // h2
+				rel.R.Tweet = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
+	if t.r.Hashtags != nil {
+		rel := models.HashtagSlice{}
+		for _, r := range t.r.Hashtags {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.R.Tweets = append(rel.R.Tweets, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Hashtags = rel
+	}
+
+	if t.r.InReplyToTweet != nil {
+		rel := t.r.InReplyToTweet.o.Build()
+		rel.R.InReplyToTweet = o
+		o.InReplyToTweetID = null.From(rel.ID) // h2
+		o.R.InReplyToTweet = rel
+	}
+
+	if t.r.ReverseInReplyToTweets != nil {
+		rel := models.TweetSlice{}
+		for _, r := range t.r.ReverseInReplyToTweets {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.InReplyToTweetID = null.From(o.ID) // h2
+				rel.R.ReverseInReplyToTweets = append(rel.R.ReverseInReplyToTweets, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ReverseInReplyToTweets = rel
+	}
+
+	if t.r.ReferencedTweet != nil {
+		rel := t.r.ReferencedTweet.o.Build()
+		rel.R.ReferencedTweet = o
+		o.ReferencedTweetID = null.From(rel.ID) // h2
+		o.R.ReferencedTweet = rel
+	}
+
+	if t.r.ReverseReferencedTweets != nil {
+		rel := models.TweetSlice{}
+		for _, r := range t.r.ReverseReferencedTweets {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ReferencedTweetID = null.From(o.ID) // h2
+				rel.R.ReverseReferencedTweets = append(rel.R.ReverseReferencedTweets, o)
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ReverseReferencedTweets = rel
+	}
+
 	if t.r.User != nil {
 		rel := t.r.User.o.Build()
 		rel.R.Tweets = append(rel.R.Tweets, o)
@@ -105,6 +257,22 @@ func (o TweetTemplate) BuildSetter() *models.TweetSetter {
 		val := o.UpdatedAt()
 		m.UpdatedAt = omitnull.FromNull(val)
 	}
+	if o.Kind != nil {
+		val := o.Kind()
+		m.Kind = omit.From(val)
+	}
+	if o.ReferencedTweetID != nil {
+		val := o.ReferencedTweetID()
+		m.ReferencedTweetID = omitnull.FromNull(val)
+	}
+	if o.InReplyToTweetID != nil {
+		val := o.InReplyToTweetID()
+		m.InReplyToTweetID = omitnull.FromNull(val)
+	}
+	if o.ConversationID != nil {
+		val := o.ConversationID()
+		m.ConversationID = omit.From(val)
+	}
 
 	return m
 }
@@ -145,6 +313,21 @@ func (o TweetTemplate) Build() *models.Tweet {
 	if o.UpdatedAt != nil {
 		m.UpdatedAt = o.UpdatedAt()
 	}
+	if o.Kind != nil {
+		m.Kind = o.Kind()
+	}
+	if o.ReferencedTweetID != nil {
+		m.ReferencedTweetID = o.ReferencedTweetID()
+	}
+	if o.InReplyToTweetID != nil {
+		m.InReplyToTweetID = o.InReplyToTweetID()
+	}
+	if o.ConversationID != nil {
+		m.ConversationID = o.ConversationID()
+	}
+	if o.SearchVector != nil {
+		m.SearchVector = o.SearchVector()
+	}
 
 	o.setModelRels(m)
 
@@ -177,6 +360,10 @@ func ensureCreatableTweet(m *models.TweetSetter) {
 		val := random_string(nil)
 		m.Content = omit.From(val)
 	}
+	if !(m.ConversationID.IsValue()) {
+		val := random_string(nil, "36")
+		m.ConversationID = omit.From(val)
+	}
 }
 
 // insertOptRels creates and inserts any optional the relationships on *models.Tweet
@@ -185,6 +372,184 @@ func ensureCreatableTweet(m *models.TweetSetter) {
 func (o *TweetTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.Tweet) error {
 	var err error
 
+	isBookmarksDone, _ := tweetRelBookmarksCtx.Value(ctx)
+	if !isBookmarksDone && o.r.Bookmarks != nil {
+		ctx = tweetRelBookmarksCtx.WithValue(ctx, true)
+		for _, r := range o.r.Bookmarks {
+			if r.o.alreadyPersisted {
+				m.R.Bookmarks = append(m.R.Bookmarks, r.o.Build())
+			} else {
+				rel0, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachBookmarks(ctx, exec, rel0...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isLikesDone, _ := tweetRelLikesCtx.Value(ctx)
+	if !isLikesDone && o.r.Likes != nil {
+		ctx = tweetRelLikesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Likes {
+			if r.o.alreadyPersisted {
+				m.R.Likes = append(m.R.Likes, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachLikes(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isMentionsDone, _ := tweetRelMentionsCtx.Value(ctx)
+	if !isMentionsDone && o.r.Mentions != nil {
+		ctx = tweetRelMentionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Mentions {
+			if r.o.alreadyPersisted {
+				m.R.Mentions = append(m.R.Mentions, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMentions(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := tweetRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = tweetRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isHashtagsDone, _ := tweetRelHashtagsCtx.Value(ctx)
+	if !isHashtagsDone && o.r.Hashtags != nil {
+		ctx = tweetRelHashtagsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Hashtags {
+			if r.o.alreadyPersisted {
+				m.R.Hashtags = append(m.R.Hashtags, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachHashtags(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isInReplyToTweetDone, _ := tweetRelInReplyToTweetCtx.Value(ctx)
+	if !isInReplyToTweetDone && o.r.InReplyToTweet != nil {
+		ctx = tweetRelInReplyToTweetCtx.WithValue(ctx, true)
+		if o.r.InReplyToTweet.o.alreadyPersisted {
+			m.R.InReplyToTweet = o.r.InReplyToTweet.o.Build()
+		} else {
+			var rel5 *models.Tweet
+			rel5, err = o.r.InReplyToTweet.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachInReplyToTweet(ctx, exec, rel5)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isReverseInReplyToTweetsDone, _ := tweetRelReverseInReplyToTweetsCtx.Value(ctx)
+	if !isReverseInReplyToTweetsDone && o.r.ReverseInReplyToTweets != nil {
+		ctx = tweetRelReverseInReplyToTweetsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ReverseInReplyToTweets {
+			if r.o.alreadyPersisted {
+				m.R.ReverseInReplyToTweets = append(m.R.ReverseInReplyToTweets, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachReverseInReplyToTweets(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isReferencedTweetDone, _ := tweetRelReferencedTweetCtx.Value(ctx)
+	if !isReferencedTweetDone && o.r.ReferencedTweet != nil {
+		ctx = tweetRelReferencedTweetCtx.WithValue(ctx, true)
+		if o.r.ReferencedTweet.o.alreadyPersisted {
+			m.R.ReferencedTweet = o.r.ReferencedTweet.o.Build()
+		} else {
+			var rel7 *models.Tweet
+			rel7, err = o.r.ReferencedTweet.o.Create(ctx, exec)
+			if err != nil {
+				return err
+			}
+			err = m.AttachReferencedTweet(ctx, exec, rel7)
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	isReverseReferencedTweetsDone, _ := tweetRelReverseReferencedTweetsCtx.Value(ctx)
+	if !isReverseReferencedTweetsDone && o.r.ReverseReferencedTweets != nil {
+		ctx = tweetRelReverseReferencedTweetsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ReverseReferencedTweets {
+			if r.o.alreadyPersisted {
+				m.R.ReverseReferencedTweets = append(m.R.ReverseReferencedTweets, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachReverseReferencedTweets(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return err
 }
 
@@ -199,25 +564,25 @@ func (o *TweetTemplate) Create(ctx context.Context, exec bob.Executor) (*models.
 		TweetMods.WithNewUser().Apply(ctx, o)
 	}
 
-	var rel0 *models.User
+	var rel9 *models.User
 
 	if o.r.User.o.alreadyPersisted {
-		rel0 = o.r.User.o.Build()
+		rel9 = o.r.User.o.Build()
 	} else {
-		rel0, err = o.r.User.o.Create(ctx, exec)
+		rel9, err = o.r.User.o.Create(ctx, exec)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	opt.UserID = omit.From(rel0.ID)
+	opt.UserID = omit.From(rel9.ID)
 
 	m, err := models.Tweets.Insert(opt).One(ctx, exec)
 	if err != nil {
 		return nil, err
 	}
 
-	m.R.User = rel0
+	m.R.User = rel9
 
 	if err := o.insertOptRels(ctx, exec, m); err != nil {
 		return nil, err
@@ -302,6 +667,11 @@ func (m tweetMods) RandomizeAllColumns(f *faker.Faker) TweetMod {
 		TweetMods.RandomDeletedAt(f),
 		TweetMods.RandomCreatedAt(f),
 		TweetMods.RandomUpdatedAt(f),
+		TweetMods.RandomKind(f),
+		TweetMods.RandomReferencedTweetID(f),
+		TweetMods.RandomInReplyToTweetID(f),
+		TweetMods.RandomConversationID(f),
+		TweetMods.RandomSearchVector(f),
 	}
 }
 
@@ -557,12 +927,243 @@ func (m tweetMods) RandomUpdatedAtNotNull(f *faker.Faker) TweetMod {
 	})
 }
 
+// Set the model columns to this value
+func (m tweetMods) Kind(val string) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.Kind = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetMods) KindFunc(f func() string) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.Kind = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetMods) UnsetKind() TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.Kind = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tweetMods) RandomKind(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.Kind = func() string {
+			return random_string(f, "16")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tweetMods) ReferencedTweetID(val null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ReferencedTweetID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetMods) ReferencedTweetIDFunc(f func() null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ReferencedTweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetMods) UnsetReferencedTweetID() TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ReferencedTweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m tweetMods) RandomReferencedTweetID(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ReferencedTweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m tweetMods) RandomReferencedTweetIDNotNull(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ReferencedTweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tweetMods) InReplyToTweetID(val null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.InReplyToTweetID = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetMods) InReplyToTweetIDFunc(f func() null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.InReplyToTweetID = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetMods) UnsetInReplyToTweetID() TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.InReplyToTweetID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m tweetMods) RandomInReplyToTweetID(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.InReplyToTweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m tweetMods) RandomInReplyToTweetIDNotNull(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.InReplyToTweetID = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f, "36")
+			return null.From(val)
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tweetMods) ConversationID(val string) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ConversationID = func() string { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetMods) ConversationIDFunc(f func() string) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ConversationID = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetMods) UnsetConversationID() TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ConversationID = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+func (m tweetMods) RandomConversationID(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.ConversationID = func() string {
+			return random_string(f, "36")
+		}
+	})
+}
+
+// Set the model columns to this value
+func (m tweetMods) SearchVector(val null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.SearchVector = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m tweetMods) SearchVectorFunc(f func() null.Val[string]) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.SearchVector = f
+	})
+}
+
+// Clear any values for the column
+func (m tweetMods) UnsetSearchVector() TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.SearchVector = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m tweetMods) RandomSearchVector(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.SearchVector = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m tweetMods) RandomSearchVectorNotNull(f *faker.Faker) TweetMod {
+	return TweetModFunc(func(_ context.Context, o *TweetTemplate) {
+		o.SearchVector = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
 func (m tweetMods) WithParentsCascading() TweetMod {
 	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
 		if isDone, _ := tweetWithParentsCascadingCtx.Value(ctx); isDone {
 			return
 		}
 		ctx = tweetWithParentsCascadingCtx.WithValue(ctx, true)
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithInReplyToTweet(related).Apply(ctx, o)
+		}
+		{
+
+			related := o.f.NewTweetWithContext(ctx, TweetMods.WithParentsCascading())
+			m.WithReferencedTweet(related).Apply(ctx, o)
+		}
 		{
 
 			related := o.f.NewUserWithContext(ctx, UserMods.WithParentsCascading())
@@ -571,6 +1172,66 @@ func (m tweetMods) WithParentsCascading() TweetMod {
 	})
 }
 
+func (m tweetMods) WithInReplyToTweet(rel *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.InReplyToTweet = &tweetRInReplyToTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m tweetMods) WithNewInReplyToTweet(mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithInReplyToTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) WithExistingInReplyToTweet(em *models.Tweet) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.InReplyToTweet = &tweetRInReplyToTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m tweetMods) WithoutInReplyToTweet() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.InReplyToTweet = nil
+	})
+}
+
+func (m tweetMods) WithReferencedTweet(rel *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReferencedTweet = &tweetRReferencedTweetR{
+			o: rel,
+		}
+	})
+}
+
+func (m tweetMods) WithNewReferencedTweet(mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+
+		m.WithReferencedTweet(related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) WithExistingReferencedTweet(em *models.Tweet) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReferencedTweet = &tweetRReferencedTweetR{
+			o: o.f.FromExistingTweet(em),
+		}
+	})
+}
+
+func (m tweetMods) WithoutReferencedTweet() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReferencedTweet = nil
+	})
+}
+
 func (m tweetMods) WithUser(rel *UserTemplate) TweetMod {
 	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
 		o.r.User = &tweetRUserR{
@@ -600,3 +1261,339 @@ func (m tweetMods) WithoutUser() TweetMod {
 		o.r.User = nil
 	})
 }
+
+func (m tweetMods) WithBookmarks(number int, related *BookmarkTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Bookmarks = []*tweetRBookmarksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewBookmarks(number int, mods ...BookmarkMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.WithBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddBookmarks(number int, related *BookmarkTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Bookmarks = append(o.r.Bookmarks, &tweetRBookmarksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewBookmarks(number int, mods ...BookmarkMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.AddBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingBookmarks(existingModels ...*models.Bookmark) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.Bookmarks = append(o.r.Bookmarks, &tweetRBookmarksR{
+				o: o.f.FromExistingBookmark(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutBookmarks() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Bookmarks = nil
+	})
+}
+
+func (m tweetMods) WithLikes(number int, related *LikeTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Likes = []*tweetRLikesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewLikes(number int, mods ...LikeMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewLikeWithContext(ctx, mods...)
+		m.WithLikes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddLikes(number int, related *LikeTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Likes = append(o.r.Likes, &tweetRLikesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewLikes(number int, mods ...LikeMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewLikeWithContext(ctx, mods...)
+		m.AddLikes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingLikes(existingModels ...*models.Like) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.Likes = append(o.r.Likes, &tweetRLikesR{
+				o: o.f.FromExistingLike(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutLikes() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Likes = nil
+	})
+}
+
+func (m tweetMods) WithMentions(number int, related *MentionTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Mentions = []*tweetRMentionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewMentions(number int, mods ...MentionMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewMentionWithContext(ctx, mods...)
+		m.WithMentions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddMentions(number int, related *MentionTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Mentions = append(o.r.Mentions, &tweetRMentionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewMentions(number int, mods ...MentionMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewMentionWithContext(ctx, mods...)
+		m.AddMentions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingMentions(existingModels ...*models.Mention) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.Mentions = append(o.r.Mentions, &tweetRMentionsR{
+				o: o.f.FromExistingMention(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutMentions() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Mentions = nil
+	})
+}
+
+func (m tweetMods) WithNotifications(number int, related *NotificationTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Notifications = []*tweetRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewNotifications(number int, mods ...NotificationMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddNotifications(number int, related *NotificationTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &tweetRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewNotifications(number int, mods ...NotificationMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingNotifications(existingModels ...*models.Notification) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &tweetRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutNotifications() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
+func (m tweetMods) WithHashtags(number int, related *HashtagTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Hashtags = []*tweetRHashtagsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewHashtags(number int, mods ...HashtagMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewHashtagWithContext(ctx, mods...)
+		m.WithHashtags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddHashtags(number int, related *HashtagTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Hashtags = append(o.r.Hashtags, &tweetRHashtagsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewHashtags(number int, mods ...HashtagMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewHashtagWithContext(ctx, mods...)
+		m.AddHashtags(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingHashtags(existingModels ...*models.Hashtag) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.Hashtags = append(o.r.Hashtags, &tweetRHashtagsR{
+				o: o.f.FromExistingHashtag(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutHashtags() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.Hashtags = nil
+	})
+}
+
+func (m tweetMods) WithReverseInReplyToTweets(number int, related *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseInReplyToTweets = []*tweetRReverseInReplyToTweetsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewReverseInReplyToTweets(number int, mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.WithReverseInReplyToTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddReverseInReplyToTweets(number int, related *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseInReplyToTweets = append(o.r.ReverseInReplyToTweets, &tweetRReverseInReplyToTweetsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewReverseInReplyToTweets(number int, mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.AddReverseInReplyToTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingReverseInReplyToTweets(existingModels ...*models.Tweet) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.ReverseInReplyToTweets = append(o.r.ReverseInReplyToTweets, &tweetRReverseInReplyToTweetsR{
+				o: o.f.FromExistingTweet(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutReverseInReplyToTweets() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseInReplyToTweets = nil
+	})
+}
+
+func (m tweetMods) WithReverseReferencedTweets(number int, related *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseReferencedTweets = []*tweetRReverseReferencedTweetsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m tweetMods) WithNewReverseReferencedTweets(number int, mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.WithReverseReferencedTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddReverseReferencedTweets(number int, related *TweetTemplate) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseReferencedTweets = append(o.r.ReverseReferencedTweets, &tweetRReverseReferencedTweetsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m tweetMods) AddNewReverseReferencedTweets(number int, mods ...TweetMod) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		related := o.f.NewTweetWithContext(ctx, mods...)
+		m.AddReverseReferencedTweets(number, related).Apply(ctx, o)
+	})
+}
+
+func (m tweetMods) AddExistingReverseReferencedTweets(existingModels ...*models.Tweet) TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		for _, em := range existingModels {
+			o.r.ReverseReferencedTweets = append(o.r.ReverseReferencedTweets, &tweetRReverseReferencedTweetsR{
+				o: o.f.FromExistingTweet(em),
+			})
+		}
+	})
+}
+
+func (m tweetMods) WithoutReverseReferencedTweets() TweetMod {
+	return TweetModFunc(func(ctx context.Context, o *TweetTemplate) {
+		o.r.ReverseReferencedTweets = nil
+	})
+}
@@ -37,13 +37,14 @@ func (mods UserModSlice) Apply(ctx context.Context, n *UserTemplate) {
 // UserTemplate is an object representing the database table.
 // all columns are optional and should be set by mods
 type UserTemplate struct {
-	ID        func() string
-	Username  func() string
-	Email     func() string
-	Name      func() null.Val[string]
-	DeletedAt func() null.Val[time.Time]
-	CreatedAt func() null.Val[time.Time]
-	UpdatedAt func() null.Val[time.Time]
+	ID           func() string
+	Username     func() string
+	Email        func() string
+	Name         func() null.Val[string]
+	DeletedAt    func() null.Val[time.Time]
+	CreatedAt    func() null.Val[time.Time]
+	UpdatedAt    func() null.Val[time.Time]
+	PasswordHash func() null.Val[string]
 
 	r userR
 	f *Factory
@@ -52,9 +53,74 @@ type UserTemplate struct {
 }
 
 type userR struct {
-	Tweets []*userRTweetsR
+	BlockedBlocks       []*userRBlockedBlocksR
+	BlockerBlocks       []*userRBlockerBlocksR
+	Bookmarks           []*userRBookmarksR
+	ConversationMembers []*userRConversationMembersR
+	FolloweeFollows     []*userRFolloweeFollowsR
+	FollowerFollows     []*userRFollowerFollowsR
+	Likes               []*userRLikesR
+	Mentions            []*userRMentionsR
+	SenderMessages      []*userRSenderMessagesR
+	MutedMutes          []*userRMutedMutesR
+	MuterMutes          []*userRMuterMutesR
+	ActorNotifications  []*userRActorNotificationsR
+	Notifications       []*userRNotificationsR
+	Tweets              []*userRTweetsR
 }
 
+type userRBlockedBlocksR struct {
+	number int
+	o      *BlockTemplate
+}
+type userRBlockerBlocksR struct {
+	number int
+	o      *BlockTemplate
+}
+type userRBookmarksR struct {
+	number int
+	o      *BookmarkTemplate
+}
+type userRConversationMembersR struct {
+	number int
+	o      *ConversationMemberTemplate
+}
+type userRFolloweeFollowsR struct {
+	number int
+	o      *FollowTemplate
+}
+type userRFollowerFollowsR struct {
+	number int
+	o      *FollowTemplate
+}
+type userRLikesR struct {
+	number int
+	o      *LikeTemplate
+}
+type userRMentionsR struct {
+	number int
+	o      *MentionTemplate
+}
+type userRSenderMessagesR struct {
+	number int
+	o      *MessageTemplate
+}
+type userRMutedMutesR struct {
+	number int
+	o      *MuteTemplate
+}
+type userRMuterMutesR struct {
+	number int
+	o      *MuteTemplate
+}
+type userRActorNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
+type userRNotificationsR struct {
+	number int
+	o      *NotificationTemplate
+}
 type userRTweetsR struct {
 	number int
 	o      *TweetTemplate
@@ -70,6 +136,175 @@ func (o *UserTemplate) Apply(ctx context.Context, mods ...UserMod) {
 // setModelRels creates and sets the relationships on *models.User
 // according to the relationships in the template. Nothing is inserted into the db
 func (t UserTemplate) setModelRels(o *models.User) {
+	if t.r.BlockedBlocks != nil {
+		rel := models.BlockSlice{}
+		for _, r := range t.r.BlockedBlocks {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.BlockedID = o.ID // h2
+				rel.R.BlockedUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.BlockedBlocks = rel
+	}
+
+	if t.r.BlockerBlocks != nil {
+		rel := models.BlockSlice{}
+		for _, r := range t.r.BlockerBlocks {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.BlockerID = o.ID // h2
+				rel.R.BlockerUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.BlockerBlocks = rel
+	}
+
+	if t.r.Bookmarks != nil {
+		rel := models.BookmarkSlice{}
+		for _, r := range t.r.Bookmarks {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Bookmarks = rel
+	}
+
+	if t.r.ConversationMembers != nil {
+		rel := models.ConversationMemberSlice{}
+		for _, r := range t.r.ConversationMembers {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ConversationMembers = rel
+	}
+
+	if t.r.FolloweeFollows != nil {
+		rel := models.FollowSlice{}
+		for _, r := range t.r.FolloweeFollows {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.FolloweeID = o.ID // h2
+				rel.R.FolloweeUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.FolloweeFollows = rel
+	}
+
+	if t.r.FollowerFollows != nil {
+		rel := models.FollowSlice{}
+		for _, r := range t.r.FollowerFollows {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.FollowerID = o.ID // h2
+				rel.R.FollowerUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.FollowerFollows = rel
+	}
+
+	if t.r.Likes != nil {
+		rel := models.LikeSlice{}
+		for _, r := range t.r.Likes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Likes = rel
+	}
+
+	if t.r.Mentions != nil {
+		rel := models.MentionSlice{}
+		for _, r := range t.r.Mentions {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Mentions = rel
+	}
+
+	if t.r.SenderMessages != nil {
+		rel := models.MessageSlice{}
+		for _, r := range t.r.SenderMessages {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.SenderID = o.ID // h2
+				rel.R.SenderUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.SenderMessages = rel
+	}
+
+	if t.r.MutedMutes != nil {
+		rel := models.MuteSlice{}
+		for _, r := range t.r.MutedMutes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.MutedID = o.ID // h2
+				rel.R.MutedUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.MutedMutes = rel
+	}
+
+	if t.r.MuterMutes != nil {
+		rel := models.MuteSlice{}
+		for _, r := range t.r.MuterMutes {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.MuterID = o.ID // h2
+				rel.R.MuterUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.MuterMutes = rel
+	}
+
+	if t.r.ActorNotifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.ActorNotifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.ActorID = o.ID // h2
+				rel.R.ActorUser = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.ActorNotifications = rel
+	}
+
+	if t.r.Notifications != nil {
+		rel := models.NotificationSlice{}
+		for _, r := range t.r.Notifications {
+			related := r.o.BuildMany(r.number)
+			for _, rel := range related {
+				rel.UserID = o.ID // h2
+				rel.R.User = o
+			}
+			rel = append(rel, related...)
+		}
+		o.R.Notifications = rel
+	}
+
 	if t.r.Tweets != nil {
 		rel := models.TweetSlice{}
 		for _, r := range t.r.Tweets {
@@ -117,6 +352,10 @@ func (o UserTemplate) BuildSetter() *models.UserSetter {
 		val := o.UpdatedAt()
 		m.UpdatedAt = omitnull.FromNull(val)
 	}
+	if o.PasswordHash != nil {
+		val := o.PasswordHash()
+		m.PasswordHash = omitnull.FromNull(val)
+	}
 
 	return m
 }
@@ -160,6 +399,9 @@ func (o UserTemplate) Build() *models.User {
 	if o.UpdatedAt != nil {
 		m.UpdatedAt = o.UpdatedAt()
 	}
+	if o.PasswordHash != nil {
+		m.PasswordHash = o.PasswordHash()
+	}
 
 	o.setModelRels(m)
 
@@ -200,19 +442,19 @@ func ensureCreatableUser(m *models.UserSetter) {
 func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *models.User) error {
 	var err error
 
-	isTweetsDone, _ := userRelTweetsCtx.Value(ctx)
-	if !isTweetsDone && o.r.Tweets != nil {
-		ctx = userRelTweetsCtx.WithValue(ctx, true)
-		for _, r := range o.r.Tweets {
+	isBlockedBlocksDone, _ := userRelBlockedBlocksCtx.Value(ctx)
+	if !isBlockedBlocksDone && o.r.BlockedBlocks != nil {
+		ctx = userRelBlockedBlocksCtx.WithValue(ctx, true)
+		for _, r := range o.r.BlockedBlocks {
 			if r.o.alreadyPersisted {
-				m.R.Tweets = append(m.R.Tweets, r.o.Build())
+				m.R.BlockedBlocks = append(m.R.BlockedBlocks, r.o.Build())
 			} else {
 				rel0, err := r.o.CreateMany(ctx, exec, r.number)
 				if err != nil {
 					return err
 				}
 
-				err = m.AttachTweets(ctx, exec, rel0...)
+				err = m.AttachBlockedBlocks(ctx, exec, rel0...)
 				if err != nil {
 					return err
 				}
@@ -220,82 +462,342 @@ func (o *UserTemplate) insertOptRels(ctx context.Context, exec bob.Executor, m *
 		}
 	}
 
-	return err
-}
-
-// Create builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
-	var err error
-	opt := o.BuildSetter()
-	ensureCreatableUser(opt)
+	isBlockerBlocksDone, _ := userRelBlockerBlocksCtx.Value(ctx)
+	if !isBlockerBlocksDone && o.r.BlockerBlocks != nil {
+		ctx = userRelBlockerBlocksCtx.WithValue(ctx, true)
+		for _, r := range o.r.BlockerBlocks {
+			if r.o.alreadyPersisted {
+				m.R.BlockerBlocks = append(m.R.BlockerBlocks, r.o.Build())
+			} else {
+				rel1, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	m, err := models.Users.Insert(opt).One(ctx, exec)
-	if err != nil {
-		return nil, err
+				err = m.AttachBlockerBlocks(ctx, exec, rel1...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	if err := o.insertOptRels(ctx, exec, m); err != nil {
-		return nil, err
+	isBookmarksDone, _ := userRelBookmarksCtx.Value(ctx)
+	if !isBookmarksDone && o.r.Bookmarks != nil {
+		ctx = userRelBookmarksCtx.WithValue(ctx, true)
+		for _, r := range o.r.Bookmarks {
+			if r.o.alreadyPersisted {
+				m.R.Bookmarks = append(m.R.Bookmarks, r.o.Build())
+			} else {
+				rel2, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachBookmarks(ctx, exec, rel2...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m, err
-}
 
-// MustCreate builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		panic(err)
+	isConversationMembersDone, _ := userRelConversationMembersCtx.Value(ctx)
+	if !isConversationMembersDone && o.r.ConversationMembers != nil {
+		ctx = userRelConversationMembersCtx.WithValue(ctx, true)
+		for _, r := range o.r.ConversationMembers {
+			if r.o.alreadyPersisted {
+				m.R.ConversationMembers = append(m.R.ConversationMembers, r.o.Build())
+			} else {
+				rel3, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachConversationMembers(ctx, exec, rel3...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateOrFail builds a user and inserts it into the database
-// Relations objects are also inserted and placed in the .R field
-// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
-func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
-	tb.Helper()
-	m, err := o.Create(ctx, exec)
-	if err != nil {
-		tb.Fatal(err)
-		return nil
+	isFolloweeFollowsDone, _ := userRelFolloweeFollowsCtx.Value(ctx)
+	if !isFolloweeFollowsDone && o.r.FolloweeFollows != nil {
+		ctx = userRelFolloweeFollowsCtx.WithValue(ctx, true)
+		for _, r := range o.r.FolloweeFollows {
+			if r.o.alreadyPersisted {
+				m.R.FolloweeFollows = append(m.R.FolloweeFollows, r.o.Build())
+			} else {
+				rel4, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachFolloweeFollows(ctx, exec, rel4...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
-	var err error
-	m := make(models.UserSlice, number)
+	isFollowerFollowsDone, _ := userRelFollowerFollowsCtx.Value(ctx)
+	if !isFollowerFollowsDone && o.r.FollowerFollows != nil {
+		ctx = userRelFollowerFollowsCtx.WithValue(ctx, true)
+		for _, r := range o.r.FollowerFollows {
+			if r.o.alreadyPersisted {
+				m.R.FollowerFollows = append(m.R.FollowerFollows, r.o.Build())
+			} else {
+				rel5, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-	for i := range m {
-		m[i], err = o.Create(ctx, exec)
-		if err != nil {
-			return nil, err
+				err = m.AttachFollowerFollows(ctx, exec, rel5...)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
-	return m, nil
-}
+	isLikesDone, _ := userRelLikesCtx.Value(ctx)
+	if !isLikesDone && o.r.Likes != nil {
+		ctx = userRelLikesCtx.WithValue(ctx, true)
+		for _, r := range o.r.Likes {
+			if r.o.alreadyPersisted {
+				m.R.Likes = append(m.R.Likes, r.o.Build())
+			} else {
+				rel6, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
 
-// MustCreateMany builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-// panics if an error occurs
-func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
-	m, err := o.CreateMany(ctx, exec, number)
-	if err != nil {
-		panic(err)
+				err = m.AttachLikes(ctx, exec, rel6...)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	return m
-}
 
-// CreateManyOrFail builds multiple users and inserts them into the database
-// Relations objects are also inserted and placed in the .R field
-// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
-func (o UserTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserSlice {
+	isMentionsDone, _ := userRelMentionsCtx.Value(ctx)
+	if !isMentionsDone && o.r.Mentions != nil {
+		ctx = userRelMentionsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Mentions {
+			if r.o.alreadyPersisted {
+				m.R.Mentions = append(m.R.Mentions, r.o.Build())
+			} else {
+				rel7, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMentions(ctx, exec, rel7...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isSenderMessagesDone, _ := userRelSenderMessagesCtx.Value(ctx)
+	if !isSenderMessagesDone && o.r.SenderMessages != nil {
+		ctx = userRelSenderMessagesCtx.WithValue(ctx, true)
+		for _, r := range o.r.SenderMessages {
+			if r.o.alreadyPersisted {
+				m.R.SenderMessages = append(m.R.SenderMessages, r.o.Build())
+			} else {
+				rel8, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachSenderMessages(ctx, exec, rel8...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isMutedMutesDone, _ := userRelMutedMutesCtx.Value(ctx)
+	if !isMutedMutesDone && o.r.MutedMutes != nil {
+		ctx = userRelMutedMutesCtx.WithValue(ctx, true)
+		for _, r := range o.r.MutedMutes {
+			if r.o.alreadyPersisted {
+				m.R.MutedMutes = append(m.R.MutedMutes, r.o.Build())
+			} else {
+				rel9, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMutedMutes(ctx, exec, rel9...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isMuterMutesDone, _ := userRelMuterMutesCtx.Value(ctx)
+	if !isMuterMutesDone && o.r.MuterMutes != nil {
+		ctx = userRelMuterMutesCtx.WithValue(ctx, true)
+		for _, r := range o.r.MuterMutes {
+			if r.o.alreadyPersisted {
+				m.R.MuterMutes = append(m.R.MuterMutes, r.o.Build())
+			} else {
+				rel10, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachMuterMutes(ctx, exec, rel10...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isActorNotificationsDone, _ := userRelActorNotificationsCtx.Value(ctx)
+	if !isActorNotificationsDone && o.r.ActorNotifications != nil {
+		ctx = userRelActorNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.ActorNotifications {
+			if r.o.alreadyPersisted {
+				m.R.ActorNotifications = append(m.R.ActorNotifications, r.o.Build())
+			} else {
+				rel11, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachActorNotifications(ctx, exec, rel11...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isNotificationsDone, _ := userRelNotificationsCtx.Value(ctx)
+	if !isNotificationsDone && o.r.Notifications != nil {
+		ctx = userRelNotificationsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Notifications {
+			if r.o.alreadyPersisted {
+				m.R.Notifications = append(m.R.Notifications, r.o.Build())
+			} else {
+				rel12, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachNotifications(ctx, exec, rel12...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	isTweetsDone, _ := userRelTweetsCtx.Value(ctx)
+	if !isTweetsDone && o.r.Tweets != nil {
+		ctx = userRelTweetsCtx.WithValue(ctx, true)
+		for _, r := range o.r.Tweets {
+			if r.o.alreadyPersisted {
+				m.R.Tweets = append(m.R.Tweets, r.o.Build())
+			} else {
+				rel13, err := r.o.CreateMany(ctx, exec, r.number)
+				if err != nil {
+					return err
+				}
+
+				err = m.AttachTweets(ctx, exec, rel13...)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return err
+}
+
+// Create builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+func (o *UserTemplate) Create(ctx context.Context, exec bob.Executor) (*models.User, error) {
+	var err error
+	opt := o.BuildSetter()
+	ensureCreatableUser(opt)
+
+	m, err := models.Users.Insert(opt).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.insertOptRels(ctx, exec, m); err != nil {
+		return nil, err
+	}
+	return m, err
+}
+
+// MustCreate builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o *UserTemplate) MustCreate(ctx context.Context, exec bob.Executor) *models.User {
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateOrFail builds a user and inserts it into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o *UserTemplate) CreateOrFail(ctx context.Context, tb testing.TB, exec bob.Executor) *models.User {
+	tb.Helper()
+	m, err := o.Create(ctx, exec)
+	if err != nil {
+		tb.Fatal(err)
+		return nil
+	}
+	return m
+}
+
+// CreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+func (o UserTemplate) CreateMany(ctx context.Context, exec bob.Executor, number int) (models.UserSlice, error) {
+	var err error
+	m := make(models.UserSlice, number)
+
+	for i := range m {
+		m[i], err = o.Create(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// MustCreateMany builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// panics if an error occurs
+func (o UserTemplate) MustCreateMany(ctx context.Context, exec bob.Executor, number int) models.UserSlice {
+	m, err := o.CreateMany(ctx, exec, number)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// CreateManyOrFail builds multiple users and inserts them into the database
+// Relations objects are also inserted and placed in the .R field
+// It calls `tb.Fatal(err)` on the test/benchmark if an error occurs
+func (o UserTemplate) CreateManyOrFail(ctx context.Context, tb testing.TB, exec bob.Executor, number int) models.UserSlice {
 	tb.Helper()
 	m, err := o.CreateMany(ctx, exec, number)
 	if err != nil {
@@ -319,6 +821,7 @@ func (m userMods) RandomizeAllColumns(f *faker.Faker) UserMod {
 		UserMods.RandomDeletedAt(f),
 		UserMods.RandomCreatedAt(f),
 		UserMods.RandomUpdatedAt(f),
+		UserMods.RandomPasswordHash(f),
 	}
 }
 
@@ -627,6 +1130,59 @@ func (m userMods) RandomUpdatedAtNotNull(f *faker.Faker) UserMod {
 	})
 }
 
+// Set the model columns to this value
+func (m userMods) PasswordHash(val null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordHash = func() null.Val[string] { return val }
+	})
+}
+
+// Set the Column from the function
+func (m userMods) PasswordHashFunc(f func() null.Val[string]) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordHash = f
+	})
+}
+
+// Clear any values for the column
+func (m userMods) UnsetPasswordHash() UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordHash = nil
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is sometimes null
+func (m userMods) RandomPasswordHash(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordHash = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
+// Generates a random value for the column using the given faker
+// if faker is nil, a default faker is used
+// The generated value is never null
+func (m userMods) RandomPasswordHashNotNull(f *faker.Faker) UserMod {
+	return UserModFunc(func(_ context.Context, o *UserTemplate) {
+		o.PasswordHash = func() null.Val[string] {
+			if f == nil {
+				f = &defaultFaker
+			}
+
+			val := random_string(f)
+			return null.From(val)
+		}
+	})
+}
+
 func (m userMods) WithParentsCascading() UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		if isDone, _ := userWithParentsCascadingCtx.Value(ctx); isDone {
@@ -636,6 +1192,630 @@ func (m userMods) WithParentsCascading() UserMod {
 	})
 }
 
+func (m userMods) WithBlockedBlocks(number int, related *BlockTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockedBlocks = []*userRBlockedBlocksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewBlockedBlocks(number int, mods ...BlockMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBlockWithContext(ctx, mods...)
+		m.WithBlockedBlocks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddBlockedBlocks(number int, related *BlockTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockedBlocks = append(o.r.BlockedBlocks, &userRBlockedBlocksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewBlockedBlocks(number int, mods ...BlockMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBlockWithContext(ctx, mods...)
+		m.AddBlockedBlocks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingBlockedBlocks(existingModels ...*models.Block) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.BlockedBlocks = append(o.r.BlockedBlocks, &userRBlockedBlocksR{
+				o: o.f.FromExistingBlock(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutBlockedBlocks() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockedBlocks = nil
+	})
+}
+
+func (m userMods) WithBlockerBlocks(number int, related *BlockTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockerBlocks = []*userRBlockerBlocksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewBlockerBlocks(number int, mods ...BlockMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBlockWithContext(ctx, mods...)
+		m.WithBlockerBlocks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddBlockerBlocks(number int, related *BlockTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockerBlocks = append(o.r.BlockerBlocks, &userRBlockerBlocksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewBlockerBlocks(number int, mods ...BlockMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBlockWithContext(ctx, mods...)
+		m.AddBlockerBlocks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingBlockerBlocks(existingModels ...*models.Block) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.BlockerBlocks = append(o.r.BlockerBlocks, &userRBlockerBlocksR{
+				o: o.f.FromExistingBlock(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutBlockerBlocks() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.BlockerBlocks = nil
+	})
+}
+
+func (m userMods) WithBookmarks(number int, related *BookmarkTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = []*userRBookmarksR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewBookmarks(number int, mods ...BookmarkMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.WithBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddBookmarks(number int, related *BookmarkTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = append(o.r.Bookmarks, &userRBookmarksR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewBookmarks(number int, mods ...BookmarkMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewBookmarkWithContext(ctx, mods...)
+		m.AddBookmarks(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingBookmarks(existingModels ...*models.Bookmark) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Bookmarks = append(o.r.Bookmarks, &userRBookmarksR{
+				o: o.f.FromExistingBookmark(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutBookmarks() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Bookmarks = nil
+	})
+}
+
+func (m userMods) WithConversationMembers(number int, related *ConversationMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ConversationMembers = []*userRConversationMembersR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewConversationMembers(number int, mods ...ConversationMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.WithConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddConversationMembers(number int, related *ConversationMemberTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ConversationMembers = append(o.r.ConversationMembers, &userRConversationMembersR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewConversationMembers(number int, mods ...ConversationMemberMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewConversationMemberWithContext(ctx, mods...)
+		m.AddConversationMembers(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingConversationMembers(existingModels ...*models.ConversationMember) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ConversationMembers = append(o.r.ConversationMembers, &userRConversationMembersR{
+				o: o.f.FromExistingConversationMember(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutConversationMembers() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ConversationMembers = nil
+	})
+}
+
+func (m userMods) WithFolloweeFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FolloweeFollows = []*userRFolloweeFollowsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewFolloweeFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.WithFolloweeFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddFolloweeFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FolloweeFollows = append(o.r.FolloweeFollows, &userRFolloweeFollowsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewFolloweeFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.AddFolloweeFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingFolloweeFollows(existingModels ...*models.Follow) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.FolloweeFollows = append(o.r.FolloweeFollows, &userRFolloweeFollowsR{
+				o: o.f.FromExistingFollow(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutFolloweeFollows() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FolloweeFollows = nil
+	})
+}
+
+func (m userMods) WithFollowerFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FollowerFollows = []*userRFollowerFollowsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewFollowerFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.WithFollowerFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddFollowerFollows(number int, related *FollowTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FollowerFollows = append(o.r.FollowerFollows, &userRFollowerFollowsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewFollowerFollows(number int, mods ...FollowMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewFollowWithContext(ctx, mods...)
+		m.AddFollowerFollows(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingFollowerFollows(existingModels ...*models.Follow) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.FollowerFollows = append(o.r.FollowerFollows, &userRFollowerFollowsR{
+				o: o.f.FromExistingFollow(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutFollowerFollows() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.FollowerFollows = nil
+	})
+}
+
+func (m userMods) WithLikes(number int, related *LikeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Likes = []*userRLikesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewLikes(number int, mods ...LikeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLikeWithContext(ctx, mods...)
+		m.WithLikes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddLikes(number int, related *LikeTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Likes = append(o.r.Likes, &userRLikesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewLikes(number int, mods ...LikeMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewLikeWithContext(ctx, mods...)
+		m.AddLikes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingLikes(existingModels ...*models.Like) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Likes = append(o.r.Likes, &userRLikesR{
+				o: o.f.FromExistingLike(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutLikes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Likes = nil
+	})
+}
+
+func (m userMods) WithMentions(number int, related *MentionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Mentions = []*userRMentionsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewMentions(number int, mods ...MentionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMentionWithContext(ctx, mods...)
+		m.WithMentions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddMentions(number int, related *MentionTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Mentions = append(o.r.Mentions, &userRMentionsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewMentions(number int, mods ...MentionMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMentionWithContext(ctx, mods...)
+		m.AddMentions(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingMentions(existingModels ...*models.Mention) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Mentions = append(o.r.Mentions, &userRMentionsR{
+				o: o.f.FromExistingMention(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutMentions() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Mentions = nil
+	})
+}
+
+func (m userMods) WithSenderMessages(number int, related *MessageTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SenderMessages = []*userRSenderMessagesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewSenderMessages(number int, mods ...MessageMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+		m.WithSenderMessages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddSenderMessages(number int, related *MessageTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SenderMessages = append(o.r.SenderMessages, &userRSenderMessagesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewSenderMessages(number int, mods ...MessageMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMessageWithContext(ctx, mods...)
+		m.AddSenderMessages(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingSenderMessages(existingModels ...*models.Message) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.SenderMessages = append(o.r.SenderMessages, &userRSenderMessagesR{
+				o: o.f.FromExistingMessage(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutSenderMessages() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.SenderMessages = nil
+	})
+}
+
+func (m userMods) WithMutedMutes(number int, related *MuteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MutedMutes = []*userRMutedMutesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewMutedMutes(number int, mods ...MuteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMuteWithContext(ctx, mods...)
+		m.WithMutedMutes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddMutedMutes(number int, related *MuteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MutedMutes = append(o.r.MutedMutes, &userRMutedMutesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewMutedMutes(number int, mods ...MuteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMuteWithContext(ctx, mods...)
+		m.AddMutedMutes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingMutedMutes(existingModels ...*models.Mute) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.MutedMutes = append(o.r.MutedMutes, &userRMutedMutesR{
+				o: o.f.FromExistingMute(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutMutedMutes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MutedMutes = nil
+	})
+}
+
+func (m userMods) WithMuterMutes(number int, related *MuteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MuterMutes = []*userRMuterMutesR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewMuterMutes(number int, mods ...MuteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMuteWithContext(ctx, mods...)
+		m.WithMuterMutes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddMuterMutes(number int, related *MuteTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MuterMutes = append(o.r.MuterMutes, &userRMuterMutesR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewMuterMutes(number int, mods ...MuteMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewMuteWithContext(ctx, mods...)
+		m.AddMuterMutes(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingMuterMutes(existingModels ...*models.Mute) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.MuterMutes = append(o.r.MuterMutes, &userRMuterMutesR{
+				o: o.f.FromExistingMute(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutMuterMutes() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.MuterMutes = nil
+	})
+}
+
+func (m userMods) WithActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = []*userRActorNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddActorNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewActorNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddActorNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingActorNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.ActorNotifications = append(o.r.ActorNotifications, &userRActorNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutActorNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.ActorNotifications = nil
+	})
+}
+
+func (m userMods) WithNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = []*userRNotificationsR{{
+			number: number,
+			o:      related,
+		}}
+	})
+}
+
+func (m userMods) WithNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.WithNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddNotifications(number int, related *NotificationTemplate) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+			number: number,
+			o:      related,
+		})
+	})
+}
+
+func (m userMods) AddNewNotifications(number int, mods ...NotificationMod) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		related := o.f.NewNotificationWithContext(ctx, mods...)
+		m.AddNotifications(number, related).Apply(ctx, o)
+	})
+}
+
+func (m userMods) AddExistingNotifications(existingModels ...*models.Notification) UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		for _, em := range existingModels {
+			o.r.Notifications = append(o.r.Notifications, &userRNotificationsR{
+				o: o.f.FromExistingNotification(em),
+			})
+		}
+	})
+}
+
+func (m userMods) WithoutNotifications() UserMod {
+	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
+		o.r.Notifications = nil
+	})
+}
+
 func (m userMods) WithTweets(number int, related *TweetTemplate) UserMod {
 	return UserModFunc(func(ctx context.Context, o *UserTemplate) {
 		o.r.Tweets = []*userRTweetsR{{
@@ -0,0 +1,816 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Follow is an object representing the database table.
+type Follow struct {
+	FollowerID string              `db:"follower_id,pk" `
+	FolloweeID string              `db:"followee_id,pk" `
+	CreatedAt  null.Val[time.Time] `db:"created_at" `
+
+	R followR `db:"-" `
+}
+
+// FollowSlice is an alias for a slice of pointers to Follow.
+// This should almost always be used instead of []*Follow.
+type FollowSlice []*Follow
+
+// Follows contains methods to work with the follows table
+var Follows = psql.NewTablex[*Follow, FollowSlice, *FollowSetter]("", "follows", buildFollowColumns("follows"))
+
+// FollowsQuery is a query on the follows table
+type FollowsQuery = *psql.ViewQuery[*Follow, FollowSlice]
+
+// followR is where relationships are stored.
+type followR struct {
+	FolloweeUser *User // follows.follows_followee_id_fkey
+	FollowerUser *User // follows.follows_follower_id_fkey
+}
+
+func buildFollowColumns(alias string) followColumns {
+	return followColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"follower_id", "followee_id", "created_at",
+		).WithParent("follows"),
+		tableAlias: alias,
+		FollowerID: psql.Quote(alias, "follower_id"),
+		FolloweeID: psql.Quote(alias, "followee_id"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type followColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	FollowerID psql.Expression
+	FolloweeID psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c followColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (followColumns) AliasedAs(alias string) followColumns {
+	return buildFollowColumns(alias)
+}
+
+// FollowSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type FollowSetter struct {
+	FollowerID omit.Val[string]        `db:"follower_id,pk" `
+	FolloweeID omit.Val[string]        `db:"followee_id,pk" `
+	CreatedAt  omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s FollowSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.FollowerID.IsValue() {
+		vals = append(vals, "follower_id")
+	}
+	if s.FolloweeID.IsValue() {
+		vals = append(vals, "followee_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s FollowSetter) Overwrite(t *Follow) {
+	if s.FollowerID.IsValue() {
+		t.FollowerID = s.FollowerID.MustGet()
+	}
+	if s.FolloweeID.IsValue() {
+		t.FolloweeID = s.FolloweeID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *FollowSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Follows.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.FollowerID.IsValue() {
+			vals[0] = psql.Arg(s.FollowerID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.FolloweeID.IsValue() {
+			vals[1] = psql.Arg(s.FolloweeID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s FollowSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s FollowSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.FollowerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "follower_id")...),
+			psql.Arg(s.FollowerID),
+		}})
+	}
+
+	if s.FolloweeID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "followee_id")...),
+			psql.Arg(s.FolloweeID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindFollow retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindFollow(ctx context.Context, exec bob.Executor, FollowerIDPK string, FolloweeIDPK string, cols ...string) (*Follow, error) {
+	if len(cols) == 0 {
+		return Follows.Query(
+			sm.Where(Follows.Columns.FollowerID.EQ(psql.Arg(FollowerIDPK))),
+			sm.Where(Follows.Columns.FolloweeID.EQ(psql.Arg(FolloweeIDPK))),
+		).One(ctx, exec)
+	}
+
+	return Follows.Query(
+		sm.Where(Follows.Columns.FollowerID.EQ(psql.Arg(FollowerIDPK))),
+		sm.Where(Follows.Columns.FolloweeID.EQ(psql.Arg(FolloweeIDPK))),
+		sm.Columns(Follows.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// FollowExists checks the presence of a single record by primary key
+func FollowExists(ctx context.Context, exec bob.Executor, FollowerIDPK string, FolloweeIDPK string) (bool, error) {
+	return Follows.Query(
+		sm.Where(Follows.Columns.FollowerID.EQ(psql.Arg(FollowerIDPK))),
+		sm.Where(Follows.Columns.FolloweeID.EQ(psql.Arg(FolloweeIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Follow is retrieved from the database
+func (o *Follow) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Follows.AfterSelectHooks.RunHooks(ctx, exec, FollowSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Follows.AfterInsertHooks.RunHooks(ctx, exec, FollowSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Follows.AfterUpdateHooks.RunHooks(ctx, exec, FollowSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Follows.AfterDeleteHooks.RunHooks(ctx, exec, FollowSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Follow
+func (o *Follow) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.FollowerID,
+		o.FolloweeID,
+	)
+}
+
+func (o *Follow) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("follows", "follower_id"), psql.Quote("follows", "followee_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Follow
+func (o *Follow) Update(ctx context.Context, exec bob.Executor, s *FollowSetter) error {
+	v, err := Follows.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Follow record with an executor
+func (o *Follow) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Follows.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Follow using the executor
+func (o *Follow) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Follows.Query(
+		sm.Where(Follows.Columns.FollowerID.EQ(psql.Arg(o.FollowerID))),
+		sm.Where(Follows.Columns.FolloweeID.EQ(psql.Arg(o.FolloweeID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after FollowSlice is retrieved from the database
+func (o FollowSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Follows.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Follows.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Follows.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Follows.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o FollowSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("follows", "follower_id"), psql.Quote("follows", "followee_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o FollowSlice) copyMatchingRows(from ...*Follow) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.FollowerID != old.FollowerID {
+				continue
+			}
+			if new.FolloweeID != old.FolloweeID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o FollowSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Follows.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Follow:
+				o.copyMatchingRows(retrieved)
+			case []*Follow:
+				o.copyMatchingRows(retrieved...)
+			case FollowSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Follow or a slice of Follow
+				// then run the AfterUpdateHooks on the slice
+				_, err = Follows.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o FollowSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Follows.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Follow:
+				o.copyMatchingRows(retrieved)
+			case []*Follow:
+				o.copyMatchingRows(retrieved...)
+			case FollowSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Follow or a slice of Follow
+				// then run the AfterDeleteHooks on the slice
+				_, err = Follows.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o FollowSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals FollowSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Follows.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o FollowSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Follows.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o FollowSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Follows.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// FolloweeUser starts a query for related objects on users
+func (o *Follow) FolloweeUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.FolloweeID))),
+	)...)
+}
+
+func (os FollowSlice) FolloweeUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkFolloweeID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkFolloweeID = append(pkFolloweeID, o.FolloweeID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkFolloweeID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// FollowerUser starts a query for related objects on users
+func (o *Follow) FollowerUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.FollowerID))),
+	)...)
+}
+
+func (os FollowSlice) FollowerUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkFollowerID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkFollowerID = append(pkFollowerID, o.FollowerID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkFollowerID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachFollowFolloweeUser0(ctx context.Context, exec bob.Executor, count int, follow0 *Follow, user1 *User) (*Follow, error) {
+	setter := &FollowSetter{
+		FolloweeID: omit.From(user1.ID),
+	}
+
+	err := follow0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachFollowFolloweeUser0: %w", err)
+	}
+
+	return follow0, nil
+}
+
+func (follow0 *Follow) InsertFolloweeUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachFollowFolloweeUser0(ctx, exec, 1, follow0, user1)
+	if err != nil {
+		return err
+	}
+
+	follow0.R.FolloweeUser = user1
+
+	user1.R.FolloweeFollows = append(user1.R.FolloweeFollows, follow0)
+
+	return nil
+}
+
+func (follow0 *Follow) AttachFolloweeUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachFollowFolloweeUser0(ctx, exec, 1, follow0, user1)
+	if err != nil {
+		return err
+	}
+
+	follow0.R.FolloweeUser = user1
+
+	user1.R.FolloweeFollows = append(user1.R.FolloweeFollows, follow0)
+
+	return nil
+}
+
+func attachFollowFollowerUser0(ctx context.Context, exec bob.Executor, count int, follow0 *Follow, user1 *User) (*Follow, error) {
+	setter := &FollowSetter{
+		FollowerID: omit.From(user1.ID),
+	}
+
+	err := follow0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachFollowFollowerUser0: %w", err)
+	}
+
+	return follow0, nil
+}
+
+func (follow0 *Follow) InsertFollowerUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachFollowFollowerUser0(ctx, exec, 1, follow0, user1)
+	if err != nil {
+		return err
+	}
+
+	follow0.R.FollowerUser = user1
+
+	user1.R.FollowerFollows = append(user1.R.FollowerFollows, follow0)
+
+	return nil
+}
+
+func (follow0 *Follow) AttachFollowerUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachFollowFollowerUser0(ctx, exec, 1, follow0, user1)
+	if err != nil {
+		return err
+	}
+
+	follow0.R.FollowerUser = user1
+
+	user1.R.FollowerFollows = append(user1.R.FollowerFollows, follow0)
+
+	return nil
+}
+
+type followWhere[Q psql.Filterable] struct {
+	FollowerID psql.WhereMod[Q, string]
+	FolloweeID psql.WhereMod[Q, string]
+	CreatedAt  psql.WhereNullMod[Q, time.Time]
+}
+
+func (followWhere[Q]) AliasedAs(alias string) followWhere[Q] {
+	return buildFollowWhere[Q](buildFollowColumns(alias))
+}
+
+func buildFollowWhere[Q psql.Filterable](cols followColumns) followWhere[Q] {
+	return followWhere[Q]{
+		FollowerID: psql.Where[Q, string](cols.FollowerID),
+		FolloweeID: psql.Where[Q, string](cols.FolloweeID),
+		CreatedAt:  psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Follow) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "FolloweeUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("follow cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FolloweeUser = rel
+
+		if rel != nil {
+			rel.R.FolloweeFollows = FollowSlice{o}
+		}
+		return nil
+	case "FollowerUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("follow cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FollowerUser = rel
+
+		if rel != nil {
+			rel.R.FollowerFollows = FollowSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("follow has no relationship %q", name)
+	}
+}
+
+type followPreloader struct {
+	FolloweeUser func(...psql.PreloadOption) psql.Preloader
+	FollowerUser func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildFollowPreloader() followPreloader {
+	return followPreloader{
+		FolloweeUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "FolloweeUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Follows,
+						To:          Users,
+						FromColumns: []string{"followee_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		FollowerUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "FollowerUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Follows,
+						To:          Users,
+						FromColumns: []string{"follower_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type followThenLoader[Q orm.Loadable] struct {
+	FolloweeUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	FollowerUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildFollowThenLoader[Q orm.Loadable]() followThenLoader[Q] {
+	type FolloweeUserLoadInterface interface {
+		LoadFolloweeUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FollowerUserLoadInterface interface {
+		LoadFollowerUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return followThenLoader[Q]{
+		FolloweeUser: thenLoadBuilder[Q](
+			"FolloweeUser",
+			func(ctx context.Context, exec bob.Executor, retrieved FolloweeUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFolloweeUser(ctx, exec, mods...)
+			},
+		),
+		FollowerUser: thenLoadBuilder[Q](
+			"FollowerUser",
+			func(ctx context.Context, exec bob.Executor, retrieved FollowerUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFollowerUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadFolloweeUser loads the follow's FolloweeUser into the .R struct
+func (o *Follow) LoadFolloweeUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FolloweeUser = nil
+
+	related, err := o.FolloweeUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.FolloweeFollows = FollowSlice{o}
+
+	o.R.FolloweeUser = related
+	return nil
+}
+
+// LoadFolloweeUser loads the follow's FolloweeUser into the .R struct
+func (os FollowSlice) LoadFolloweeUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.FolloweeUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.FolloweeID == rel.ID) {
+				continue
+			}
+
+			rel.R.FolloweeFollows = append(rel.R.FolloweeFollows, o)
+
+			o.R.FolloweeUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerUser loads the follow's FollowerUser into the .R struct
+func (o *Follow) LoadFollowerUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FollowerUser = nil
+
+	related, err := o.FollowerUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.FollowerFollows = FollowSlice{o}
+
+	o.R.FollowerUser = related
+	return nil
+}
+
+// LoadFollowerUser loads the follow's FollowerUser into the .R struct
+func (os FollowSlice) LoadFollowerUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.FollowerUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.FollowerID == rel.ID) {
+				continue
+			}
+
+			rel.R.FollowerFollows = append(rel.R.FollowerFollows, o)
+
+			o.R.FollowerUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type followJoins[Q dialect.Joinable] struct {
+	typ          string
+	FolloweeUser modAs[Q, userColumns]
+	FollowerUser modAs[Q, userColumns]
+}
+
+func (j followJoins[Q]) aliasedAs(alias string) followJoins[Q] {
+	return buildFollowJoins[Q](buildFollowColumns(alias), j.typ)
+}
+
+func buildFollowJoins[Q dialect.Joinable](cols followColumns, typ string) followJoins[Q] {
+	return followJoins[Q]{
+		typ: typ,
+		FolloweeUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.FolloweeID),
+					))
+				}
+
+				return mods
+			},
+		},
+		FollowerUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.FollowerID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
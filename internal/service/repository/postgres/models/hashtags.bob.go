@@ -0,0 +1,677 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
+)
+
+// Hashtag is an object representing the database table.
+type Hashtag struct {
+	ID        string              `db:"id,pk" `
+	Tag       string              `db:"tag" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R hashtagR `db:"-" `
+}
+
+// HashtagSlice is an alias for a slice of pointers to Hashtag.
+// This should almost always be used instead of []*Hashtag.
+type HashtagSlice []*Hashtag
+
+// Hashtags contains methods to work with the hashtags table
+var Hashtags = psql.NewTablex[*Hashtag, HashtagSlice, *HashtagSetter]("", "hashtags", buildHashtagColumns("hashtags"))
+
+// HashtagsQuery is a query on the hashtags table
+type HashtagsQuery = *psql.ViewQuery[*Hashtag, HashtagSlice]
+
+// hashtagR is where relationships are stored.
+type hashtagR struct {
+	Tweets TweetSlice // tweet_hashtags.tweet_hashtags_hashtag_id_fkeytweet_hashtags.tweet_hashtags_tweet_id_fkey
+}
+
+func buildHashtagColumns(alias string) hashtagColumns {
+	return hashtagColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "tag", "created_at",
+		).WithParent("hashtags"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		Tag:        psql.Quote(alias, "tag"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type hashtagColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	Tag        psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c hashtagColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (hashtagColumns) AliasedAs(alias string) hashtagColumns {
+	return buildHashtagColumns(alias)
+}
+
+// HashtagSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type HashtagSetter struct {
+	ID        omit.Val[string]        `db:"id,pk" `
+	Tag       omit.Val[string]        `db:"tag" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s HashtagSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.Tag.IsValue() {
+		vals = append(vals, "tag")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s HashtagSetter) Overwrite(t *Hashtag) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.Tag.IsValue() {
+		t.Tag = s.Tag.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *HashtagSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Hashtags.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.Tag.IsValue() {
+			vals[1] = psql.Arg(s.Tag.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s HashtagSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s HashtagSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.Tag.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tag")...),
+			psql.Arg(s.Tag),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindHashtag retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindHashtag(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Hashtag, error) {
+	if len(cols) == 0 {
+		return Hashtags.Query(
+			sm.Where(Hashtags.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Hashtags.Query(
+		sm.Where(Hashtags.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Hashtags.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// HashtagExists checks the presence of a single record by primary key
+func HashtagExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Hashtags.Query(
+		sm.Where(Hashtags.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Hashtag is retrieved from the database
+func (o *Hashtag) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Hashtags.AfterSelectHooks.RunHooks(ctx, exec, HashtagSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Hashtags.AfterInsertHooks.RunHooks(ctx, exec, HashtagSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Hashtags.AfterUpdateHooks.RunHooks(ctx, exec, HashtagSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Hashtags.AfterDeleteHooks.RunHooks(ctx, exec, HashtagSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Hashtag
+func (o *Hashtag) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Hashtag) pkEQ() dialect.Expression {
+	return psql.Quote("hashtags", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Hashtag
+func (o *Hashtag) Update(ctx context.Context, exec bob.Executor, s *HashtagSetter) error {
+	v, err := Hashtags.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Hashtag record with an executor
+func (o *Hashtag) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Hashtags.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Hashtag using the executor
+func (o *Hashtag) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Hashtags.Query(
+		sm.Where(Hashtags.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after HashtagSlice is retrieved from the database
+func (o HashtagSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Hashtags.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Hashtags.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Hashtags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Hashtags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o HashtagSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("hashtags", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o HashtagSlice) copyMatchingRows(from ...*Hashtag) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o HashtagSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Hashtags.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Hashtag:
+				o.copyMatchingRows(retrieved)
+			case []*Hashtag:
+				o.copyMatchingRows(retrieved...)
+			case HashtagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Hashtag or a slice of Hashtag
+				// then run the AfterUpdateHooks on the slice
+				_, err = Hashtags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o HashtagSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Hashtags.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Hashtag:
+				o.copyMatchingRows(retrieved)
+			case []*Hashtag:
+				o.copyMatchingRows(retrieved...)
+			case HashtagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Hashtag or a slice of Hashtag
+				// then run the AfterDeleteHooks on the slice
+				_, err = Hashtags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o HashtagSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals HashtagSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Hashtags.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o HashtagSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Hashtags.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o HashtagSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Hashtags.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Tweets starts a query for related objects on tweets
+func (o *Hashtag) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.InnerJoin(TweetHashtags.NameAs()).On(
+			Tweets.Columns.ID.EQ(TweetHashtags.Columns.TweetID)),
+		sm.Where(TweetHashtags.Columns.HashtagID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os HashtagSlice) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.InnerJoin(TweetHashtags.NameAs()).On(
+			Tweets.Columns.ID.EQ(TweetHashtags.Columns.TweetID),
+		),
+		sm.Where(psql.Group(TweetHashtags.Columns.HashtagID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachHashtagTweets0(ctx context.Context, exec bob.Executor, count int, hashtag0 *Hashtag, tweets2 TweetSlice) (TweetHashtagSlice, error) {
+	setters := make([]*TweetHashtagSetter, count)
+	for i := range count {
+		setters[i] = &TweetHashtagSetter{
+			HashtagID: omit.From(hashtag0.ID),
+			TweetID:   omit.From(tweets2[i].ID),
+		}
+	}
+
+	tweetHashtags1, err := TweetHashtags.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachHashtagTweets0: %w", err)
+	}
+
+	return tweetHashtags1, nil
+}
+
+func (hashtag0 *Hashtag) InsertTweets(ctx context.Context, exec bob.Executor, related ...*TweetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Tweets.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	tweets2 := TweetSlice(inserted)
+
+	_, err = attachHashtagTweets0(ctx, exec, len(related), hashtag0, tweets2)
+	if err != nil {
+		return err
+	}
+
+	hashtag0.R.Tweets = append(hashtag0.R.Tweets, tweets2...)
+
+	for _, rel := range tweets2 {
+		rel.R.Hashtags = append(rel.R.Hashtags, hashtag0)
+	}
+	return nil
+}
+
+func (hashtag0 *Hashtag) AttachTweets(ctx context.Context, exec bob.Executor, related ...*Tweet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tweets2 := TweetSlice(related)
+
+	_, err = attachHashtagTweets0(ctx, exec, len(related), hashtag0, tweets2)
+	if err != nil {
+		return err
+	}
+
+	hashtag0.R.Tweets = append(hashtag0.R.Tweets, tweets2...)
+
+	for _, rel := range related {
+		rel.R.Hashtags = append(rel.R.Hashtags, hashtag0)
+	}
+
+	return nil
+}
+
+type hashtagWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, string]
+	Tag       psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (hashtagWhere[Q]) AliasedAs(alias string) hashtagWhere[Q] {
+	return buildHashtagWhere[Q](buildHashtagColumns(alias))
+}
+
+func buildHashtagWhere[Q psql.Filterable](cols hashtagColumns) hashtagWhere[Q] {
+	return hashtagWhere[Q]{
+		ID:        psql.Where[Q, string](cols.ID),
+		Tag:       psql.Where[Q, string](cols.Tag),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Hashtag) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Tweets":
+		rels, ok := retrieved.(TweetSlice)
+		if !ok {
+			return fmt.Errorf("hashtag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Hashtags = HashtagSlice{o}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("hashtag has no relationship %q", name)
+	}
+}
+
+type hashtagPreloader struct{}
+
+func buildHashtagPreloader() hashtagPreloader {
+	return hashtagPreloader{}
+}
+
+type hashtagThenLoader[Q orm.Loadable] struct {
+	Tweets func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildHashtagThenLoader[Q orm.Loadable]() hashtagThenLoader[Q] {
+	type TweetsLoadInterface interface {
+		LoadTweets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return hashtagThenLoader[Q]{
+		Tweets: thenLoadBuilder[Q](
+			"Tweets",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweets(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTweets loads the hashtag's Tweets into the .R struct
+func (o *Hashtag) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweets = nil
+
+	related, err := o.Tweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Hashtags = HashtagSlice{o}
+	}
+
+	o.R.Tweets = related
+	return nil
+}
+
+// LoadTweets loads the hashtag's Tweets into the .R struct
+func (os HashtagSlice) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Tweets.Columns))
+	}
+
+	q := os.Tweets(append(
+		mods,
+		sm.Columns(TweetHashtags.Columns.HashtagID.As("related_hashtags.ID")),
+	)...)
+
+	IDSlice := []string{}
+
+	mapper := scan.Mod(scan.StructMapper[*Tweet](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(string))
+				row.ScheduleScanByName("related_hashtags.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	tweets, err := bob.Allx[bob.SliceTransformer[*Tweet, TweetSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Tweets = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range tweets {
+			if !(o.ID == IDSlice[i]) {
+				continue
+			}
+
+			rel.R.Hashtags = append(rel.R.Hashtags, o)
+
+			o.R.Tweets = append(o.R.Tweets, rel)
+		}
+	}
+
+	return nil
+}
+
+type hashtagJoins[Q dialect.Joinable] struct {
+	typ    string
+	Tweets modAs[Q, tweetColumns]
+}
+
+func (j hashtagJoins[Q]) aliasedAs(alias string) hashtagJoins[Q] {
+	return buildHashtagJoins[Q](buildHashtagColumns(alias), j.typ)
+}
+
+func buildHashtagJoins[Q dialect.Joinable](cols hashtagColumns, typ string) hashtagJoins[Q] {
+	return hashtagJoins[Q]{
+		typ: typ,
+		Tweets: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := TweetHashtags.Columns.AliasedAs(TweetHashtags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, TweetHashtags.Name().As(to.Alias())).On(
+						to.HashtagID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := TweetHashtags.Columns.AliasedAs(TweetHashtags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,816 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Like is an object representing the database table.
+type Like struct {
+	UserID    string              `db:"user_id,pk" `
+	TweetID   string              `db:"tweet_id,pk" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R likeR `db:"-" `
+}
+
+// LikeSlice is an alias for a slice of pointers to Like.
+// This should almost always be used instead of []*Like.
+type LikeSlice []*Like
+
+// Likes contains methods to work with the likes table
+var Likes = psql.NewTablex[*Like, LikeSlice, *LikeSetter]("", "likes", buildLikeColumns("likes"))
+
+// LikesQuery is a query on the likes table
+type LikesQuery = *psql.ViewQuery[*Like, LikeSlice]
+
+// likeR is where relationships are stored.
+type likeR struct {
+	Tweet *Tweet // likes.likes_tweet_id_fkey
+	User  *User  // likes.likes_user_id_fkey
+}
+
+func buildLikeColumns(alias string) likeColumns {
+	return likeColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"user_id", "tweet_id", "created_at",
+		).WithParent("likes"),
+		tableAlias: alias,
+		UserID:     psql.Quote(alias, "user_id"),
+		TweetID:    psql.Quote(alias, "tweet_id"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type likeColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	UserID     psql.Expression
+	TweetID    psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c likeColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (likeColumns) AliasedAs(alias string) likeColumns {
+	return buildLikeColumns(alias)
+}
+
+// LikeSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type LikeSetter struct {
+	UserID    omit.Val[string]        `db:"user_id,pk" `
+	TweetID   omit.Val[string]        `db:"tweet_id,pk" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s LikeSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.TweetID.IsValue() {
+		vals = append(vals, "tweet_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s LikeSetter) Overwrite(t *Like) {
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.TweetID.IsValue() {
+		t.TweetID = s.TweetID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *LikeSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Likes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.UserID.IsValue() {
+			vals[0] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.TweetID.IsValue() {
+			vals[1] = psql.Arg(s.TweetID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s LikeSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s LikeSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.TweetID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tweet_id")...),
+			psql.Arg(s.TweetID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindLike retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindLike(ctx context.Context, exec bob.Executor, UserIDPK string, TweetIDPK string, cols ...string) (*Like, error) {
+	if len(cols) == 0 {
+		return Likes.Query(
+			sm.Where(Likes.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+			sm.Where(Likes.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		).One(ctx, exec)
+	}
+
+	return Likes.Query(
+		sm.Where(Likes.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		sm.Where(Likes.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		sm.Columns(Likes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// LikeExists checks the presence of a single record by primary key
+func LikeExists(ctx context.Context, exec bob.Executor, UserIDPK string, TweetIDPK string) (bool, error) {
+	return Likes.Query(
+		sm.Where(Likes.Columns.UserID.EQ(psql.Arg(UserIDPK))),
+		sm.Where(Likes.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Like is retrieved from the database
+func (o *Like) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Likes.AfterSelectHooks.RunHooks(ctx, exec, LikeSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Likes.AfterInsertHooks.RunHooks(ctx, exec, LikeSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Likes.AfterUpdateHooks.RunHooks(ctx, exec, LikeSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Likes.AfterDeleteHooks.RunHooks(ctx, exec, LikeSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Like
+func (o *Like) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.UserID,
+		o.TweetID,
+	)
+}
+
+func (o *Like) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("likes", "user_id"), psql.Quote("likes", "tweet_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Like
+func (o *Like) Update(ctx context.Context, exec bob.Executor, s *LikeSetter) error {
+	v, err := Likes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Like record with an executor
+func (o *Like) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Likes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Like using the executor
+func (o *Like) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Likes.Query(
+		sm.Where(Likes.Columns.UserID.EQ(psql.Arg(o.UserID))),
+		sm.Where(Likes.Columns.TweetID.EQ(psql.Arg(o.TweetID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after LikeSlice is retrieved from the database
+func (o LikeSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Likes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Likes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Likes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Likes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o LikeSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("likes", "user_id"), psql.Quote("likes", "tweet_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o LikeSlice) copyMatchingRows(from ...*Like) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.UserID != old.UserID {
+				continue
+			}
+			if new.TweetID != old.TweetID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o LikeSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Likes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Like:
+				o.copyMatchingRows(retrieved)
+			case []*Like:
+				o.copyMatchingRows(retrieved...)
+			case LikeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Like or a slice of Like
+				// then run the AfterUpdateHooks on the slice
+				_, err = Likes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o LikeSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Likes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Like:
+				o.copyMatchingRows(retrieved)
+			case []*Like:
+				o.copyMatchingRows(retrieved...)
+			case LikeSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Like or a slice of Like
+				// then run the AfterDeleteHooks on the slice
+				_, err = Likes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o LikeSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals LikeSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Likes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o LikeSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Likes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o LikeSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Likes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Tweet starts a query for related objects on tweets
+func (o *Like) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.TweetID))),
+	)...)
+}
+
+func (os LikeSlice) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkTweetID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkTweetID = append(pkTweetID, o.TweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Like) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os LikeSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachLikeTweet0(ctx context.Context, exec bob.Executor, count int, like0 *Like, tweet1 *Tweet) (*Like, error) {
+	setter := &LikeSetter{
+		TweetID: omit.From(tweet1.ID),
+	}
+
+	err := like0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachLikeTweet0: %w", err)
+	}
+
+	return like0, nil
+}
+
+func (like0 *Like) InsertTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachLikeTweet0(ctx, exec, 1, like0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	like0.R.Tweet = tweet1
+
+	tweet1.R.Likes = append(tweet1.R.Likes, like0)
+
+	return nil
+}
+
+func (like0 *Like) AttachTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachLikeTweet0(ctx, exec, 1, like0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	like0.R.Tweet = tweet1
+
+	tweet1.R.Likes = append(tweet1.R.Likes, like0)
+
+	return nil
+}
+
+func attachLikeUser0(ctx context.Context, exec bob.Executor, count int, like0 *Like, user1 *User) (*Like, error) {
+	setter := &LikeSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := like0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachLikeUser0: %w", err)
+	}
+
+	return like0, nil
+}
+
+func (like0 *Like) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachLikeUser0(ctx, exec, 1, like0, user1)
+	if err != nil {
+		return err
+	}
+
+	like0.R.User = user1
+
+	user1.R.Likes = append(user1.R.Likes, like0)
+
+	return nil
+}
+
+func (like0 *Like) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachLikeUser0(ctx, exec, 1, like0, user1)
+	if err != nil {
+		return err
+	}
+
+	like0.R.User = user1
+
+	user1.R.Likes = append(user1.R.Likes, like0)
+
+	return nil
+}
+
+type likeWhere[Q psql.Filterable] struct {
+	UserID    psql.WhereMod[Q, string]
+	TweetID   psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (likeWhere[Q]) AliasedAs(alias string) likeWhere[Q] {
+	return buildLikeWhere[Q](buildLikeColumns(alias))
+}
+
+func buildLikeWhere[Q psql.Filterable](cols likeColumns) likeWhere[Q] {
+	return likeWhere[Q]{
+		UserID:    psql.Where[Q, string](cols.UserID),
+		TweetID:   psql.Where[Q, string](cols.TweetID),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Like) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Tweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("like cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweet = rel
+
+		if rel != nil {
+			rel.R.Likes = LikeSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("like cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Likes = LikeSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("like has no relationship %q", name)
+	}
+}
+
+type likePreloader struct {
+	Tweet func(...psql.PreloadOption) psql.Preloader
+	User  func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildLikePreloader() likePreloader {
+	return likePreloader{
+		Tweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "Tweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Likes,
+						To:          Tweets,
+						FromColumns: []string{"tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Likes,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type likeThenLoader[Q orm.Loadable] struct {
+	Tweet func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildLikeThenLoader[Q orm.Loadable]() likeThenLoader[Q] {
+	type TweetLoadInterface interface {
+		LoadTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return likeThenLoader[Q]{
+		Tweet: thenLoadBuilder[Q](
+			"Tweet",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweet(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTweet loads the like's Tweet into the .R struct
+func (o *Like) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweet = nil
+
+	related, err := o.Tweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Likes = LikeSlice{o}
+
+	o.R.Tweet = related
+	return nil
+}
+
+// LoadTweet loads the like's Tweet into the .R struct
+func (os LikeSlice) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !(o.TweetID == rel.ID) {
+				continue
+			}
+
+			rel.R.Likes = append(rel.R.Likes, o)
+
+			o.R.Tweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the like's User into the .R struct
+func (o *Like) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Likes = LikeSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the like's User into the .R struct
+func (os LikeSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Likes = append(rel.R.Likes, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type likeJoins[Q dialect.Joinable] struct {
+	typ   string
+	Tweet modAs[Q, tweetColumns]
+	User  modAs[Q, userColumns]
+}
+
+func (j likeJoins[Q]) aliasedAs(alias string) likeJoins[Q] {
+	return buildLikeJoins[Q](buildLikeColumns(alias), j.typ)
+}
+
+func buildLikeJoins[Q dialect.Joinable](cols likeColumns, typ string) likeJoins[Q] {
+	return likeJoins[Q]{
+		typ: typ,
+		Tweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,838 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Mention is an object representing the database table.
+type Mention struct {
+	TweetID     string `db:"tweet_id,pk" `
+	UserID      string `db:"user_id" `
+	StartOffset int32  `db:"start_offset,pk" `
+	EndOffset   int32  `db:"end_offset" `
+
+	R mentionR `db:"-" `
+}
+
+// MentionSlice is an alias for a slice of pointers to Mention.
+// This should almost always be used instead of []*Mention.
+type MentionSlice []*Mention
+
+// Mentions contains methods to work with the mentions table
+var Mentions = psql.NewTablex[*Mention, MentionSlice, *MentionSetter]("", "mentions", buildMentionColumns("mentions"))
+
+// MentionsQuery is a query on the mentions table
+type MentionsQuery = *psql.ViewQuery[*Mention, MentionSlice]
+
+// mentionR is where relationships are stored.
+type mentionR struct {
+	Tweet *Tweet // mentions.mentions_tweet_id_fkey
+	User  *User  // mentions.mentions_user_id_fkey
+}
+
+func buildMentionColumns(alias string) mentionColumns {
+	return mentionColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"tweet_id", "user_id", "start_offset", "end_offset",
+		).WithParent("mentions"),
+		tableAlias:  alias,
+		TweetID:     psql.Quote(alias, "tweet_id"),
+		UserID:      psql.Quote(alias, "user_id"),
+		StartOffset: psql.Quote(alias, "start_offset"),
+		EndOffset:   psql.Quote(alias, "end_offset"),
+	}
+}
+
+type mentionColumns struct {
+	expr.ColumnsExpr
+	tableAlias  string
+	TweetID     psql.Expression
+	UserID      psql.Expression
+	StartOffset psql.Expression
+	EndOffset   psql.Expression
+}
+
+func (c mentionColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (mentionColumns) AliasedAs(alias string) mentionColumns {
+	return buildMentionColumns(alias)
+}
+
+// MentionSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type MentionSetter struct {
+	TweetID     omit.Val[string] `db:"tweet_id,pk" `
+	UserID      omit.Val[string] `db:"user_id" `
+	StartOffset omit.Val[int32]  `db:"start_offset,pk" `
+	EndOffset   omit.Val[int32]  `db:"end_offset" `
+}
+
+func (s MentionSetter) SetColumns() []string {
+	vals := make([]string, 0, 4)
+	if s.TweetID.IsValue() {
+		vals = append(vals, "tweet_id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.StartOffset.IsValue() {
+		vals = append(vals, "start_offset")
+	}
+	if s.EndOffset.IsValue() {
+		vals = append(vals, "end_offset")
+	}
+	return vals
+}
+
+func (s MentionSetter) Overwrite(t *Mention) {
+	if s.TweetID.IsValue() {
+		t.TweetID = s.TweetID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.StartOffset.IsValue() {
+		t.StartOffset = s.StartOffset.MustGet()
+	}
+	if s.EndOffset.IsValue() {
+		t.EndOffset = s.EndOffset.MustGet()
+	}
+}
+
+func (s *MentionSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Mentions.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 4)
+		if s.TweetID.IsValue() {
+			vals[0] = psql.Arg(s.TweetID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.StartOffset.IsValue() {
+			vals[2] = psql.Arg(s.StartOffset.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.EndOffset.IsValue() {
+			vals[3] = psql.Arg(s.EndOffset.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s MentionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s MentionSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 4)
+
+	if s.TweetID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tweet_id")...),
+			psql.Arg(s.TweetID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.StartOffset.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "start_offset")...),
+			psql.Arg(s.StartOffset),
+		}})
+	}
+
+	if s.EndOffset.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "end_offset")...),
+			psql.Arg(s.EndOffset),
+		}})
+	}
+
+	return exprs
+}
+
+// FindMention retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindMention(ctx context.Context, exec bob.Executor, TweetIDPK string, StartOffsetPK int32, cols ...string) (*Mention, error) {
+	if len(cols) == 0 {
+		return Mentions.Query(
+			sm.Where(Mentions.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+			sm.Where(Mentions.Columns.StartOffset.EQ(psql.Arg(StartOffsetPK))),
+		).One(ctx, exec)
+	}
+
+	return Mentions.Query(
+		sm.Where(Mentions.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		sm.Where(Mentions.Columns.StartOffset.EQ(psql.Arg(StartOffsetPK))),
+		sm.Columns(Mentions.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// MentionExists checks the presence of a single record by primary key
+func MentionExists(ctx context.Context, exec bob.Executor, TweetIDPK string, StartOffsetPK int32) (bool, error) {
+	return Mentions.Query(
+		sm.Where(Mentions.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		sm.Where(Mentions.Columns.StartOffset.EQ(psql.Arg(StartOffsetPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Mention is retrieved from the database
+func (o *Mention) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Mentions.AfterSelectHooks.RunHooks(ctx, exec, MentionSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Mentions.AfterInsertHooks.RunHooks(ctx, exec, MentionSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Mentions.AfterUpdateHooks.RunHooks(ctx, exec, MentionSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Mentions.AfterDeleteHooks.RunHooks(ctx, exec, MentionSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Mention
+func (o *Mention) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.TweetID,
+		o.StartOffset,
+	)
+}
+
+func (o *Mention) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("mentions", "tweet_id"), psql.Quote("mentions", "start_offset")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Mention
+func (o *Mention) Update(ctx context.Context, exec bob.Executor, s *MentionSetter) error {
+	v, err := Mentions.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Mention record with an executor
+func (o *Mention) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Mentions.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Mention using the executor
+func (o *Mention) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Mentions.Query(
+		sm.Where(Mentions.Columns.TweetID.EQ(psql.Arg(o.TweetID))),
+		sm.Where(Mentions.Columns.StartOffset.EQ(psql.Arg(o.StartOffset))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after MentionSlice is retrieved from the database
+func (o MentionSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Mentions.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Mentions.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Mentions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Mentions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o MentionSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("mentions", "tweet_id"), psql.Quote("mentions", "start_offset")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o MentionSlice) copyMatchingRows(from ...*Mention) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.TweetID != old.TweetID {
+				continue
+			}
+			if new.StartOffset != old.StartOffset {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o MentionSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Mentions.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Mention:
+				o.copyMatchingRows(retrieved)
+			case []*Mention:
+				o.copyMatchingRows(retrieved...)
+			case MentionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Mention or a slice of Mention
+				// then run the AfterUpdateHooks on the slice
+				_, err = Mentions.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o MentionSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Mentions.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Mention:
+				o.copyMatchingRows(retrieved)
+			case []*Mention:
+				o.copyMatchingRows(retrieved...)
+			case MentionSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Mention or a slice of Mention
+				// then run the AfterDeleteHooks on the slice
+				_, err = Mentions.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o MentionSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals MentionSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Mentions.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o MentionSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Mentions.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o MentionSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Mentions.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Tweet starts a query for related objects on tweets
+func (o *Mention) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.TweetID))),
+	)...)
+}
+
+func (os MentionSlice) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkTweetID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkTweetID = append(pkTweetID, o.TweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Mention) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os MentionSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachMentionTweet0(ctx context.Context, exec bob.Executor, count int, mention0 *Mention, tweet1 *Tweet) (*Mention, error) {
+	setter := &MentionSetter{
+		TweetID: omit.From(tweet1.ID),
+	}
+
+	err := mention0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMentionTweet0: %w", err)
+	}
+
+	return mention0, nil
+}
+
+func (mention0 *Mention) InsertTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMentionTweet0(ctx, exec, 1, mention0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	mention0.R.Tweet = tweet1
+
+	tweet1.R.Mentions = append(tweet1.R.Mentions, mention0)
+
+	return nil
+}
+
+func (mention0 *Mention) AttachTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachMentionTweet0(ctx, exec, 1, mention0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	mention0.R.Tweet = tweet1
+
+	tweet1.R.Mentions = append(tweet1.R.Mentions, mention0)
+
+	return nil
+}
+
+func attachMentionUser0(ctx context.Context, exec bob.Executor, count int, mention0 *Mention, user1 *User) (*Mention, error) {
+	setter := &MentionSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := mention0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMentionUser0: %w", err)
+	}
+
+	return mention0, nil
+}
+
+func (mention0 *Mention) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMentionUser0(ctx, exec, 1, mention0, user1)
+	if err != nil {
+		return err
+	}
+
+	mention0.R.User = user1
+
+	user1.R.Mentions = append(user1.R.Mentions, mention0)
+
+	return nil
+}
+
+func (mention0 *Mention) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachMentionUser0(ctx, exec, 1, mention0, user1)
+	if err != nil {
+		return err
+	}
+
+	mention0.R.User = user1
+
+	user1.R.Mentions = append(user1.R.Mentions, mention0)
+
+	return nil
+}
+
+type mentionWhere[Q psql.Filterable] struct {
+	TweetID     psql.WhereMod[Q, string]
+	UserID      psql.WhereMod[Q, string]
+	StartOffset psql.WhereMod[Q, int32]
+	EndOffset   psql.WhereMod[Q, int32]
+}
+
+func (mentionWhere[Q]) AliasedAs(alias string) mentionWhere[Q] {
+	return buildMentionWhere[Q](buildMentionColumns(alias))
+}
+
+func buildMentionWhere[Q psql.Filterable](cols mentionColumns) mentionWhere[Q] {
+	return mentionWhere[Q]{
+		TweetID:     psql.Where[Q, string](cols.TweetID),
+		UserID:      psql.Where[Q, string](cols.UserID),
+		StartOffset: psql.Where[Q, int32](cols.StartOffset),
+		EndOffset:   psql.Where[Q, int32](cols.EndOffset),
+	}
+}
+
+func (o *Mention) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Tweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("mention cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweet = rel
+
+		if rel != nil {
+			rel.R.Mentions = MentionSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("mention cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Mentions = MentionSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("mention has no relationship %q", name)
+	}
+}
+
+type mentionPreloader struct {
+	Tweet func(...psql.PreloadOption) psql.Preloader
+	User  func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildMentionPreloader() mentionPreloader {
+	return mentionPreloader{
+		Tweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "Tweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Mentions,
+						To:          Tweets,
+						FromColumns: []string{"tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Mentions,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type mentionThenLoader[Q orm.Loadable] struct {
+	Tweet func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildMentionThenLoader[Q orm.Loadable]() mentionThenLoader[Q] {
+	type TweetLoadInterface interface {
+		LoadTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return mentionThenLoader[Q]{
+		Tweet: thenLoadBuilder[Q](
+			"Tweet",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweet(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadTweet loads the mention's Tweet into the .R struct
+func (o *Mention) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweet = nil
+
+	related, err := o.Tweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Mentions = MentionSlice{o}
+
+	o.R.Tweet = related
+	return nil
+}
+
+// LoadTweet loads the mention's Tweet into the .R struct
+func (os MentionSlice) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !(o.TweetID == rel.ID) {
+				continue
+			}
+
+			rel.R.Mentions = append(rel.R.Mentions, o)
+
+			o.R.Tweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the mention's User into the .R struct
+func (o *Mention) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Mentions = MentionSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the mention's User into the .R struct
+func (os MentionSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Mentions = append(rel.R.Mentions, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type mentionJoins[Q dialect.Joinable] struct {
+	typ   string
+	Tweet modAs[Q, tweetColumns]
+	User  modAs[Q, userColumns]
+}
+
+func (j mentionJoins[Q]) aliasedAs(alias string) mentionJoins[Q] {
+	return buildMentionJoins[Q](buildMentionColumns(alias), j.typ)
+}
+
+func buildMentionJoins[Q dialect.Joinable](cols mentionColumns, typ string) mentionJoins[Q] {
+	return mentionJoins[Q]{
+		typ: typ,
+		Tweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1248 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Message is an object representing the database table.
+type Message struct {
+	ID             string              `db:"id,pk" `
+	ConversationID string              `db:"conversation_id" `
+	SenderID       string              `db:"sender_id" `
+	Content        string              `db:"content" `
+	CreatedAt      null.Val[time.Time] `db:"created_at" `
+
+	R messageR `db:"-" `
+}
+
+// MessageSlice is an alias for a slice of pointers to Message.
+// This should almost always be used instead of []*Message.
+type MessageSlice []*Message
+
+// Messages contains methods to work with the messages table
+var Messages = psql.NewTablex[*Message, MessageSlice, *MessageSetter]("", "messages", buildMessageColumns("messages"))
+
+// MessagesQuery is a query on the messages table
+type MessagesQuery = *psql.ViewQuery[*Message, MessageSlice]
+
+// messageR is where relationships are stored.
+type messageR struct {
+	LastReadMessageConversationMembers ConversationMemberSlice // conversation_members.conversation_members_last_read_message_id_fkey
+	LastMessageConversations           ConversationSlice       // conversations.conversations_last_message_id_fkey
+	Conversation                       *Conversation           // messages.messages_conversation_id_fkey
+	SenderUser                         *User                   // messages.messages_sender_id_fkey
+}
+
+func buildMessageColumns(alias string) messageColumns {
+	return messageColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "conversation_id", "sender_id", "content", "created_at",
+		).WithParent("messages"),
+		tableAlias:     alias,
+		ID:             psql.Quote(alias, "id"),
+		ConversationID: psql.Quote(alias, "conversation_id"),
+		SenderID:       psql.Quote(alias, "sender_id"),
+		Content:        psql.Quote(alias, "content"),
+		CreatedAt:      psql.Quote(alias, "created_at"),
+	}
+}
+
+type messageColumns struct {
+	expr.ColumnsExpr
+	tableAlias     string
+	ID             psql.Expression
+	ConversationID psql.Expression
+	SenderID       psql.Expression
+	Content        psql.Expression
+	CreatedAt      psql.Expression
+}
+
+func (c messageColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (messageColumns) AliasedAs(alias string) messageColumns {
+	return buildMessageColumns(alias)
+}
+
+// MessageSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type MessageSetter struct {
+	ID             omit.Val[string]        `db:"id,pk" `
+	ConversationID omit.Val[string]        `db:"conversation_id" `
+	SenderID       omit.Val[string]        `db:"sender_id" `
+	Content        omit.Val[string]        `db:"content" `
+	CreatedAt      omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s MessageSetter) SetColumns() []string {
+	vals := make([]string, 0, 5)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.ConversationID.IsValue() {
+		vals = append(vals, "conversation_id")
+	}
+	if s.SenderID.IsValue() {
+		vals = append(vals, "sender_id")
+	}
+	if s.Content.IsValue() {
+		vals = append(vals, "content")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s MessageSetter) Overwrite(t *Message) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.ConversationID.IsValue() {
+		t.ConversationID = s.ConversationID.MustGet()
+	}
+	if s.SenderID.IsValue() {
+		t.SenderID = s.SenderID.MustGet()
+	}
+	if s.Content.IsValue() {
+		t.Content = s.Content.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *MessageSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Messages.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 5)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.ConversationID.IsValue() {
+			vals[1] = psql.Arg(s.ConversationID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.SenderID.IsValue() {
+			vals[2] = psql.Arg(s.SenderID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Content.IsValue() {
+			vals[3] = psql.Arg(s.Content.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[4] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s MessageSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s MessageSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 5)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.ConversationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "conversation_id")...),
+			psql.Arg(s.ConversationID),
+		}})
+	}
+
+	if s.SenderID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "sender_id")...),
+			psql.Arg(s.SenderID),
+		}})
+	}
+
+	if s.Content.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "content")...),
+			psql.Arg(s.Content),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindMessage retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindMessage(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Message, error) {
+	if len(cols) == 0 {
+		return Messages.Query(
+			sm.Where(Messages.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Messages.Query(
+		sm.Where(Messages.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Messages.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// MessageExists checks the presence of a single record by primary key
+func MessageExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Messages.Query(
+		sm.Where(Messages.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Message is retrieved from the database
+func (o *Message) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Messages.AfterSelectHooks.RunHooks(ctx, exec, MessageSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Messages.AfterInsertHooks.RunHooks(ctx, exec, MessageSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Messages.AfterUpdateHooks.RunHooks(ctx, exec, MessageSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Messages.AfterDeleteHooks.RunHooks(ctx, exec, MessageSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Message
+func (o *Message) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Message) pkEQ() dialect.Expression {
+	return psql.Quote("messages", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Message
+func (o *Message) Update(ctx context.Context, exec bob.Executor, s *MessageSetter) error {
+	v, err := Messages.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Message record with an executor
+func (o *Message) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Messages.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Message using the executor
+func (o *Message) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Messages.Query(
+		sm.Where(Messages.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after MessageSlice is retrieved from the database
+func (o MessageSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Messages.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Messages.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Messages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Messages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o MessageSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("messages", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o MessageSlice) copyMatchingRows(from ...*Message) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o MessageSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Messages.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Message:
+				o.copyMatchingRows(retrieved)
+			case []*Message:
+				o.copyMatchingRows(retrieved...)
+			case MessageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Message or a slice of Message
+				// then run the AfterUpdateHooks on the slice
+				_, err = Messages.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o MessageSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Messages.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Message:
+				o.copyMatchingRows(retrieved)
+			case []*Message:
+				o.copyMatchingRows(retrieved...)
+			case MessageSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Message or a slice of Message
+				// then run the AfterDeleteHooks on the slice
+				_, err = Messages.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o MessageSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals MessageSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Messages.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o MessageSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Messages.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o MessageSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Messages.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// LastReadMessageConversationMembers starts a query for related objects on conversation_members
+func (o *Message) LastReadMessageConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	return ConversationMembers.Query(append(mods,
+		sm.Where(ConversationMembers.Columns.LastReadMessageID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os MessageSlice) LastReadMessageConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return ConversationMembers.Query(append(mods,
+		sm.Where(psql.Group(ConversationMembers.Columns.LastReadMessageID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// LastMessageConversations starts a query for related objects on conversations
+func (o *Message) LastMessageConversations(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	return Conversations.Query(append(mods,
+		sm.Where(Conversations.Columns.LastMessageID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os MessageSlice) LastMessageConversations(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Conversations.Query(append(mods,
+		sm.Where(psql.Group(Conversations.Columns.LastMessageID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Conversation starts a query for related objects on conversations
+func (o *Message) Conversation(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	return Conversations.Query(append(mods,
+		sm.Where(Conversations.Columns.ID.EQ(psql.Arg(o.ConversationID))),
+	)...)
+}
+
+func (os MessageSlice) Conversation(mods ...bob.Mod[*dialect.SelectQuery]) ConversationsQuery {
+	pkConversationID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkConversationID = append(pkConversationID, o.ConversationID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkConversationID), "character varying[]")),
+	))
+
+	return Conversations.Query(append(mods,
+		sm.Where(psql.Group(Conversations.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// SenderUser starts a query for related objects on users
+func (o *Message) SenderUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.SenderID))),
+	)...)
+}
+
+func (os MessageSlice) SenderUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkSenderID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkSenderID = append(pkSenderID, o.SenderID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkSenderID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertMessageLastReadMessageConversationMembers0(ctx context.Context, exec bob.Executor, conversationMembers1 []*ConversationMemberSetter, message0 *Message) (ConversationMemberSlice, error) {
+	for i := range conversationMembers1 {
+		conversationMembers1[i].LastReadMessageID = omitnull.From(message0.ID)
+	}
+
+	ret, err := ConversationMembers.Insert(bob.ToMods(conversationMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertMessageLastReadMessageConversationMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachMessageLastReadMessageConversationMembers0(ctx context.Context, exec bob.Executor, count int, conversationMembers1 ConversationMemberSlice, message0 *Message) (ConversationMemberSlice, error) {
+	setter := &ConversationMemberSetter{
+		LastReadMessageID: omitnull.From(message0.ID),
+	}
+
+	err := conversationMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMessageLastReadMessageConversationMembers0: %w", err)
+	}
+
+	return conversationMembers1, nil
+}
+
+func (message0 *Message) InsertLastReadMessageConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	conversationMembers1, err := insertMessageLastReadMessageConversationMembers0(ctx, exec, related, message0)
+	if err != nil {
+		return err
+	}
+
+	message0.R.LastReadMessageConversationMembers = append(message0.R.LastReadMessageConversationMembers, conversationMembers1...)
+
+	for _, rel := range conversationMembers1 {
+		rel.R.LastReadMessageMessage = message0
+	}
+	return nil
+}
+
+func (message0 *Message) AttachLastReadMessageConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	conversationMembers1 := ConversationMemberSlice(related)
+
+	_, err = attachMessageLastReadMessageConversationMembers0(ctx, exec, len(related), conversationMembers1, message0)
+	if err != nil {
+		return err
+	}
+
+	message0.R.LastReadMessageConversationMembers = append(message0.R.LastReadMessageConversationMembers, conversationMembers1...)
+
+	for _, rel := range related {
+		rel.R.LastReadMessageMessage = message0
+	}
+
+	return nil
+}
+
+func insertMessageLastMessageConversations0(ctx context.Context, exec bob.Executor, conversations1 []*ConversationSetter, message0 *Message) (ConversationSlice, error) {
+	for i := range conversations1 {
+		conversations1[i].LastMessageID = omitnull.From(message0.ID)
+	}
+
+	ret, err := Conversations.Insert(bob.ToMods(conversations1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertMessageLastMessageConversations0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachMessageLastMessageConversations0(ctx context.Context, exec bob.Executor, count int, conversations1 ConversationSlice, message0 *Message) (ConversationSlice, error) {
+	setter := &ConversationSetter{
+		LastMessageID: omitnull.From(message0.ID),
+	}
+
+	err := conversations1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMessageLastMessageConversations0: %w", err)
+	}
+
+	return conversations1, nil
+}
+
+func (message0 *Message) InsertLastMessageConversations(ctx context.Context, exec bob.Executor, related ...*ConversationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	conversations1, err := insertMessageLastMessageConversations0(ctx, exec, related, message0)
+	if err != nil {
+		return err
+	}
+
+	message0.R.LastMessageConversations = append(message0.R.LastMessageConversations, conversations1...)
+
+	for _, rel := range conversations1 {
+		rel.R.LastMessageMessage = message0
+	}
+	return nil
+}
+
+func (message0 *Message) AttachLastMessageConversations(ctx context.Context, exec bob.Executor, related ...*Conversation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	conversations1 := ConversationSlice(related)
+
+	_, err = attachMessageLastMessageConversations0(ctx, exec, len(related), conversations1, message0)
+	if err != nil {
+		return err
+	}
+
+	message0.R.LastMessageConversations = append(message0.R.LastMessageConversations, conversations1...)
+
+	for _, rel := range related {
+		rel.R.LastMessageMessage = message0
+	}
+
+	return nil
+}
+
+func attachMessageConversation0(ctx context.Context, exec bob.Executor, count int, message0 *Message, conversation1 *Conversation) (*Message, error) {
+	setter := &MessageSetter{
+		ConversationID: omit.From(conversation1.ID),
+	}
+
+	err := message0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMessageConversation0: %w", err)
+	}
+
+	return message0, nil
+}
+
+func (message0 *Message) InsertConversation(ctx context.Context, exec bob.Executor, related *ConversationSetter) error {
+	var err error
+
+	conversation1, err := Conversations.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMessageConversation0(ctx, exec, 1, message0, conversation1)
+	if err != nil {
+		return err
+	}
+
+	message0.R.Conversation = conversation1
+
+	conversation1.R.Messages = append(conversation1.R.Messages, message0)
+
+	return nil
+}
+
+func (message0 *Message) AttachConversation(ctx context.Context, exec bob.Executor, conversation1 *Conversation) error {
+	var err error
+
+	_, err = attachMessageConversation0(ctx, exec, 1, message0, conversation1)
+	if err != nil {
+		return err
+	}
+
+	message0.R.Conversation = conversation1
+
+	conversation1.R.Messages = append(conversation1.R.Messages, message0)
+
+	return nil
+}
+
+func attachMessageSenderUser0(ctx context.Context, exec bob.Executor, count int, message0 *Message, user1 *User) (*Message, error) {
+	setter := &MessageSetter{
+		SenderID: omit.From(user1.ID),
+	}
+
+	err := message0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMessageSenderUser0: %w", err)
+	}
+
+	return message0, nil
+}
+
+func (message0 *Message) InsertSenderUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMessageSenderUser0(ctx, exec, 1, message0, user1)
+	if err != nil {
+		return err
+	}
+
+	message0.R.SenderUser = user1
+
+	user1.R.SenderMessages = append(user1.R.SenderMessages, message0)
+
+	return nil
+}
+
+func (message0 *Message) AttachSenderUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachMessageSenderUser0(ctx, exec, 1, message0, user1)
+	if err != nil {
+		return err
+	}
+
+	message0.R.SenderUser = user1
+
+	user1.R.SenderMessages = append(user1.R.SenderMessages, message0)
+
+	return nil
+}
+
+type messageWhere[Q psql.Filterable] struct {
+	ID             psql.WhereMod[Q, string]
+	ConversationID psql.WhereMod[Q, string]
+	SenderID       psql.WhereMod[Q, string]
+	Content        psql.WhereMod[Q, string]
+	CreatedAt      psql.WhereNullMod[Q, time.Time]
+}
+
+func (messageWhere[Q]) AliasedAs(alias string) messageWhere[Q] {
+	return buildMessageWhere[Q](buildMessageColumns(alias))
+}
+
+func buildMessageWhere[Q psql.Filterable](cols messageColumns) messageWhere[Q] {
+	return messageWhere[Q]{
+		ID:             psql.Where[Q, string](cols.ID),
+		ConversationID: psql.Where[Q, string](cols.ConversationID),
+		SenderID:       psql.Where[Q, string](cols.SenderID),
+		Content:        psql.Where[Q, string](cols.Content),
+		CreatedAt:      psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Message) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "LastReadMessageConversationMembers":
+		rels, ok := retrieved.(ConversationMemberSlice)
+		if !ok {
+			return fmt.Errorf("message cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LastReadMessageConversationMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.LastReadMessageMessage = o
+			}
+		}
+		return nil
+	case "LastMessageConversations":
+		rels, ok := retrieved.(ConversationSlice)
+		if !ok {
+			return fmt.Errorf("message cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.LastMessageConversations = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.LastMessageMessage = o
+			}
+		}
+		return nil
+	case "Conversation":
+		rel, ok := retrieved.(*Conversation)
+		if !ok {
+			return fmt.Errorf("message cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Conversation = rel
+
+		if rel != nil {
+			rel.R.Messages = MessageSlice{o}
+		}
+		return nil
+	case "SenderUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("message cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.SenderUser = rel
+
+		if rel != nil {
+			rel.R.SenderMessages = MessageSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("message has no relationship %q", name)
+	}
+}
+
+type messagePreloader struct {
+	Conversation func(...psql.PreloadOption) psql.Preloader
+	SenderUser   func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildMessagePreloader() messagePreloader {
+	return messagePreloader{
+		Conversation: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Conversation, ConversationSlice](psql.PreloadRel{
+				Name: "Conversation",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Messages,
+						To:          Conversations,
+						FromColumns: []string{"conversation_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Conversations.Columns.Names(), opts...)
+		},
+		SenderUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "SenderUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Messages,
+						To:          Users,
+						FromColumns: []string{"sender_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type messageThenLoader[Q orm.Loadable] struct {
+	LastReadMessageConversationMembers func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	LastMessageConversations           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Conversation                       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	SenderUser                         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildMessageThenLoader[Q orm.Loadable]() messageThenLoader[Q] {
+	type LastReadMessageConversationMembersLoadInterface interface {
+		LoadLastReadMessageConversationMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LastMessageConversationsLoadInterface interface {
+		LoadLastMessageConversations(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ConversationLoadInterface interface {
+		LoadConversation(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SenderUserLoadInterface interface {
+		LoadSenderUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return messageThenLoader[Q]{
+		LastReadMessageConversationMembers: thenLoadBuilder[Q](
+			"LastReadMessageConversationMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved LastReadMessageConversationMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLastReadMessageConversationMembers(ctx, exec, mods...)
+			},
+		),
+		LastMessageConversations: thenLoadBuilder[Q](
+			"LastMessageConversations",
+			func(ctx context.Context, exec bob.Executor, retrieved LastMessageConversationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLastMessageConversations(ctx, exec, mods...)
+			},
+		),
+		Conversation: thenLoadBuilder[Q](
+			"Conversation",
+			func(ctx context.Context, exec bob.Executor, retrieved ConversationLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadConversation(ctx, exec, mods...)
+			},
+		),
+		SenderUser: thenLoadBuilder[Q](
+			"SenderUser",
+			func(ctx context.Context, exec bob.Executor, retrieved SenderUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSenderUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadLastReadMessageConversationMembers loads the message's LastReadMessageConversationMembers into the .R struct
+func (o *Message) LoadLastReadMessageConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.LastReadMessageConversationMembers = nil
+
+	related, err := o.LastReadMessageConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.LastReadMessageMessage = o
+	}
+
+	o.R.LastReadMessageConversationMembers = related
+	return nil
+}
+
+// LoadLastReadMessageConversationMembers loads the message's LastReadMessageConversationMembers into the .R struct
+func (os MessageSlice) LoadLastReadMessageConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversationMembers, err := os.LastReadMessageConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.LastReadMessageConversationMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversationMembers {
+
+			if !rel.LastReadMessageID.IsValue() {
+				continue
+			}
+			if !(rel.LastReadMessageID.IsValue() && o.ID == rel.LastReadMessageID.MustGet()) {
+				continue
+			}
+
+			rel.R.LastReadMessageMessage = o
+
+			o.R.LastReadMessageConversationMembers = append(o.R.LastReadMessageConversationMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLastMessageConversations loads the message's LastMessageConversations into the .R struct
+func (o *Message) LoadLastMessageConversations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.LastMessageConversations = nil
+
+	related, err := o.LastMessageConversations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.LastMessageMessage = o
+	}
+
+	o.R.LastMessageConversations = related
+	return nil
+}
+
+// LoadLastMessageConversations loads the message's LastMessageConversations into the .R struct
+func (os MessageSlice) LoadLastMessageConversations(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversations, err := os.LastMessageConversations(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.LastMessageConversations = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversations {
+
+			if !rel.LastMessageID.IsValue() {
+				continue
+			}
+			if !(rel.LastMessageID.IsValue() && o.ID == rel.LastMessageID.MustGet()) {
+				continue
+			}
+
+			rel.R.LastMessageMessage = o
+
+			o.R.LastMessageConversations = append(o.R.LastMessageConversations, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadConversation loads the message's Conversation into the .R struct
+func (o *Message) LoadConversation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Conversation = nil
+
+	related, err := o.Conversation(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Messages = MessageSlice{o}
+
+	o.R.Conversation = related
+	return nil
+}
+
+// LoadConversation loads the message's Conversation into the .R struct
+func (os MessageSlice) LoadConversation(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversations, err := os.Conversation(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversations {
+
+			if !(o.ConversationID == rel.ID) {
+				continue
+			}
+
+			rel.R.Messages = append(rel.R.Messages, o)
+
+			o.R.Conversation = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadSenderUser loads the message's SenderUser into the .R struct
+func (o *Message) LoadSenderUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.SenderUser = nil
+
+	related, err := o.SenderUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.SenderMessages = MessageSlice{o}
+
+	o.R.SenderUser = related
+	return nil
+}
+
+// LoadSenderUser loads the message's SenderUser into the .R struct
+func (os MessageSlice) LoadSenderUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.SenderUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.SenderID == rel.ID) {
+				continue
+			}
+
+			rel.R.SenderMessages = append(rel.R.SenderMessages, o)
+
+			o.R.SenderUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type messageJoins[Q dialect.Joinable] struct {
+	typ                                string
+	LastReadMessageConversationMembers modAs[Q, conversationMemberColumns]
+	LastMessageConversations           modAs[Q, conversationColumns]
+	Conversation                       modAs[Q, conversationColumns]
+	SenderUser                         modAs[Q, userColumns]
+}
+
+func (j messageJoins[Q]) aliasedAs(alias string) messageJoins[Q] {
+	return buildMessageJoins[Q](buildMessageColumns(alias), j.typ)
+}
+
+func buildMessageJoins[Q dialect.Joinable](cols messageColumns, typ string) messageJoins[Q] {
+	return messageJoins[Q]{
+		typ: typ,
+		LastReadMessageConversationMembers: modAs[Q, conversationMemberColumns]{
+			c: ConversationMembers.Columns,
+			f: func(to conversationMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ConversationMembers.Name().As(to.Alias())).On(
+						to.LastReadMessageID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		LastMessageConversations: modAs[Q, conversationColumns]{
+			c: Conversations.Columns,
+			f: func(to conversationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Conversations.Name().As(to.Alias())).On(
+						to.LastMessageID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Conversation: modAs[Q, conversationColumns]{
+			c: Conversations.Columns,
+			f: func(to conversationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Conversations.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ConversationID),
+					))
+				}
+
+				return mods
+			},
+		},
+		SenderUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.SenderID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,816 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Mute is an object representing the database table.
+type Mute struct {
+	MuterID   string              `db:"muter_id,pk" `
+	MutedID   string              `db:"muted_id,pk" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R muteR `db:"-" `
+}
+
+// MuteSlice is an alias for a slice of pointers to Mute.
+// This should almost always be used instead of []*Mute.
+type MuteSlice []*Mute
+
+// Mutes contains methods to work with the mutes table
+var Mutes = psql.NewTablex[*Mute, MuteSlice, *MuteSetter]("", "mutes", buildMuteColumns("mutes"))
+
+// MutesQuery is a query on the mutes table
+type MutesQuery = *psql.ViewQuery[*Mute, MuteSlice]
+
+// muteR is where relationships are stored.
+type muteR struct {
+	MutedUser *User // mutes.mutes_muted_id_fkey
+	MuterUser *User // mutes.mutes_muter_id_fkey
+}
+
+func buildMuteColumns(alias string) muteColumns {
+	return muteColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"muter_id", "muted_id", "created_at",
+		).WithParent("mutes"),
+		tableAlias: alias,
+		MuterID:    psql.Quote(alias, "muter_id"),
+		MutedID:    psql.Quote(alias, "muted_id"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type muteColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	MuterID    psql.Expression
+	MutedID    psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c muteColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (muteColumns) AliasedAs(alias string) muteColumns {
+	return buildMuteColumns(alias)
+}
+
+// MuteSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type MuteSetter struct {
+	MuterID   omit.Val[string]        `db:"muter_id,pk" `
+	MutedID   omit.Val[string]        `db:"muted_id,pk" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s MuteSetter) SetColumns() []string {
+	vals := make([]string, 0, 3)
+	if s.MuterID.IsValue() {
+		vals = append(vals, "muter_id")
+	}
+	if s.MutedID.IsValue() {
+		vals = append(vals, "muted_id")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s MuteSetter) Overwrite(t *Mute) {
+	if s.MuterID.IsValue() {
+		t.MuterID = s.MuterID.MustGet()
+	}
+	if s.MutedID.IsValue() {
+		t.MutedID = s.MutedID.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *MuteSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Mutes.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 3)
+		if s.MuterID.IsValue() {
+			vals[0] = psql.Arg(s.MuterID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.MutedID.IsValue() {
+			vals[1] = psql.Arg(s.MutedID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[2] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s MuteSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s MuteSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 3)
+
+	if s.MuterID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "muter_id")...),
+			psql.Arg(s.MuterID),
+		}})
+	}
+
+	if s.MutedID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "muted_id")...),
+			psql.Arg(s.MutedID),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindMute retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindMute(ctx context.Context, exec bob.Executor, MuterIDPK string, MutedIDPK string, cols ...string) (*Mute, error) {
+	if len(cols) == 0 {
+		return Mutes.Query(
+			sm.Where(Mutes.Columns.MuterID.EQ(psql.Arg(MuterIDPK))),
+			sm.Where(Mutes.Columns.MutedID.EQ(psql.Arg(MutedIDPK))),
+		).One(ctx, exec)
+	}
+
+	return Mutes.Query(
+		sm.Where(Mutes.Columns.MuterID.EQ(psql.Arg(MuterIDPK))),
+		sm.Where(Mutes.Columns.MutedID.EQ(psql.Arg(MutedIDPK))),
+		sm.Columns(Mutes.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// MuteExists checks the presence of a single record by primary key
+func MuteExists(ctx context.Context, exec bob.Executor, MuterIDPK string, MutedIDPK string) (bool, error) {
+	return Mutes.Query(
+		sm.Where(Mutes.Columns.MuterID.EQ(psql.Arg(MuterIDPK))),
+		sm.Where(Mutes.Columns.MutedID.EQ(psql.Arg(MutedIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Mute is retrieved from the database
+func (o *Mute) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Mutes.AfterSelectHooks.RunHooks(ctx, exec, MuteSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Mutes.AfterInsertHooks.RunHooks(ctx, exec, MuteSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Mutes.AfterUpdateHooks.RunHooks(ctx, exec, MuteSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Mutes.AfterDeleteHooks.RunHooks(ctx, exec, MuteSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Mute
+func (o *Mute) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.MuterID,
+		o.MutedID,
+	)
+}
+
+func (o *Mute) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("mutes", "muter_id"), psql.Quote("mutes", "muted_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Mute
+func (o *Mute) Update(ctx context.Context, exec bob.Executor, s *MuteSetter) error {
+	v, err := Mutes.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Mute record with an executor
+func (o *Mute) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Mutes.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Mute using the executor
+func (o *Mute) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Mutes.Query(
+		sm.Where(Mutes.Columns.MuterID.EQ(psql.Arg(o.MuterID))),
+		sm.Where(Mutes.Columns.MutedID.EQ(psql.Arg(o.MutedID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after MuteSlice is retrieved from the database
+func (o MuteSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Mutes.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Mutes.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Mutes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Mutes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o MuteSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("mutes", "muter_id"), psql.Quote("mutes", "muted_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o MuteSlice) copyMatchingRows(from ...*Mute) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.MuterID != old.MuterID {
+				continue
+			}
+			if new.MutedID != old.MutedID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o MuteSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Mutes.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Mute:
+				o.copyMatchingRows(retrieved)
+			case []*Mute:
+				o.copyMatchingRows(retrieved...)
+			case MuteSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Mute or a slice of Mute
+				// then run the AfterUpdateHooks on the slice
+				_, err = Mutes.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o MuteSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Mutes.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Mute:
+				o.copyMatchingRows(retrieved)
+			case []*Mute:
+				o.copyMatchingRows(retrieved...)
+			case MuteSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Mute or a slice of Mute
+				// then run the AfterDeleteHooks on the slice
+				_, err = Mutes.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o MuteSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals MuteSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Mutes.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o MuteSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Mutes.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o MuteSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Mutes.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// MutedUser starts a query for related objects on users
+func (o *Mute) MutedUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.MutedID))),
+	)...)
+}
+
+func (os MuteSlice) MutedUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkMutedID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkMutedID = append(pkMutedID, o.MutedID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkMutedID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// MuterUser starts a query for related objects on users
+func (o *Mute) MuterUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.MuterID))),
+	)...)
+}
+
+func (os MuteSlice) MuterUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkMuterID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkMuterID = append(pkMuterID, o.MuterID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkMuterID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachMuteMutedUser0(ctx context.Context, exec bob.Executor, count int, mute0 *Mute, user1 *User) (*Mute, error) {
+	setter := &MuteSetter{
+		MutedID: omit.From(user1.ID),
+	}
+
+	err := mute0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMuteMutedUser0: %w", err)
+	}
+
+	return mute0, nil
+}
+
+func (mute0 *Mute) InsertMutedUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMuteMutedUser0(ctx, exec, 1, mute0, user1)
+	if err != nil {
+		return err
+	}
+
+	mute0.R.MutedUser = user1
+
+	user1.R.MutedMutes = append(user1.R.MutedMutes, mute0)
+
+	return nil
+}
+
+func (mute0 *Mute) AttachMutedUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachMuteMutedUser0(ctx, exec, 1, mute0, user1)
+	if err != nil {
+		return err
+	}
+
+	mute0.R.MutedUser = user1
+
+	user1.R.MutedMutes = append(user1.R.MutedMutes, mute0)
+
+	return nil
+}
+
+func attachMuteMuterUser0(ctx context.Context, exec bob.Executor, count int, mute0 *Mute, user1 *User) (*Mute, error) {
+	setter := &MuteSetter{
+		MuterID: omit.From(user1.ID),
+	}
+
+	err := mute0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachMuteMuterUser0: %w", err)
+	}
+
+	return mute0, nil
+}
+
+func (mute0 *Mute) InsertMuterUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachMuteMuterUser0(ctx, exec, 1, mute0, user1)
+	if err != nil {
+		return err
+	}
+
+	mute0.R.MuterUser = user1
+
+	user1.R.MuterMutes = append(user1.R.MuterMutes, mute0)
+
+	return nil
+}
+
+func (mute0 *Mute) AttachMuterUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachMuteMuterUser0(ctx, exec, 1, mute0, user1)
+	if err != nil {
+		return err
+	}
+
+	mute0.R.MuterUser = user1
+
+	user1.R.MuterMutes = append(user1.R.MuterMutes, mute0)
+
+	return nil
+}
+
+type muteWhere[Q psql.Filterable] struct {
+	MuterID   psql.WhereMod[Q, string]
+	MutedID   psql.WhereMod[Q, string]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (muteWhere[Q]) AliasedAs(alias string) muteWhere[Q] {
+	return buildMuteWhere[Q](buildMuteColumns(alias))
+}
+
+func buildMuteWhere[Q psql.Filterable](cols muteColumns) muteWhere[Q] {
+	return muteWhere[Q]{
+		MuterID:   psql.Where[Q, string](cols.MuterID),
+		MutedID:   psql.Where[Q, string](cols.MutedID),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Mute) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "MutedUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("mute cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MutedUser = rel
+
+		if rel != nil {
+			rel.R.MutedMutes = MuteSlice{o}
+		}
+		return nil
+	case "MuterUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("mute cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MuterUser = rel
+
+		if rel != nil {
+			rel.R.MuterMutes = MuteSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("mute has no relationship %q", name)
+	}
+}
+
+type mutePreloader struct {
+	MutedUser func(...psql.PreloadOption) psql.Preloader
+	MuterUser func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildMutePreloader() mutePreloader {
+	return mutePreloader{
+		MutedUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "MutedUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Mutes,
+						To:          Users,
+						FromColumns: []string{"muted_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		MuterUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "MuterUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Mutes,
+						To:          Users,
+						FromColumns: []string{"muter_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type muteThenLoader[Q orm.Loadable] struct {
+	MutedUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	MuterUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildMuteThenLoader[Q orm.Loadable]() muteThenLoader[Q] {
+	type MutedUserLoadInterface interface {
+		LoadMutedUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MuterUserLoadInterface interface {
+		LoadMuterUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return muteThenLoader[Q]{
+		MutedUser: thenLoadBuilder[Q](
+			"MutedUser",
+			func(ctx context.Context, exec bob.Executor, retrieved MutedUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMutedUser(ctx, exec, mods...)
+			},
+		),
+		MuterUser: thenLoadBuilder[Q](
+			"MuterUser",
+			func(ctx context.Context, exec bob.Executor, retrieved MuterUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMuterUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadMutedUser loads the mute's MutedUser into the .R struct
+func (o *Mute) LoadMutedUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MutedUser = nil
+
+	related, err := o.MutedUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.MutedMutes = MuteSlice{o}
+
+	o.R.MutedUser = related
+	return nil
+}
+
+// LoadMutedUser loads the mute's MutedUser into the .R struct
+func (os MuteSlice) LoadMutedUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.MutedUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.MutedID == rel.ID) {
+				continue
+			}
+
+			rel.R.MutedMutes = append(rel.R.MutedMutes, o)
+
+			o.R.MutedUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadMuterUser loads the mute's MuterUser into the .R struct
+func (o *Mute) LoadMuterUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MuterUser = nil
+
+	related, err := o.MuterUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.MuterMutes = MuteSlice{o}
+
+	o.R.MuterUser = related
+	return nil
+}
+
+// LoadMuterUser loads the mute's MuterUser into the .R struct
+func (os MuteSlice) LoadMuterUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.MuterUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.MuterID == rel.ID) {
+				continue
+			}
+
+			rel.R.MuterMutes = append(rel.R.MuterMutes, o)
+
+			o.R.MuterUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type muteJoins[Q dialect.Joinable] struct {
+	typ       string
+	MutedUser modAs[Q, userColumns]
+	MuterUser modAs[Q, userColumns]
+}
+
+func (j muteJoins[Q]) aliasedAs(alias string) muteJoins[Q] {
+	return buildMuteJoins[Q](buildMuteColumns(alias), j.typ)
+}
+
+func buildMuteJoins[Q dialect.Joinable](cols muteColumns, typ string) muteJoins[Q] {
+	return muteJoins[Q]{
+		typ: typ,
+		MutedUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.MutedID),
+					))
+				}
+
+				return mods
+			},
+		},
+		MuterUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.MuterID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
@@ -0,0 +1,1110 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// Notification is an object representing the database table.
+type Notification struct {
+	ID        string              `db:"id,pk" `
+	UserID    string              `db:"user_id" `
+	ActorID   string              `db:"actor_id" `
+	Type      string              `db:"type" `
+	TweetID   null.Val[string]    `db:"tweet_id" `
+	GroupKey  string              `db:"group_key" `
+	Read      bool                `db:"read" `
+	CreatedAt null.Val[time.Time] `db:"created_at" `
+
+	R notificationR `db:"-" `
+}
+
+// NotificationSlice is an alias for a slice of pointers to Notification.
+// This should almost always be used instead of []*Notification.
+type NotificationSlice []*Notification
+
+// Notifications contains methods to work with the notifications table
+var Notifications = psql.NewTablex[*Notification, NotificationSlice, *NotificationSetter]("", "notifications", buildNotificationColumns("notifications"))
+
+// NotificationsQuery is a query on the notifications table
+type NotificationsQuery = *psql.ViewQuery[*Notification, NotificationSlice]
+
+// notificationR is where relationships are stored.
+type notificationR struct {
+	ActorUser *User  // notifications.notifications_actor_id_fkey
+	Tweet     *Tweet // notifications.notifications_tweet_id_fkey
+	User      *User  // notifications.notifications_user_id_fkey
+}
+
+func buildNotificationColumns(alias string) notificationColumns {
+	return notificationColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"id", "user_id", "actor_id", "type", "tweet_id", "group_key", "read", "created_at",
+		).WithParent("notifications"),
+		tableAlias: alias,
+		ID:         psql.Quote(alias, "id"),
+		UserID:     psql.Quote(alias, "user_id"),
+		ActorID:    psql.Quote(alias, "actor_id"),
+		Type:       psql.Quote(alias, "type"),
+		TweetID:    psql.Quote(alias, "tweet_id"),
+		GroupKey:   psql.Quote(alias, "group_key"),
+		Read:       psql.Quote(alias, "read"),
+		CreatedAt:  psql.Quote(alias, "created_at"),
+	}
+}
+
+type notificationColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	ID         psql.Expression
+	UserID     psql.Expression
+	ActorID    psql.Expression
+	Type       psql.Expression
+	TweetID    psql.Expression
+	GroupKey   psql.Expression
+	Read       psql.Expression
+	CreatedAt  psql.Expression
+}
+
+func (c notificationColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (notificationColumns) AliasedAs(alias string) notificationColumns {
+	return buildNotificationColumns(alias)
+}
+
+// NotificationSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type NotificationSetter struct {
+	ID        omit.Val[string]        `db:"id,pk" `
+	UserID    omit.Val[string]        `db:"user_id" `
+	ActorID   omit.Val[string]        `db:"actor_id" `
+	Type      omit.Val[string]        `db:"type" `
+	TweetID   omitnull.Val[string]    `db:"tweet_id" `
+	GroupKey  omit.Val[string]        `db:"group_key" `
+	Read      omit.Val[bool]          `db:"read" `
+	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
+}
+
+func (s NotificationSetter) SetColumns() []string {
+	vals := make([]string, 0, 8)
+	if s.ID.IsValue() {
+		vals = append(vals, "id")
+	}
+	if s.UserID.IsValue() {
+		vals = append(vals, "user_id")
+	}
+	if s.ActorID.IsValue() {
+		vals = append(vals, "actor_id")
+	}
+	if s.Type.IsValue() {
+		vals = append(vals, "type")
+	}
+	if !s.TweetID.IsUnset() {
+		vals = append(vals, "tweet_id")
+	}
+	if s.GroupKey.IsValue() {
+		vals = append(vals, "group_key")
+	}
+	if s.Read.IsValue() {
+		vals = append(vals, "read")
+	}
+	if !s.CreatedAt.IsUnset() {
+		vals = append(vals, "created_at")
+	}
+	return vals
+}
+
+func (s NotificationSetter) Overwrite(t *Notification) {
+	if s.ID.IsValue() {
+		t.ID = s.ID.MustGet()
+	}
+	if s.UserID.IsValue() {
+		t.UserID = s.UserID.MustGet()
+	}
+	if s.ActorID.IsValue() {
+		t.ActorID = s.ActorID.MustGet()
+	}
+	if s.Type.IsValue() {
+		t.Type = s.Type.MustGet()
+	}
+	if !s.TweetID.IsUnset() {
+		t.TweetID = s.TweetID.MustGetNull()
+	}
+	if s.GroupKey.IsValue() {
+		t.GroupKey = s.GroupKey.MustGet()
+	}
+	if s.Read.IsValue() {
+		t.Read = s.Read.MustGet()
+	}
+	if !s.CreatedAt.IsUnset() {
+		t.CreatedAt = s.CreatedAt.MustGetNull()
+	}
+}
+
+func (s *NotificationSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return Notifications.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 8)
+		if s.ID.IsValue() {
+			vals[0] = psql.Arg(s.ID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.UserID.IsValue() {
+			vals[1] = psql.Arg(s.UserID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		if s.ActorID.IsValue() {
+			vals[2] = psql.Arg(s.ActorID.MustGet())
+		} else {
+			vals[2] = psql.Raw("DEFAULT")
+		}
+
+		if s.Type.IsValue() {
+			vals[3] = psql.Arg(s.Type.MustGet())
+		} else {
+			vals[3] = psql.Raw("DEFAULT")
+		}
+
+		if !s.TweetID.IsUnset() {
+			vals[4] = psql.Arg(s.TweetID.MustGetNull())
+		} else {
+			vals[4] = psql.Raw("DEFAULT")
+		}
+
+		if s.GroupKey.IsValue() {
+			vals[5] = psql.Arg(s.GroupKey.MustGet())
+		} else {
+			vals[5] = psql.Raw("DEFAULT")
+		}
+
+		if s.Read.IsValue() {
+			vals[6] = psql.Arg(s.Read.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.CreatedAt.IsUnset() {
+			vals[7] = psql.Arg(s.CreatedAt.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s NotificationSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s NotificationSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 8)
+
+	if s.ID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "id")...),
+			psql.Arg(s.ID),
+		}})
+	}
+
+	if s.UserID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "user_id")...),
+			psql.Arg(s.UserID),
+		}})
+	}
+
+	if s.ActorID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "actor_id")...),
+			psql.Arg(s.ActorID),
+		}})
+	}
+
+	if s.Type.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "type")...),
+			psql.Arg(s.Type),
+		}})
+	}
+
+	if !s.TweetID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tweet_id")...),
+			psql.Arg(s.TweetID),
+		}})
+	}
+
+	if s.GroupKey.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "group_key")...),
+			psql.Arg(s.GroupKey),
+		}})
+	}
+
+	if s.Read.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "read")...),
+			psql.Arg(s.Read),
+		}})
+	}
+
+	if !s.CreatedAt.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "created_at")...),
+			psql.Arg(s.CreatedAt),
+		}})
+	}
+
+	return exprs
+}
+
+// FindNotification retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindNotification(ctx context.Context, exec bob.Executor, IDPK string, cols ...string) (*Notification, error) {
+	if len(cols) == 0 {
+		return Notifications.Query(
+			sm.Where(Notifications.Columns.ID.EQ(psql.Arg(IDPK))),
+		).One(ctx, exec)
+	}
+
+	return Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(psql.Arg(IDPK))),
+		sm.Columns(Notifications.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// NotificationExists checks the presence of a single record by primary key
+func NotificationExists(ctx context.Context, exec bob.Executor, IDPK string) (bool, error) {
+	return Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(psql.Arg(IDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after Notification is retrieved from the database
+func (o *Notification) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Notifications.AfterSelectHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = Notifications.AfterInsertHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, NotificationSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the Notification
+func (o *Notification) primaryKeyVals() bob.Expression {
+	return psql.Arg(o.ID)
+}
+
+func (o *Notification) pkEQ() dialect.Expression {
+	return psql.Quote("notifications", "id").EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the Notification
+func (o *Notification) Update(ctx context.Context, exec bob.Executor, s *NotificationSetter) error {
+	v, err := Notifications.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single Notification record with an executor
+func (o *Notification) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := Notifications.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the Notification using the executor
+func (o *Notification) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := Notifications.Query(
+		sm.Where(Notifications.Columns.ID.EQ(psql.Arg(o.ID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after NotificationSlice is retrieved from the database
+func (o NotificationSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = Notifications.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = Notifications.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o NotificationSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Quote("notifications", "id").In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o NotificationSlice) copyMatchingRows(from ...*Notification) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.ID != old.ID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o NotificationSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Notifications.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Notification:
+				o.copyMatchingRows(retrieved)
+			case []*Notification:
+				o.copyMatchingRows(retrieved...)
+			case NotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Notification or a slice of Notification
+				// then run the AfterUpdateHooks on the slice
+				_, err = Notifications.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o NotificationSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return Notifications.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *Notification:
+				o.copyMatchingRows(retrieved)
+			case []*Notification:
+				o.copyMatchingRows(retrieved...)
+			case NotificationSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a Notification or a slice of Notification
+				// then run the AfterDeleteHooks on the slice
+				_, err = Notifications.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o NotificationSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals NotificationSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Notifications.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o NotificationSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := Notifications.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o NotificationSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := Notifications.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// ActorUser starts a query for related objects on users
+func (o *Notification) ActorUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.ActorID))),
+	)...)
+}
+
+func (os NotificationSlice) ActorUser(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkActorID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkActorID = append(pkActorID, o.ActorID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkActorID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Tweet starts a query for related objects on tweets
+func (o *Notification) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.TweetID))),
+	)...)
+}
+
+func (os NotificationSlice) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkTweetID := make(pgtypes.Array[null.Val[string]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkTweetID = append(pkTweetID, o.TweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// User starts a query for related objects on users
+func (o *Notification) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
+}
+
+func (os NotificationSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachNotificationActorUser0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, user1 *User) (*Notification, error) {
+	setter := &NotificationSetter{
+		ActorID: omit.From(user1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationActorUser0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertActorUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationActorUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.ActorUser = user1
+
+	user1.R.ActorNotifications = append(user1.R.ActorNotifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachActorUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachNotificationActorUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.ActorUser = user1
+
+	user1.R.ActorNotifications = append(user1.R.ActorNotifications, notification0)
+
+	return nil
+}
+
+func attachNotificationTweet0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, tweet1 *Tweet) (*Notification, error) {
+	setter := &NotificationSetter{
+		TweetID: omitnull.From(tweet1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationTweet0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationTweet0(ctx, exec, 1, notification0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Tweet = tweet1
+
+	tweet1.R.Notifications = append(tweet1.R.Notifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachNotificationTweet0(ctx, exec, 1, notification0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.Tweet = tweet1
+
+	tweet1.R.Notifications = append(tweet1.R.Notifications, notification0)
+
+	return nil
+}
+
+func attachNotificationUser0(ctx context.Context, exec bob.Executor, count int, notification0 *Notification, user1 *User) (*Notification, error) {
+	setter := &NotificationSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := notification0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachNotificationUser0: %w", err)
+	}
+
+	return notification0, nil
+}
+
+func (notification0 *Notification) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachNotificationUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.User = user1
+
+	user1.R.Notifications = append(user1.R.Notifications, notification0)
+
+	return nil
+}
+
+func (notification0 *Notification) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachNotificationUser0(ctx, exec, 1, notification0, user1)
+	if err != nil {
+		return err
+	}
+
+	notification0.R.User = user1
+
+	user1.R.Notifications = append(user1.R.Notifications, notification0)
+
+	return nil
+}
+
+type notificationWhere[Q psql.Filterable] struct {
+	ID        psql.WhereMod[Q, string]
+	UserID    psql.WhereMod[Q, string]
+	ActorID   psql.WhereMod[Q, string]
+	Type      psql.WhereMod[Q, string]
+	TweetID   psql.WhereNullMod[Q, string]
+	GroupKey  psql.WhereMod[Q, string]
+	Read      psql.WhereMod[Q, bool]
+	CreatedAt psql.WhereNullMod[Q, time.Time]
+}
+
+func (notificationWhere[Q]) AliasedAs(alias string) notificationWhere[Q] {
+	return buildNotificationWhere[Q](buildNotificationColumns(alias))
+}
+
+func buildNotificationWhere[Q psql.Filterable](cols notificationColumns) notificationWhere[Q] {
+	return notificationWhere[Q]{
+		ID:        psql.Where[Q, string](cols.ID),
+		UserID:    psql.Where[Q, string](cols.UserID),
+		ActorID:   psql.Where[Q, string](cols.ActorID),
+		Type:      psql.Where[Q, string](cols.Type),
+		TweetID:   psql.WhereNull[Q, string](cols.TweetID),
+		GroupKey:  psql.Where[Q, string](cols.GroupKey),
+		Read:      psql.Where[Q, bool](cols.Read),
+		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
+	}
+}
+
+func (o *Notification) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "ActorUser":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorUser = rel
+
+		if rel != nil {
+			rel.R.ActorNotifications = NotificationSlice{o}
+		}
+		return nil
+	case "Tweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweet = rel
+
+		if rel != nil {
+			rel.R.Notifications = NotificationSlice{o}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("notification cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Notifications = NotificationSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("notification has no relationship %q", name)
+	}
+}
+
+type notificationPreloader struct {
+	ActorUser func(...psql.PreloadOption) psql.Preloader
+	Tweet     func(...psql.PreloadOption) psql.Preloader
+	User      func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildNotificationPreloader() notificationPreloader {
+	return notificationPreloader{
+		ActorUser: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "ActorUser",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Users,
+						FromColumns: []string{"actor_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+		Tweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "Tweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Tweets,
+						FromColumns: []string{"tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Notifications,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type notificationThenLoader[Q orm.Loadable] struct {
+	ActorUser func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tweet     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildNotificationThenLoader[Q orm.Loadable]() notificationThenLoader[Q] {
+	type ActorUserLoadInterface interface {
+		LoadActorUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TweetLoadInterface interface {
+		LoadTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return notificationThenLoader[Q]{
+		ActorUser: thenLoadBuilder[Q](
+			"ActorUser",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorUserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorUser(ctx, exec, mods...)
+			},
+		),
+		Tweet: thenLoadBuilder[Q](
+			"Tweet",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweet(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadActorUser loads the notification's ActorUser into the .R struct
+func (o *Notification) LoadActorUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorUser = nil
+
+	related, err := o.ActorUser(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ActorNotifications = NotificationSlice{o}
+
+	o.R.ActorUser = related
+	return nil
+}
+
+// LoadActorUser loads the notification's ActorUser into the .R struct
+func (os NotificationSlice) LoadActorUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.ActorUser(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.ActorID == rel.ID) {
+				continue
+			}
+
+			rel.R.ActorNotifications = append(rel.R.ActorNotifications, o)
+
+			o.R.ActorUser = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTweet loads the notification's Tweet into the .R struct
+func (o *Notification) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweet = nil
+
+	related, err := o.Tweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Notifications = NotificationSlice{o}
+
+	o.R.Tweet = related
+	return nil
+}
+
+// LoadTweet loads the notification's Tweet into the .R struct
+func (os NotificationSlice) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+			if !o.TweetID.IsValue() {
+				continue
+			}
+
+			if !(o.TweetID.IsValue() && o.TweetID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.Notifications = append(rel.R.Notifications, o)
+
+			o.R.Tweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the notification's User into the .R struct
+func (o *Notification) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Notifications = NotificationSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the notification's User into the .R struct
+func (os NotificationSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Notifications = append(rel.R.Notifications, o)
+
+			o.R.User = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type notificationJoins[Q dialect.Joinable] struct {
+	typ       string
+	ActorUser modAs[Q, userColumns]
+	Tweet     modAs[Q, tweetColumns]
+	User      modAs[Q, userColumns]
+}
+
+func (j notificationJoins[Q]) aliasedAs(alias string) notificationJoins[Q] {
+	return buildNotificationJoins[Q](buildNotificationColumns(alias), j.typ)
+}
+
+func buildNotificationJoins[Q dialect.Joinable](cols notificationColumns, typ string) notificationJoins[Q] {
+	return notificationJoins[Q]{
+		typ: typ,
+		ActorUser: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ActorID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Tweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		User: modAs[Q, userColumns]{
+			c: Users.Columns,
+			f: func(to userColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Users.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.UserID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
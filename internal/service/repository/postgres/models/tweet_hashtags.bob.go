@@ -0,0 +1,766 @@
+// Code generated by BobGen psql v0.42.0. DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
+)
+
+// TweetHashtag is an object representing the database table.
+type TweetHashtag struct {
+	TweetID   string `db:"tweet_id,pk" `
+	HashtagID string `db:"hashtag_id,pk" `
+
+	R tweetHashtagR `db:"-" `
+}
+
+// TweetHashtagSlice is an alias for a slice of pointers to TweetHashtag.
+// This should almost always be used instead of []*TweetHashtag.
+type TweetHashtagSlice []*TweetHashtag
+
+// TweetHashtags contains methods to work with the tweet_hashtags table
+var TweetHashtags = psql.NewTablex[*TweetHashtag, TweetHashtagSlice, *TweetHashtagSetter]("", "tweet_hashtags", buildTweetHashtagColumns("tweet_hashtags"))
+
+// TweetHashtagsQuery is a query on the tweet_hashtags table
+type TweetHashtagsQuery = *psql.ViewQuery[*TweetHashtag, TweetHashtagSlice]
+
+// tweetHashtagR is where relationships are stored.
+type tweetHashtagR struct {
+	Hashtag *Hashtag // tweet_hashtags.tweet_hashtags_hashtag_id_fkey
+	Tweet   *Tweet   // tweet_hashtags.tweet_hashtags_tweet_id_fkey
+}
+
+func buildTweetHashtagColumns(alias string) tweetHashtagColumns {
+	return tweetHashtagColumns{
+		ColumnsExpr: expr.NewColumnsExpr(
+			"tweet_id", "hashtag_id",
+		).WithParent("tweet_hashtags"),
+		tableAlias: alias,
+		TweetID:    psql.Quote(alias, "tweet_id"),
+		HashtagID:  psql.Quote(alias, "hashtag_id"),
+	}
+}
+
+type tweetHashtagColumns struct {
+	expr.ColumnsExpr
+	tableAlias string
+	TweetID    psql.Expression
+	HashtagID  psql.Expression
+}
+
+func (c tweetHashtagColumns) Alias() string {
+	return c.tableAlias
+}
+
+func (tweetHashtagColumns) AliasedAs(alias string) tweetHashtagColumns {
+	return buildTweetHashtagColumns(alias)
+}
+
+// TweetHashtagSetter is used for insert/upsert/update operations
+// All values are optional, and do not have to be set
+// Generated columns are not included
+type TweetHashtagSetter struct {
+	TweetID   omit.Val[string] `db:"tweet_id,pk" `
+	HashtagID omit.Val[string] `db:"hashtag_id,pk" `
+}
+
+func (s TweetHashtagSetter) SetColumns() []string {
+	vals := make([]string, 0, 2)
+	if s.TweetID.IsValue() {
+		vals = append(vals, "tweet_id")
+	}
+	if s.HashtagID.IsValue() {
+		vals = append(vals, "hashtag_id")
+	}
+	return vals
+}
+
+func (s TweetHashtagSetter) Overwrite(t *TweetHashtag) {
+	if s.TweetID.IsValue() {
+		t.TweetID = s.TweetID.MustGet()
+	}
+	if s.HashtagID.IsValue() {
+		t.HashtagID = s.HashtagID.MustGet()
+	}
+}
+
+func (s *TweetHashtagSetter) Apply(q *dialect.InsertQuery) {
+	q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+		return TweetHashtags.BeforeInsertHooks.RunHooks(ctx, exec, s)
+	})
+
+	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		vals := make([]bob.Expression, 2)
+		if s.TweetID.IsValue() {
+			vals[0] = psql.Arg(s.TweetID.MustGet())
+		} else {
+			vals[0] = psql.Raw("DEFAULT")
+		}
+
+		if s.HashtagID.IsValue() {
+			vals[1] = psql.Arg(s.HashtagID.MustGet())
+		} else {
+			vals[1] = psql.Raw("DEFAULT")
+		}
+
+		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
+	}))
+}
+
+func (s TweetHashtagSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return um.Set(s.Expressions()...)
+}
+
+func (s TweetHashtagSetter) Expressions(prefix ...string) []bob.Expression {
+	exprs := make([]bob.Expression, 0, 2)
+
+	if s.TweetID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "tweet_id")...),
+			psql.Arg(s.TweetID),
+		}})
+	}
+
+	if s.HashtagID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "hashtag_id")...),
+			psql.Arg(s.HashtagID),
+		}})
+	}
+
+	return exprs
+}
+
+// FindTweetHashtag retrieves a single record by primary key
+// If cols is empty Find will return all columns.
+func FindTweetHashtag(ctx context.Context, exec bob.Executor, TweetIDPK string, HashtagIDPK string, cols ...string) (*TweetHashtag, error) {
+	if len(cols) == 0 {
+		return TweetHashtags.Query(
+			sm.Where(TweetHashtags.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+			sm.Where(TweetHashtags.Columns.HashtagID.EQ(psql.Arg(HashtagIDPK))),
+		).One(ctx, exec)
+	}
+
+	return TweetHashtags.Query(
+		sm.Where(TweetHashtags.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		sm.Where(TweetHashtags.Columns.HashtagID.EQ(psql.Arg(HashtagIDPK))),
+		sm.Columns(TweetHashtags.Columns.Only(cols...)),
+	).One(ctx, exec)
+}
+
+// TweetHashtagExists checks the presence of a single record by primary key
+func TweetHashtagExists(ctx context.Context, exec bob.Executor, TweetIDPK string, HashtagIDPK string) (bool, error) {
+	return TweetHashtags.Query(
+		sm.Where(TweetHashtags.Columns.TweetID.EQ(psql.Arg(TweetIDPK))),
+		sm.Where(TweetHashtags.Columns.HashtagID.EQ(psql.Arg(HashtagIDPK))),
+	).Exists(ctx, exec)
+}
+
+// AfterQueryHook is called after TweetHashtag is retrieved from the database
+func (o *TweetHashtag) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TweetHashtags.AfterSelectHooks.RunHooks(ctx, exec, TweetHashtagSlice{o})
+	case bob.QueryTypeInsert:
+		ctx, err = TweetHashtags.AfterInsertHooks.RunHooks(ctx, exec, TweetHashtagSlice{o})
+	case bob.QueryTypeUpdate:
+		ctx, err = TweetHashtags.AfterUpdateHooks.RunHooks(ctx, exec, TweetHashtagSlice{o})
+	case bob.QueryTypeDelete:
+		ctx, err = TweetHashtags.AfterDeleteHooks.RunHooks(ctx, exec, TweetHashtagSlice{o})
+	}
+
+	return err
+}
+
+// primaryKeyVals returns the primary key values of the TweetHashtag
+func (o *TweetHashtag) primaryKeyVals() bob.Expression {
+	return psql.ArgGroup(
+		o.TweetID,
+		o.HashtagID,
+	)
+}
+
+func (o *TweetHashtag) pkEQ() dialect.Expression {
+	return psql.Group(psql.Quote("tweet_hashtags", "tweet_id"), psql.Quote("tweet_hashtags", "hashtag_id")).EQ(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		return o.primaryKeyVals().WriteSQL(ctx, w, d, start)
+	}))
+}
+
+// Update uses an executor to update the TweetHashtag
+func (o *TweetHashtag) Update(ctx context.Context, exec bob.Executor, s *TweetHashtagSetter) error {
+	v, err := TweetHashtags.Update(s.UpdateMod(), um.Where(o.pkEQ())).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R = v.R
+	*o = *v
+
+	return nil
+}
+
+// Delete deletes a single TweetHashtag record with an executor
+func (o *TweetHashtag) Delete(ctx context.Context, exec bob.Executor) error {
+	_, err := TweetHashtags.Delete(dm.Where(o.pkEQ())).Exec(ctx, exec)
+	return err
+}
+
+// Reload refreshes the TweetHashtag using the executor
+func (o *TweetHashtag) Reload(ctx context.Context, exec bob.Executor) error {
+	o2, err := TweetHashtags.Query(
+		sm.Where(TweetHashtags.Columns.TweetID.EQ(psql.Arg(o.TweetID))),
+		sm.Where(TweetHashtags.Columns.HashtagID.EQ(psql.Arg(o.HashtagID))),
+	).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+	o2.R = o.R
+	*o = *o2
+
+	return nil
+}
+
+// AfterQueryHook is called after TweetHashtagSlice is retrieved from the database
+func (o TweetHashtagSlice) AfterQueryHook(ctx context.Context, exec bob.Executor, queryType bob.QueryType) error {
+	var err error
+
+	switch queryType {
+	case bob.QueryTypeSelect:
+		ctx, err = TweetHashtags.AfterSelectHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeInsert:
+		ctx, err = TweetHashtags.AfterInsertHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeUpdate:
+		ctx, err = TweetHashtags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+	case bob.QueryTypeDelete:
+		ctx, err = TweetHashtags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+	}
+
+	return err
+}
+
+func (o TweetHashtagSlice) pkIN() dialect.Expression {
+	if len(o) == 0 {
+		return psql.Raw("NULL")
+	}
+
+	return psql.Group(psql.Quote("tweet_hashtags", "tweet_id"), psql.Quote("tweet_hashtags", "hashtag_id")).In(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
+		pkPairs := make([]bob.Expression, len(o))
+		for i, row := range o {
+			pkPairs[i] = row.primaryKeyVals()
+		}
+		return bob.ExpressSlice(ctx, w, d, start, pkPairs, "", ", ", "")
+	}))
+}
+
+// copyMatchingRows finds models in the given slice that have the same primary key
+// then it first copies the existing relationships from the old model to the new model
+// and then replaces the old model in the slice with the new model
+func (o TweetHashtagSlice) copyMatchingRows(from ...*TweetHashtag) {
+	for i, old := range o {
+		for _, new := range from {
+			if new.TweetID != old.TweetID {
+				continue
+			}
+			if new.HashtagID != old.HashtagID {
+				continue
+			}
+			new.R = old.R
+			o[i] = new
+			break
+		}
+	}
+}
+
+// UpdateMod modifies an update query with "WHERE primary_key IN (o...)"
+func (o TweetHashtagSlice) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
+	return bob.ModFunc[*dialect.UpdateQuery](func(q *dialect.UpdateQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TweetHashtags.BeforeUpdateHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TweetHashtag:
+				o.copyMatchingRows(retrieved)
+			case []*TweetHashtag:
+				o.copyMatchingRows(retrieved...)
+			case TweetHashtagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TweetHashtag or a slice of TweetHashtag
+				// then run the AfterUpdateHooks on the slice
+				_, err = TweetHashtags.AfterUpdateHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+// DeleteMod modifies an delete query with "WHERE primary_key IN (o...)"
+func (o TweetHashtagSlice) DeleteMod() bob.Mod[*dialect.DeleteQuery] {
+	return bob.ModFunc[*dialect.DeleteQuery](func(q *dialect.DeleteQuery) {
+		q.AppendHooks(func(ctx context.Context, exec bob.Executor) (context.Context, error) {
+			return TweetHashtags.BeforeDeleteHooks.RunHooks(ctx, exec, o)
+		})
+
+		q.AppendLoader(bob.LoaderFunc(func(ctx context.Context, exec bob.Executor, retrieved any) error {
+			var err error
+			switch retrieved := retrieved.(type) {
+			case *TweetHashtag:
+				o.copyMatchingRows(retrieved)
+			case []*TweetHashtag:
+				o.copyMatchingRows(retrieved...)
+			case TweetHashtagSlice:
+				o.copyMatchingRows(retrieved...)
+			default:
+				// If the retrieved value is not a TweetHashtag or a slice of TweetHashtag
+				// then run the AfterDeleteHooks on the slice
+				_, err = TweetHashtags.AfterDeleteHooks.RunHooks(ctx, exec, o)
+			}
+
+			return err
+		}))
+
+		q.AppendWhere(o.pkIN())
+	})
+}
+
+func (o TweetHashtagSlice) UpdateAll(ctx context.Context, exec bob.Executor, vals TweetHashtagSetter) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TweetHashtags.Update(vals.UpdateMod(), o.UpdateMod()).All(ctx, exec)
+	return err
+}
+
+func (o TweetHashtagSlice) DeleteAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	_, err := TweetHashtags.Delete(o.DeleteMod()).Exec(ctx, exec)
+	return err
+}
+
+func (o TweetHashtagSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	o2, err := TweetHashtags.Query(sm.Where(o.pkIN())).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.copyMatchingRows(o2...)
+
+	return nil
+}
+
+// Hashtag starts a query for related objects on hashtags
+func (o *TweetHashtag) Hashtag(mods ...bob.Mod[*dialect.SelectQuery]) HashtagsQuery {
+	return Hashtags.Query(append(mods,
+		sm.Where(Hashtags.Columns.ID.EQ(psql.Arg(o.HashtagID))),
+	)...)
+}
+
+func (os TweetHashtagSlice) Hashtag(mods ...bob.Mod[*dialect.SelectQuery]) HashtagsQuery {
+	pkHashtagID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkHashtagID = append(pkHashtagID, o.HashtagID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkHashtagID), "character varying[]")),
+	))
+
+	return Hashtags.Query(append(mods,
+		sm.Where(psql.Group(Hashtags.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Tweet starts a query for related objects on tweets
+func (o *TweetHashtag) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.TweetID))),
+	)...)
+}
+
+func (os TweetHashtagSlice) Tweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkTweetID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkTweetID = append(pkTweetID, o.TweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachTweetHashtagHashtag0(ctx context.Context, exec bob.Executor, count int, tweetHashtag0 *TweetHashtag, hashtag1 *Hashtag) (*TweetHashtag, error) {
+	setter := &TweetHashtagSetter{
+		HashtagID: omit.From(hashtag1.ID),
+	}
+
+	err := tweetHashtag0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetHashtagHashtag0: %w", err)
+	}
+
+	return tweetHashtag0, nil
+}
+
+func (tweetHashtag0 *TweetHashtag) InsertHashtag(ctx context.Context, exec bob.Executor, related *HashtagSetter) error {
+	var err error
+
+	hashtag1, err := Hashtags.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTweetHashtagHashtag0(ctx, exec, 1, tweetHashtag0, hashtag1)
+	if err != nil {
+		return err
+	}
+
+	tweetHashtag0.R.Hashtag = hashtag1
+
+	return nil
+}
+
+func (tweetHashtag0 *TweetHashtag) AttachHashtag(ctx context.Context, exec bob.Executor, hashtag1 *Hashtag) error {
+	var err error
+
+	_, err = attachTweetHashtagHashtag0(ctx, exec, 1, tweetHashtag0, hashtag1)
+	if err != nil {
+		return err
+	}
+
+	tweetHashtag0.R.Hashtag = hashtag1
+
+	return nil
+}
+
+func attachTweetHashtagTweet0(ctx context.Context, exec bob.Executor, count int, tweetHashtag0 *TweetHashtag, tweet1 *Tweet) (*TweetHashtag, error) {
+	setter := &TweetHashtagSetter{
+		TweetID: omit.From(tweet1.ID),
+	}
+
+	err := tweetHashtag0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetHashtagTweet0: %w", err)
+	}
+
+	return tweetHashtag0, nil
+}
+
+func (tweetHashtag0 *TweetHashtag) InsertTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTweetHashtagTweet0(ctx, exec, 1, tweetHashtag0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweetHashtag0.R.Tweet = tweet1
+
+	return nil
+}
+
+func (tweetHashtag0 *TweetHashtag) AttachTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachTweetHashtagTweet0(ctx, exec, 1, tweetHashtag0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweetHashtag0.R.Tweet = tweet1
+
+	return nil
+}
+
+type tweetHashtagWhere[Q psql.Filterable] struct {
+	TweetID   psql.WhereMod[Q, string]
+	HashtagID psql.WhereMod[Q, string]
+}
+
+func (tweetHashtagWhere[Q]) AliasedAs(alias string) tweetHashtagWhere[Q] {
+	return buildTweetHashtagWhere[Q](buildTweetHashtagColumns(alias))
+}
+
+func buildTweetHashtagWhere[Q psql.Filterable](cols tweetHashtagColumns) tweetHashtagWhere[Q] {
+	return tweetHashtagWhere[Q]{
+		TweetID:   psql.Where[Q, string](cols.TweetID),
+		HashtagID: psql.Where[Q, string](cols.HashtagID),
+	}
+}
+
+func (o *TweetHashtag) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Hashtag":
+		rel, ok := retrieved.(*Hashtag)
+		if !ok {
+			return fmt.Errorf("tweetHashtag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Hashtag = rel
+
+		return nil
+	case "Tweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("tweetHashtag cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweet = rel
+
+		return nil
+	default:
+		return fmt.Errorf("tweetHashtag has no relationship %q", name)
+	}
+}
+
+type tweetHashtagPreloader struct {
+	Hashtag func(...psql.PreloadOption) psql.Preloader
+	Tweet   func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildTweetHashtagPreloader() tweetHashtagPreloader {
+	return tweetHashtagPreloader{
+		Hashtag: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Hashtag, HashtagSlice](psql.PreloadRel{
+				Name: "Hashtag",
+				Sides: []psql.PreloadSide{
+					{
+						From:        TweetHashtags,
+						To:          Hashtags,
+						FromColumns: []string{"hashtag_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Hashtags.Columns.Names(), opts...)
+		},
+		Tweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "Tweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        TweetHashtags,
+						To:          Tweets,
+						FromColumns: []string{"tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+	}
+}
+
+type tweetHashtagThenLoader[Q orm.Loadable] struct {
+	Hashtag func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tweet   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTweetHashtagThenLoader[Q orm.Loadable]() tweetHashtagThenLoader[Q] {
+	type HashtagLoadInterface interface {
+		LoadHashtag(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TweetLoadInterface interface {
+		LoadTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return tweetHashtagThenLoader[Q]{
+		Hashtag: thenLoadBuilder[Q](
+			"Hashtag",
+			func(ctx context.Context, exec bob.Executor, retrieved HashtagLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadHashtag(ctx, exec, mods...)
+			},
+		),
+		Tweet: thenLoadBuilder[Q](
+			"Tweet",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweet(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadHashtag loads the tweetHashtag's Hashtag into the .R struct
+func (o *TweetHashtag) LoadHashtag(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Hashtag = nil
+
+	related, err := o.Hashtag(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Hashtag = related
+	return nil
+}
+
+// LoadHashtag loads the tweetHashtag's Hashtag into the .R struct
+func (os TweetHashtagSlice) LoadHashtag(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	hashtags, err := os.Hashtag(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range hashtags {
+
+			if !(o.HashtagID == rel.ID) {
+				continue
+			}
+
+			o.R.Hashtag = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTweet loads the tweetHashtag's Tweet into the .R struct
+func (o *TweetHashtag) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweet = nil
+
+	related, err := o.Tweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	o.R.Tweet = related
+	return nil
+}
+
+// LoadTweet loads the tweetHashtag's Tweet into the .R struct
+func (os TweetHashtagSlice) LoadTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !(o.TweetID == rel.ID) {
+				continue
+			}
+
+			o.R.Tweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+type tweetHashtagJoins[Q dialect.Joinable] struct {
+	typ     string
+	Hashtag modAs[Q, hashtagColumns]
+	Tweet   modAs[Q, tweetColumns]
+}
+
+func (j tweetHashtagJoins[Q]) aliasedAs(alias string) tweetHashtagJoins[Q] {
+	return buildTweetHashtagJoins[Q](buildTweetHashtagColumns(alias), j.typ)
+}
+
+func buildTweetHashtagJoins[Q dialect.Joinable](cols tweetHashtagColumns, typ string) tweetHashtagJoins[Q] {
+	return tweetHashtagJoins[Q]{
+		typ: typ,
+		Hashtag: modAs[Q, hashtagColumns]{
+			c: Hashtags.Columns,
+			f: func(to hashtagColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Hashtags.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.HashtagID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Tweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.TweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+	}
+}
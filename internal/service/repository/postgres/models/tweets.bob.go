@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"github.com/aarondl/opt/null"
@@ -22,16 +23,22 @@ import (
 	"github.com/stephenafamo/bob/mods"
 	"github.com/stephenafamo/bob/orm"
 	"github.com/stephenafamo/bob/types/pgtypes"
+	"github.com/stephenafamo/scan"
 )
 
 // Tweet is an object representing the database table.
 type Tweet struct {
-	ID        string              `db:"id,pk" `
-	UserID    string              `db:"user_id" `
-	Content   string              `db:"content" `
-	DeletedAt null.Val[time.Time] `db:"deleted_at" `
-	CreatedAt null.Val[time.Time] `db:"created_at" `
-	UpdatedAt null.Val[time.Time] `db:"updated_at" `
+	ID                string              `db:"id,pk" `
+	UserID            string              `db:"user_id" `
+	Content           string              `db:"content" `
+	DeletedAt         null.Val[time.Time] `db:"deleted_at" `
+	CreatedAt         null.Val[time.Time] `db:"created_at" `
+	UpdatedAt         null.Val[time.Time] `db:"updated_at" `
+	Kind              string              `db:"kind" `
+	ReferencedTweetID null.Val[string]    `db:"referenced_tweet_id" `
+	InReplyToTweetID  null.Val[string]    `db:"in_reply_to_tweet_id" `
+	ConversationID    string              `db:"conversation_id" `
+	SearchVector      null.Val[string]    `db:"search_vector,generated" `
 
 	R tweetR `db:"-" `
 }
@@ -48,33 +55,52 @@ type TweetsQuery = *psql.ViewQuery[*Tweet, TweetSlice]
 
 // tweetR is where relationships are stored.
 type tweetR struct {
-	User *User // tweets.tweets_user_id_fkey
+	Bookmarks               BookmarkSlice     // bookmarks.bookmarks_tweet_id_fkey
+	Likes                   LikeSlice         // likes.likes_tweet_id_fkey
+	Mentions                MentionSlice      // mentions.mentions_tweet_id_fkey
+	Notifications           NotificationSlice // notifications.notifications_tweet_id_fkey
+	Hashtags                HashtagSlice      // tweet_hashtags.tweet_hashtags_hashtag_id_fkeytweet_hashtags.tweet_hashtags_tweet_id_fkey
+	InReplyToTweet          *Tweet            // tweets.tweets_in_reply_to_tweet_id_fkey
+	ReverseInReplyToTweets  TweetSlice        // tweets.tweets_in_reply_to_tweet_id_fkey__self_join_reverse
+	ReferencedTweet         *Tweet            // tweets.tweets_referenced_tweet_id_fkey
+	ReverseReferencedTweets TweetSlice        // tweets.tweets_referenced_tweet_id_fkey__self_join_reverse
+	User                    *User             // tweets.tweets_user_id_fkey
 }
 
 func buildTweetColumns(alias string) tweetColumns {
 	return tweetColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "user_id", "content", "deleted_at", "created_at", "updated_at",
+			"id", "user_id", "content", "deleted_at", "created_at", "updated_at", "kind", "referenced_tweet_id", "in_reply_to_tweet_id", "conversation_id", "search_vector",
 		).WithParent("tweets"),
-		tableAlias: alias,
-		ID:         psql.Quote(alias, "id"),
-		UserID:     psql.Quote(alias, "user_id"),
-		Content:    psql.Quote(alias, "content"),
-		DeletedAt:  psql.Quote(alias, "deleted_at"),
-		CreatedAt:  psql.Quote(alias, "created_at"),
-		UpdatedAt:  psql.Quote(alias, "updated_at"),
+		tableAlias:        alias,
+		ID:                psql.Quote(alias, "id"),
+		UserID:            psql.Quote(alias, "user_id"),
+		Content:           psql.Quote(alias, "content"),
+		DeletedAt:         psql.Quote(alias, "deleted_at"),
+		CreatedAt:         psql.Quote(alias, "created_at"),
+		UpdatedAt:         psql.Quote(alias, "updated_at"),
+		Kind:              psql.Quote(alias, "kind"),
+		ReferencedTweetID: psql.Quote(alias, "referenced_tweet_id"),
+		InReplyToTweetID:  psql.Quote(alias, "in_reply_to_tweet_id"),
+		ConversationID:    psql.Quote(alias, "conversation_id"),
+		SearchVector:      psql.Quote(alias, "search_vector"),
 	}
 }
 
 type tweetColumns struct {
 	expr.ColumnsExpr
-	tableAlias string
-	ID         psql.Expression
-	UserID     psql.Expression
-	Content    psql.Expression
-	DeletedAt  psql.Expression
-	CreatedAt  psql.Expression
-	UpdatedAt  psql.Expression
+	tableAlias        string
+	ID                psql.Expression
+	UserID            psql.Expression
+	Content           psql.Expression
+	DeletedAt         psql.Expression
+	CreatedAt         psql.Expression
+	UpdatedAt         psql.Expression
+	Kind              psql.Expression
+	ReferencedTweetID psql.Expression
+	InReplyToTweetID  psql.Expression
+	ConversationID    psql.Expression
+	SearchVector      psql.Expression
 }
 
 func (c tweetColumns) Alias() string {
@@ -89,16 +115,20 @@ func (tweetColumns) AliasedAs(alias string) tweetColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type TweetSetter struct {
-	ID        omit.Val[string]        `db:"id,pk" `
-	UserID    omit.Val[string]        `db:"user_id" `
-	Content   omit.Val[string]        `db:"content" `
-	DeletedAt omitnull.Val[time.Time] `db:"deleted_at" `
-	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
-	UpdatedAt omitnull.Val[time.Time] `db:"updated_at" `
+	ID                omit.Val[string]        `db:"id,pk" `
+	UserID            omit.Val[string]        `db:"user_id" `
+	Content           omit.Val[string]        `db:"content" `
+	DeletedAt         omitnull.Val[time.Time] `db:"deleted_at" `
+	CreatedAt         omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt         omitnull.Val[time.Time] `db:"updated_at" `
+	Kind              omit.Val[string]        `db:"kind" `
+	ReferencedTweetID omitnull.Val[string]    `db:"referenced_tweet_id" `
+	InReplyToTweetID  omitnull.Val[string]    `db:"in_reply_to_tweet_id" `
+	ConversationID    omit.Val[string]        `db:"conversation_id" `
 }
 
 func (s TweetSetter) SetColumns() []string {
-	vals := make([]string, 0, 6)
+	vals := make([]string, 0, 10)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -117,6 +147,18 @@ func (s TweetSetter) SetColumns() []string {
 	if !s.UpdatedAt.IsUnset() {
 		vals = append(vals, "updated_at")
 	}
+	if s.Kind.IsValue() {
+		vals = append(vals, "kind")
+	}
+	if !s.ReferencedTweetID.IsUnset() {
+		vals = append(vals, "referenced_tweet_id")
+	}
+	if !s.InReplyToTweetID.IsUnset() {
+		vals = append(vals, "in_reply_to_tweet_id")
+	}
+	if s.ConversationID.IsValue() {
+		vals = append(vals, "conversation_id")
+	}
 	return vals
 }
 
@@ -139,6 +181,18 @@ func (s TweetSetter) Overwrite(t *Tweet) {
 	if !s.UpdatedAt.IsUnset() {
 		t.UpdatedAt = s.UpdatedAt.MustGetNull()
 	}
+	if s.Kind.IsValue() {
+		t.Kind = s.Kind.MustGet()
+	}
+	if !s.ReferencedTweetID.IsUnset() {
+		t.ReferencedTweetID = s.ReferencedTweetID.MustGetNull()
+	}
+	if !s.InReplyToTweetID.IsUnset() {
+		t.InReplyToTweetID = s.InReplyToTweetID.MustGetNull()
+	}
+	if s.ConversationID.IsValue() {
+		t.ConversationID = s.ConversationID.MustGet()
+	}
 }
 
 func (s *TweetSetter) Apply(q *dialect.InsertQuery) {
@@ -147,7 +201,7 @@ func (s *TweetSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 6)
+		vals := make([]bob.Expression, 10)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -184,6 +238,30 @@ func (s *TweetSetter) Apply(q *dialect.InsertQuery) {
 			vals[5] = psql.Raw("DEFAULT")
 		}
 
+		if s.Kind.IsValue() {
+			vals[6] = psql.Arg(s.Kind.MustGet())
+		} else {
+			vals[6] = psql.Raw("DEFAULT")
+		}
+
+		if !s.ReferencedTweetID.IsUnset() {
+			vals[7] = psql.Arg(s.ReferencedTweetID.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if !s.InReplyToTweetID.IsUnset() {
+			vals[8] = psql.Arg(s.InReplyToTweetID.MustGetNull())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if s.ConversationID.IsValue() {
+			vals[9] = psql.Arg(s.ConversationID.MustGet())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -193,7 +271,7 @@ func (s TweetSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TweetSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 6)
+	exprs := make([]bob.Expression, 0, 10)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -237,6 +315,34 @@ func (s TweetSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.Kind.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "kind")...),
+			psql.Arg(s.Kind),
+		}})
+	}
+
+	if !s.ReferencedTweetID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "referenced_tweet_id")...),
+			psql.Arg(s.ReferencedTweetID),
+		}})
+	}
+
+	if !s.InReplyToTweetID.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "in_reply_to_tweet_id")...),
+			psql.Arg(s.InReplyToTweetID),
+		}})
+	}
+
+	if s.ConversationID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "conversation_id")...),
+			psql.Arg(s.ConversationID),
+		}})
+	}
+
 	return exprs
 }
 
@@ -463,209 +569,1815 @@ func (o TweetSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-// User starts a query for related objects on users
-func (o *Tweet) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
-	return Users.Query(append(mods,
-		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+// Bookmarks starts a query for related objects on bookmarks
+func (o *Tweet) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	return Bookmarks.Query(append(mods,
+		sm.Where(Bookmarks.Columns.TweetID.EQ(psql.Arg(o.ID))),
 	)...)
 }
 
-func (os TweetSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
-	pkUserID := make(pgtypes.Array[string], 0, len(os))
+func (os TweetSlice) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
-		pkUserID = append(pkUserID, o.UserID)
+		pkID = append(pkID, o.ID)
 	}
 	PKArgExpr := psql.Select(sm.Columns(
-		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
 	))
 
-	return Users.Query(append(mods,
-		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	return Bookmarks.Query(append(mods,
+		sm.Where(psql.Group(Bookmarks.Columns.TweetID).OP("IN", PKArgExpr)),
 	)...)
 }
 
-func attachTweetUser0(ctx context.Context, exec bob.Executor, count int, tweet0 *Tweet, user1 *User) (*Tweet, error) {
-	setter := &TweetSetter{
-		UserID: omit.From(user1.ID),
-	}
+// Likes starts a query for related objects on likes
+func (o *Tweet) Likes(mods ...bob.Mod[*dialect.SelectQuery]) LikesQuery {
+	return Likes.Query(append(mods,
+		sm.Where(Likes.Columns.TweetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	err := tweet0.Update(ctx, exec, setter)
-	if err != nil {
-		return nil, fmt.Errorf("attachTweetUser0: %w", err)
+func (os TweetSlice) Likes(mods ...bob.Mod[*dialect.SelectQuery]) LikesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	return tweet0, nil
+	return Likes.Query(append(mods,
+		sm.Where(psql.Group(Likes.Columns.TweetID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (tweet0 *Tweet) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
-	var err error
+// Mentions starts a query for related objects on mentions
+func (o *Tweet) Mentions(mods ...bob.Mod[*dialect.SelectQuery]) MentionsQuery {
+	return Mentions.Query(append(mods,
+		sm.Where(Mentions.Columns.TweetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	user1, err := Users.Insert(related).One(ctx, exec)
-	if err != nil {
-		return fmt.Errorf("inserting related objects: %w", err)
+func (os TweetSlice) Mentions(mods ...bob.Mod[*dialect.SelectQuery]) MentionsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	_, err = attachTweetUser0(ctx, exec, 1, tweet0, user1)
-	if err != nil {
-		return err
-	}
+	return Mentions.Query(append(mods,
+		sm.Where(psql.Group(Mentions.Columns.TweetID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	tweet0.R.User = user1
+// Notifications starts a query for related objects on notifications
+func (o *Tweet) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.TweetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	user1.R.Tweets = append(user1.R.Tweets, tweet0)
+func (os TweetSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	return nil
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(Notifications.Columns.TweetID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (tweet0 *Tweet) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
-	var err error
+// Hashtags starts a query for related objects on hashtags
+func (o *Tweet) Hashtags(mods ...bob.Mod[*dialect.SelectQuery]) HashtagsQuery {
+	return Hashtags.Query(append(mods,
+		sm.InnerJoin(TweetHashtags.NameAs()).On(
+			Hashtags.Columns.ID.EQ(TweetHashtags.Columns.HashtagID)),
+		sm.Where(TweetHashtags.Columns.TweetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	_, err = attachTweetUser0(ctx, exec, 1, tweet0, user1)
-	if err != nil {
-		return err
+func (os TweetSlice) Hashtags(mods ...bob.Mod[*dialect.SelectQuery]) HashtagsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	tweet0.R.User = user1
-
-	user1.R.Tweets = append(user1.R.Tweets, tweet0)
+	return Hashtags.Query(append(mods,
+		sm.InnerJoin(TweetHashtags.NameAs()).On(
+			Hashtags.Columns.ID.EQ(TweetHashtags.Columns.HashtagID),
+		),
+		sm.Where(psql.Group(TweetHashtags.Columns.TweetID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	return nil
+// InReplyToTweet starts a query for related objects on tweets
+func (o *Tweet) InReplyToTweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.InReplyToTweetID))),
+	)...)
 }
 
-type tweetWhere[Q psql.Filterable] struct {
-	ID        psql.WhereMod[Q, string]
-	UserID    psql.WhereMod[Q, string]
-	Content   psql.WhereMod[Q, string]
-	DeletedAt psql.WhereNullMod[Q, time.Time]
-	CreatedAt psql.WhereNullMod[Q, time.Time]
-	UpdatedAt psql.WhereNullMod[Q, time.Time]
+func (os TweetSlice) InReplyToTweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkInReplyToTweetID := make(pgtypes.Array[null.Val[string]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkInReplyToTweetID = append(pkInReplyToTweetID, o.InReplyToTweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkInReplyToTweetID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (tweetWhere[Q]) AliasedAs(alias string) tweetWhere[Q] {
-	return buildTweetWhere[Q](buildTweetColumns(alias))
+// ReverseInReplyToTweets starts a query for related objects on tweets
+func (o *Tweet) ReverseInReplyToTweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.InReplyToTweetID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func buildTweetWhere[Q psql.Filterable](cols tweetColumns) tweetWhere[Q] {
-	return tweetWhere[Q]{
-		ID:        psql.Where[Q, string](cols.ID),
-		UserID:    psql.Where[Q, string](cols.UserID),
-		Content:   psql.Where[Q, string](cols.Content),
-		DeletedAt: psql.WhereNull[Q, time.Time](cols.DeletedAt),
-		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
-		UpdatedAt: psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+func (os TweetSlice) ReverseInReplyToTweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.InReplyToTweetID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (o *Tweet) Preload(name string, retrieved any) error {
-	if o == nil {
-		return nil
-	}
+// ReferencedTweet starts a query for related objects on tweets
+func (o *Tweet) ReferencedTweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ID.EQ(psql.Arg(o.ReferencedTweetID))),
+	)...)
+}
 
-	switch name {
-	case "User":
-		rel, ok := retrieved.(*User)
-		if !ok {
-			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+func (os TweetSlice) ReferencedTweet(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkReferencedTweetID := make(pgtypes.Array[null.Val[string]], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
+		pkReferencedTweetID = append(pkReferencedTweetID, o.ReferencedTweetID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkReferencedTweetID), "character varying[]")),
+	))
 
-		o.R.User = rel
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-		if rel != nil {
-			rel.R.Tweets = TweetSlice{o}
+// ReverseReferencedTweets starts a query for related objects on tweets
+func (o *Tweet) ReverseReferencedTweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.ReferencedTweetID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os TweetSlice) ReverseReferencedTweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
-		return nil
-	default:
-		return fmt.Errorf("tweet has no relationship %q", name)
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.ReferencedTweetID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type tweetPreloader struct {
-	User func(...psql.PreloadOption) psql.Preloader
+// User starts a query for related objects on users
+func (o *Tweet) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	return Users.Query(append(mods,
+		sm.Where(Users.Columns.ID.EQ(psql.Arg(o.UserID))),
+	)...)
 }
 
-func buildTweetPreloader() tweetPreloader {
-	return tweetPreloader{
-		User: func(opts ...psql.PreloadOption) psql.Preloader {
-			return psql.Preload[*User, UserSlice](psql.PreloadRel{
-				Name: "User",
-				Sides: []psql.PreloadSide{
-					{
-						From:        Tweets,
-						To:          Users,
-						FromColumns: []string{"user_id"},
-						ToColumns:   []string{"id"},
-					},
-				},
-			}, Users.Columns.Names(), opts...)
-		},
+func (os TweetSlice) User(mods ...bob.Mod[*dialect.SelectQuery]) UsersQuery {
+	pkUserID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkUserID = append(pkUserID, o.UserID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkUserID), "character varying[]")),
+	))
+
+	return Users.Query(append(mods,
+		sm.Where(psql.Group(Users.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type tweetThenLoader[Q orm.Loadable] struct {
-	User func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+func insertTweetBookmarks0(ctx context.Context, exec bob.Executor, bookmarks1 []*BookmarkSetter, tweet0 *Tweet) (BookmarkSlice, error) {
+	for i := range bookmarks1 {
+		bookmarks1[i].TweetID = omit.From(tweet0.ID)
+	}
+
+	ret, err := Bookmarks.Insert(bob.ToMods(bookmarks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetBookmarks0: %w", err)
+	}
+
+	return ret, nil
 }
 
-func buildTweetThenLoader[Q orm.Loadable]() tweetThenLoader[Q] {
-	type UserLoadInterface interface {
-		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+func attachTweetBookmarks0(ctx context.Context, exec bob.Executor, count int, bookmarks1 BookmarkSlice, tweet0 *Tweet) (BookmarkSlice, error) {
+	setter := &BookmarkSetter{
+		TweetID: omit.From(tweet0.ID),
 	}
 
-	return tweetThenLoader[Q]{
-		User: thenLoadBuilder[Q](
-			"User",
-			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadUser(ctx, exec, mods...)
-			},
-		),
+	err := bookmarks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetBookmarks0: %w", err)
 	}
+
+	return bookmarks1, nil
 }
 
-// LoadUser loads the tweet's User into the .R struct
-func (o *Tweet) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if o == nil {
+func (tweet0 *Tweet) InsertBookmarks(ctx context.Context, exec bob.Executor, related ...*BookmarkSetter) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	// Reset the relationship
-	o.R.User = nil
+	var err error
 
-	related, err := o.User(mods...).One(ctx, exec)
+	bookmarks1, err := insertTweetBookmarks0(ctx, exec, related, tweet0)
 	if err != nil {
 		return err
 	}
 
-	related.R.Tweets = TweetSlice{o}
+	tweet0.R.Bookmarks = append(tweet0.R.Bookmarks, bookmarks1...)
 
-	o.R.User = related
+	for _, rel := range bookmarks1 {
+		rel.R.Tweet = tweet0
+	}
 	return nil
 }
 
-// LoadUser loads the tweet's User into the .R struct
-func (os TweetSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if len(os) == 0 {
+func (tweet0 *Tweet) AttachBookmarks(ctx context.Context, exec bob.Executor, related ...*Bookmark) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	users, err := os.User(mods...).All(ctx, exec)
+	var err error
+	bookmarks1 := BookmarkSlice(related)
+
+	_, err = attachTweetBookmarks0(ctx, exec, len(related), bookmarks1, tweet0)
 	if err != nil {
 		return err
 	}
 
-	for _, o := range os {
-		if o == nil {
-			continue
-		}
+	tweet0.R.Bookmarks = append(tweet0.R.Bookmarks, bookmarks1...)
 
-		for _, rel := range users {
+	for _, rel := range related {
+		rel.R.Tweet = tweet0
+	}
 
-			if !(o.UserID == rel.ID) {
-				continue
-			}
+	return nil
+}
 
-			rel.R.Tweets = append(rel.R.Tweets, o)
+func insertTweetLikes0(ctx context.Context, exec bob.Executor, likes1 []*LikeSetter, tweet0 *Tweet) (LikeSlice, error) {
+	for i := range likes1 {
+		likes1[i].TweetID = omit.From(tweet0.ID)
+	}
+
+	ret, err := Likes.Insert(bob.ToMods(likes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetLikes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTweetLikes0(ctx context.Context, exec bob.Executor, count int, likes1 LikeSlice, tweet0 *Tweet) (LikeSlice, error) {
+	setter := &LikeSetter{
+		TweetID: omit.From(tweet0.ID),
+	}
+
+	err := likes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetLikes0: %w", err)
+	}
+
+	return likes1, nil
+}
+
+func (tweet0 *Tweet) InsertLikes(ctx context.Context, exec bob.Executor, related ...*LikeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	likes1, err := insertTweetLikes0(ctx, exec, related, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Likes = append(tweet0.R.Likes, likes1...)
+
+	for _, rel := range likes1 {
+		rel.R.Tweet = tweet0
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachLikes(ctx context.Context, exec bob.Executor, related ...*Like) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	likes1 := LikeSlice(related)
+
+	_, err = attachTweetLikes0(ctx, exec, len(related), likes1, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Likes = append(tweet0.R.Likes, likes1...)
+
+	for _, rel := range related {
+		rel.R.Tweet = tweet0
+	}
+
+	return nil
+}
+
+func insertTweetMentions0(ctx context.Context, exec bob.Executor, mentions1 []*MentionSetter, tweet0 *Tweet) (MentionSlice, error) {
+	for i := range mentions1 {
+		mentions1[i].TweetID = omit.From(tweet0.ID)
+	}
+
+	ret, err := Mentions.Insert(bob.ToMods(mentions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetMentions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTweetMentions0(ctx context.Context, exec bob.Executor, count int, mentions1 MentionSlice, tweet0 *Tweet) (MentionSlice, error) {
+	setter := &MentionSetter{
+		TweetID: omit.From(tweet0.ID),
+	}
+
+	err := mentions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetMentions0: %w", err)
+	}
+
+	return mentions1, nil
+}
+
+func (tweet0 *Tweet) InsertMentions(ctx context.Context, exec bob.Executor, related ...*MentionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mentions1, err := insertTweetMentions0(ctx, exec, related, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Mentions = append(tweet0.R.Mentions, mentions1...)
+
+	for _, rel := range mentions1 {
+		rel.R.Tweet = tweet0
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachMentions(ctx context.Context, exec bob.Executor, related ...*Mention) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mentions1 := MentionSlice(related)
+
+	_, err = attachTweetMentions0(ctx, exec, len(related), mentions1, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Mentions = append(tweet0.R.Mentions, mentions1...)
+
+	for _, rel := range related {
+		rel.R.Tweet = tweet0
+	}
+
+	return nil
+}
+
+func insertTweetNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, tweet0 *Tweet) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].TweetID = omitnull.From(tweet0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTweetNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, tweet0 *Tweet) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		TweetID: omitnull.From(tweet0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (tweet0 *Tweet) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertTweetNotifications0(ctx, exec, related, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Notifications = append(tweet0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.Tweet = tweet0
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachTweetNotifications0(ctx, exec, len(related), notifications1, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Notifications = append(tweet0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.Tweet = tweet0
+	}
+
+	return nil
+}
+
+func attachTweetHashtags0(ctx context.Context, exec bob.Executor, count int, tweet0 *Tweet, hashtags2 HashtagSlice) (TweetHashtagSlice, error) {
+	setters := make([]*TweetHashtagSetter, count)
+	for i := range count {
+		setters[i] = &TweetHashtagSetter{
+			TweetID:   omit.From(tweet0.ID),
+			HashtagID: omit.From(hashtags2[i].ID),
+		}
+	}
+
+	tweetHashtags1, err := TweetHashtags.Insert(bob.ToMods(setters...)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetHashtags0: %w", err)
+	}
+
+	return tweetHashtags1, nil
+}
+
+func (tweet0 *Tweet) InsertHashtags(ctx context.Context, exec bob.Executor, related ...*HashtagSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	inserted, err := Hashtags.Insert(bob.ToMods(related...)).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+	hashtags2 := HashtagSlice(inserted)
+
+	_, err = attachTweetHashtags0(ctx, exec, len(related), tweet0, hashtags2)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Hashtags = append(tweet0.R.Hashtags, hashtags2...)
+
+	for _, rel := range hashtags2 {
+		rel.R.Tweets = append(rel.R.Tweets, tweet0)
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachHashtags(ctx context.Context, exec bob.Executor, related ...*Hashtag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	hashtags2 := HashtagSlice(related)
+
+	_, err = attachTweetHashtags0(ctx, exec, len(related), tweet0, hashtags2)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.Hashtags = append(tweet0.R.Hashtags, hashtags2...)
+
+	for _, rel := range related {
+		rel.R.Tweets = append(rel.R.Tweets, tweet0)
+	}
+
+	return nil
+}
+
+func attachTweetInReplyToTweet0(ctx context.Context, exec bob.Executor, count int, tweet0 *Tweet, tweet1 *Tweet) (*Tweet, error) {
+	setter := &TweetSetter{
+		InReplyToTweetID: omitnull.From(tweet1.ID),
+	}
+
+	err := tweet0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetInReplyToTweet0: %w", err)
+	}
+
+	return tweet0, nil
+}
+
+func (tweet0 *Tweet) InsertInReplyToTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTweetInReplyToTweet0(ctx, exec, 1, tweet0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.InReplyToTweet = tweet1
+
+	tweet1.R.InReplyToTweet = tweet0
+
+	return nil
+}
+
+func (tweet0 *Tweet) AttachInReplyToTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachTweetInReplyToTweet0(ctx, exec, 1, tweet0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.InReplyToTweet = tweet1
+
+	tweet1.R.InReplyToTweet = tweet0
+
+	return nil
+}
+
+func insertTweetReverseInReplyToTweets0(ctx context.Context, exec bob.Executor, tweets1 []*TweetSetter, tweet0 *Tweet) (TweetSlice, error) {
+	for i := range tweets1 {
+		tweets1[i].InReplyToTweetID = omitnull.From(tweet0.ID)
+	}
+
+	ret, err := Tweets.Insert(bob.ToMods(tweets1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetReverseInReplyToTweets0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTweetReverseInReplyToTweets0(ctx context.Context, exec bob.Executor, count int, tweets1 TweetSlice, tweet0 *Tweet) (TweetSlice, error) {
+	setter := &TweetSetter{
+		InReplyToTweetID: omitnull.From(tweet0.ID),
+	}
+
+	err := tweets1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetReverseInReplyToTweets0: %w", err)
+	}
+
+	return tweets1, nil
+}
+
+func (tweet0 *Tweet) InsertReverseInReplyToTweets(ctx context.Context, exec bob.Executor, related ...*TweetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	tweets1, err := insertTweetReverseInReplyToTweets0(ctx, exec, related, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReverseInReplyToTweets = append(tweet0.R.ReverseInReplyToTweets, tweets1...)
+
+	for _, rel := range tweets1 {
+		rel.R.ReverseInReplyToTweets = append(rel.R.ReverseInReplyToTweets, tweet0)
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachReverseInReplyToTweets(ctx context.Context, exec bob.Executor, related ...*Tweet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tweets1 := TweetSlice(related)
+
+	_, err = attachTweetReverseInReplyToTweets0(ctx, exec, len(related), tweets1, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReverseInReplyToTweets = append(tweet0.R.ReverseInReplyToTweets, tweets1...)
+
+	for _, rel := range related {
+		rel.R.ReverseInReplyToTweets = append(rel.R.ReverseInReplyToTweets, tweet0)
+	}
+
+	return nil
+}
+
+func attachTweetReferencedTweet0(ctx context.Context, exec bob.Executor, count int, tweet0 *Tweet, tweet1 *Tweet) (*Tweet, error) {
+	setter := &TweetSetter{
+		ReferencedTweetID: omitnull.From(tweet1.ID),
+	}
+
+	err := tweet0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetReferencedTweet0: %w", err)
+	}
+
+	return tweet0, nil
+}
+
+func (tweet0 *Tweet) InsertReferencedTweet(ctx context.Context, exec bob.Executor, related *TweetSetter) error {
+	var err error
+
+	tweet1, err := Tweets.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTweetReferencedTweet0(ctx, exec, 1, tweet0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReferencedTweet = tweet1
+
+	tweet1.R.ReferencedTweet = tweet0
+
+	return nil
+}
+
+func (tweet0 *Tweet) AttachReferencedTweet(ctx context.Context, exec bob.Executor, tweet1 *Tweet) error {
+	var err error
+
+	_, err = attachTweetReferencedTweet0(ctx, exec, 1, tweet0, tweet1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReferencedTweet = tweet1
+
+	tweet1.R.ReferencedTweet = tweet0
+
+	return nil
+}
+
+func insertTweetReverseReferencedTweets0(ctx context.Context, exec bob.Executor, tweets1 []*TweetSetter, tweet0 *Tweet) (TweetSlice, error) {
+	for i := range tweets1 {
+		tweets1[i].ReferencedTweetID = omitnull.From(tweet0.ID)
+	}
+
+	ret, err := Tweets.Insert(bob.ToMods(tweets1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertTweetReverseReferencedTweets0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachTweetReverseReferencedTweets0(ctx context.Context, exec bob.Executor, count int, tweets1 TweetSlice, tweet0 *Tweet) (TweetSlice, error) {
+	setter := &TweetSetter{
+		ReferencedTweetID: omitnull.From(tweet0.ID),
+	}
+
+	err := tweets1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetReverseReferencedTweets0: %w", err)
+	}
+
+	return tweets1, nil
+}
+
+func (tweet0 *Tweet) InsertReverseReferencedTweets(ctx context.Context, exec bob.Executor, related ...*TweetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	tweets1, err := insertTweetReverseReferencedTweets0(ctx, exec, related, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReverseReferencedTweets = append(tweet0.R.ReverseReferencedTweets, tweets1...)
+
+	for _, rel := range tweets1 {
+		rel.R.ReverseReferencedTweets = append(rel.R.ReverseReferencedTweets, tweet0)
+	}
+	return nil
+}
+
+func (tweet0 *Tweet) AttachReverseReferencedTweets(ctx context.Context, exec bob.Executor, related ...*Tweet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tweets1 := TweetSlice(related)
+
+	_, err = attachTweetReverseReferencedTweets0(ctx, exec, len(related), tweets1, tweet0)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.ReverseReferencedTweets = append(tweet0.R.ReverseReferencedTweets, tweets1...)
+
+	for _, rel := range related {
+		rel.R.ReverseReferencedTweets = append(rel.R.ReverseReferencedTweets, tweet0)
+	}
+
+	return nil
+}
+
+func attachTweetUser0(ctx context.Context, exec bob.Executor, count int, tweet0 *Tweet, user1 *User) (*Tweet, error) {
+	setter := &TweetSetter{
+		UserID: omit.From(user1.ID),
+	}
+
+	err := tweet0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTweetUser0: %w", err)
+	}
+
+	return tweet0, nil
+}
+
+func (tweet0 *Tweet) InsertUser(ctx context.Context, exec bob.Executor, related *UserSetter) error {
+	var err error
+
+	user1, err := Users.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTweetUser0(ctx, exec, 1, tweet0, user1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.User = user1
+
+	user1.R.Tweets = append(user1.R.Tweets, tweet0)
+
+	return nil
+}
+
+func (tweet0 *Tweet) AttachUser(ctx context.Context, exec bob.Executor, user1 *User) error {
+	var err error
+
+	_, err = attachTweetUser0(ctx, exec, 1, tweet0, user1)
+	if err != nil {
+		return err
+	}
+
+	tweet0.R.User = user1
+
+	user1.R.Tweets = append(user1.R.Tweets, tweet0)
+
+	return nil
+}
+
+type tweetWhere[Q psql.Filterable] struct {
+	ID                psql.WhereMod[Q, string]
+	UserID            psql.WhereMod[Q, string]
+	Content           psql.WhereMod[Q, string]
+	DeletedAt         psql.WhereNullMod[Q, time.Time]
+	CreatedAt         psql.WhereNullMod[Q, time.Time]
+	UpdatedAt         psql.WhereNullMod[Q, time.Time]
+	Kind              psql.WhereMod[Q, string]
+	ReferencedTweetID psql.WhereNullMod[Q, string]
+	InReplyToTweetID  psql.WhereNullMod[Q, string]
+	ConversationID    psql.WhereMod[Q, string]
+	SearchVector      psql.WhereNullMod[Q, string]
+}
+
+func (tweetWhere[Q]) AliasedAs(alias string) tweetWhere[Q] {
+	return buildTweetWhere[Q](buildTweetColumns(alias))
+}
+
+func buildTweetWhere[Q psql.Filterable](cols tweetColumns) tweetWhere[Q] {
+	return tweetWhere[Q]{
+		ID:                psql.Where[Q, string](cols.ID),
+		UserID:            psql.Where[Q, string](cols.UserID),
+		Content:           psql.Where[Q, string](cols.Content),
+		DeletedAt:         psql.WhereNull[Q, time.Time](cols.DeletedAt),
+		CreatedAt:         psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:         psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+		Kind:              psql.Where[Q, string](cols.Kind),
+		ReferencedTweetID: psql.WhereNull[Q, string](cols.ReferencedTweetID),
+		InReplyToTweetID:  psql.WhereNull[Q, string](cols.InReplyToTweetID),
+		ConversationID:    psql.Where[Q, string](cols.ConversationID),
+		SearchVector:      psql.WhereNull[Q, string](cols.SearchVector),
+	}
+}
+
+func (o *Tweet) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Bookmarks":
+		rels, ok := retrieved.(BookmarkSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Bookmarks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tweet = o
+			}
+		}
+		return nil
+	case "Likes":
+		rels, ok := retrieved.(LikeSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Likes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tweet = o
+			}
+		}
+		return nil
+	case "Mentions":
+		rels, ok := retrieved.(MentionSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Mentions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tweet = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tweet = o
+			}
+		}
+		return nil
+	case "Hashtags":
+		rels, ok := retrieved.(HashtagSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Hashtags = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Tweets = TweetSlice{o}
+			}
+		}
+		return nil
+	case "InReplyToTweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.InReplyToTweet = rel
+
+		if rel != nil {
+			rel.R.InReplyToTweet = o
+		}
+		return nil
+	case "ReverseInReplyToTweets":
+		rels, ok := retrieved.(TweetSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ReverseInReplyToTweets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ReverseInReplyToTweets = TweetSlice{o}
+			}
+		}
+		return nil
+	case "ReferencedTweet":
+		rel, ok := retrieved.(*Tweet)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ReferencedTweet = rel
+
+		if rel != nil {
+			rel.R.ReferencedTweet = o
+		}
+		return nil
+	case "ReverseReferencedTweets":
+		rels, ok := retrieved.(TweetSlice)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ReverseReferencedTweets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ReverseReferencedTweets = TweetSlice{o}
+			}
+		}
+		return nil
+	case "User":
+		rel, ok := retrieved.(*User)
+		if !ok {
+			return fmt.Errorf("tweet cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.User = rel
+
+		if rel != nil {
+			rel.R.Tweets = TweetSlice{o}
+		}
+		return nil
+	default:
+		return fmt.Errorf("tweet has no relationship %q", name)
+	}
+}
+
+type tweetPreloader struct {
+	InReplyToTweet  func(...psql.PreloadOption) psql.Preloader
+	ReferencedTweet func(...psql.PreloadOption) psql.Preloader
+	User            func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildTweetPreloader() tweetPreloader {
+	return tweetPreloader{
+		InReplyToTweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "InReplyToTweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Tweets,
+						To:          Tweets,
+						FromColumns: []string{"in_reply_to_tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		ReferencedTweet: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Tweet, TweetSlice](psql.PreloadRel{
+				Name: "ReferencedTweet",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Tweets,
+						To:          Tweets,
+						FromColumns: []string{"referenced_tweet_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Tweets.Columns.Names(), opts...)
+		},
+		User: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*User, UserSlice](psql.PreloadRel{
+				Name: "User",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Tweets,
+						To:          Users,
+						FromColumns: []string{"user_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Users.Columns.Names(), opts...)
+		},
+	}
+}
+
+type tweetThenLoader[Q orm.Loadable] struct {
+	Bookmarks               func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Likes                   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Mentions                func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Hashtags                func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	InReplyToTweet          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReverseInReplyToTweets  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReferencedTweet         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReverseReferencedTweets func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	User                    func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildTweetThenLoader[Q orm.Loadable]() tweetThenLoader[Q] {
+	type BookmarksLoadInterface interface {
+		LoadBookmarks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LikesLoadInterface interface {
+		LoadLikes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MentionsLoadInterface interface {
+		LoadMentions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type HashtagsLoadInterface interface {
+		LoadHashtags(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type InReplyToTweetLoadInterface interface {
+		LoadInReplyToTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReverseInReplyToTweetsLoadInterface interface {
+		LoadReverseInReplyToTweets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReferencedTweetLoadInterface interface {
+		LoadReferencedTweet(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReverseReferencedTweetsLoadInterface interface {
+		LoadReverseReferencedTweets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type UserLoadInterface interface {
+		LoadUser(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return tweetThenLoader[Q]{
+		Bookmarks: thenLoadBuilder[Q](
+			"Bookmarks",
+			func(ctx context.Context, exec bob.Executor, retrieved BookmarksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBookmarks(ctx, exec, mods...)
+			},
+		),
+		Likes: thenLoadBuilder[Q](
+			"Likes",
+			func(ctx context.Context, exec bob.Executor, retrieved LikesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLikes(ctx, exec, mods...)
+			},
+		),
+		Mentions: thenLoadBuilder[Q](
+			"Mentions",
+			func(ctx context.Context, exec bob.Executor, retrieved MentionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMentions(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		Hashtags: thenLoadBuilder[Q](
+			"Hashtags",
+			func(ctx context.Context, exec bob.Executor, retrieved HashtagsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadHashtags(ctx, exec, mods...)
+			},
+		),
+		InReplyToTweet: thenLoadBuilder[Q](
+			"InReplyToTweet",
+			func(ctx context.Context, exec bob.Executor, retrieved InReplyToTweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadInReplyToTweet(ctx, exec, mods...)
+			},
+		),
+		ReverseInReplyToTweets: thenLoadBuilder[Q](
+			"ReverseInReplyToTweets",
+			func(ctx context.Context, exec bob.Executor, retrieved ReverseInReplyToTweetsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReverseInReplyToTweets(ctx, exec, mods...)
+			},
+		),
+		ReferencedTweet: thenLoadBuilder[Q](
+			"ReferencedTweet",
+			func(ctx context.Context, exec bob.Executor, retrieved ReferencedTweetLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReferencedTweet(ctx, exec, mods...)
+			},
+		),
+		ReverseReferencedTweets: thenLoadBuilder[Q](
+			"ReverseReferencedTweets",
+			func(ctx context.Context, exec bob.Executor, retrieved ReverseReferencedTweetsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReverseReferencedTweets(ctx, exec, mods...)
+			},
+		),
+		User: thenLoadBuilder[Q](
+			"User",
+			func(ctx context.Context, exec bob.Executor, retrieved UserLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadUser(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadBookmarks loads the tweet's Bookmarks into the .R struct
+func (o *Tweet) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Bookmarks = nil
+
+	related, err := o.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tweet = o
+	}
+
+	o.R.Bookmarks = related
+	return nil
+}
+
+// LoadBookmarks loads the tweet's Bookmarks into the .R struct
+func (os TweetSlice) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	bookmarks, err := os.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Bookmarks = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range bookmarks {
+
+			if !(o.ID == rel.TweetID) {
+				continue
+			}
+
+			rel.R.Tweet = o
+
+			o.R.Bookmarks = append(o.R.Bookmarks, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLikes loads the tweet's Likes into the .R struct
+func (o *Tweet) LoadLikes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Likes = nil
+
+	related, err := o.Likes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tweet = o
+	}
+
+	o.R.Likes = related
+	return nil
+}
+
+// LoadLikes loads the tweet's Likes into the .R struct
+func (os TweetSlice) LoadLikes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	likes, err := os.Likes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Likes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range likes {
+
+			if !(o.ID == rel.TweetID) {
+				continue
+			}
+
+			rel.R.Tweet = o
+
+			o.R.Likes = append(o.R.Likes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMentions loads the tweet's Mentions into the .R struct
+func (o *Tweet) LoadMentions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Mentions = nil
+
+	related, err := o.Mentions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tweet = o
+	}
+
+	o.R.Mentions = related
+	return nil
+}
+
+// LoadMentions loads the tweet's Mentions into the .R struct
+func (os TweetSlice) LoadMentions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mentions, err := os.Mentions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Mentions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mentions {
+
+			if !(o.ID == rel.TweetID) {
+				continue
+			}
+
+			rel.R.Tweet = o
+
+			o.R.Mentions = append(o.R.Mentions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the tweet's Notifications into the .R struct
+func (o *Tweet) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tweet = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the tweet's Notifications into the .R struct
+func (os TweetSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !rel.TweetID.IsValue() {
+				continue
+			}
+			if !(rel.TweetID.IsValue() && o.ID == rel.TweetID.MustGet()) {
+				continue
+			}
+
+			rel.R.Tweet = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadHashtags loads the tweet's Hashtags into the .R struct
+func (o *Tweet) LoadHashtags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Hashtags = nil
+
+	related, err := o.Hashtags(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Tweets = TweetSlice{o}
+	}
+
+	o.R.Hashtags = related
+	return nil
+}
+
+// LoadHashtags loads the tweet's Hashtags into the .R struct
+func (os TweetSlice) LoadHashtags(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	// since we are changing the columns, we need to check if the original columns were set or add the defaults
+	sq := dialect.SelectQuery{}
+	for _, mod := range mods {
+		mod.Apply(&sq)
+	}
+
+	if len(sq.SelectList.Columns) == 0 {
+		mods = append(mods, sm.Columns(Hashtags.Columns))
+	}
+
+	q := os.Hashtags(append(
+		mods,
+		sm.Columns(TweetHashtags.Columns.TweetID.As("related_tweets.ID")),
+	)...)
+
+	IDSlice := []string{}
+
+	mapper := scan.Mod(scan.StructMapper[*Hashtag](), func(ctx context.Context, cols []string) (scan.BeforeFunc, func(any, any) error) {
+		return func(row *scan.Row) (any, error) {
+				IDSlice = append(IDSlice, *new(string))
+				row.ScheduleScanByName("related_tweets.ID", &IDSlice[len(IDSlice)-1])
+
+				return nil, nil
+			},
+			func(any, any) error {
+				return nil
+			}
+	})
+
+	hashtags, err := bob.Allx[bob.SliceTransformer[*Hashtag, HashtagSlice]](ctx, exec, q, mapper)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		o.R.Hashtags = nil
+	}
+
+	for _, o := range os {
+		for i, rel := range hashtags {
+			if !(o.ID == IDSlice[i]) {
+				continue
+			}
+
+			rel.R.Tweets = append(rel.R.Tweets, o)
+
+			o.R.Hashtags = append(o.R.Hashtags, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadInReplyToTweet loads the tweet's InReplyToTweet into the .R struct
+func (o *Tweet) LoadInReplyToTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.InReplyToTweet = nil
+
+	related, err := o.InReplyToTweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.InReplyToTweet = o
+
+	o.R.InReplyToTweet = related
+	return nil
+}
+
+// LoadInReplyToTweet loads the tweet's InReplyToTweet into the .R struct
+func (os TweetSlice) LoadInReplyToTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.InReplyToTweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+			if !o.InReplyToTweetID.IsValue() {
+				continue
+			}
+
+			if !(o.InReplyToTweetID.IsValue() && o.InReplyToTweetID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.InReplyToTweet = o
+
+			o.R.InReplyToTweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadReverseInReplyToTweets loads the tweet's ReverseInReplyToTweets into the .R struct
+func (o *Tweet) LoadReverseInReplyToTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ReverseInReplyToTweets = nil
+
+	related, err := o.ReverseInReplyToTweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ReverseInReplyToTweets = TweetSlice{o}
+	}
+
+	o.R.ReverseInReplyToTweets = related
+	return nil
+}
+
+// LoadReverseInReplyToTweets loads the tweet's ReverseInReplyToTweets into the .R struct
+func (os TweetSlice) LoadReverseInReplyToTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.ReverseInReplyToTweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ReverseInReplyToTweets = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !rel.InReplyToTweetID.IsValue() {
+				continue
+			}
+			if !(rel.InReplyToTweetID.IsValue() && o.ID == rel.InReplyToTweetID.MustGet()) {
+				continue
+			}
+
+			rel.R.ReverseInReplyToTweets = append(rel.R.ReverseInReplyToTweets, o)
+
+			o.R.ReverseInReplyToTweets = append(o.R.ReverseInReplyToTweets, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadReferencedTweet loads the tweet's ReferencedTweet into the .R struct
+func (o *Tweet) LoadReferencedTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ReferencedTweet = nil
+
+	related, err := o.ReferencedTweet(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.ReferencedTweet = o
+
+	o.R.ReferencedTweet = related
+	return nil
+}
+
+// LoadReferencedTweet loads the tweet's ReferencedTweet into the .R struct
+func (os TweetSlice) LoadReferencedTweet(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.ReferencedTweet(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+			if !o.ReferencedTweetID.IsValue() {
+				continue
+			}
+
+			if !(o.ReferencedTweetID.IsValue() && o.ReferencedTweetID.MustGet() == rel.ID) {
+				continue
+			}
+
+			rel.R.ReferencedTweet = o
+
+			o.R.ReferencedTweet = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadReverseReferencedTweets loads the tweet's ReverseReferencedTweets into the .R struct
+func (o *Tweet) LoadReverseReferencedTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ReverseReferencedTweets = nil
+
+	related, err := o.ReverseReferencedTweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ReverseReferencedTweets = TweetSlice{o}
+	}
+
+	o.R.ReverseReferencedTweets = related
+	return nil
+}
+
+// LoadReverseReferencedTweets loads the tweet's ReverseReferencedTweets into the .R struct
+func (os TweetSlice) LoadReverseReferencedTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.ReverseReferencedTweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ReverseReferencedTweets = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range tweets {
+
+			if !rel.ReferencedTweetID.IsValue() {
+				continue
+			}
+			if !(rel.ReferencedTweetID.IsValue() && o.ID == rel.ReferencedTweetID.MustGet()) {
+				continue
+			}
+
+			rel.R.ReverseReferencedTweets = append(rel.R.ReverseReferencedTweets, o)
+
+			o.R.ReverseReferencedTweets = append(o.R.ReverseReferencedTweets, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadUser loads the tweet's User into the .R struct
+func (o *Tweet) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.User = nil
+
+	related, err := o.User(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Tweets = TweetSlice{o}
+
+	o.R.User = related
+	return nil
+}
+
+// LoadUser loads the tweet's User into the .R struct
+func (os TweetSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	users, err := os.User(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range users {
+
+			if !(o.UserID == rel.ID) {
+				continue
+			}
+
+			rel.R.Tweets = append(rel.R.Tweets, o)
 
 			o.R.User = rel
 			break
@@ -676,8 +2388,17 @@ func (os TweetSlice) LoadUser(ctx context.Context, exec bob.Executor, mods ...bo
 }
 
 type tweetJoins[Q dialect.Joinable] struct {
-	typ  string
-	User modAs[Q, userColumns]
+	typ                     string
+	Bookmarks               modAs[Q, bookmarkColumns]
+	Likes                   modAs[Q, likeColumns]
+	Mentions                modAs[Q, mentionColumns]
+	Notifications           modAs[Q, notificationColumns]
+	Hashtags                modAs[Q, hashtagColumns]
+	InReplyToTweet          modAs[Q, tweetColumns]
+	ReverseInReplyToTweets  modAs[Q, tweetColumns]
+	ReferencedTweet         modAs[Q, tweetColumns]
+	ReverseReferencedTweets modAs[Q, tweetColumns]
+	User                    modAs[Q, userColumns]
 }
 
 func (j tweetJoins[Q]) aliasedAs(alias string) tweetJoins[Q] {
@@ -687,6 +2408,140 @@ func (j tweetJoins[Q]) aliasedAs(alias string) tweetJoins[Q] {
 func buildTweetJoins[Q dialect.Joinable](cols tweetColumns, typ string) tweetJoins[Q] {
 	return tweetJoins[Q]{
 		typ: typ,
+		Bookmarks: modAs[Q, bookmarkColumns]{
+			c: Bookmarks.Columns,
+			f: func(to bookmarkColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Bookmarks.Name().As(to.Alias())).On(
+						to.TweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Likes: modAs[Q, likeColumns]{
+			c: Likes.Columns,
+			f: func(to likeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Likes.Name().As(to.Alias())).On(
+						to.TweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Mentions: modAs[Q, mentionColumns]{
+			c: Mentions.Columns,
+			f: func(to mentionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Mentions.Name().As(to.Alias())).On(
+						to.TweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.TweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Hashtags: modAs[Q, hashtagColumns]{
+			c: Hashtags.Columns,
+			f: func(to hashtagColumns) bob.Mod[Q] {
+				random := strconv.FormatInt(randInt(), 10)
+				mods := make(mods.QueryMods[Q], 0, 2)
+
+				{
+					to := TweetHashtags.Columns.AliasedAs(TweetHashtags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, TweetHashtags.Name().As(to.Alias())).On(
+						to.TweetID.EQ(cols.ID),
+					))
+				}
+				{
+					cols := TweetHashtags.Columns.AliasedAs(TweetHashtags.Columns.Alias() + random)
+					mods = append(mods, dialect.Join[Q](typ, Hashtags.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.HashtagID),
+					))
+				}
+
+				return mods
+			},
+		},
+		InReplyToTweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.InReplyToTweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ReverseInReplyToTweets: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.InReplyToTweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ReferencedTweet: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.ReferencedTweetID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ReverseReferencedTweets: modAs[Q, tweetColumns]{
+			c: Tweets.Columns,
+			f: func(to tweetColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Tweets.Name().As(to.Alias())).On(
+						to.ReferencedTweetID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		User: modAs[Q, userColumns]{
 			c: Users.Columns,
 			f: func(to userColumns) bob.Mod[Q] {
@@ -26,13 +26,14 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID        string              `db:"id,pk" `
-	Username  string              `db:"username" `
-	Email     string              `db:"email" `
-	Name      null.Val[string]    `db:"name" `
-	DeletedAt null.Val[time.Time] `db:"deleted_at" `
-	CreatedAt null.Val[time.Time] `db:"created_at" `
-	UpdatedAt null.Val[time.Time] `db:"updated_at" `
+	ID           string              `db:"id,pk" `
+	Username     string              `db:"username" `
+	Email        string              `db:"email" `
+	Name         null.Val[string]    `db:"name" `
+	DeletedAt    null.Val[time.Time] `db:"deleted_at" `
+	CreatedAt    null.Val[time.Time] `db:"created_at" `
+	UpdatedAt    null.Val[time.Time] `db:"updated_at" `
+	PasswordHash null.Val[string]    `db:"password_hash" `
 
 	R userR `db:"-" `
 }
@@ -49,35 +50,50 @@ type UsersQuery = *psql.ViewQuery[*User, UserSlice]
 
 // userR is where relationships are stored.
 type userR struct {
-	Tweets TweetSlice // tweets.tweets_user_id_fkey
+	BlockedBlocks       BlockSlice              // blocks.blocks_blocked_id_fkey
+	BlockerBlocks       BlockSlice              // blocks.blocks_blocker_id_fkey
+	Bookmarks           BookmarkSlice           // bookmarks.bookmarks_user_id_fkey
+	ConversationMembers ConversationMemberSlice // conversation_members.conversation_members_user_id_fkey
+	FolloweeFollows     FollowSlice             // follows.follows_followee_id_fkey
+	FollowerFollows     FollowSlice             // follows.follows_follower_id_fkey
+	Likes               LikeSlice               // likes.likes_user_id_fkey
+	Mentions            MentionSlice            // mentions.mentions_user_id_fkey
+	SenderMessages      MessageSlice            // messages.messages_sender_id_fkey
+	MutedMutes          MuteSlice               // mutes.mutes_muted_id_fkey
+	MuterMutes          MuteSlice               // mutes.mutes_muter_id_fkey
+	ActorNotifications  NotificationSlice       // notifications.notifications_actor_id_fkey
+	Notifications       NotificationSlice       // notifications.notifications_user_id_fkey
+	Tweets              TweetSlice              // tweets.tweets_user_id_fkey
 }
 
 func buildUserColumns(alias string) userColumns {
 	return userColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "username", "email", "name", "deleted_at", "created_at", "updated_at",
+			"id", "username", "email", "name", "deleted_at", "created_at", "updated_at", "password_hash",
 		).WithParent("users"),
-		tableAlias: alias,
-		ID:         psql.Quote(alias, "id"),
-		Username:   psql.Quote(alias, "username"),
-		Email:      psql.Quote(alias, "email"),
-		Name:       psql.Quote(alias, "name"),
-		DeletedAt:  psql.Quote(alias, "deleted_at"),
-		CreatedAt:  psql.Quote(alias, "created_at"),
-		UpdatedAt:  psql.Quote(alias, "updated_at"),
+		tableAlias:   alias,
+		ID:           psql.Quote(alias, "id"),
+		Username:     psql.Quote(alias, "username"),
+		Email:        psql.Quote(alias, "email"),
+		Name:         psql.Quote(alias, "name"),
+		DeletedAt:    psql.Quote(alias, "deleted_at"),
+		CreatedAt:    psql.Quote(alias, "created_at"),
+		UpdatedAt:    psql.Quote(alias, "updated_at"),
+		PasswordHash: psql.Quote(alias, "password_hash"),
 	}
 }
 
 type userColumns struct {
 	expr.ColumnsExpr
-	tableAlias string
-	ID         psql.Expression
-	Username   psql.Expression
-	Email      psql.Expression
-	Name       psql.Expression
-	DeletedAt  psql.Expression
-	CreatedAt  psql.Expression
-	UpdatedAt  psql.Expression
+	tableAlias   string
+	ID           psql.Expression
+	Username     psql.Expression
+	Email        psql.Expression
+	Name         psql.Expression
+	DeletedAt    psql.Expression
+	CreatedAt    psql.Expression
+	UpdatedAt    psql.Expression
+	PasswordHash psql.Expression
 }
 
 func (c userColumns) Alias() string {
@@ -92,17 +108,18 @@ func (userColumns) AliasedAs(alias string) userColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type UserSetter struct {
-	ID        omit.Val[string]        `db:"id,pk" `
-	Username  omit.Val[string]        `db:"username" `
-	Email     omit.Val[string]        `db:"email" `
-	Name      omitnull.Val[string]    `db:"name" `
-	DeletedAt omitnull.Val[time.Time] `db:"deleted_at" `
-	CreatedAt omitnull.Val[time.Time] `db:"created_at" `
-	UpdatedAt omitnull.Val[time.Time] `db:"updated_at" `
+	ID           omit.Val[string]        `db:"id,pk" `
+	Username     omit.Val[string]        `db:"username" `
+	Email        omit.Val[string]        `db:"email" `
+	Name         omitnull.Val[string]    `db:"name" `
+	DeletedAt    omitnull.Val[time.Time] `db:"deleted_at" `
+	CreatedAt    omitnull.Val[time.Time] `db:"created_at" `
+	UpdatedAt    omitnull.Val[time.Time] `db:"updated_at" `
+	PasswordHash omitnull.Val[string]    `db:"password_hash" `
 }
 
 func (s UserSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 8)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -124,6 +141,9 @@ func (s UserSetter) SetColumns() []string {
 	if !s.UpdatedAt.IsUnset() {
 		vals = append(vals, "updated_at")
 	}
+	if !s.PasswordHash.IsUnset() {
+		vals = append(vals, "password_hash")
+	}
 	return vals
 }
 
@@ -149,6 +169,9 @@ func (s UserSetter) Overwrite(t *User) {
 	if !s.UpdatedAt.IsUnset() {
 		t.UpdatedAt = s.UpdatedAt.MustGetNull()
 	}
+	if !s.PasswordHash.IsUnset() {
+		t.PasswordHash = s.PasswordHash.MustGetNull()
+	}
 }
 
 func (s *UserSetter) Apply(q *dialect.InsertQuery) {
@@ -157,7 +180,7 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 8)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -200,6 +223,12 @@ func (s *UserSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Raw("DEFAULT")
 		}
 
+		if !s.PasswordHash.IsUnset() {
+			vals[7] = psql.Arg(s.PasswordHash.MustGetNull())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -209,7 +238,7 @@ func (s UserSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 8)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -260,6 +289,13 @@ func (s UserSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if !s.PasswordHash.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "password_hash")...),
+			psql.Arg(s.PasswordHash),
+		}})
+	}
+
 	return exprs
 }
 
@@ -486,14 +522,14 @@ func (o UserSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-// Tweets starts a query for related objects on tweets
-func (o *User) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
-	return Tweets.Query(append(mods,
-		sm.Where(Tweets.Columns.UserID.EQ(psql.Arg(o.ID))),
+// BlockedBlocks starts a query for related objects on blocks
+func (o *User) BlockedBlocks(mods ...bob.Mod[*dialect.SelectQuery]) BlocksQuery {
+	return Blocks.Query(append(mods,
+		sm.Where(Blocks.Columns.BlockedID.EQ(psql.Arg(o.ID))),
 	)...)
 }
 
-func (os UserSlice) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+func (os UserSlice) BlockedBlocks(mods ...bob.Mod[*dialect.SelectQuery]) BlocksQuery {
 	pkID := make(pgtypes.Array[string], 0, len(os))
 	for _, o := range os {
 		if o == nil {
@@ -505,194 +541,2497 @@ func (os UserSlice) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
 		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
 	))
 
-	return Tweets.Query(append(mods,
-		sm.Where(psql.Group(Tweets.Columns.UserID).OP("IN", PKArgExpr)),
+	return Blocks.Query(append(mods,
+		sm.Where(psql.Group(Blocks.Columns.BlockedID).OP("IN", PKArgExpr)),
 	)...)
 }
 
-func insertUserTweets0(ctx context.Context, exec bob.Executor, tweets1 []*TweetSetter, user0 *User) (TweetSlice, error) {
-	for i := range tweets1 {
-		tweets1[i].UserID = omit.From(user0.ID)
-	}
-
-	ret, err := Tweets.Insert(bob.ToMods(tweets1...)).All(ctx, exec)
-	if err != nil {
-		return ret, fmt.Errorf("insertUserTweets0: %w", err)
-	}
-
-	return ret, nil
+// BlockerBlocks starts a query for related objects on blocks
+func (o *User) BlockerBlocks(mods ...bob.Mod[*dialect.SelectQuery]) BlocksQuery {
+	return Blocks.Query(append(mods,
+		sm.Where(Blocks.Columns.BlockerID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func attachUserTweets0(ctx context.Context, exec bob.Executor, count int, tweets1 TweetSlice, user0 *User) (TweetSlice, error) {
-	setter := &TweetSetter{
-		UserID: omit.From(user0.ID),
-	}
-
-	err := tweets1.UpdateAll(ctx, exec, *setter)
-	if err != nil {
-		return nil, fmt.Errorf("attachUserTweets0: %w", err)
+func (os UserSlice) BlockerBlocks(mods ...bob.Mod[*dialect.SelectQuery]) BlocksQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	return tweets1, nil
+	return Blocks.Query(append(mods,
+		sm.Where(psql.Group(Blocks.Columns.BlockerID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func (user0 *User) InsertTweets(ctx context.Context, exec bob.Executor, related ...*TweetSetter) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
+// Bookmarks starts a query for related objects on bookmarks
+func (o *User) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	return Bookmarks.Query(append(mods,
+		sm.Where(Bookmarks.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	tweets1, err := insertUserTweets0(ctx, exec, related, user0)
-	if err != nil {
-		return err
+func (os UserSlice) Bookmarks(mods ...bob.Mod[*dialect.SelectQuery]) BookmarksQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	user0.R.Tweets = append(user0.R.Tweets, tweets1...)
+	return Bookmarks.Query(append(mods,
+		sm.Where(psql.Group(Bookmarks.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	for _, rel := range tweets1 {
-		rel.R.User = user0
-	}
-	return nil
+// ConversationMembers starts a query for related objects on conversation_members
+func (o *User) ConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	return ConversationMembers.Query(append(mods,
+		sm.Where(ConversationMembers.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func (user0 *User) AttachTweets(ctx context.Context, exec bob.Executor, related ...*Tweet) error {
-	if len(related) == 0 {
-		return nil
+func (os UserSlice) ConversationMembers(mods ...bob.Mod[*dialect.SelectQuery]) ConversationMembersQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	var err error
-	tweets1 := TweetSlice(related)
-
-	_, err = attachUserTweets0(ctx, exec, len(related), tweets1, user0)
-	if err != nil {
-		return err
-	}
+	return ConversationMembers.Query(append(mods,
+		sm.Where(psql.Group(ConversationMembers.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-	user0.R.Tweets = append(user0.R.Tweets, tweets1...)
+// FolloweeFollows starts a query for related objects on follows
+func (o *User) FolloweeFollows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	return Follows.Query(append(mods,
+		sm.Where(Follows.Columns.FolloweeID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	for _, rel := range related {
-		rel.R.User = user0
+func (os UserSlice) FolloweeFollows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	return nil
+	return Follows.Query(append(mods,
+		sm.Where(psql.Group(Follows.Columns.FolloweeID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type userWhere[Q psql.Filterable] struct {
-	ID        psql.WhereMod[Q, string]
-	Username  psql.WhereMod[Q, string]
-	Email     psql.WhereMod[Q, string]
-	Name      psql.WhereNullMod[Q, string]
-	DeletedAt psql.WhereNullMod[Q, time.Time]
-	CreatedAt psql.WhereNullMod[Q, time.Time]
-	UpdatedAt psql.WhereNullMod[Q, time.Time]
+// FollowerFollows starts a query for related objects on follows
+func (o *User) FollowerFollows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	return Follows.Query(append(mods,
+		sm.Where(Follows.Columns.FollowerID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
-	return buildUserWhere[Q](buildUserColumns(alias))
+func (os UserSlice) FollowerFollows(mods ...bob.Mod[*dialect.SelectQuery]) FollowsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Follows.Query(append(mods,
+		sm.Where(psql.Group(Follows.Columns.FollowerID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
-	return userWhere[Q]{
-		ID:        psql.Where[Q, string](cols.ID),
-		Username:  psql.Where[Q, string](cols.Username),
-		Email:     psql.Where[Q, string](cols.Email),
-		Name:      psql.WhereNull[Q, string](cols.Name),
-		DeletedAt: psql.WhereNull[Q, time.Time](cols.DeletedAt),
-		CreatedAt: psql.WhereNull[Q, time.Time](cols.CreatedAt),
-		UpdatedAt: psql.WhereNull[Q, time.Time](cols.UpdatedAt),
-	}
+// Likes starts a query for related objects on likes
+func (o *User) Likes(mods ...bob.Mod[*dialect.SelectQuery]) LikesQuery {
+	return Likes.Query(append(mods,
+		sm.Where(Likes.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func (o *User) Preload(name string, retrieved any) error {
-	if o == nil {
-		return nil
+func (os UserSlice) Likes(mods ...bob.Mod[*dialect.SelectQuery]) LikesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	switch name {
-	case "Tweets":
-		rels, ok := retrieved.(TweetSlice)
-		if !ok {
-			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
-		}
+	return Likes.Query(append(mods,
+		sm.Where(psql.Group(Likes.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
 
-		o.R.Tweets = rels
+// Mentions starts a query for related objects on mentions
+func (o *User) Mentions(mods ...bob.Mod[*dialect.SelectQuery]) MentionsQuery {
+	return Mentions.Query(append(mods,
+		sm.Where(Mentions.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-		for _, rel := range rels {
-			if rel != nil {
-				rel.R.User = o
-			}
+func (os UserSlice) Mentions(mods ...bob.Mod[*dialect.SelectQuery]) MentionsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
 		}
-		return nil
-	default:
-		return fmt.Errorf("user has no relationship %q", name)
+		pkID = append(pkID, o.ID)
 	}
-}
-
-type userPreloader struct{}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-func buildUserPreloader() userPreloader {
-	return userPreloader{}
+	return Mentions.Query(append(mods,
+		sm.Where(psql.Group(Mentions.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-type userThenLoader[Q orm.Loadable] struct {
-	Tweets func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+// SenderMessages starts a query for related objects on messages
+func (o *User) SenderMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	return Messages.Query(append(mods,
+		sm.Where(Messages.Columns.SenderID.EQ(psql.Arg(o.ID))),
+	)...)
 }
 
-func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
-	type TweetsLoadInterface interface {
-		LoadTweets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+func (os UserSlice) SenderMessages(mods ...bob.Mod[*dialect.SelectQuery]) MessagesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	return userThenLoader[Q]{
-		Tweets: thenLoadBuilder[Q](
-			"Tweets",
-			func(ctx context.Context, exec bob.Executor, retrieved TweetsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadTweets(ctx, exec, mods...)
-			},
-		),
-	}
+	return Messages.Query(append(mods,
+		sm.Where(psql.Group(Messages.Columns.SenderID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-// LoadTweets loads the user's Tweets into the .R struct
-func (o *User) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if o == nil {
-		return nil
-	}
-
-	// Reset the relationship
-	o.R.Tweets = nil
-
-	related, err := o.Tweets(mods...).All(ctx, exec)
-	if err != nil {
-		return err
-	}
+// MutedMutes starts a query for related objects on mutes
+func (o *User) MutedMutes(mods ...bob.Mod[*dialect.SelectQuery]) MutesQuery {
+	return Mutes.Query(append(mods,
+		sm.Where(Mutes.Columns.MutedID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
-	for _, rel := range related {
-		rel.R.User = o
+func (os UserSlice) MutedMutes(mods ...bob.Mod[*dialect.SelectQuery]) MutesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
 	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
 
-	o.R.Tweets = related
-	return nil
+	return Mutes.Query(append(mods,
+		sm.Where(psql.Group(Mutes.Columns.MutedID).OP("IN", PKArgExpr)),
+	)...)
 }
 
-// LoadTweets loads the user's Tweets into the .R struct
-func (os UserSlice) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
-	if len(os) == 0 {
-		return nil
-	}
-
-	tweets, err := os.Tweets(mods...).All(ctx, exec)
-	if err != nil {
-		return err
-	}
+// MuterMutes starts a query for related objects on mutes
+func (o *User) MuterMutes(mods ...bob.Mod[*dialect.SelectQuery]) MutesQuery {
+	return Mutes.Query(append(mods,
+		sm.Where(Mutes.Columns.MuterID.EQ(psql.Arg(o.ID))),
+	)...)
+}
 
+func (os UserSlice) MuterMutes(mods ...bob.Mod[*dialect.SelectQuery]) MutesQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
-
-		o.R.Tweets = nil
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Mutes.Query(append(mods,
+		sm.Where(psql.Group(Mutes.Columns.MuterID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// ActorNotifications starts a query for related objects on notifications
+func (o *User) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.ActorID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) ActorNotifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(Notifications.Columns.ActorID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Notifications starts a query for related objects on notifications
+func (o *User) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	return Notifications.Query(append(mods,
+		sm.Where(Notifications.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Notifications(mods ...bob.Mod[*dialect.SelectQuery]) NotificationsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Notifications.Query(append(mods,
+		sm.Where(psql.Group(Notifications.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Tweets starts a query for related objects on tweets
+func (o *User) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	return Tweets.Query(append(mods,
+		sm.Where(Tweets.Columns.UserID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os UserSlice) Tweets(mods ...bob.Mod[*dialect.SelectQuery]) TweetsQuery {
+	pkID := make(pgtypes.Array[string], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "character varying[]")),
+	))
+
+	return Tweets.Query(append(mods,
+		sm.Where(psql.Group(Tweets.Columns.UserID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func insertUserBlockedBlocks0(ctx context.Context, exec bob.Executor, blocks1 []*BlockSetter, user0 *User) (BlockSlice, error) {
+	for i := range blocks1 {
+		blocks1[i].BlockedID = omit.From(user0.ID)
+	}
+
+	ret, err := Blocks.Insert(bob.ToMods(blocks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserBlockedBlocks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserBlockedBlocks0(ctx context.Context, exec bob.Executor, count int, blocks1 BlockSlice, user0 *User) (BlockSlice, error) {
+	setter := &BlockSetter{
+		BlockedID: omit.From(user0.ID),
+	}
+
+	err := blocks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserBlockedBlocks0: %w", err)
+	}
+
+	return blocks1, nil
+}
+
+func (user0 *User) InsertBlockedBlocks(ctx context.Context, exec bob.Executor, related ...*BlockSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	blocks1, err := insertUserBlockedBlocks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BlockedBlocks = append(user0.R.BlockedBlocks, blocks1...)
+
+	for _, rel := range blocks1 {
+		rel.R.BlockedUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachBlockedBlocks(ctx context.Context, exec bob.Executor, related ...*Block) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	blocks1 := BlockSlice(related)
+
+	_, err = attachUserBlockedBlocks0(ctx, exec, len(related), blocks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BlockedBlocks = append(user0.R.BlockedBlocks, blocks1...)
+
+	for _, rel := range related {
+		rel.R.BlockedUser = user0
+	}
+
+	return nil
+}
+
+func insertUserBlockerBlocks0(ctx context.Context, exec bob.Executor, blocks1 []*BlockSetter, user0 *User) (BlockSlice, error) {
+	for i := range blocks1 {
+		blocks1[i].BlockerID = omit.From(user0.ID)
+	}
+
+	ret, err := Blocks.Insert(bob.ToMods(blocks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserBlockerBlocks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserBlockerBlocks0(ctx context.Context, exec bob.Executor, count int, blocks1 BlockSlice, user0 *User) (BlockSlice, error) {
+	setter := &BlockSetter{
+		BlockerID: omit.From(user0.ID),
+	}
+
+	err := blocks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserBlockerBlocks0: %w", err)
+	}
+
+	return blocks1, nil
+}
+
+func (user0 *User) InsertBlockerBlocks(ctx context.Context, exec bob.Executor, related ...*BlockSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	blocks1, err := insertUserBlockerBlocks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BlockerBlocks = append(user0.R.BlockerBlocks, blocks1...)
+
+	for _, rel := range blocks1 {
+		rel.R.BlockerUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachBlockerBlocks(ctx context.Context, exec bob.Executor, related ...*Block) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	blocks1 := BlockSlice(related)
+
+	_, err = attachUserBlockerBlocks0(ctx, exec, len(related), blocks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.BlockerBlocks = append(user0.R.BlockerBlocks, blocks1...)
+
+	for _, rel := range related {
+		rel.R.BlockerUser = user0
+	}
+
+	return nil
+}
+
+func insertUserBookmarks0(ctx context.Context, exec bob.Executor, bookmarks1 []*BookmarkSetter, user0 *User) (BookmarkSlice, error) {
+	for i := range bookmarks1 {
+		bookmarks1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Bookmarks.Insert(bob.ToMods(bookmarks1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserBookmarks0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserBookmarks0(ctx context.Context, exec bob.Executor, count int, bookmarks1 BookmarkSlice, user0 *User) (BookmarkSlice, error) {
+	setter := &BookmarkSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := bookmarks1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserBookmarks0: %w", err)
+	}
+
+	return bookmarks1, nil
+}
+
+func (user0 *User) InsertBookmarks(ctx context.Context, exec bob.Executor, related ...*BookmarkSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	bookmarks1, err := insertUserBookmarks0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Bookmarks = append(user0.R.Bookmarks, bookmarks1...)
+
+	for _, rel := range bookmarks1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachBookmarks(ctx context.Context, exec bob.Executor, related ...*Bookmark) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	bookmarks1 := BookmarkSlice(related)
+
+	_, err = attachUserBookmarks0(ctx, exec, len(related), bookmarks1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Bookmarks = append(user0.R.Bookmarks, bookmarks1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserConversationMembers0(ctx context.Context, exec bob.Executor, conversationMembers1 []*ConversationMemberSetter, user0 *User) (ConversationMemberSlice, error) {
+	for i := range conversationMembers1 {
+		conversationMembers1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := ConversationMembers.Insert(bob.ToMods(conversationMembers1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserConversationMembers0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserConversationMembers0(ctx context.Context, exec bob.Executor, count int, conversationMembers1 ConversationMemberSlice, user0 *User) (ConversationMemberSlice, error) {
+	setter := &ConversationMemberSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := conversationMembers1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserConversationMembers0: %w", err)
+	}
+
+	return conversationMembers1, nil
+}
+
+func (user0 *User) InsertConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMemberSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	conversationMembers1, err := insertUserConversationMembers0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ConversationMembers = append(user0.R.ConversationMembers, conversationMembers1...)
+
+	for _, rel := range conversationMembers1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachConversationMembers(ctx context.Context, exec bob.Executor, related ...*ConversationMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	conversationMembers1 := ConversationMemberSlice(related)
+
+	_, err = attachUserConversationMembers0(ctx, exec, len(related), conversationMembers1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ConversationMembers = append(user0.R.ConversationMembers, conversationMembers1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserFolloweeFollows0(ctx context.Context, exec bob.Executor, follows1 []*FollowSetter, user0 *User) (FollowSlice, error) {
+	for i := range follows1 {
+		follows1[i].FolloweeID = omit.From(user0.ID)
+	}
+
+	ret, err := Follows.Insert(bob.ToMods(follows1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserFolloweeFollows0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserFolloweeFollows0(ctx context.Context, exec bob.Executor, count int, follows1 FollowSlice, user0 *User) (FollowSlice, error) {
+	setter := &FollowSetter{
+		FolloweeID: omit.From(user0.ID),
+	}
+
+	err := follows1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserFolloweeFollows0: %w", err)
+	}
+
+	return follows1, nil
+}
+
+func (user0 *User) InsertFolloweeFollows(ctx context.Context, exec bob.Executor, related ...*FollowSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	follows1, err := insertUserFolloweeFollows0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FolloweeFollows = append(user0.R.FolloweeFollows, follows1...)
+
+	for _, rel := range follows1 {
+		rel.R.FolloweeUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachFolloweeFollows(ctx context.Context, exec bob.Executor, related ...*Follow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	follows1 := FollowSlice(related)
+
+	_, err = attachUserFolloweeFollows0(ctx, exec, len(related), follows1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FolloweeFollows = append(user0.R.FolloweeFollows, follows1...)
+
+	for _, rel := range related {
+		rel.R.FolloweeUser = user0
+	}
+
+	return nil
+}
+
+func insertUserFollowerFollows0(ctx context.Context, exec bob.Executor, follows1 []*FollowSetter, user0 *User) (FollowSlice, error) {
+	for i := range follows1 {
+		follows1[i].FollowerID = omit.From(user0.ID)
+	}
+
+	ret, err := Follows.Insert(bob.ToMods(follows1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserFollowerFollows0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserFollowerFollows0(ctx context.Context, exec bob.Executor, count int, follows1 FollowSlice, user0 *User) (FollowSlice, error) {
+	setter := &FollowSetter{
+		FollowerID: omit.From(user0.ID),
+	}
+
+	err := follows1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserFollowerFollows0: %w", err)
+	}
+
+	return follows1, nil
+}
+
+func (user0 *User) InsertFollowerFollows(ctx context.Context, exec bob.Executor, related ...*FollowSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	follows1, err := insertUserFollowerFollows0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FollowerFollows = append(user0.R.FollowerFollows, follows1...)
+
+	for _, rel := range follows1 {
+		rel.R.FollowerUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachFollowerFollows(ctx context.Context, exec bob.Executor, related ...*Follow) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	follows1 := FollowSlice(related)
+
+	_, err = attachUserFollowerFollows0(ctx, exec, len(related), follows1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.FollowerFollows = append(user0.R.FollowerFollows, follows1...)
+
+	for _, rel := range related {
+		rel.R.FollowerUser = user0
+	}
+
+	return nil
+}
+
+func insertUserLikes0(ctx context.Context, exec bob.Executor, likes1 []*LikeSetter, user0 *User) (LikeSlice, error) {
+	for i := range likes1 {
+		likes1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Likes.Insert(bob.ToMods(likes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserLikes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserLikes0(ctx context.Context, exec bob.Executor, count int, likes1 LikeSlice, user0 *User) (LikeSlice, error) {
+	setter := &LikeSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := likes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserLikes0: %w", err)
+	}
+
+	return likes1, nil
+}
+
+func (user0 *User) InsertLikes(ctx context.Context, exec bob.Executor, related ...*LikeSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	likes1, err := insertUserLikes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Likes = append(user0.R.Likes, likes1...)
+
+	for _, rel := range likes1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachLikes(ctx context.Context, exec bob.Executor, related ...*Like) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	likes1 := LikeSlice(related)
+
+	_, err = attachUserLikes0(ctx, exec, len(related), likes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Likes = append(user0.R.Likes, likes1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserMentions0(ctx context.Context, exec bob.Executor, mentions1 []*MentionSetter, user0 *User) (MentionSlice, error) {
+	for i := range mentions1 {
+		mentions1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Mentions.Insert(bob.ToMods(mentions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserMentions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserMentions0(ctx context.Context, exec bob.Executor, count int, mentions1 MentionSlice, user0 *User) (MentionSlice, error) {
+	setter := &MentionSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := mentions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMentions0: %w", err)
+	}
+
+	return mentions1, nil
+}
+
+func (user0 *User) InsertMentions(ctx context.Context, exec bob.Executor, related ...*MentionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mentions1, err := insertUserMentions0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Mentions = append(user0.R.Mentions, mentions1...)
+
+	for _, rel := range mentions1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachMentions(ctx context.Context, exec bob.Executor, related ...*Mention) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mentions1 := MentionSlice(related)
+
+	_, err = attachUserMentions0(ctx, exec, len(related), mentions1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Mentions = append(user0.R.Mentions, mentions1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserSenderMessages0(ctx context.Context, exec bob.Executor, messages1 []*MessageSetter, user0 *User) (MessageSlice, error) {
+	for i := range messages1 {
+		messages1[i].SenderID = omit.From(user0.ID)
+	}
+
+	ret, err := Messages.Insert(bob.ToMods(messages1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserSenderMessages0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserSenderMessages0(ctx context.Context, exec bob.Executor, count int, messages1 MessageSlice, user0 *User) (MessageSlice, error) {
+	setter := &MessageSetter{
+		SenderID: omit.From(user0.ID),
+	}
+
+	err := messages1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserSenderMessages0: %w", err)
+	}
+
+	return messages1, nil
+}
+
+func (user0 *User) InsertSenderMessages(ctx context.Context, exec bob.Executor, related ...*MessageSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	messages1, err := insertUserSenderMessages0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SenderMessages = append(user0.R.SenderMessages, messages1...)
+
+	for _, rel := range messages1 {
+		rel.R.SenderUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachSenderMessages(ctx context.Context, exec bob.Executor, related ...*Message) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	messages1 := MessageSlice(related)
+
+	_, err = attachUserSenderMessages0(ctx, exec, len(related), messages1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.SenderMessages = append(user0.R.SenderMessages, messages1...)
+
+	for _, rel := range related {
+		rel.R.SenderUser = user0
+	}
+
+	return nil
+}
+
+func insertUserMutedMutes0(ctx context.Context, exec bob.Executor, mutes1 []*MuteSetter, user0 *User) (MuteSlice, error) {
+	for i := range mutes1 {
+		mutes1[i].MutedID = omit.From(user0.ID)
+	}
+
+	ret, err := Mutes.Insert(bob.ToMods(mutes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserMutedMutes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserMutedMutes0(ctx context.Context, exec bob.Executor, count int, mutes1 MuteSlice, user0 *User) (MuteSlice, error) {
+	setter := &MuteSetter{
+		MutedID: omit.From(user0.ID),
+	}
+
+	err := mutes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMutedMutes0: %w", err)
+	}
+
+	return mutes1, nil
+}
+
+func (user0 *User) InsertMutedMutes(ctx context.Context, exec bob.Executor, related ...*MuteSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mutes1, err := insertUserMutedMutes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MutedMutes = append(user0.R.MutedMutes, mutes1...)
+
+	for _, rel := range mutes1 {
+		rel.R.MutedUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachMutedMutes(ctx context.Context, exec bob.Executor, related ...*Mute) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mutes1 := MuteSlice(related)
+
+	_, err = attachUserMutedMutes0(ctx, exec, len(related), mutes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MutedMutes = append(user0.R.MutedMutes, mutes1...)
+
+	for _, rel := range related {
+		rel.R.MutedUser = user0
+	}
+
+	return nil
+}
+
+func insertUserMuterMutes0(ctx context.Context, exec bob.Executor, mutes1 []*MuteSetter, user0 *User) (MuteSlice, error) {
+	for i := range mutes1 {
+		mutes1[i].MuterID = omit.From(user0.ID)
+	}
+
+	ret, err := Mutes.Insert(bob.ToMods(mutes1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserMuterMutes0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserMuterMutes0(ctx context.Context, exec bob.Executor, count int, mutes1 MuteSlice, user0 *User) (MuteSlice, error) {
+	setter := &MuteSetter{
+		MuterID: omit.From(user0.ID),
+	}
+
+	err := mutes1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserMuterMutes0: %w", err)
+	}
+
+	return mutes1, nil
+}
+
+func (user0 *User) InsertMuterMutes(ctx context.Context, exec bob.Executor, related ...*MuteSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	mutes1, err := insertUserMuterMutes0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MuterMutes = append(user0.R.MuterMutes, mutes1...)
+
+	for _, rel := range mutes1 {
+		rel.R.MuterUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachMuterMutes(ctx context.Context, exec bob.Executor, related ...*Mute) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	mutes1 := MuteSlice(related)
+
+	_, err = attachUserMuterMutes0(ctx, exec, len(related), mutes1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.MuterMutes = append(user0.R.MuterMutes, mutes1...)
+
+	for _, rel := range related {
+		rel.R.MuterUser = user0
+	}
+
+	return nil
+}
+
+func insertUserActorNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].ActorID = omit.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserActorNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserActorNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		ActorID: omit.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserActorNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertActorNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserActorNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.ActorUser = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachActorNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserActorNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.ActorNotifications = append(user0.R.ActorNotifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.ActorUser = user0
+	}
+
+	return nil
+}
+
+func insertUserNotifications0(ctx context.Context, exec bob.Executor, notifications1 []*NotificationSetter, user0 *User) (NotificationSlice, error) {
+	for i := range notifications1 {
+		notifications1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Notifications.Insert(bob.ToMods(notifications1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserNotifications0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserNotifications0(ctx context.Context, exec bob.Executor, count int, notifications1 NotificationSlice, user0 *User) (NotificationSlice, error) {
+	setter := &NotificationSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := notifications1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserNotifications0: %w", err)
+	}
+
+	return notifications1, nil
+}
+
+func (user0 *User) InsertNotifications(ctx context.Context, exec bob.Executor, related ...*NotificationSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	notifications1, err := insertUserNotifications0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range notifications1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachNotifications(ctx context.Context, exec bob.Executor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	notifications1 := NotificationSlice(related)
+
+	_, err = attachUserNotifications0(ctx, exec, len(related), notifications1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Notifications = append(user0.R.Notifications, notifications1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+func insertUserTweets0(ctx context.Context, exec bob.Executor, tweets1 []*TweetSetter, user0 *User) (TweetSlice, error) {
+	for i := range tweets1 {
+		tweets1[i].UserID = omit.From(user0.ID)
+	}
+
+	ret, err := Tweets.Insert(bob.ToMods(tweets1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertUserTweets0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachUserTweets0(ctx context.Context, exec bob.Executor, count int, tweets1 TweetSlice, user0 *User) (TweetSlice, error) {
+	setter := &TweetSetter{
+		UserID: omit.From(user0.ID),
+	}
+
+	err := tweets1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachUserTweets0: %w", err)
+	}
+
+	return tweets1, nil
+}
+
+func (user0 *User) InsertTweets(ctx context.Context, exec bob.Executor, related ...*TweetSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	tweets1, err := insertUserTweets0(ctx, exec, related, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tweets = append(user0.R.Tweets, tweets1...)
+
+	for _, rel := range tweets1 {
+		rel.R.User = user0
+	}
+	return nil
+}
+
+func (user0 *User) AttachTweets(ctx context.Context, exec bob.Executor, related ...*Tweet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	tweets1 := TweetSlice(related)
+
+	_, err = attachUserTweets0(ctx, exec, len(related), tweets1, user0)
+	if err != nil {
+		return err
+	}
+
+	user0.R.Tweets = append(user0.R.Tweets, tweets1...)
+
+	for _, rel := range related {
+		rel.R.User = user0
+	}
+
+	return nil
+}
+
+type userWhere[Q psql.Filterable] struct {
+	ID           psql.WhereMod[Q, string]
+	Username     psql.WhereMod[Q, string]
+	Email        psql.WhereMod[Q, string]
+	Name         psql.WhereNullMod[Q, string]
+	DeletedAt    psql.WhereNullMod[Q, time.Time]
+	CreatedAt    psql.WhereNullMod[Q, time.Time]
+	UpdatedAt    psql.WhereNullMod[Q, time.Time]
+	PasswordHash psql.WhereNullMod[Q, string]
+}
+
+func (userWhere[Q]) AliasedAs(alias string) userWhere[Q] {
+	return buildUserWhere[Q](buildUserColumns(alias))
+}
+
+func buildUserWhere[Q psql.Filterable](cols userColumns) userWhere[Q] {
+	return userWhere[Q]{
+		ID:           psql.Where[Q, string](cols.ID),
+		Username:     psql.Where[Q, string](cols.Username),
+		Email:        psql.Where[Q, string](cols.Email),
+		Name:         psql.WhereNull[Q, string](cols.Name),
+		DeletedAt:    psql.WhereNull[Q, time.Time](cols.DeletedAt),
+		CreatedAt:    psql.WhereNull[Q, time.Time](cols.CreatedAt),
+		UpdatedAt:    psql.WhereNull[Q, time.Time](cols.UpdatedAt),
+		PasswordHash: psql.WhereNull[Q, string](cols.PasswordHash),
+	}
+}
+
+func (o *User) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "BlockedBlocks":
+		rels, ok := retrieved.(BlockSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BlockedBlocks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.BlockedUser = o
+			}
+		}
+		return nil
+	case "BlockerBlocks":
+		rels, ok := retrieved.(BlockSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.BlockerBlocks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.BlockerUser = o
+			}
+		}
+		return nil
+	case "Bookmarks":
+		rels, ok := retrieved.(BookmarkSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Bookmarks = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "ConversationMembers":
+		rels, ok := retrieved.(ConversationMemberSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ConversationMembers = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "FolloweeFollows":
+		rels, ok := retrieved.(FollowSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FolloweeFollows = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.FolloweeUser = o
+			}
+		}
+		return nil
+	case "FollowerFollows":
+		rels, ok := retrieved.(FollowSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.FollowerFollows = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.FollowerUser = o
+			}
+		}
+		return nil
+	case "Likes":
+		rels, ok := retrieved.(LikeSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Likes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Mentions":
+		rels, ok := retrieved.(MentionSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Mentions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "SenderMessages":
+		rels, ok := retrieved.(MessageSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.SenderMessages = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.SenderUser = o
+			}
+		}
+		return nil
+	case "MutedMutes":
+		rels, ok := retrieved.(MuteSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MutedMutes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.MutedUser = o
+			}
+		}
+		return nil
+	case "MuterMutes":
+		rels, ok := retrieved.(MuteSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.MuterMutes = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.MuterUser = o
+			}
+		}
+		return nil
+	case "ActorNotifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.ActorNotifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.ActorUser = o
+			}
+		}
+		return nil
+	case "Notifications":
+		rels, ok := retrieved.(NotificationSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Notifications = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	case "Tweets":
+		rels, ok := retrieved.(TweetSlice)
+		if !ok {
+			return fmt.Errorf("user cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Tweets = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.User = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("user has no relationship %q", name)
+	}
+}
+
+type userPreloader struct{}
+
+func buildUserPreloader() userPreloader {
+	return userPreloader{}
+}
+
+type userThenLoader[Q orm.Loadable] struct {
+	BlockedBlocks       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	BlockerBlocks       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Bookmarks           func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ConversationMembers func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	FolloweeFollows     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	FollowerFollows     func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Likes               func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Mentions            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	SenderMessages      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	MutedMutes          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	MuterMutes          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ActorNotifications  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Notifications       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Tweets              func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildUserThenLoader[Q orm.Loadable]() userThenLoader[Q] {
+	type BlockedBlocksLoadInterface interface {
+		LoadBlockedBlocks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BlockerBlocksLoadInterface interface {
+		LoadBlockerBlocks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type BookmarksLoadInterface interface {
+		LoadBookmarks(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ConversationMembersLoadInterface interface {
+		LoadConversationMembers(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FolloweeFollowsLoadInterface interface {
+		LoadFolloweeFollows(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type FollowerFollowsLoadInterface interface {
+		LoadFollowerFollows(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type LikesLoadInterface interface {
+		LoadLikes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MentionsLoadInterface interface {
+		LoadMentions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type SenderMessagesLoadInterface interface {
+		LoadSenderMessages(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MutedMutesLoadInterface interface {
+		LoadMutedMutes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type MuterMutesLoadInterface interface {
+		LoadMuterMutes(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ActorNotificationsLoadInterface interface {
+		LoadActorNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type NotificationsLoadInterface interface {
+		LoadNotifications(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TweetsLoadInterface interface {
+		LoadTweets(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return userThenLoader[Q]{
+		BlockedBlocks: thenLoadBuilder[Q](
+			"BlockedBlocks",
+			func(ctx context.Context, exec bob.Executor, retrieved BlockedBlocksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBlockedBlocks(ctx, exec, mods...)
+			},
+		),
+		BlockerBlocks: thenLoadBuilder[Q](
+			"BlockerBlocks",
+			func(ctx context.Context, exec bob.Executor, retrieved BlockerBlocksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBlockerBlocks(ctx, exec, mods...)
+			},
+		),
+		Bookmarks: thenLoadBuilder[Q](
+			"Bookmarks",
+			func(ctx context.Context, exec bob.Executor, retrieved BookmarksLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadBookmarks(ctx, exec, mods...)
+			},
+		),
+		ConversationMembers: thenLoadBuilder[Q](
+			"ConversationMembers",
+			func(ctx context.Context, exec bob.Executor, retrieved ConversationMembersLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadConversationMembers(ctx, exec, mods...)
+			},
+		),
+		FolloweeFollows: thenLoadBuilder[Q](
+			"FolloweeFollows",
+			func(ctx context.Context, exec bob.Executor, retrieved FolloweeFollowsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFolloweeFollows(ctx, exec, mods...)
+			},
+		),
+		FollowerFollows: thenLoadBuilder[Q](
+			"FollowerFollows",
+			func(ctx context.Context, exec bob.Executor, retrieved FollowerFollowsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadFollowerFollows(ctx, exec, mods...)
+			},
+		),
+		Likes: thenLoadBuilder[Q](
+			"Likes",
+			func(ctx context.Context, exec bob.Executor, retrieved LikesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLikes(ctx, exec, mods...)
+			},
+		),
+		Mentions: thenLoadBuilder[Q](
+			"Mentions",
+			func(ctx context.Context, exec bob.Executor, retrieved MentionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMentions(ctx, exec, mods...)
+			},
+		),
+		SenderMessages: thenLoadBuilder[Q](
+			"SenderMessages",
+			func(ctx context.Context, exec bob.Executor, retrieved SenderMessagesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadSenderMessages(ctx, exec, mods...)
+			},
+		),
+		MutedMutes: thenLoadBuilder[Q](
+			"MutedMutes",
+			func(ctx context.Context, exec bob.Executor, retrieved MutedMutesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMutedMutes(ctx, exec, mods...)
+			},
+		),
+		MuterMutes: thenLoadBuilder[Q](
+			"MuterMutes",
+			func(ctx context.Context, exec bob.Executor, retrieved MuterMutesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadMuterMutes(ctx, exec, mods...)
+			},
+		),
+		ActorNotifications: thenLoadBuilder[Q](
+			"ActorNotifications",
+			func(ctx context.Context, exec bob.Executor, retrieved ActorNotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadActorNotifications(ctx, exec, mods...)
+			},
+		),
+		Notifications: thenLoadBuilder[Q](
+			"Notifications",
+			func(ctx context.Context, exec bob.Executor, retrieved NotificationsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadNotifications(ctx, exec, mods...)
+			},
+		),
+		Tweets: thenLoadBuilder[Q](
+			"Tweets",
+			func(ctx context.Context, exec bob.Executor, retrieved TweetsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTweets(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadBlockedBlocks loads the user's BlockedBlocks into the .R struct
+func (o *User) LoadBlockedBlocks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BlockedBlocks = nil
+
+	related, err := o.BlockedBlocks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.BlockedUser = o
+	}
+
+	o.R.BlockedBlocks = related
+	return nil
+}
+
+// LoadBlockedBlocks loads the user's BlockedBlocks into the .R struct
+func (os UserSlice) LoadBlockedBlocks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	blocks, err := os.BlockedBlocks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.BlockedBlocks = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range blocks {
+
+			if !(o.ID == rel.BlockedID) {
+				continue
+			}
+
+			rel.R.BlockedUser = o
+
+			o.R.BlockedBlocks = append(o.R.BlockedBlocks, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockerBlocks loads the user's BlockerBlocks into the .R struct
+func (o *User) LoadBlockerBlocks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.BlockerBlocks = nil
+
+	related, err := o.BlockerBlocks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.BlockerUser = o
+	}
+
+	o.R.BlockerBlocks = related
+	return nil
+}
+
+// LoadBlockerBlocks loads the user's BlockerBlocks into the .R struct
+func (os UserSlice) LoadBlockerBlocks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	blocks, err := os.BlockerBlocks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.BlockerBlocks = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range blocks {
+
+			if !(o.ID == rel.BlockerID) {
+				continue
+			}
+
+			rel.R.BlockerUser = o
+
+			o.R.BlockerBlocks = append(o.R.BlockerBlocks, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadBookmarks loads the user's Bookmarks into the .R struct
+func (o *User) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Bookmarks = nil
+
+	related, err := o.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Bookmarks = related
+	return nil
+}
+
+// LoadBookmarks loads the user's Bookmarks into the .R struct
+func (os UserSlice) LoadBookmarks(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	bookmarks, err := os.Bookmarks(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Bookmarks = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range bookmarks {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Bookmarks = append(o.R.Bookmarks, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadConversationMembers loads the user's ConversationMembers into the .R struct
+func (o *User) LoadConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ConversationMembers = nil
+
+	related, err := o.ConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.ConversationMembers = related
+	return nil
+}
+
+// LoadConversationMembers loads the user's ConversationMembers into the .R struct
+func (os UserSlice) LoadConversationMembers(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	conversationMembers, err := os.ConversationMembers(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ConversationMembers = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range conversationMembers {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.ConversationMembers = append(o.R.ConversationMembers, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadFolloweeFollows loads the user's FolloweeFollows into the .R struct
+func (o *User) LoadFolloweeFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FolloweeFollows = nil
+
+	related, err := o.FolloweeFollows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.FolloweeUser = o
+	}
+
+	o.R.FolloweeFollows = related
+	return nil
+}
+
+// LoadFolloweeFollows loads the user's FolloweeFollows into the .R struct
+func (os UserSlice) LoadFolloweeFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	follows, err := os.FolloweeFollows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.FolloweeFollows = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range follows {
+
+			if !(o.ID == rel.FolloweeID) {
+				continue
+			}
+
+			rel.R.FolloweeUser = o
+
+			o.R.FolloweeFollows = append(o.R.FolloweeFollows, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerFollows loads the user's FollowerFollows into the .R struct
+func (o *User) LoadFollowerFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.FollowerFollows = nil
+
+	related, err := o.FollowerFollows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.FollowerUser = o
+	}
+
+	o.R.FollowerFollows = related
+	return nil
+}
+
+// LoadFollowerFollows loads the user's FollowerFollows into the .R struct
+func (os UserSlice) LoadFollowerFollows(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	follows, err := os.FollowerFollows(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.FollowerFollows = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range follows {
+
+			if !(o.ID == rel.FollowerID) {
+				continue
+			}
+
+			rel.R.FollowerUser = o
+
+			o.R.FollowerFollows = append(o.R.FollowerFollows, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadLikes loads the user's Likes into the .R struct
+func (o *User) LoadLikes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Likes = nil
+
+	related, err := o.Likes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Likes = related
+	return nil
+}
+
+// LoadLikes loads the user's Likes into the .R struct
+func (os UserSlice) LoadLikes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	likes, err := os.Likes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Likes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range likes {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Likes = append(o.R.Likes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMentions loads the user's Mentions into the .R struct
+func (o *User) LoadMentions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Mentions = nil
+
+	related, err := o.Mentions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Mentions = related
+	return nil
+}
+
+// LoadMentions loads the user's Mentions into the .R struct
+func (os UserSlice) LoadMentions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mentions, err := os.Mentions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Mentions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mentions {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Mentions = append(o.R.Mentions, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadSenderMessages loads the user's SenderMessages into the .R struct
+func (o *User) LoadSenderMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.SenderMessages = nil
+
+	related, err := o.SenderMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.SenderUser = o
+	}
+
+	o.R.SenderMessages = related
+	return nil
+}
+
+// LoadSenderMessages loads the user's SenderMessages into the .R struct
+func (os UserSlice) LoadSenderMessages(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	messages, err := os.SenderMessages(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.SenderMessages = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range messages {
+
+			if !(o.ID == rel.SenderID) {
+				continue
+			}
+
+			rel.R.SenderUser = o
+
+			o.R.SenderMessages = append(o.R.SenderMessages, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMutedMutes loads the user's MutedMutes into the .R struct
+func (o *User) LoadMutedMutes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MutedMutes = nil
+
+	related, err := o.MutedMutes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.MutedUser = o
+	}
+
+	o.R.MutedMutes = related
+	return nil
+}
+
+// LoadMutedMutes loads the user's MutedMutes into the .R struct
+func (os UserSlice) LoadMutedMutes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mutes, err := os.MutedMutes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.MutedMutes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mutes {
+
+			if !(o.ID == rel.MutedID) {
+				continue
+			}
+
+			rel.R.MutedUser = o
+
+			o.R.MutedMutes = append(o.R.MutedMutes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadMuterMutes loads the user's MuterMutes into the .R struct
+func (o *User) LoadMuterMutes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.MuterMutes = nil
+
+	related, err := o.MuterMutes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.MuterUser = o
+	}
+
+	o.R.MuterMutes = related
+	return nil
+}
+
+// LoadMuterMutes loads the user's MuterMutes into the .R struct
+func (os UserSlice) LoadMuterMutes(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	mutes, err := os.MuterMutes(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.MuterMutes = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range mutes {
+
+			if !(o.ID == rel.MuterID) {
+				continue
+			}
+
+			rel.R.MuterUser = o
+
+			o.R.MuterMutes = append(o.R.MuterMutes, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (o *User) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.ActorNotifications = nil
+
+	related, err := o.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.ActorUser = o
+	}
+
+	o.R.ActorNotifications = related
+	return nil
+}
+
+// LoadActorNotifications loads the user's ActorNotifications into the .R struct
+func (os UserSlice) LoadActorNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.ActorNotifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.ActorNotifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.ActorID) {
+				continue
+			}
+
+			rel.R.ActorUser = o
+
+			o.R.ActorNotifications = append(o.R.ActorNotifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (o *User) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Notifications = nil
+
+	related, err := o.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Notifications = related
+	return nil
+}
+
+// LoadNotifications loads the user's Notifications into the .R struct
+func (os UserSlice) LoadNotifications(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	notifications, err := os.Notifications(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Notifications = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range notifications {
+
+			if !(o.ID == rel.UserID) {
+				continue
+			}
+
+			rel.R.User = o
+
+			o.R.Notifications = append(o.R.Notifications, rel)
+		}
+	}
+
+	return nil
+}
+
+// LoadTweets loads the user's Tweets into the .R struct
+func (o *User) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Tweets = nil
+
+	related, err := o.Tweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.User = o
+	}
+
+	o.R.Tweets = related
+	return nil
+}
+
+// LoadTweets loads the user's Tweets into the .R struct
+func (os UserSlice) LoadTweets(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	tweets, err := os.Tweets(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Tweets = nil
 	}
 
 	for _, o := range os {
@@ -700,8 +3039,8 @@ func (os UserSlice) LoadTweets(ctx context.Context, exec bob.Executor, mods ...b
 			continue
 		}
 
-		for _, rel := range tweets {
-
+		for _, rel := range tweets {
+
 			if !(o.ID == rel.UserID) {
 				continue
 			}
@@ -716,8 +3055,21 @@ func (os UserSlice) LoadTweets(ctx context.Context, exec bob.Executor, mods ...b
 }
 
 type userJoins[Q dialect.Joinable] struct {
-	typ    string
-	Tweets modAs[Q, tweetColumns]
+	typ                 string
+	BlockedBlocks       modAs[Q, blockColumns]
+	BlockerBlocks       modAs[Q, blockColumns]
+	Bookmarks           modAs[Q, bookmarkColumns]
+	ConversationMembers modAs[Q, conversationMemberColumns]
+	FolloweeFollows     modAs[Q, followColumns]
+	FollowerFollows     modAs[Q, followColumns]
+	Likes               modAs[Q, likeColumns]
+	Mentions            modAs[Q, mentionColumns]
+	SenderMessages      modAs[Q, messageColumns]
+	MutedMutes          modAs[Q, muteColumns]
+	MuterMutes          modAs[Q, muteColumns]
+	ActorNotifications  modAs[Q, notificationColumns]
+	Notifications       modAs[Q, notificationColumns]
+	Tweets              modAs[Q, tweetColumns]
 }
 
 func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
@@ -727,6 +3079,188 @@ func (j userJoins[Q]) aliasedAs(alias string) userJoins[Q] {
 func buildUserJoins[Q dialect.Joinable](cols userColumns, typ string) userJoins[Q] {
 	return userJoins[Q]{
 		typ: typ,
+		BlockedBlocks: modAs[Q, blockColumns]{
+			c: Blocks.Columns,
+			f: func(to blockColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Blocks.Name().As(to.Alias())).On(
+						to.BlockedID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		BlockerBlocks: modAs[Q, blockColumns]{
+			c: Blocks.Columns,
+			f: func(to blockColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Blocks.Name().As(to.Alias())).On(
+						to.BlockerID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Bookmarks: modAs[Q, bookmarkColumns]{
+			c: Bookmarks.Columns,
+			f: func(to bookmarkColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Bookmarks.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ConversationMembers: modAs[Q, conversationMemberColumns]{
+			c: ConversationMembers.Columns,
+			f: func(to conversationMemberColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, ConversationMembers.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		FolloweeFollows: modAs[Q, followColumns]{
+			c: Follows.Columns,
+			f: func(to followColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Follows.Name().As(to.Alias())).On(
+						to.FolloweeID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		FollowerFollows: modAs[Q, followColumns]{
+			c: Follows.Columns,
+			f: func(to followColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Follows.Name().As(to.Alias())).On(
+						to.FollowerID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Likes: modAs[Q, likeColumns]{
+			c: Likes.Columns,
+			f: func(to likeColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Likes.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Mentions: modAs[Q, mentionColumns]{
+			c: Mentions.Columns,
+			f: func(to mentionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Mentions.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		SenderMessages: modAs[Q, messageColumns]{
+			c: Messages.Columns,
+			f: func(to messageColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Messages.Name().As(to.Alias())).On(
+						to.SenderID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		MutedMutes: modAs[Q, muteColumns]{
+			c: Mutes.Columns,
+			f: func(to muteColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Mutes.Name().As(to.Alias())).On(
+						to.MutedID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		MuterMutes: modAs[Q, muteColumns]{
+			c: Mutes.Columns,
+			f: func(to muteColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Mutes.Name().As(to.Alias())).On(
+						to.MuterID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		ActorNotifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.ActorID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Notifications: modAs[Q, notificationColumns]{
+			c: Notifications.Columns,
+			f: func(to notificationColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Notifications.Name().As(to.Alias())).On(
+						to.UserID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Tweets: modAs[Q, tweetColumns]{
 			c: Tweets.Columns,
 			f: func(to tweetColumns) bob.Mod[Q] {
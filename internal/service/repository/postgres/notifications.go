@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/opt/null"
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/fm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// NotificationStorage is a postgres implementation of the repository.NotificationRepository interface.
+type NotificationStorage struct {
+	dbConn bob.Executor
+}
+
+// NewNotificationStorage returns a new NotificationStorage.
+func NewNotificationStorage(dbConn bob.Executor) *NotificationStorage {
+	return &NotificationStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create records that actorID did something of type typ to userID.
+func (s *NotificationStorage) Create(
+	ctx context.Context,
+	userID, actorID string,
+	typ entities.NotificationType,
+	tweetID string,
+) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate notification id: %w", err)
+	}
+	setter := &models.NotificationSetter{
+		ID:       omit.From(id.String()),
+		UserID:   omit.From(userID),
+		ActorID:  omit.From(actorID),
+		Type:     omit.From(string(typ)),
+		GroupKey: omit.From(notificationGroupKey(typ, tweetID, id.String())),
+	}
+	if tweetID != "" {
+		setter.TweetID = omitnull.From(tweetID)
+	}
+	if _, err = models.Notifications.Insert(setter).Exec(ctx, s.dbConn); err != nil {
+		return fmt.Errorf("failed to insert notification: %w", err)
+	}
+
+	return nil
+}
+
+// notificationRow is a row of the grouped notifications query. The actors come
+// newest first and may repeat.
+type notificationRow struct {
+	ID             string              `db:"id"`
+	Type           string              `db:"type"`
+	TweetID        null.Val[string]    `db:"tweet_id"`
+	Read           bool                `db:"read"`
+	CreatedAt      null.Val[time.Time] `db:"created_at"`
+	ActorIDs       pq.StringArray      `db:"actor_ids"`
+	ActorUsernames pq.StringArray      `db:"actor_usernames"`
+}
+
+// FindByUser returns at most limit groups of notifications of userID whose
+// newest notification comes before beforeID, newest first. A group is read
+// once all of its notifications are. Groups are paged on the highest
+// notification ID in them, served by idx_notifications_user_id.
+func (s *NotificationStorage) FindByUser(
+	ctx context.Context,
+	userID string,
+	typ entities.NotificationType,
+	beforeID string,
+	limit int,
+) ([]entities.Notification, error) {
+	cols := models.Notifications.Columns
+	// PostgreSQL has no max(uuid); the text form of UUIDs sorts the same way
+	newest := psql.F("max", psql.Cast(cols.ID, "text"))()
+	mods := append(
+		liveNotificationMods(userID),
+		sm.Columns(
+			newest.As("id"),
+			cols.Type,
+			cols.TweetID,
+			psql.F("bool_and", cols.Read)().As("read"),
+			psql.F("max", cols.CreatedAt)().As("created_at"),
+			psql.F("array_agg", cols.ActorID)(fm.OrderBy(cols.ID).Desc()).As("actor_ids"),
+			psql.F("array_agg", models.Users.Columns.Username)(fm.OrderBy(cols.ID).Desc()).As("actor_usernames"),
+		),
+		sm.GroupBy(cols.GroupKey),
+		sm.GroupBy(cols.Type),
+		sm.GroupBy(cols.TweetID),
+		sm.OrderBy(newest).Desc(),
+		sm.Limit(limit),
+	)
+	if typ != "" {
+		mods = append(mods, sm.Where(cols.Type.EQ(psql.Arg(string(typ)))))
+	}
+	if beforeID != "" {
+		mods = append(mods, sm.Having(newest.LT(psql.Arg(beforeID))))
+	}
+	rows, err := bob.All(ctx, s.dbConn, psql.Select(mods...), scan.StructMapper[notificationRow]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+
+	notifications := make([]entities.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, toNotification(userID, row))
+	}
+	return notifications, nil
+}
+
+// CountUnread counts the groups of unread notifications of userID. The rows
+// are found through idx_notifications_unread.
+func (s *NotificationStorage) CountUnread(ctx context.Context, userID string) (int, error) {
+	cols := models.Notifications.Columns
+	mods := append(
+		liveNotificationMods(userID),
+		sm.Columns(psql.F("count", cols.GroupKey)(fm.Distinct())),
+		sm.Where(psql.Not(cols.Read)),
+	)
+	count, err := bob.One(ctx, s.dbConn, psql.Select(mods...), scan.SingleColumnMapper[int])
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks every notification of userID read.
+func (s *NotificationStorage) MarkRead(ctx context.Context, userID string) error {
+	cols := models.Notifications.Columns
+	_, err := models.Notifications.Update(
+		models.NotificationSetter{Read: omit.From(true)}.UpdateMod(),
+		um.Where(cols.UserID.EQ(psql.Arg(userID))),
+		um.Where(psql.Not(cols.Read)),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+
+	return nil
+}
+
+// liveNotificationMods selects the notifications of userID whose actor is live
+// and whose tweet, if any, is live too.
+func liveNotificationMods(userID string) []bob.Mod[*dialect.SelectQuery] {
+	return []bob.Mod[*dialect.SelectQuery]{
+		sm.From(models.Notifications.Name()),
+		models.SelectJoins.Notifications.InnerJoin.ActorUser,
+		models.SelectJoins.Notifications.LeftJoin.Tweet,
+		sm.Where(models.Notifications.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		// also true when there is no tweet to join
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	}
+}
+
+// toNotification converts a row of the grouped notifications query to a
+// domain notification of userID, dropping repeated actors.
+func toNotification(userID string, row notificationRow) entities.Notification {
+	n := entities.Notification{
+		ID:        uuid.MustParse(row.ID),
+		UserID:    uuid.MustParse(userID),
+		Type:      entities.NotificationType(row.Type),
+		Read:      row.Read,
+		CreatedAt: row.CreatedAt.GetOrZero(),
+	}
+	if tweetID, ok := row.TweetID.Get(); ok {
+		id := uuid.MustParse(tweetID)
+		n.TweetID = &id
+	}
+	seen := make(map[string]bool, len(row.ActorIDs))
+	for i, actorID := range row.ActorIDs {
+		if seen[actorID] {
+			continue
+		}
+		seen[actorID] = true
+		n.Actors = append(n.Actors, entities.NotificationActor{
+			UserID:   uuid.MustParse(actorID),
+			Username: row.ActorUsernames[i],
+		})
+	}
+	n.ActorCount = len(n.Actors)
+	return n
+}
+
+// notificationGroupKey returns the key shared by the notifications listed as
+// one: those of a grouped type about the same tweet. Other notifications are
+// keyed by their own ID.
+func notificationGroupKey(typ entities.NotificationType, tweetID, id string) string {
+	if typ.Grouped() {
+		return string(typ) + ":" + tweetID
+	}
+	return id
+}
@@ -0,0 +1,98 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type NotificationsTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestNotificationsTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationsTestSuite))
+}
+
+func (ts *NotificationsTestSuite) TestNotifications() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	n := postgres.NewNotificationStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	ts.Require().NoError(u.Create(ctx, jane))
+	jim := &entities.User{Username: "jim", Email: "jim@test.com"}
+	ts.Require().NoError(u.Create(ctx, jim))
+	tweet := &entities.Tweet{Content: "hello", UserID: john.ID}
+	ts.Require().NoError(t.Create(ctx, tweet))
+	johnID, tweetID := john.ID.String(), tweet.ID.String()
+
+	// Likes of one tweet are grouped, and so are follows; replies never are
+	ts.Require().NoError(n.Create(ctx, johnID, jane.ID.String(), entities.NotificationFollow, ""))
+	ts.Require().NoError(n.Create(ctx, johnID, jane.ID.String(), entities.NotificationLike, tweetID))
+	ts.Require().NoError(n.Create(ctx, johnID, jim.ID.String(), entities.NotificationFollow, ""))
+	ts.Require().NoError(n.Create(ctx, johnID, jim.ID.String(), entities.NotificationLike, tweetID))
+	ts.Require().NoError(n.Create(ctx, johnID, jim.ID.String(), entities.NotificationReply, tweetID))
+	// the check constraint rejects notifying oneself
+	ts.Require().Error(n.Create(ctx, johnID, johnID, entities.NotificationFollow, ""))
+
+	groups, err := n.FindByUser(ctx, johnID, "", "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(groups, 3)
+	ts.Require().Equal(entities.NotificationReply, groups[0].Type)
+	likes := groups[1]
+	ts.Require().Equal(entities.NotificationLike, likes.Type)
+	ts.Require().Equal(2, likes.ActorCount)
+	ts.Require().Equal("jim", likes.Actors[0].Username)
+	ts.Require().Equal("jane", likes.Actors[1].Username)
+	ts.Require().Equal(tweet.ID, *likes.TweetID)
+	page, err := n.FindByUser(ctx, johnID, "", likes.ID.String(), 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal(entities.NotificationFollow, page[0].Type)
+	ts.Require().Nil(page[0].TweetID)
+	page, err = n.FindByUser(ctx, johnID, entities.NotificationLike, "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+
+	count, err := n.CountUnread(ctx, johnID)
+	ts.Require().NoError(err)
+	ts.Require().Equal(3, count)
+
+	// A new like after marking all read makes its group unread again
+	ts.Require().NoError(n.MarkRead(ctx, johnID))
+	ts.Require().NoError(n.Create(ctx, johnID, jane.ID.String(), entities.NotificationLike, tweetID))
+	count, err = n.CountUnread(ctx, johnID)
+	ts.Require().NoError(err)
+	ts.Require().Equal(1, count)
+	page, err = n.FindByUser(ctx, johnID, entities.NotificationLike, "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().False(page[0].Read)
+	ts.Require().Equal(2, page[0].ActorCount)
+
+	// Deleted actors and tweets drop out
+	ts.Require().NoError(u.Delete(ctx, jim.ID.String()))
+	groups, err = n.FindByUser(ctx, johnID, "", "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(groups, 2)
+	ts.Require().Equal(1, groups[0].ActorCount)
+	ts.Require().Equal(1, groups[1].ActorCount)
+	ts.Require().NoError(t.Delete(ctx, tweetID))
+	groups, err = n.FindByUser(ctx, johnID, "", "", 10)
+	ts.Require().NoError(err)
+	ts.Require().Len(groups, 1)
+	ts.Require().Equal(entities.NotificationFollow, groups[0].Type)
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+// pageMods returns the query mods for a keyset page over idCol: at most limit
+// rows with an ID lower than beforeID, ordered by ID descending. UUIDv7 IDs
+// sort by creation time, so pages are stable without an OFFSET.
+func pageMods(idCol psql.Expression, beforeID string, limit int) []bob.Mod[*dialect.SelectQuery] {
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.OrderBy(idCol).Desc(),
+		sm.Limit(limit),
+	}
+	if beforeID != "" {
+		mods = append(mods, sm.Where(idCol.LT(psql.Arg(beforeID))))
+	}
+	return mods
+}
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// BlockStorage is a postgres implementation of the repository.BlockRepository interface.
+type BlockStorage struct {
+	dbConn bob.Executor
+}
+
+// NewBlockStorage returns a new BlockStorage.
+func NewBlockStorage(dbConn bob.Executor) *BlockStorage {
+	return &BlockStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create makes blockerID block blockedID.
+func (s *BlockStorage) Create(ctx context.Context, blockerID, blockedID string) error {
+	setter := &models.BlockSetter{
+		BlockerID: omit.From(blockerID),
+		BlockedID: omit.From(blockedID),
+	}
+
+	if _, err := models.Blocks.Insert(setter).Exec(ctx, s.dbConn); err != nil {
+		if isUniqueViolation(err, dberrors.BlockErrors.ErrUniqueBlocksPkey) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to insert block: %w", err)
+	}
+
+	return nil
+}
+
+// Delete makes blockerID stop blocking blockedID.
+func (s *BlockStorage) Delete(ctx context.Context, blockerID, blockedID string) error {
+	rows, err := models.Blocks.Delete(
+		dm.Where(models.Blocks.Columns.BlockerID.EQ(psql.Arg(blockerID))),
+		dm.Where(models.Blocks.Columns.BlockedID.EQ(psql.Arg(blockedID))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete block: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// Exists reports whether blockerID blocks blockedID.
+func (s *BlockStorage) Exists(ctx context.Context, blockerID, blockedID string) (bool, error) {
+	exists, err := models.BlockExists(ctx, s.dbConn, blockerID, blockedID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find block: %w", err)
+	}
+
+	return exists, nil
+}
+
+// FindBlocked returns the users blocked by userID, most recent first.
+func (s *BlockStorage) FindBlocked(ctx context.Context, userID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.BlockedBlocks,
+		sm.Where(models.Blocks.Columns.BlockerID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Blocks.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocked users: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
+
+// FindBlockers returns the users blocking userID, most recent first. The query
+// is served by idx_blocks_blocked_id.
+func (s *BlockStorage) FindBlockers(ctx context.Context, userID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.BlockerBlocks,
+		sm.Where(models.Blocks.Columns.BlockedID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Blocks.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blockers: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
+
+// MuteStorage is a postgres implementation of the repository.MuteRepository interface.
+type MuteStorage struct {
+	dbConn bob.Executor
+}
+
+// NewMuteStorage returns a new MuteStorage.
+func NewMuteStorage(dbConn bob.Executor) *MuteStorage {
+	return &MuteStorage{
+		dbConn: dbConn,
+	}
+}
+
+// Create makes muterID mute mutedID.
+func (s *MuteStorage) Create(ctx context.Context, muterID, mutedID string) error {
+	setter := &models.MuteSetter{
+		MuterID: omit.From(muterID),
+		MutedID: omit.From(mutedID),
+	}
+
+	if _, err := models.Mutes.Insert(setter).Exec(ctx, s.dbConn); err != nil {
+		if isUniqueViolation(err, dberrors.MuteErrors.ErrUniqueMutesPkey) {
+			return repository.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to insert mute: %w", err)
+	}
+
+	return nil
+}
+
+// Delete makes muterID stop muting mutedID.
+func (s *MuteStorage) Delete(ctx context.Context, muterID, mutedID string) error {
+	rows, err := models.Mutes.Delete(
+		dm.Where(models.Mutes.Columns.MuterID.EQ(psql.Arg(muterID))),
+		dm.Where(models.Mutes.Columns.MutedID.EQ(psql.Arg(mutedID))),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete mute: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindMuted returns the users muted by userID, most recent first.
+func (s *MuteStorage) FindMuted(ctx context.Context, userID string) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		models.SelectJoins.Users.InnerJoin.MutedMutes,
+		sm.Where(models.Mutes.Columns.MuterID.EQ(psql.Arg(userID))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		sm.OrderBy(models.Mutes.Columns.CreatedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find muted users: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
@@ -0,0 +1,88 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type RelationshipsTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestRelationshipsTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipsTestSuite))
+}
+
+func (ts *RelationshipsTestSuite) TestBlocksAndMutes() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	b := postgres.NewBlockStorage(ts.s.DB())
+	m := postgres.NewMuteStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	ts.Require().NoError(u.Create(ctx, john))
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	ts.Require().NoError(u.Create(ctx, jane))
+	johnID, janeID := john.ID.String(), jane.ID.String()
+
+	ts.Require().NoError(b.Create(ctx, johnID, janeID))
+	ts.Require().ErrorIs(b.Create(ctx, johnID, janeID), repository.ErrAlreadyExists)
+	// the check constraint rejects blocking oneself
+	ts.Require().Error(b.Create(ctx, johnID, johnID))
+	exists, err := b.Exists(ctx, johnID, janeID)
+	ts.Require().NoError(err)
+	ts.Require().True(exists)
+	exists, err = b.Exists(ctx, janeID, johnID)
+	ts.Require().NoError(err)
+	ts.Require().False(exists)
+	users, err := b.FindBlocked(ctx, johnID)
+	ts.Require().NoError(err)
+	ts.Require().Len(users, 1)
+	ts.Require().Equal(jane.ID, users[0].ID)
+	users, err = b.FindBlockers(ctx, janeID)
+	ts.Require().NoError(err)
+	ts.Require().Len(users, 1)
+	ts.Require().Equal(john.ID, users[0].ID)
+	ts.Require().NoError(b.Delete(ctx, johnID, janeID))
+	ts.Require().ErrorIs(b.Delete(ctx, johnID, janeID), repository.ErrNotFound)
+
+	ts.Require().NoError(m.Create(ctx, johnID, janeID))
+	ts.Require().ErrorIs(m.Create(ctx, johnID, janeID), repository.ErrAlreadyExists)
+	users, err = m.FindMuted(ctx, johnID)
+	ts.Require().NoError(err)
+	ts.Require().Len(users, 1)
+	ts.Require().Equal(jane.ID, users[0].ID)
+	ts.Require().NoError(m.Delete(ctx, johnID, janeID))
+	ts.Require().ErrorIs(m.Delete(ctx, johnID, janeID), repository.ErrNotFound)
+
+	// Excluded authors drop out of listings, and their replies take the
+	// replies below them along
+	root := &entities.Tweet{Content: "root", UserID: john.ID}
+	ts.Require().NoError(t.Create(ctx, root))
+	reply := &entities.Tweet{Content: "reply", UserID: jane.ID, InReplyToTweetID: &root.ID, ConversationID: root.ID}
+	ts.Require().NoError(t.Create(ctx, reply))
+	nested := &entities.Tweet{Content: "nested", UserID: john.ID, InReplyToTweetID: &reply.ID, ConversationID: root.ID}
+	ts.Require().NoError(t.Create(ctx, nested))
+	excluded := []string{janeID}
+	page, err := t.FindPage(ctx, "", 10, excluded)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 2)
+	ts.Require().Equal(nested.ID, page[0].ID)
+	ts.Require().Equal(root.ID, page[1].ID)
+	thread, err := t.FindThread(ctx, root.ID.String(), 10, excluded)
+	ts.Require().NoError(err)
+	ts.Require().Len(thread, 1)
+	ts.Require().Equal(root.ID, thread[0].ID)
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
+)
+
+// searchConfig is the text search configuration of the tweets.search_vector
+// column; queries must be parsed with the same one.
+const searchConfig = "simple"
+
+// Search returns the live tweets matching q. The words of q are matched
+// against the generated search_vector column, which a GIN index covers, and
+// relevance is ts_rank. Tweets written by excludedUserIDs are left out.
+func (s *TweetStorage) Search(
+	ctx context.Context,
+	q entities.TweetQuery,
+	beforeID string,
+	offset, limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	mods := append([]bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	}, excludeAuthors(excludedUserIDs)...)
+	if q.FromUsername != "" {
+		author := psql.Select(
+			sm.Columns(models.Users.Columns.ID),
+			sm.From(models.Users.Name()),
+			sm.Where(psql.F("lower", models.Users.Columns.Username)().EQ(psql.F("lower", psql.Arg(q.FromUsername))())),
+			sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+		)
+		mods = append(mods, sm.Where(models.Tweets.Columns.UserID.OP("IN", author)))
+	}
+	for _, tag := range q.Hashtags {
+		mods = append(mods, sm.Where(models.Tweets.Columns.ID.OP("IN", taggedTweetIDs(tag))))
+	}
+
+	var rank bob.Expression
+	if text := tsqueryText(q); text != "" {
+		tsquery := psql.F("to_tsquery", psql.S(searchConfig), psql.Arg(text))()
+		mods = append(mods, sm.Where(models.Tweets.Columns.SearchVector.OP("@@", tsquery)))
+		rank = psql.F("ts_rank", models.Tweets.Columns.SearchVector, tsquery)()
+	}
+
+	if q.Order == entities.SearchOrderRecency {
+		mods = append(mods, pageMods(models.Tweets.Columns.ID, beforeID, limit)...)
+	} else {
+		if rank != nil {
+			mods = append(mods, sm.OrderBy(rank).Desc())
+		}
+		mods = append(mods,
+			sm.OrderBy(models.Tweets.Columns.ID).Desc(),
+			sm.Limit(limit),
+			sm.Offset(offset),
+		)
+	}
+
+	ormRows, err := models.Tweets.Query(mods...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tweets: %w", err)
+	}
+
+	return toTweets(ormRows), nil
+}
+
+// tsqueryText renders the words of q in the syntax of to_tsquery: every term,
+// phrase and exclusion is a quoted lexeme, joined by explicit & and !
+// operators. Parsers such as websearch_to_tsquery read words like "or" as
+// operators; quoted, they are only ever matched as words. A quoted phrase
+// matches its words in order.
+func tsqueryText(q entities.TweetQuery) string {
+	parts := make([]string, 0, len(q.Terms)+len(q.Phrases)+len(q.Excluded))
+	for _, term := range q.Terms {
+		parts = append(parts, tsqueryQuote(term))
+	}
+	for _, phrase := range q.Phrases {
+		parts = append(parts, tsqueryQuote(phrase))
+	}
+	for _, excluded := range q.Excluded {
+		parts = append(parts, "!"+tsqueryQuote(excluded))
+	}
+	return strings.Join(parts, " & ")
+}
+
+// tsqueryQuoter escapes the quotes and backslashes of a to_tsquery lexeme.
+var tsqueryQuoter = strings.NewReplacer(`'`, `''`, `\`, `\\`)
+
+// tsqueryQuote returns s as a quoted to_tsquery lexeme.
+func tsqueryQuote(s string) string {
+	return "'" + tsqueryQuoter.Replace(s) + "'"
+}
+
+// likeEscaper escapes the LIKE wildcards and the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search returns the live users whose username is similar to query or whose
+// name holds a word similar to it, by pg_trgm, along with those containing
+// query outright. The trigram GIN indexes on both columns serve every branch
+// of the filter. Matches are ranked by the closer of the two similarities.
+func (s *UserStorage) Search(ctx context.Context, query string, offset, limit int) ([]entities.User, error) {
+	cols := models.Users.Columns
+	pattern := psql.Arg("%" + likeEscaper.Replace(query) + "%")
+	rank := psql.F("greatest",
+		psql.F("similarity", cols.Username, psql.Arg(query))(),
+		psql.F("word_similarity", psql.Arg(query), cols.Name)(),
+	)()
+
+	ormRows, err := models.Users.Query(
+		sm.Where(cols.DeletedAt.IsNull()),
+		sm.Where(psql.Or(
+			cols.Username.OP("%", psql.Arg(query)),
+			psql.Arg(query).OP("<%", cols.Name),
+			cols.Username.ILike(pattern),
+			cols.Name.ILike(pattern),
+		)),
+		sm.OrderBy(rank).Desc(),
+		sm.OrderBy(cols.ID).Desc(),
+		sm.Limit(limit),
+		sm.Offset(offset),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return toUsers(ormRows), nil
+}
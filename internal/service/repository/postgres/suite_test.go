@@ -0,0 +1,42 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	testcontainers "github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/test"
+)
+
+// storageSuite is embedded by the suites of this package: every test gets a
+// freshly migrated database and a Storage connected to it.
+type storageSuite struct {
+	suite.Suite
+	container *testcontainers.PostgresContainer
+	s         *postgres.Storage
+}
+
+func (ts *storageSuite) SetupTest() {
+	var err error
+	ctx := context.Background()
+	ts.container, err = test.SetupDB(ctx)
+	require.NoError(ts.T(), err)
+	ts.s, err = postgres.NewStorage(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(ts.T(), err)
+}
+
+func (ts *storageSuite) TearDownTest() {
+	ctx := context.Background()
+	err := test.TeardownDB(ctx, ts.container)
+	require.NoError(ts.T(), err)
+	ts.s.Close()
+}
@@ -5,15 +5,21 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
 	"github.com/google/uuid"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/scan"
 
 	"github.com/ricleal/twitter-clone/internal/entities"
 	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
 )
 
@@ -35,43 +41,98 @@ func (s *TweetStorage) Create(ctx context.Context, t *entities.Tweet) error {
 	if err != nil {
 		return fmt.Errorf("failed to generate tweet id: %w", err)
 	}
+	if t.Kind == "" {
+		t.Kind = entities.TweetKindTweet
+	}
 	setter := &models.TweetSetter{
 		ID:      omit.From(id.String()),
 		Content: omit.From(t.Content),
 		UserID:  omit.From(t.UserID.String()),
+		Kind:    omit.From(string(t.Kind)),
+	}
+	if t.ReferencedTweetID != nil {
+		setter.ReferencedTweetID = omitnull.From(t.ReferencedTweetID.String())
+	}
+	if t.InReplyToTweetID != nil {
+		setter.InReplyToTweetID = omitnull.From(t.InReplyToTweetID.String())
 	}
+	if t.ConversationID == uuid.Nil {
+		t.ConversationID = id
+	}
+	setter.ConversationID = omit.From(t.ConversationID.String())
 
-	_, err = models.Tweets.Insert(setter).One(ctx, s.dbConn)
+	row, err := models.Tweets.Insert(setter).One(ctx, s.dbConn)
 	if err != nil {
+		// the only unique index a new tweet can break is the one on live retweets
+		if isUniqueViolation(err, dberrors.ErrUniqueConstraint) {
+			return repository.ErrAlreadyExists
+		}
 		return fmt.Errorf("failed to insert tweet: %w", err)
 	}
 
+	// created_at and updated_at are filled in by the database
+	t.ID = id
+	t.CreatedAt = row.CreatedAt.GetOrZero()
+	t.UpdatedAt = row.UpdatedAt.GetOrZero()
 	return nil
 }
 
 // FindAll returns all tweets.
 func (s *TweetStorage) FindAll(ctx context.Context) ([]entities.Tweet, error) {
-	ormTweets, err := models.Tweets.Query().All(ctx, s.dbConn)
+	ormTweets, err := models.Tweets.Query(
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	).All(ctx, s.dbConn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find all tweets: %w", err)
 	}
 
-	tweets := make([]entities.Tweet, 0, len(ormTweets))
-	for _, t := range ormTweets {
-		tweets = append(tweets, entities.Tweet{
-			ID:      uuid.MustParse(t.ID),
-			Content: t.Content,
-			UserID:  uuid.MustParse(t.UserID),
-		})
+	return toTweets(ormTweets), nil
+}
+
+// FindPage returns at most limit tweets created before beforeID, newest first,
+// leaving out those written by excludedUserIDs.
+func (s *TweetStorage) FindPage(
+	ctx context.Context,
+	beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	mods := append(
+		pageMods(models.Tweets.Columns.ID, beforeID, limit),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	ormRows, err := models.Tweets.Query(append(mods, excludeAuthors(excludedUserIDs)...)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tweets page: %w", err)
+	}
+
+	return toTweets(ormRows), nil
+}
+
+// FindByUserID returns at most limit tweets by userID created before beforeID,
+// newest first. The query is served by idx_tweets_user_id.
+func (s *TweetStorage) FindByUserID(
+	ctx context.Context,
+	userID, beforeID string,
+	limit int,
+) ([]entities.Tweet, error) {
+	ormRows, err := models.Tweets.Query(append(
+		pageMods(models.Tweets.Columns.ID, beforeID, limit),
+		sm.Where(models.Tweets.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tweets of user: %w", err)
 	}
 
-	return tweets, nil
+	return toTweets(ormRows), nil
 }
 
 // FindByID returns a tweet by ID.
 func (s *TweetStorage) FindByID(ctx context.Context, id string) (*entities.Tweet, error) {
 	ormTweet, err := models.Tweets.Query(
 		sm.Where(models.Tweets.Columns.ID.EQ(psql.Arg(id))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
 	).One(ctx, s.dbConn)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -80,9 +141,249 @@ func (s *TweetStorage) FindByID(ctx context.Context, id string) (*entities.Tweet
 		return nil, fmt.Errorf("failed to find tweet by id: %w", err)
 	}
 
-	return &entities.Tweet{
-		ID:      uuid.MustParse(ormTweet.ID),
-		Content: ormTweet.Content,
-		UserID:  uuid.MustParse(ormTweet.UserID),
-	}, nil
+	t := toTweet(ormTweet)
+	return &t, nil
+}
+
+// FindByIDs returns the live tweets among ids.
+func (s *TweetStorage) FindByIDs(ctx context.Context, ids []string) ([]entities.Tweet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	ormTweets, err := models.Tweets.Query(
+		sm.Where(models.Tweets.Columns.ID.In(stringArgs(ids)...)),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tweets by ids: %w", err)
+	}
+
+	return toTweets(ormTweets), nil
+}
+
+// FindRetweet returns the live retweet of tweetID by userID.
+func (s *TweetStorage) FindRetweet(ctx context.Context, userID, tweetID string) (*entities.Tweet, error) {
+	ormTweet, err := models.Tweets.Query(
+		sm.Where(models.Tweets.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(models.Tweets.Columns.ReferencedTweetID.EQ(psql.Arg(tweetID))),
+		sm.Where(models.Tweets.Columns.Kind.EQ(psql.Arg(string(entities.TweetKindRetweet)))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	).One(ctx, s.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find retweet: %w", err)
+	}
+
+	t := toTweet(ormTweet)
+	return &t, nil
+}
+
+// FindThread returns the live tweet rootID and its live replies at most
+// maxDepth levels below it. The recursive CTE carries the path of IDs from the
+// root to each reply; ordering by it yields the tree depth first, and since
+// the IDs are v7 UUIDs, siblings oldest first. Replies written by
+// excludedUserIDs never enter the CTE, so the replies below them do not either.
+func (s *TweetStorage) FindThread(
+	ctx context.Context,
+	rootID string,
+	maxDepth int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	root := psql.Select(
+		sm.Columns(models.Tweets.Columns.ID, psql.Raw("0"), psql.Raw("ARRAY[id]")),
+		sm.From(models.Tweets.Name()),
+		sm.Where(models.Tweets.Columns.ID.EQ(psql.Arg(rootID))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	replies := psql.Select(
+		sm.Columns(
+			models.Tweets.Columns.ID,
+			psql.Quote("thread", "depth").Plus(psql.Raw("1")),
+			psql.Quote("thread", "path").OP("||", models.Tweets.Columns.ID),
+		),
+		sm.From(models.Tweets.Name()),
+		sm.InnerJoin("thread").On(models.Tweets.Columns.InReplyToTweetID.EQ(psql.Quote("thread", "id"))),
+		sm.Where(psql.Quote("thread", "depth").LT(psql.Arg(maxDepth))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	replies.Apply(excludeAuthors(excludedUserIDs)...)
+	root.Apply(sm.UnionAll(replies))
+
+	ormTweets, err := models.Tweets.Query(
+		sm.With("thread", "id", "depth", "path").As(root),
+		sm.Recursive(true),
+		sm.InnerJoin("thread").On(models.Tweets.Columns.ID.EQ(psql.Quote("thread", "id"))),
+		sm.OrderBy(psql.Quote("thread", "path")),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find thread: %w", err)
+	}
+	if len(ormTweets) == 0 {
+		return nil, repository.ErrNotFound
+	}
+
+	return toTweets(ormTweets), nil
+}
+
+// referenceCount is a row of the per-tweet reference count query.
+type referenceCount struct {
+	TweetID string `db:"referenced_tweet_id"`
+	Count   int    `db:"count"`
+}
+
+// CountReferences counts the live tweets of the given kind referencing each of tweetIDs.
+func (s *TweetStorage) CountReferences(
+	ctx context.Context,
+	tweetIDs []string,
+	kind entities.TweetKind,
+) (map[string]int, error) {
+	counts := make(map[string]int, len(tweetIDs))
+	if len(tweetIDs) == 0 {
+		return counts, nil
+	}
+
+	q := psql.Select(
+		sm.Columns(models.Tweets.Columns.ReferencedTweetID, psql.F("count", psql.Raw("*"))().As("count")),
+		sm.From(models.Tweets.Name()),
+		sm.Where(models.Tweets.Columns.ReferencedTweetID.In(stringArgs(tweetIDs)...)),
+		sm.Where(models.Tweets.Columns.Kind.EQ(psql.Arg(string(kind)))),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+		sm.GroupBy(models.Tweets.Columns.ReferencedTweetID),
+	)
+	rows, err := bob.All(ctx, s.dbConn, q, scan.StructMapper[referenceCount]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count referencing tweets: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[row.TweetID] = row.Count
+	}
+	return counts, nil
+}
+
+// FindTimeline returns at most limit tweets authored by userID and the users
+// they follow, other than excludedUserIDs, created before beforeID, newest
+// first.
+//
+// Tweet IDs are UUIDv7, which start with their creation time in milliseconds,
+// so the pages seek on the primary key rather than on idx_tweets_created_at:
+// the order is the same, ties within a millisecond are broken, and the cursor
+// stays a single ID. idx_tweets_user_id picks the tweets of the authors.
+func (s *TweetStorage) FindTimeline(
+	ctx context.Context,
+	userID, beforeID string,
+	limit int,
+	excludedUserIDs []string,
+) ([]entities.Tweet, error) {
+	followees := psql.Select(
+		sm.Columns(models.Follows.Columns.FolloweeID),
+		sm.From(models.Follows.Name()),
+		sm.Where(models.Follows.Columns.FollowerID.EQ(psql.Arg(userID))),
+	)
+	mods := append(
+		pageMods(models.Tweets.Columns.ID, beforeID, limit),
+		sm.Where(psql.Or(
+			models.Tweets.Columns.UserID.EQ(psql.Arg(userID)),
+			models.Tweets.Columns.UserID.OP("IN", followees),
+		)),
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	)
+	ormTweets, err := models.Tweets.Query(append(mods, excludeAuthors(excludedUserIDs)...)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find timeline: %w", err)
+	}
+
+	return toTweets(ormTweets), nil
+}
+
+// Delete soft-deletes a tweet.
+func (s *TweetStorage) Delete(ctx context.Context, id string) error {
+	rows, err := models.Tweets.Update(
+		models.TweetSetter{DeletedAt: omitnull.From(time.Now())}.UpdateMod(),
+		um.Where(models.Tweets.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Tweets.Columns.DeletedAt.IsNull()),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete tweet: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindDeleted returns the soft-deleted tweets, most recently deleted first.
+func (s *TweetStorage) FindDeleted(ctx context.Context) ([]entities.Tweet, error) {
+	ormTweets, err := models.Tweets.Query(
+		sm.Where(models.Tweets.Columns.DeletedAt.IsNotNull()),
+		sm.OrderBy(models.Tweets.Columns.DeletedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deleted tweets: %w", err)
+	}
+
+	return toTweets(ormTweets), nil
+}
+
+// Restore undoes the soft delete of a tweet.
+func (s *TweetStorage) Restore(ctx context.Context, id string) error {
+	rows, err := models.Tweets.Update(
+		models.TweetSetter{DeletedAt: omitnull.FromPtr[time.Time](nil)}.UpdateMod(),
+		um.Where(models.Tweets.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Tweets.Columns.DeletedAt.IsNotNull()),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to restore tweet: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// excludeAuthors returns the mods leaving out the tweets written by userIDs,
+// which are none when userIDs is empty.
+func excludeAuthors(userIDs []string) []bob.Mod[*dialect.SelectQuery] {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(models.Tweets.Columns.UserID.NotIn(stringArgs(userIDs)...)),
+	}
+}
+
+// toTweet converts a bob tweet model to a domain tweet.
+func toTweet(t *models.Tweet) entities.Tweet {
+	tweet := entities.Tweet{
+		ID:             uuid.MustParse(t.ID),
+		Content:        t.Content,
+		UserID:         uuid.MustParse(t.UserID),
+		Kind:           entities.TweetKind(t.Kind),
+		ConversationID: uuid.MustParse(t.ConversationID),
+		CreatedAt:      t.CreatedAt.GetOrZero(),
+		UpdatedAt:      t.UpdatedAt.GetOrZero(),
+		DeletedAt:      t.DeletedAt.Ptr(),
+	}
+	if refID, ok := t.ReferencedTweetID.Get(); ok {
+		id := uuid.MustParse(refID)
+		tweet.ReferencedTweetID = &id
+	}
+	if parentID, ok := t.InReplyToTweetID.Get(); ok {
+		id := uuid.MustParse(parentID)
+		tweet.InReplyToTweetID = &id
+	}
+	return tweet
+}
+
+// toTweets converts bob tweet models to domain tweets.
+func toTweets(ormTweets models.TweetSlice) []entities.Tweet {
+	tweets := make([]entities.Tweet, 0, len(ormTweets))
+	for _, t := range ormTweets {
+		tweets = append(tweets, toTweet(t))
+	}
+	return tweets
 }
@@ -5,26 +5,18 @@ package postgres_test
 
 import (
 	"context"
-	"io"
-	"log/slog"
 	"testing"
 
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
-	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
-	testcontainers "github.com/testcontainers/testcontainers-go/modules/postgres"
 
 	"github.com/ricleal/twitter-clone/internal/entities"
 	"github.com/ricleal/twitter-clone/internal/service/repository"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
-	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/test"
 )
 
 type TweetsTestSuite struct {
-	suite.Suite
-	container *testcontainers.PostgresContainer
-	s         *postgres.Storage
+	storageSuite
 }
 
 // In order for 'go test' to run this suite, we need to create
@@ -33,22 +25,6 @@ func TestTweetsTestSuite(t *testing.T) {
 	suite.Run(t, new(TweetsTestSuite))
 }
 
-func (ts *TweetsTestSuite) SetupTest() {
-	var err error
-	ctx := context.Background()
-	ts.container, err = test.SetupDB(ctx)
-	require.NoError(ts.T(), err)
-	ts.s, err = postgres.NewStorage(ctx, slog.New(slog.NewTextHandler(io.Discard, nil)))
-	require.NoError(ts.T(), err)
-}
-
-func (ts *TweetsTestSuite) TearDownTest() {
-	ctx := context.Background()
-	err := test.TeardownDB(ctx, ts.container)
-	require.NoError(ts.T(), err)
-	ts.s.Close()
-}
-
 func (ts *TweetsTestSuite) TestData() {
 	ctx := context.Background()
 	t := postgres.NewTweetStorage(ts.s.DB())
@@ -147,4 +123,414 @@ func (ts *TweetsTestSuite) TestData() {
 		ts.Require().ErrorIs(err, repository.ErrNotFound)
 		ts.Require().Nil(tweet)
 	}
+	// Timeline contains the user's own tweets, newest first
+	{
+		tweets, err := t.FindTimeline(ctx, userID, "", 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 2)
+		ts.Require().Equal("Ut enim ad minim veniam", tweets[0].Content)
+
+		// ... a page at a time
+		tweets, err = t.FindTimeline(ctx, userID, "", 1, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 1)
+		rest, err := t.FindTimeline(ctx, userID, tweets[0].ID.String(), 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(rest, 1)
+		ts.Require().NotEqual("Ut enim ad minim veniam", rest[0].Content)
+	}
+	// Timeline of an unrelated user is empty
+	{
+		err := u.Create(ctx, &entities.User{
+			Username: "other",
+			Email:    "other@test.com",
+		})
+		ts.Require().NoError(err)
+		other, err := u.FindByUsername(ctx, "other")
+		ts.Require().NoError(err)
+		tweets, err := t.FindTimeline(ctx, other.ID.String(), "", 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 0)
+
+		// ... until they follow the author
+		f := postgres.NewFollowStorage(ts.s.DB())
+		err = f.Create(ctx, other.ID.String(), userID)
+		ts.Require().NoError(err)
+		tweets, err = t.FindTimeline(ctx, other.ID.String(), "", 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 2)
+	}
+}
+
+func (ts *TweetsTestSuite) TestFindPage() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	err := u.Create(ctx, &entities.User{
+		Username: "test",
+		Email:    "test@test.com",
+	})
+	ts.Require().NoError(err)
+	user, err := u.FindByUsername(ctx, "test")
+	ts.Require().NoError(err)
+
+	for _, content := range []string{"first", "second", "third"} {
+		err = t.Create(ctx, &entities.Tweet{UserID: user.ID, Content: content})
+		ts.Require().NoError(err)
+	}
+
+	// First page starts with the newest tweet
+	page, err := t.FindPage(ctx, "", 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 2)
+	ts.Require().Equal("third", page[0].Content)
+	ts.Require().Equal("second", page[1].Content)
+
+	// Next page seeks past the last ID of the previous one
+	page, err = t.FindPage(ctx, page[1].ID.String(), 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal("first", page[0].Content)
+}
+
+func (ts *TweetsTestSuite) TestSoftDelete() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	err := u.Create(ctx, &entities.User{
+		Username: "test",
+		Email:    "test@test.com",
+	})
+	ts.Require().NoError(err)
+	user, err := u.FindByUsername(ctx, "test")
+	ts.Require().NoError(err)
+
+	err = t.Create(ctx, &entities.Tweet{UserID: user.ID, Content: "Lorem ipsum"})
+	ts.Require().NoError(err)
+	tweets, err := t.FindAll(ctx)
+	ts.Require().NoError(err)
+	ts.Require().Len(tweets, 1)
+	tweetID := tweets[0].ID.String()
+
+	// Deleted tweets are hidden from the read paths
+	{
+		err = t.Delete(ctx, tweetID)
+		ts.Require().NoError(err)
+		_, err = t.FindByID(ctx, tweetID)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+		tweets, err = t.FindPage(ctx, "", 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 0)
+		tweets, err = t.FindTimeline(ctx, user.ID.String(), "", 10, nil)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 0)
+		err = t.Delete(ctx, tweetID)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+	}
+	// ... listed as deleted, and can be restored
+	{
+		tweets, err = t.FindDeleted(ctx)
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 1)
+		ts.Require().NotNil(tweets[0].DeletedAt)
+		// updated_at is bumped by the trigger
+		ts.Require().True(tweets[0].UpdatedAt.After(tweets[0].CreatedAt))
+		err = t.Restore(ctx, tweetID)
+		ts.Require().NoError(err)
+		_, err = t.FindByID(ctx, tweetID)
+		ts.Require().NoError(err)
+		err = t.Restore(ctx, tweetID)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+	}
+	// Same for users
+	{
+		err = u.Delete(ctx, user.ID.String())
+		ts.Require().NoError(err)
+		_, err = u.FindByID(ctx, user.ID.String())
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+		_, err = u.FindByEmail(ctx, user.Email)
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+		users, findErr := u.FindDeleted(ctx)
+		ts.Require().NoError(findErr)
+		ts.Require().Len(users, 1)
+		err = u.Restore(ctx, user.ID.String())
+		ts.Require().NoError(err)
+		_, err = u.FindByUsername(ctx, user.Username)
+		ts.Require().NoError(err)
+	}
+}
+
+func (ts *TweetsTestSuite) TestFindByUserID() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	for _, user := range []*entities.User{john, jane} {
+		ts.Require().NoError(u.Create(ctx, user))
+	}
+	for _, tweet := range []*entities.Tweet{
+		{Content: "john 1", UserID: john.ID},
+		{Content: "jane 1", UserID: jane.ID},
+		{Content: "john 2", UserID: john.ID},
+		{Content: "john 3", UserID: john.ID},
+	} {
+		ts.Require().NoError(t.Create(ctx, tweet))
+	}
+
+	page, err := t.FindByUserID(ctx, john.ID.String(), "", 2)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 2)
+	ts.Require().Equal("john 3", page[0].Content)
+	ts.Require().Equal("john 2", page[1].Content)
+
+	page, err = t.FindByUserID(ctx, john.ID.String(), page[1].ID.String(), 2)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal("john 1", page[0].Content)
+}
+
+func (ts *TweetsTestSuite) TestHashtags() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	h := postgres.NewHashtagStorage(ts.s.DB())
+
+	user := &entities.User{Username: "test", Email: "test@test.com"}
+	ts.Require().NoError(u.Create(ctx, user))
+	var tweets []*entities.Tweet
+	for _, tags := range [][]string{{"go"}, {"rust"}, {"go", "rust"}, {"go"}} {
+		tweet := &entities.Tweet{Content: "tagged", UserID: user.ID}
+		ts.Require().NoError(t.Create(ctx, tweet))
+		// existing hashtags are reused
+		ts.Require().NoError(h.Attach(ctx, tweet.ID.String(), tags))
+		tweets = append(tweets, tweet)
+	}
+
+	page, err := h.FindTweets(ctx, "go", "", 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 2)
+	ts.Require().Equal(tweets[3].ID, page[0].ID)
+	ts.Require().Equal(tweets[2].ID, page[1].ID)
+
+	page, err = h.FindTweets(ctx, "go", page[1].ID.String(), 2, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal(tweets[0].ID, page[0].ID)
+
+	// Deleted tweets are skipped
+	ts.Require().NoError(t.Delete(ctx, tweets[2].ID.String()))
+	page, err = h.FindTweets(ctx, "rust", "", 10, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal(tweets[1].ID, page[0].ID)
+
+	page, err = h.FindTweets(ctx, "zig", "", 10, nil)
+	ts.Require().NoError(err)
+	ts.Require().Empty(page)
+}
+
+func (ts *TweetsTestSuite) TestMentions() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	m := postgres.NewMentionStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	for _, user := range []*entities.User{john, jane} {
+		ts.Require().NoError(u.Create(ctx, user))
+	}
+	first := &entities.Tweet{Content: "hi @jane", UserID: john.ID}
+	second := &entities.Tweet{Content: "@jane @john", UserID: john.ID}
+	for _, tweet := range []*entities.Tweet{first, second} {
+		ts.Require().NoError(t.Create(ctx, tweet))
+	}
+	ts.Require().NoError(m.Create(ctx, first.ID.String(), []entities.Mention{
+		{UserID: jane.ID, Start: 3, End: 8},
+	}))
+	ts.Require().NoError(m.Create(ctx, second.ID.String(), []entities.Mention{
+		{UserID: john.ID, Start: 6, End: 11},
+		{UserID: jane.ID, Start: 0, End: 5},
+	}))
+
+	found, err := m.FindByTweets(ctx, []string{first.ID.String(), second.ID.String()})
+	ts.Require().NoError(err)
+	ts.Require().Len(found, 2)
+	ts.Require().Equal([]entities.Mention{
+		{UserID: jane.ID, Username: "jane", Start: 0, End: 5},
+		{UserID: john.ID, Username: "john", Start: 6, End: 11},
+	}, found[second.ID.String()])
+
+	page, err := m.FindTweets(ctx, jane.ID.String(), "", 1, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal(second.ID, page[0].ID)
+
+	page, err = m.FindTweets(ctx, jane.ID.String(), page[0].ID.String(), 1, nil)
+	ts.Require().NoError(err)
+	ts.Require().Len(page, 1)
+	ts.Require().Equal(first.ID, page[0].ID)
+}
+
+func (ts *TweetsTestSuite) TestSearch() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+	h := postgres.NewHashtagStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	for _, user := range []*entities.User{john, jane} {
+		ts.Require().NoError(u.Create(ctx, user))
+	}
+	tweets := []*entities.Tweet{
+		{Content: "Go go go, said the gopher", UserID: john.ID},
+		{Content: "I like Go and #rust", UserID: jane.ID},
+		{Content: "the big world of go", UserID: john.ID},
+		{Content: "a world that is big", UserID: jane.ID},
+	}
+	for _, tweet := range tweets {
+		ts.Require().NoError(t.Create(ctx, tweet))
+	}
+	ts.Require().NoError(h.Attach(ctx, tweets[1].ID.String(), []string{"rust"}))
+
+	search := func(q entities.TweetQuery, beforeID string, offset, limit int) []uuid.UUID {
+		found, err := t.Search(ctx, q, beforeID, offset, limit, nil)
+		ts.Require().NoError(err)
+		ids := make([]uuid.UUID, 0, len(found))
+		for _, tweet := range found {
+			ids = append(ids, tweet.ID)
+		}
+		return ids
+	}
+
+	// The tweet using the word most often ranks first
+	relevance := entities.TweetQuery{Terms: []string{"go"}, Order: entities.SearchOrderRelevance}
+	ts.Require().Equal(tweets[0].ID, search(relevance, "", 0, 1)[0])
+	ts.Require().Len(search(relevance, "", 1, 10), 2)
+
+	recency := entities.TweetQuery{Terms: []string{"go"}, Order: entities.SearchOrderRecency}
+	ts.Require().Equal([]uuid.UUID{tweets[2].ID, tweets[1].ID}, search(recency, "", 0, 2))
+	ts.Require().Equal([]uuid.UUID{tweets[0].ID}, search(recency, tweets[1].ID.String(), 0, 2))
+
+	ts.Require().Equal([]uuid.UUID{tweets[2].ID},
+		search(entities.TweetQuery{Phrases: []string{"big world"}}, "", 0, 10))
+	ts.Require().Equal([]uuid.UUID{tweets[2].ID},
+		search(entities.TweetQuery{Terms: []string{"go"}, Excluded: []string{"gopher", "like"}}, "", 0, 10))
+	ts.Require().Equal([]uuid.UUID{tweets[3].ID, tweets[1].ID},
+		search(entities.TweetQuery{FromUsername: "JANE", Order: entities.SearchOrderRecency}, "", 0, 10))
+	ts.Require().Equal([]uuid.UUID{tweets[1].ID},
+		search(entities.TweetQuery{Hashtags: []string{"rust"}}, "", 0, 10))
+}
+
+func (ts *TweetsTestSuite) TestRetweets() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	user := &entities.User{Username: "test", Email: "test@test.com"}
+	ts.Require().NoError(u.Create(ctx, user))
+	original := &entities.Tweet{Content: "Hello", UserID: user.ID}
+	ts.Require().NoError(t.Create(ctx, original))
+	ts.Require().Equal(entities.TweetKindTweet, original.Kind)
+	retweet := &entities.Tweet{UserID: user.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID}
+	ts.Require().NoError(t.Create(ctx, retweet))
+	quote := &entities.Tweet{
+		Content: "So true", UserID: user.ID, Kind: entities.TweetKindQuote, ReferencedTweetID: &original.ID,
+	}
+	ts.Require().NoError(t.Create(ctx, quote))
+
+	// The partial unique index rejects a second live retweet
+	{
+		err := t.Create(ctx, &entities.Tweet{
+			UserID: user.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID,
+		})
+		ts.Require().ErrorIs(err, repository.ErrAlreadyExists)
+	}
+	// The check constraint rejects a retweet without a reference
+	{
+		err := t.Create(ctx, &entities.Tweet{UserID: user.ID, Kind: entities.TweetKindRetweet})
+		ts.Require().Error(err)
+	}
+	// References round-trip
+	{
+		found, err := t.FindRetweet(ctx, user.ID.String(), original.ID.String())
+		ts.Require().NoError(err)
+		ts.Require().Equal(retweet.ID, found.ID)
+		ts.Require().Equal(original.ID, *found.ReferencedTweetID)
+		tweets, err := t.FindByIDs(ctx, []string{original.ID.String(), uuid.NewString()})
+		ts.Require().NoError(err)
+		ts.Require().Len(tweets, 1)
+	}
+	// Counts per kind
+	{
+		ids := []string{original.ID.String()}
+		retweets, err := t.CountReferences(ctx, ids, entities.TweetKindRetweet)
+		ts.Require().NoError(err)
+		ts.Require().Equal(1, retweets[original.ID.String()])
+		quotes, err := t.CountReferences(ctx, ids, entities.TweetKindQuote)
+		ts.Require().NoError(err)
+		ts.Require().Equal(1, quotes[original.ID.String()])
+	}
+	// Deleting the retweet allows retweeting again
+	{
+		ts.Require().NoError(t.Delete(ctx, retweet.ID.String()))
+		_, err := t.FindRetweet(ctx, user.ID.String(), original.ID.String())
+		ts.Require().ErrorIs(err, repository.ErrNotFound)
+		ts.Require().NoError(t.Create(ctx, &entities.Tweet{
+			UserID: user.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID,
+		}))
+	}
+}
+
+func (ts *TweetsTestSuite) TestThread() {
+	ctx := context.Background()
+	t := postgres.NewTweetStorage(ts.s.DB())
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	user := &entities.User{Username: "test", Email: "test@test.com"}
+	ts.Require().NoError(u.Create(ctx, user))
+	root := &entities.Tweet{Content: "root", UserID: user.ID}
+	ts.Require().NoError(t.Create(ctx, root))
+	ts.Require().Equal(root.ID, root.ConversationID)
+	reply := func(parent *entities.Tweet, content string) *entities.Tweet {
+		tweet := &entities.Tweet{
+			Content: content, UserID: user.ID, InReplyToTweetID: &parent.ID, ConversationID: parent.ConversationID,
+		}
+		ts.Require().NoError(t.Create(ctx, tweet))
+		return tweet
+	}
+	first := reply(root, "first")
+	reply(root, "second")
+	nested := reply(first, "reply to first")
+	reply(nested, "deepest")
+
+	contents := func(maxDepth int) []string {
+		tweets, err := t.FindThread(ctx, root.ID.String(), maxDepth, nil)
+		ts.Require().NoError(err)
+		var got []string
+		for _, tweet := range tweets {
+			ts.Require().Equal(root.ID, tweet.ConversationID)
+			got = append(got, tweet.Content)
+		}
+		return got
+	}
+	// The recursive CTE returns the tree depth first, siblings oldest first
+	ts.Require().Equal([]string{"root", "first", "reply to first", "deepest", "second"}, contents(10))
+	ts.Require().Equal([]string{"root", "first", "reply to first", "second"}, contents(2))
+
+	// Deleting a reply hides the replies below it
+	ts.Require().NoError(t.Delete(ctx, first.ID.String()))
+	ts.Require().Equal([]string{"root", "second"}, contents(10))
+
+	// The foreign key rejects replies to unknown tweets
+	unknownID := uuid.New()
+	ts.Require().Error(t.Create(ctx, &entities.Tweet{Content: "x", UserID: user.ID, InReplyToTweetID: &unknownID}))
+
+	_, err := t.FindThread(ctx, unknownID.String(), 10, nil)
+	ts.Require().ErrorIs(err, repository.ErrNotFound)
 }
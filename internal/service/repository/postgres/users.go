@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aarondl/opt/omit"
 	"github.com/aarondl/opt/omitnull"
@@ -12,9 +13,11 @@ import (
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
 
 	"github.com/ricleal/twitter-clone/internal/entities"
 	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/dberrors"
 	"github.com/ricleal/twitter-clone/internal/service/repository/postgres/models"
 )
 
@@ -42,38 +45,56 @@ func (s *UserStorage) Create(ctx context.Context, u *entities.User) error {
 		Email:    omit.From(u.Email),
 		Name:     omitnull.From(u.Name),
 	}
+	if u.PasswordHash != "" {
+		setter.PasswordHash = omitnull.From(u.PasswordHash)
+	}
 
-	_, err = models.Users.Insert(setter).One(ctx, s.dbConn)
+	row, err := models.Users.Insert(setter).One(ctx, s.dbConn)
 	if err != nil {
+		if conflict := userConflict(err); conflict != nil {
+			return conflict
+		}
 		return fmt.Errorf("failed to insert user: %w", err)
 	}
 
+	// created_at and updated_at are filled in by the database
+	u.ID = id
+	u.CreatedAt = row.CreatedAt.GetOrZero()
+	u.UpdatedAt = row.UpdatedAt.GetOrZero()
 	return nil
 }
 
 // FindAll returns all users.
 func (s *UserStorage) FindAll(ctx context.Context) ([]entities.User, error) {
-	ormUsers, err := models.Users.Query().All(ctx, s.dbConn)
+	ormUsers, err := models.Users.Query(
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+	).All(ctx, s.dbConn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find all users: %w", err)
 	}
 
-	users := make([]entities.User, 0, len(ormUsers))
-	for _, u := range ormUsers {
-		users = append(users, entities.User{
-			ID:       uuid.MustParse(u.ID),
-			Username: u.Username,
-			Email:    u.Email,
-			Name:     u.Name.GetOrZero(),
-		})
+	return toUsers(ormUsers), nil
+}
+
+// FindPage returns at most limit users created before beforeID, newest first.
+func (s *UserStorage) FindPage(ctx context.Context, beforeID string, limit int) ([]entities.User, error) {
+	ormRows, err := models.Users.Query(append(
+		pageMods(models.Users.Columns.ID, beforeID, limit),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+	)...).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users page: %w", err)
 	}
 
-	return users, nil
+	return toUsers(ormRows), nil
 }
 
 // FindByID returns a user by ID.
 func (s *UserStorage) FindByID(ctx context.Context, id string) (*entities.User, error) {
-	ormUser, err := models.FindUser(ctx, s.dbConn, id)
+	ormUser, err := models.Users.Query(
+		sm.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
+	).One(ctx, s.dbConn)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrNotFound
@@ -81,18 +102,16 @@ func (s *UserStorage) FindByID(ctx context.Context, id string) (*entities.User,
 		return nil, fmt.Errorf("failed to find user by id: %w", err)
 	}
 
-	return &entities.User{
-		ID:       uuid.MustParse(ormUser.ID),
-		Username: ormUser.Username,
-		Email:    ormUser.Email,
-		Name:     ormUser.Name.GetOrZero(),
-	}, nil
+	u := toUser(ormUser)
+	return &u, nil
 }
 
-// FindByUsername returns a user by username.
+// FindByUsername returns a user by username, ignoring case. The lookup is
+// served by users_username_lower_key.
 func (s *UserStorage) FindByUsername(ctx context.Context, username string) (*entities.User, error) {
 	ormUser, err := models.Users.Query(
-		sm.Where(models.Users.Columns.Username.EQ(psql.Arg(username))),
+		sm.Where(psql.F("lower", models.Users.Columns.Username)().EQ(psql.F("lower", psql.Arg(username))())),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
 	).One(ctx, s.dbConn)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -101,18 +120,15 @@ func (s *UserStorage) FindByUsername(ctx context.Context, username string) (*ent
 		return nil, fmt.Errorf("failed to find user by username: %w", err)
 	}
 
-	return &entities.User{
-		ID:       uuid.MustParse(ormUser.ID),
-		Username: ormUser.Username,
-		Email:    ormUser.Email,
-		Name:     ormUser.Name.GetOrZero(),
-	}, nil
+	u := toUser(ormUser)
+	return &u, nil
 }
 
 // FindByEmail returns a user by email.
 func (s *UserStorage) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	ormUser, err := models.Users.Query(
 		sm.Where(models.Users.Columns.Email.EQ(psql.Arg(email))),
+		sm.Where(models.Users.Columns.DeletedAt.IsNull()),
 	).One(ctx, s.dbConn)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -121,10 +137,138 @@ func (s *UserStorage) FindByEmail(ctx context.Context, email string) (*entities.
 		return nil, fmt.Errorf("failed to find user by email: %w", err)
 	}
 
-	return &entities.User{
-		ID:       uuid.MustParse(ormUser.ID),
-		Username: ormUser.Username,
-		Email:    ormUser.Email,
-		Name:     ormUser.Name.GetOrZero(),
-	}, nil
+	u := toUser(ormUser)
+	return &u, nil
+}
+
+// Update applies a partial update to a user.
+func (s *UserStorage) Update(ctx context.Context, id string, upd entities.UserUpdate) (*entities.User, error) {
+	setter := models.UserSetter{
+		Username: omit.FromPtr(upd.Username),
+		Email:    omit.FromPtr(upd.Email),
+	}
+	if upd.Name != nil {
+		setter.Name = omitnull.From(*upd.Name)
+	}
+
+	ormUser, err := models.Users.Update(
+		setter.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Users.Columns.DeletedAt.IsNull()),
+	).One(ctx, s.dbConn)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrNotFound
+		}
+		if conflict := userConflict(err); conflict != nil {
+			return nil, conflict
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	u := toUser(ormUser)
+	return &u, nil
+}
+
+// UpdatePasswordHash replaces the password hash of a user.
+func (s *UserStorage) UpdatePasswordHash(ctx context.Context, id, hash string) error {
+	rows, err := models.Users.Update(
+		models.UserSetter{PasswordHash: omitnull.From(hash)}.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Users.Columns.DeletedAt.IsNull()),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to update password hash: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete soft-deletes a user.
+func (s *UserStorage) Delete(ctx context.Context, id string) error {
+	rows, err := models.Users.Update(
+		models.UserSetter{DeletedAt: omitnull.From(time.Now())}.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Users.Columns.DeletedAt.IsNull()),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindDeleted returns the soft-deleted users, most recently deleted first.
+func (s *UserStorage) FindDeleted(ctx context.Context) ([]entities.User, error) {
+	ormUsers, err := models.Users.Query(
+		sm.Where(models.Users.Columns.DeletedAt.IsNotNull()),
+		sm.OrderBy(models.Users.Columns.DeletedAt).Desc(),
+	).All(ctx, s.dbConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deleted users: %w", err)
+	}
+
+	return toUsers(ormUsers), nil
+}
+
+// Restore undoes the soft delete of a user.
+func (s *UserStorage) Restore(ctx context.Context, id string) error {
+	rows, err := models.Users.Update(
+		models.UserSetter{DeletedAt: omitnull.FromPtr[time.Time](nil)}.UpdateMod(),
+		um.Where(models.Users.Columns.ID.EQ(psql.Arg(id))),
+		um.Where(models.Users.Columns.DeletedAt.IsNotNull()),
+	).Exec(ctx, s.dbConn)
+	if err != nil {
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// userConflict returns a *repository.ConflictError if err was caused by one of
+// the unique constraints on users, nil otherwise.
+func userConflict(err error) error {
+	switch {
+	case isUniqueViolation(err, dberrors.UserErrors.ErrUniqueUsersUsernameKey):
+		return &repository.ConflictError{Field: "username"}
+	case isUniqueViolation(err, dberrors.UserErrors.ErrUniqueUsersEmailKey):
+		return &repository.ConflictError{Field: "email"}
+	case isUniqueViolation(err, dberrors.ErrUniqueConstraint):
+		// users_username_lower_key is an index rather than a constraint, so
+		// bob generates no error for it
+		return &repository.ConflictError{Field: "username"}
+	}
+	return nil
+}
+
+// toUser converts a bob user model to a domain user.
+func toUser(u *models.User) entities.User {
+	return entities.User{
+		ID:           uuid.MustParse(u.ID),
+		Username:     u.Username,
+		Email:        u.Email,
+		Name:         u.Name.GetOrZero(),
+		PasswordHash: u.PasswordHash.GetOrZero(),
+		CreatedAt:    u.CreatedAt.GetOrZero(),
+		UpdatedAt:    u.UpdatedAt.GetOrZero(),
+		DeletedAt:    u.DeletedAt.Ptr(),
+	}
+}
+
+// toUsers converts bob user models to domain users.
+func toUsers(ormUsers models.UserSlice) []entities.User {
+	users := make([]entities.User, 0, len(ormUsers))
+	for _, u := range ormUsers {
+		users = append(users, toUser(u))
+	}
+	return users
 }
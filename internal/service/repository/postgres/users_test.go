@@ -0,0 +1,129 @@
+//go:build integration
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/repository/postgres"
+)
+
+type UsersTestSuite struct {
+	storageSuite
+}
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestUsersTestSuite(t *testing.T) {
+	suite.Run(t, new(UsersTestSuite))
+}
+
+func (ts *UsersTestSuite) TestUpdateUser() {
+	ctx := context.Background()
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	for _, user := range []*entities.User{john, jane} {
+		err := u.Create(ctx, user)
+		ts.Require().NoError(err)
+	}
+
+	name := "John Doe"
+	updated, err := u.Update(ctx, john.ID.String(), entities.UserUpdate{Name: &name})
+	ts.Require().NoError(err)
+	ts.Require().Equal(name, updated.Name)
+	ts.Require().Equal("john", updated.Username)
+	ts.Require().True(updated.UpdatedAt.After(updated.CreatedAt))
+
+	var conflict *repository.ConflictError
+	_, err = u.Update(ctx, john.ID.String(), entities.UserUpdate{Username: &jane.Username})
+	ts.Require().ErrorAs(err, &conflict)
+	ts.Require().Equal("username", conflict.Field)
+	_, err = u.Update(ctx, john.ID.String(), entities.UserUpdate{Email: &jane.Email})
+	ts.Require().ErrorAs(err, &conflict)
+	ts.Require().Equal("email", conflict.Field)
+
+	// Create reports conflicts the same way
+	err = u.Create(ctx, &entities.User{Username: "john", Email: "other@test.com"})
+	ts.Require().ErrorAs(err, &conflict)
+	ts.Require().Equal("username", conflict.Field)
+	ts.Require().ErrorIs(err, repository.ErrAlreadyExists)
+	_, err = u.Update(ctx, uuid.NewString(), entities.UserUpdate{Name: &name})
+	ts.Require().ErrorIs(err, repository.ErrNotFound)
+
+	// Usernames are unique and looked up regardless of case
+	err = u.Create(ctx, &entities.User{Username: "JOHN", Email: "other@test.com"})
+	ts.Require().ErrorAs(err, &conflict)
+	ts.Require().Equal("username", conflict.Field)
+	found, err := u.FindByUsername(ctx, "JoHn")
+	ts.Require().NoError(err)
+	ts.Require().Equal(john.ID, found.ID)
+}
+
+func (ts *UsersTestSuite) TestSearchUsers() {
+	ctx := context.Background()
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com", Name: "John Smith"}
+	johnny := &entities.User{Username: "johnny", Email: "johnny@test.com"}
+	gone := &entities.User{Username: "johm", Email: "johm@test.com"}
+	for _, user := range []*entities.User{john, johnny, gone} {
+		ts.Require().NoError(u.Create(ctx, user))
+	}
+	ts.Require().NoError(u.Delete(ctx, gone.ID.String()))
+
+	search := func(query string, offset, limit int) []uuid.UUID {
+		found, err := u.Search(ctx, query, offset, limit)
+		ts.Require().NoError(err)
+		ids := make([]uuid.UUID, 0, len(found))
+		for _, user := range found {
+			ids = append(ids, user.ID)
+		}
+		return ids
+	}
+
+	// A typo still finds the closest username first
+	ts.Require().Equal([]uuid.UUID{john.ID, johnny.ID}, search("JOHM", 0, 10))
+	ts.Require().Equal([]uuid.UUID{johnny.ID}, search("johm", 1, 10))
+	// Substrings too short to be similar match outright
+	ts.Require().Equal([]uuid.UUID{johnny.ID}, search("hnn", 0, 10))
+	ts.Require().Equal([]uuid.UUID{john.ID}, search("smith", 0, 10))
+	// LIKE wildcards are taken literally
+	ts.Require().Empty(search("j_hn", 0, 10))
+}
+
+func (ts *UsersTestSuite) TestPasswordHash() {
+	ctx := context.Background()
+	u := postgres.NewUserStorage(ts.s.DB())
+
+	john := &entities.User{Username: "john", Email: "john@test.com", PasswordHash: "old"}
+	jane := &entities.User{Username: "jane", Email: "jane@test.com"}
+	for _, user := range []*entities.User{john, jane} {
+		err := u.Create(ctx, user)
+		ts.Require().NoError(err)
+	}
+
+	found, err := u.FindByUsername(ctx, "john")
+	ts.Require().NoError(err)
+	ts.Require().Equal("old", found.PasswordHash)
+	found, err = u.FindByUsername(ctx, "jane")
+	ts.Require().NoError(err)
+	ts.Require().Empty(found.PasswordHash)
+
+	err = u.UpdatePasswordHash(ctx, john.ID.String(), "new")
+	ts.Require().NoError(err)
+	found, err = u.FindByID(ctx, john.ID.String())
+	ts.Require().NoError(err)
+	ts.Require().Equal("new", found.PasswordHash)
+
+	err = u.UpdatePasswordHash(ctx, uuid.NewString(), "new")
+	ts.Require().ErrorIs(err, repository.ErrNotFound)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+)
+
+// fromPrefix restricts a search to the tweets of one user, as in "from:jane".
+const fromPrefix = "from:"
+
+// ParseTweetQuery parses a search query. Whitespace separates words, which
+// must all appear in the tweets found, and the operators below:
+//
+//   - "exact phrase" matches the words in that order;
+//   - -word and -"some phrase" exclude the tweets containing them;
+//   - from:username keeps the tweets of that user, matched regardless of case;
+//   - #tag keeps the tweets using that hashtag.
+//
+// Punctuation inside words separates them, as it does in tweets. The query
+// must look for something besides excluded words. The returned query is
+// ordered by relevance.
+func ParseTweetQuery(query string) (entities.TweetQuery, error) {
+	q := entities.TweetQuery{Order: entities.SearchOrderRelevance}
+	for rest := strings.TrimSpace(query); rest != ""; rest = strings.TrimLeftFunc(rest, unicode.IsSpace) {
+		excluded := false
+		if len(rest) > 1 && rest[0] == '-' {
+			if r, _ := utf8.DecodeRuneInString(rest[1:]); !unicode.IsSpace(r) {
+				excluded = true
+				rest = rest[1:]
+			}
+		}
+
+		var words []string
+		quoted := rest[0] == '"'
+		if quoted {
+			phrase, after, _ := strings.Cut(rest[1:], `"`)
+			words, rest = searchWords(phrase), after
+		} else {
+			end := strings.IndexFunc(rest, unicode.IsSpace)
+			if end < 0 {
+				end = len(rest)
+			}
+			var tok string
+			tok, rest = rest[:end], rest[end:]
+			if !excluded && parseOperator(&q, tok) {
+				continue
+			}
+			words = searchWords(tok)
+		}
+
+		switch {
+		case len(words) == 0:
+		case excluded:
+			q.Excluded = appendUnique(q.Excluded, strings.Join(words, " "))
+		case quoted && len(words) > 1:
+			q.Phrases = appendUnique(q.Phrases, strings.Join(words, " "))
+		default:
+			for _, w := range words {
+				q.Terms = appendUnique(q.Terms, w)
+			}
+		}
+	}
+	if len(q.Terms) == 0 && len(q.Phrases) == 0 && len(q.Hashtags) == 0 && q.FromUsername == "" {
+		return entities.TweetQuery{}, entities.ErrEmptySearchQuery
+	}
+	return q, nil
+}
+
+// parseOperator adds the from: or #tag operator tok to q. It reports false
+// when tok is neither, leaving it to be read as words.
+func parseOperator(q *entities.TweetQuery, tok string) bool {
+	if len(tok) > len(fromPrefix) && strings.EqualFold(tok[:len(fromPrefix)], fromPrefix) {
+		q.FromUsername = tok[len(fromPrefix):]
+		return true
+	}
+	if r, _ := utf8.DecodeRuneInString(tok); isHashSign(r) {
+		tag, err := parseHashtag(tok)
+		if err != nil {
+			return false
+		}
+		q.Hashtags = appendUnique(q.Hashtags, tag)
+		return true
+	}
+	return false
+}
+
+// searchWords splits s into lowercased words of word runes.
+func searchWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool { return !isWordRune(r) })
+}
+
+func appendUnique(values []string, v string) []string {
+	if slices.Contains(values, v) {
+		return values
+	}
+	return append(values, v)
+}
@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestParseTweetQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  entities.TweetQuery
+	}{
+		{"words", "Hello,  World hello", entities.TweetQuery{Terms: []string{"hello", "world"}}},
+		{"phrase", `"Hello World" again`, entities.TweetQuery{
+			Terms: []string{"again"}, Phrases: []string{"hello world"},
+		}},
+		{"one word phrase", `"hello"`, entities.TweetQuery{Terms: []string{"hello"}}},
+		{"unterminated phrase", `"hello world`, entities.TweetQuery{Phrases: []string{"hello world"}}},
+		{"exclusions", `go -rust -"big words" - x`, entities.TweetQuery{
+			Terms: []string{"go", "x"}, Excluded: []string{"rust", "big words"},
+		}},
+		{"from", "From:Jane hi", entities.TweetQuery{Terms: []string{"hi"}, FromUsername: "Jane"}},
+		{"hashtags", "#Go #go #123", entities.TweetQuery{Terms: []string{"123"}, Hashtags: []string{"go"}}},
+		{"excluded hashtag", "hi -#go", entities.TweetQuery{Terms: []string{"hi"}, Excluded: []string{"go"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Order = entities.SearchOrderRelevance
+			got, err := service.ParseTweetQuery(tt.query)
+			if err != nil {
+				t.Fatalf("Error parsing %q: %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTweetQuery(%q) = %+v, want %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+
+	for _, query := range []string{"", "   ", "-go", `-"big words"`, "!?"} {
+		if _, err := service.ParseTweetQuery(query); !errors.Is(err, entities.ErrEmptySearchQuery) {
+			t.Errorf("Expected ErrEmptySearchQuery for %q, got %v", query, err)
+		}
+	}
+}
+
+func TestTweetSearch(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	createTweet(t, st, john.ID, "go go go, said the gopher")
+	createTweet(t, st, jane.ID, "I like Go and #rust")
+	createTweet(t, st, john.ID, "the big world of go")
+	createTweet(t, st, jane.ID, "a world that is big")
+
+	search := func(query string, order entities.SearchOrder, cursor string, limit int) ([]string, string) {
+		t.Helper()
+		page, err := st.Search(t.Context(), query, order, "", cursor, limit)
+		if err != nil {
+			t.Fatalf("Error searching %q: %v", query, err)
+		}
+		contents := make([]string, 0, len(page.Items))
+		for _, tweet := range page.Items {
+			contents = append(contents, tweet.Content)
+		}
+		return contents, page.NextCursor
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		order entities.SearchOrder
+		want  []string
+	}{
+		{"by relevance", "go", "", []string{"go go go, said the gopher", "the big world of go", "I like Go and #rust"}},
+		{"by recency", "go", entities.SearchOrderRecency, []string{
+			"the big world of go", "I like Go and #rust", "go go go, said the gopher",
+		}},
+		{"all words", "big world", "", []string{"a world that is big", "the big world of go"}},
+		{"phrase", `"big world"`, "", []string{"the big world of go"}},
+		{"exclusion", "go -gopher -like", "", []string{"the big world of go"}},
+		{"from", "go from:JOHN", entities.SearchOrderRecency, []string{
+			"the big world of go", "go go go, said the gopher",
+		}},
+		{"hashtag", "#Rust", "", []string{"I like Go and #rust"}},
+		{"no match", "python", "", []string{}},
+		{"unknown author", "from:nobody", "", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := search(tt.query, tt.order, "", 10)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+
+	// Both orders page through all the results
+	for _, order := range []entities.SearchOrder{entities.SearchOrderRelevance, entities.SearchOrderRecency} {
+		first, cursor := search("go", order, "", 2)
+		if len(first) != 2 || cursor == "" {
+			t.Fatalf("Unexpected first %s page: %q, %q", order, first, cursor)
+		}
+		last, cursor := search("go", order, cursor, 2)
+		if len(last) != 1 || cursor != "" {
+			t.Errorf("Unexpected last %s page: %q, %q", order, last, cursor)
+		}
+	}
+
+	if _, err := st.Search(t.Context(), "-go", "", "", "", 10); !errors.Is(err, entities.ErrEmptySearchQuery) {
+		t.Errorf("Expected ErrEmptySearchQuery, got %v", err)
+	}
+	if _, err := st.Search(t.Context(), "go", "oldest", "", "", 10); !errors.Is(err, entities.ErrInvalidSearchOrder) {
+		t.Errorf("Expected ErrInvalidSearchOrder, got %v", err)
+	}
+	// A recency cursor does not page relevance-ordered results
+	_, cursor := search("go", entities.SearchOrderRecency, "", 1)
+	if _, err := st.Search(t.Context(), "go", "", "", cursor, 1); !errors.Is(err, entities.ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestUserSearch(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	for _, name := range []string{"jo", "johnny", "john", "jane"} {
+		createUser(t, su, name)
+	}
+
+	page, err := su.Search(t.Context(), "  JOHN ", "", 2)
+	if err != nil {
+		t.Fatalf("Error searching users: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Username != "john" || page.Items[1].Username != "johnny" {
+		t.Errorf("Expected john then johnny, got %+v", page.Items)
+	}
+	if page.NextCursor != "" {
+		t.Errorf("Expected no next cursor, got %q", page.NextCursor)
+	}
+
+	page, err = su.Search(t.Context(), "j", "", 3)
+	if err != nil {
+		t.Fatalf("Error searching users: %v", err)
+	}
+	if len(page.Items) != 3 || page.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v", page)
+	}
+	page, err = su.Search(t.Context(), "j", page.NextCursor, 3)
+	if err != nil {
+		t.Fatalf("Error searching users: %v", err)
+	}
+	if len(page.Items) != 1 || page.NextCursor != "" {
+		t.Errorf("Unexpected last page: %+v", page)
+	}
+
+	if _, err = su.Search(t.Context(), " ", "", 10); !errors.Is(err, entities.ErrEmptySearchQuery) {
+		t.Errorf("Expected ErrEmptySearchQuery, got %v", err)
+	}
+}
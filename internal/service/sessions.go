@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/repository"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// SessionService is a domain service for the sessions users authenticate
+// their requests with.
+type SessionService interface {
+	// Issue starts a session for u, valid for the TTL of the service.
+	Issue(ctx context.Context, u *entities.User) (entities.Session, error)
+	// Authenticate returns the live user token stands for, or
+	// entities.ErrInvalidToken.
+	Authenticate(ctx context.Context, token string) (*entities.User, error)
+}
+
+// sessionService is an implementation of the SessionService interface.
+//
+// Its tokens are stateless: the user ID and expiry, signed with HMAC-SHA256.
+// They cannot be revoked one by one before they expire, but deleting the user
+// or changing the key ends every session at once.
+type sessionService struct {
+	store store.Store
+	key   []byte
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+// NewSessionService returns a new SessionService signing its tokens with key
+// and issuing them for ttl.
+func NewSessionService(s store.Store, key []byte, ttl time.Duration) SessionService {
+	return &sessionService{store: s, key: key, ttl: ttl, now: time.Now}
+}
+
+// Issue starts a session for u.
+func (s *sessionService) Issue(_ context.Context, u *entities.User) (entities.Session, error) {
+	expiresAt := s.now().Add(s.ttl).Truncate(time.Second)
+	payload := base64.RawURLEncoding.EncodeToString(
+		fmt.Appendf(nil, "%s|%d", u.ID, expiresAt.Unix()),
+	)
+	return entities.Session{
+		Token:     payload + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload)),
+		User:      *u,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Authenticate checks the signature and expiry of token and returns its user,
+// who must not have been deleted since.
+func (s *sessionService) Authenticate(ctx context.Context, token string) (*entities.User, error) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, entities.ErrInvalidToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.sign(payload)) {
+		return nil, entities.ErrInvalidToken
+	}
+	claims, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, entities.ErrInvalidToken
+	}
+	userID, expiry, ok := strings.Cut(string(claims), "|")
+	if !ok {
+		return nil, entities.ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || !s.now().Before(time.Unix(expiresAt, 0)) {
+		return nil, entities.ErrInvalidToken
+	}
+	if _, err = uuid.Parse(userID); err != nil {
+		return nil, entities.ErrInvalidToken
+	}
+
+	u, err := s.store.Users().FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("could not find user: %w", err)
+	}
+	return u, nil
+}
+
+// sign returns the HMAC of payload.
+func (s *sessionService) sign(payload string) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
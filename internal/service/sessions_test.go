@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestSessions(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	ss := service.NewSessionService(s, []byte("key"), time.Hour)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+
+	session, err := ss.Issue(t.Context(), &john)
+	if err != nil {
+		t.Fatalf("Error issuing session: %v", err)
+	}
+	if session.User.ID != john.ID || !session.ExpiresAt.After(time.Now()) {
+		t.Errorf("Unexpected session: %+v", session)
+	}
+	u, err := ss.Authenticate(t.Context(), session.Token)
+	if err != nil {
+		t.Fatalf("Error authenticating: %v", err)
+	}
+	if u.ID != john.ID {
+		t.Errorf("Expected John, got %+v", u)
+	}
+
+	other, err := ss.Issue(t.Context(), &jane)
+	if err != nil {
+		t.Fatalf("Error issuing session: %v", err)
+	}
+	expired, err := service.NewSessionService(s, []byte("key"), -time.Second).Issue(t.Context(), &john)
+	if err != nil {
+		t.Fatalf("Error issuing session: %v", err)
+	}
+	foreign, err := service.NewSessionService(s, []byte("other key"), time.Hour).Issue(t.Context(), &john)
+	if err != nil {
+		t.Fatalf("Error issuing session: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"malformed", "not-a-token"},
+		{"expired", expired.Token},
+		{"signed with another key", foreign.Token},
+		{"tampered", tamper(other.Token, session.Token)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ss.Authenticate(t.Context(), tt.token); !errors.Is(err, entities.ErrInvalidToken) {
+				t.Errorf("Expected ErrInvalidToken, got %v", err)
+			}
+		})
+	}
+
+	// Deleting the user ends their sessions
+	if err = su.Delete(t.Context(), john.ID.String()); err != nil {
+		t.Fatalf("Error deleting user: %v", err)
+	}
+	if _, err = ss.Authenticate(t.Context(), session.Token); !errors.Is(err, entities.ErrInvalidToken) {
+		t.Errorf("Expected ErrInvalidToken, got %v", err)
+	}
+}
+
+// tamper returns the claims of token under the signature of signed.
+func tamper(token, signed string) string {
+	claims, _, _ := strings.Cut(token, ".")
+	_, sig, _ := strings.Cut(signed, ".")
+	return claims + "." + sig
+}
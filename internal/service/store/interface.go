@@ -10,5 +10,14 @@ import (
 type Store interface {
 	Tweets() repository.TweetRepository
 	Users() repository.UserRepository
+	Follows() repository.FollowRepository
+	Likes() repository.LikeRepository
+	Hashtags() repository.HashtagRepository
+	Mentions() repository.MentionRepository
+	Bookmarks() repository.BookmarkRepository
+	Blocks() repository.BlockRepository
+	Mutes() repository.MuteRepository
+	Messages() repository.MessageRepository
+	Notifications() repository.NotificationRepository
 	ExecTx(ctx context.Context, fn func(Store) error) error
 }
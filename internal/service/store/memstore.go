@@ -31,6 +31,42 @@ func (s *memStore) Users() repository.UserRepository {
 	return memory.NewUserHandler(s.db)
 }
 
+func (s *memStore) Follows() repository.FollowRepository {
+	return memory.NewFollowHandler(s.db)
+}
+
+func (s *memStore) Likes() repository.LikeRepository {
+	return memory.NewLikeHandler(s.db)
+}
+
+func (s *memStore) Hashtags() repository.HashtagRepository {
+	return memory.NewHashtagHandler(s.db)
+}
+
+func (s *memStore) Mentions() repository.MentionRepository {
+	return memory.NewMentionHandler(s.db)
+}
+
+func (s *memStore) Bookmarks() repository.BookmarkRepository {
+	return memory.NewBookmarkHandler(s.db)
+}
+
+func (s *memStore) Blocks() repository.BlockRepository {
+	return memory.NewBlockHandler(s.db)
+}
+
+func (s *memStore) Mutes() repository.MuteRepository {
+	return memory.NewMuteHandler(s.db)
+}
+
+func (s *memStore) Messages() repository.MessageRepository {
+	return memory.NewMessageHandler(s.db)
+}
+
+func (s *memStore) Notifications() repository.NotificationRepository {
+	return memory.NewNotificationHandler(s.db)
+}
+
 // ExecTx runs fn directly without isolation — go-memdb does not support
 // nested transactions. The in-memory store is intended for testing only.
 func (s *memStore) ExecTx(_ context.Context, fn func(Store) error) error {
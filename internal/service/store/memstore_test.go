@@ -30,6 +30,105 @@ func TestMemStoreUsers(t *testing.T) {
 	}
 }
 
+func TestMemStoreFollows(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned FollowRepository is the memory implementation
+	followRepo := memStore.Follows()
+	_, ok := followRepo.(*memory.FollowHandler)
+	if !ok {
+		t.Error("Expected FollowRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreLikes(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned LikeRepository is the memory implementation
+	likeRepo := memStore.Likes()
+	_, ok := likeRepo.(*memory.LikeHandler)
+	if !ok {
+		t.Error("Expected LikeRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreHashtags(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned HashtagRepository is the memory implementation
+	hashtagRepo := memStore.Hashtags()
+	_, ok := hashtagRepo.(*memory.HashtagHandler)
+	if !ok {
+		t.Error("Expected HashtagRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreMentions(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned MentionRepository is the memory implementation
+	mentionRepo := memStore.Mentions()
+	_, ok := mentionRepo.(*memory.MentionHandler)
+	if !ok {
+		t.Error("Expected MentionRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreBookmarks(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned BookmarkRepository is the memory implementation
+	bookmarkRepo := memStore.Bookmarks()
+	_, ok := bookmarkRepo.(*memory.BookmarkHandler)
+	if !ok {
+		t.Error("Expected BookmarkRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreBlocks(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned BlockRepository is the memory implementation
+	blockRepo := memStore.Blocks()
+	_, ok := blockRepo.(*memory.BlockHandler)
+	if !ok {
+		t.Error("Expected BlockRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreMutes(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned MuteRepository is the memory implementation
+	muteRepo := memStore.Mutes()
+	_, ok := muteRepo.(*memory.MuteHandler)
+	if !ok {
+		t.Error("Expected MuteRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreMessages(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned MessageRepository is the memory implementation
+	messageRepo := memStore.Messages()
+	_, ok := messageRepo.(*memory.MessageHandler)
+	if !ok {
+		t.Error("Expected MessageRepository to be a memory implementation")
+	}
+}
+
+func TestMemStoreNotifications(t *testing.T) {
+	memStore := store.NewMemStore()
+
+	// Ensure the returned NotificationRepository is the memory implementation
+	notificationRepo := memStore.Notifications()
+	_, ok := notificationRepo.(*memory.NotificationHandler)
+	if !ok {
+		t.Error("Expected NotificationRepository to be a memory implementation")
+	}
+}
+
 func TestMemStoreExecTx_Success(t *testing.T) {
 	memStore := store.NewMemStore()
 
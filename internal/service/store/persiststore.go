@@ -36,6 +36,51 @@ func (s *persistentStore) Users() repository.UserRepository {
 	return postgres.NewUserStorage(s.db)
 }
 
+// Follows returns a FollowRepository for managing the follow graph.
+func (s *persistentStore) Follows() repository.FollowRepository {
+	return postgres.NewFollowStorage(s.db)
+}
+
+// Likes returns a LikeRepository for managing likes of tweets.
+func (s *persistentStore) Likes() repository.LikeRepository {
+	return postgres.NewLikeStorage(s.db)
+}
+
+// Hashtags returns a HashtagRepository for managing hashtags of tweets.
+func (s *persistentStore) Hashtags() repository.HashtagRepository {
+	return postgres.NewHashtagStorage(s.db)
+}
+
+// Mentions returns a MentionRepository for managing users mentioned in tweets.
+func (s *persistentStore) Mentions() repository.MentionRepository {
+	return postgres.NewMentionStorage(s.db)
+}
+
+// Bookmarks returns a BookmarkRepository for managing the bookmarks of users.
+func (s *persistentStore) Bookmarks() repository.BookmarkRepository {
+	return postgres.NewBookmarkStorage(s.db)
+}
+
+// Blocks returns a BlockRepository for managing the users blocking others.
+func (s *persistentStore) Blocks() repository.BlockRepository {
+	return postgres.NewBlockStorage(s.db)
+}
+
+// Mutes returns a MuteRepository for managing the users muting others.
+func (s *persistentStore) Mutes() repository.MuteRepository {
+	return postgres.NewMuteStorage(s.db)
+}
+
+// Messages returns a MessageRepository for managing the conversations between users.
+func (s *persistentStore) Messages() repository.MessageRepository {
+	return postgres.NewMessageStorage(s.db)
+}
+
+// Notifications returns a NotificationRepository for managing the notifications of users.
+func (s *persistentStore) Notifications() repository.NotificationRepository {
+	return postgres.NewNotificationStorage(s.db)
+}
+
 // ExecTx executes fn within a database transaction.
 func (s *persistentStore) ExecTx(ctx context.Context, fn func(Store) error) error {
 	err := s.db.RunInTx(ctx, nil, func(_ context.Context, tx bob.Executor) error {
@@ -55,6 +100,42 @@ func (s *persistentStoreTx) Users() repository.UserRepository {
 	return postgres.NewUserStorage(s.db)
 }
 
+func (s *persistentStoreTx) Follows() repository.FollowRepository {
+	return postgres.NewFollowStorage(s.db)
+}
+
+func (s *persistentStoreTx) Likes() repository.LikeRepository {
+	return postgres.NewLikeStorage(s.db)
+}
+
+func (s *persistentStoreTx) Hashtags() repository.HashtagRepository {
+	return postgres.NewHashtagStorage(s.db)
+}
+
+func (s *persistentStoreTx) Mentions() repository.MentionRepository {
+	return postgres.NewMentionStorage(s.db)
+}
+
+func (s *persistentStoreTx) Bookmarks() repository.BookmarkRepository {
+	return postgres.NewBookmarkStorage(s.db)
+}
+
+func (s *persistentStoreTx) Blocks() repository.BlockRepository {
+	return postgres.NewBlockStorage(s.db)
+}
+
+func (s *persistentStoreTx) Mutes() repository.MuteRepository {
+	return postgres.NewMuteStorage(s.db)
+}
+
+func (s *persistentStoreTx) Messages() repository.MessageRepository {
+	return postgres.NewMessageStorage(s.db)
+}
+
+func (s *persistentStoreTx) Notifications() repository.NotificationRepository {
+	return postgres.NewNotificationStorage(s.db)
+}
+
 // ExecTx on a transaction-scoped store runs fn directly — nested transactions
 // are not supported by the underlying driver.
 func (s *persistentStoreTx) ExecTx(_ context.Context, fn func(Store) error) error {
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// StreamService is a domain service for the live events of the application.
+type StreamService interface {
+	// Subscribe returns a subscription to new tweets and, when viewerID is not
+	// empty, to the notifications of viewerID, and the events after lastEventID
+	// still kept for replay.
+	Subscribe(ctx context.Context, viewerID string, lastEventID uint64) (*events.Subscription, []events.Event, error)
+	// SubscribeHome returns a subscription to the new tweets of the home
+	// timeline of userID.
+	SubscribeHome(ctx context.Context, userID string) (*events.Subscription, error)
+	// SubscribeHashtag returns a subscription to the new tweets tagged with tag
+	// that viewerID may see.
+	SubscribeHashtag(ctx context.Context, tag, viewerID string) (*events.Subscription, error)
+	// SubscribeConversation returns a subscription to the new messages of
+	// conversationID, and to the other members typing. userID must be a member
+	// of the conversation.
+	SubscribeConversation(ctx context.Context, conversationID, userID string) (*events.Subscription, error)
+	// Typing tells the other members of conversationID that userID, a member
+	// too, is typing.
+	Typing(ctx context.Context, conversationID, userID string) error
+}
+
+// streamService is an implementation of the StreamService interface.
+type streamService struct {
+	store  store.Store
+	broker *events.Broker
+}
+
+// NewStreamService returns a new StreamService subscribing to b.
+func NewStreamService(s store.Store, b *events.Broker) StreamService {
+	return &streamService{s, b}
+}
+
+// Subscribe subscribes viewerID to the events meant for them. Tweets by users
+// blocking, blocked by or muted by viewerID when the subscription starts are
+// left out.
+func (s *streamService) Subscribe(
+	ctx context.Context,
+	viewerID string,
+	lastEventID uint64,
+) (*events.Subscription, []events.Event, error) {
+	var hidden []string
+	if viewerID != "" {
+		if err := checkUserExists(ctx, s.store.Users(), viewerID); err != nil {
+			return nil, nil, err
+		}
+		var err error
+		if hidden, err = hiddenAuthors(ctx, s.store, viewerID, true); err != nil {
+			return nil, nil, err
+		}
+	}
+	sub, missed, err := s.broker.Subscribe(lastEventID, func(e events.Event) bool {
+		switch e.Kind {
+		case events.KindTweet:
+			return !slices.Contains(hidden, e.Tweet.UserID.String())
+		case events.KindNotification:
+			return viewerID != "" && e.UserID == viewerID
+		}
+		return false
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not subscribe: %w", err)
+	}
+	return sub, missed, nil
+}
+
+// SubscribeHome subscribes userID to the tweets by themselves and the users
+// they follow, leaving out the users they mute. Follows and mutes are those of
+// when the subscription starts.
+func (s *streamService) SubscribeHome(ctx context.Context, userID string) (*events.Subscription, error) {
+	if err := checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return nil, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	following, err := s.store.Follows().FindFollowing(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not find following: %w", err)
+	}
+	authors := []string{userID}
+	for _, u := range following {
+		if id := u.ID.String(); !slices.Contains(hidden, id) {
+			authors = append(authors, id)
+		}
+	}
+	return s.subscribe(func(e events.Event) bool {
+		return e.Kind == events.KindTweet && slices.Contains(authors, e.Tweet.UserID.String())
+	})
+}
+
+// SubscribeHashtag subscribes viewerID, who may be empty, to the tweets tagged
+// with tag. Tweets by users blocking, blocked by or muted by viewerID when the
+// subscription starts are left out.
+func (s *streamService) SubscribeHashtag(ctx context.Context, tag, viewerID string) (*events.Subscription, error) {
+	tag, err := parseHashtag(tag)
+	if err != nil {
+		return nil, err
+	}
+	var hidden []string
+	if viewerID != "" {
+		if err = checkUserExists(ctx, s.store.Users(), viewerID); err != nil {
+			return nil, err
+		}
+		if hidden, err = hiddenAuthors(ctx, s.store, viewerID, true); err != nil {
+			return nil, err
+		}
+	}
+	return s.subscribe(func(e events.Event) bool {
+		return e.Kind == events.KindTweet &&
+			!slices.Contains(hidden, e.Tweet.UserID.String()) &&
+			slices.Contains(ExtractHashtags(e.Tweet.Content), tag)
+	})
+}
+
+// SubscribeConversation subscribes userID to the messages of conversationID
+// and to the other members typing.
+func (s *streamService) SubscribeConversation(
+	ctx context.Context,
+	conversationID, userID string,
+) (*events.Subscription, error) {
+	if _, err := findConversation(ctx, s.store.Messages(), conversationID, userID); err != nil {
+		return nil, err
+	}
+	return s.subscribe(func(e events.Event) bool {
+		switch e.Kind {
+		case events.KindMessage:
+			return e.ConversationID == conversationID
+		case events.KindTyping:
+			return e.ConversationID == conversationID && e.UserID != userID
+		}
+		return false
+	})
+}
+
+// Typing publishes that userID is typing in conversationID.
+func (s *streamService) Typing(ctx context.Context, conversationID, userID string) error {
+	if _, err := findConversation(ctx, s.store.Messages(), conversationID, userID); err != nil {
+		return err
+	}
+	s.broker.Publish(events.Event{Kind: events.KindTyping, ConversationID: conversationID, UserID: userID})
+	return nil
+}
+
+// subscribe subscribes to the events match accepts from now on.
+func (s *streamService) subscribe(match func(events.Event) bool) (*events.Subscription, error) {
+	sub, _, err := s.broker.Subscribe(0, match)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe: %w", err)
+	}
+	return sub, nil
+}
@@ -0,0 +1,224 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/events"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestStream(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	b := events.NewBroker(100, 100)
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, b)
+	sl := service.NewLikeService(s, b)
+	sr := service.NewRelationshipService(s)
+	ss := service.NewStreamService(s, b)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	jim := createUser(t, su, "jim")
+	johnID := john.ID.String()
+	if err := sr.Mute(ctx, johnID, jim.ID.String()); err != nil {
+		t.Fatalf("Error muting user: %v", err)
+	}
+
+	johnSub, _, err := ss.Subscribe(ctx, johnID, 0)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer johnSub.Close()
+	anonSub, _, err := ss.Subscribe(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer anonSub.Close()
+
+	tweet := &entities.Tweet{UserID: john.ID, Content: "hello"}
+	if err = st.Create(ctx, tweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	createTweet(t, st, jim.ID, "muted")
+	if err = sl.Like(ctx, jane.ID.String(), tweet.ID.String()); err != nil {
+		t.Fatalf("Error liking tweet: %v", err)
+	}
+	// Failed writes publish nothing
+	err = st.Create(ctx, &entities.Tweet{UserID: uuid.New(), Content: "lost"})
+	if !errors.Is(err, entities.ErrInvalidUserID) {
+		t.Fatalf("Expected ErrInvalidUserID, got %v", err)
+	}
+	createTweet(t, st, jane.ID, "last")
+
+	// John gets his notification but not the tweet of the user he mutes
+	var kinds []events.Kind
+	for range 3 {
+		e := <-johnSub.Events()
+		kinds = append(kinds, e.Kind)
+	}
+	want := []events.Kind{events.KindTweet, events.KindNotification, events.KindTweet}
+	if kinds[0] != want[0] || kinds[1] != want[1] || kinds[2] != want[2] {
+		t.Errorf("Expected %v, got %v", want, kinds)
+	}
+	var contents []string
+	for range 3 {
+		e := <-anonSub.Events()
+		contents = append(contents, e.Tweet.Content)
+	}
+	if contents[0] != "hello" || contents[1] != "muted" || contents[2] != "last" {
+		t.Errorf("Expected every tweet and no notification, got %v", contents)
+	}
+
+	// Resuming replays what came after the last event seen
+	_, missed, err := ss.Subscribe(ctx, johnID, 1)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	if len(missed) != 2 || missed[0].Kind != events.KindNotification || missed[1].Tweet.Content != "last" {
+		t.Errorf("Expected the notification and the last tweet, got %+v", missed)
+	}
+
+	if _, _, err = ss.Subscribe(ctx, uuid.NewString(), 0); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	b.Close()
+	if _, _, err = ss.Subscribe(ctx, "", 0); !errors.Is(err, events.ErrClosed) {
+		t.Errorf("Expected ErrClosed, got %v", err)
+	}
+}
+
+// received returns the events waiting on sub.
+func received(sub *events.Subscription) []events.Event {
+	var got []events.Event
+	for {
+		select {
+		case e := <-sub.Events():
+			got = append(got, e)
+		default:
+			return got
+		}
+	}
+}
+
+func TestStreamChannels(t *testing.T) {
+	ctx := t.Context()
+	s := store.NewMemStore()
+	b := events.NewBroker(100, 100)
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, b)
+	sf := service.NewFollowService(s, b)
+	sm := service.NewMessageService(s, b)
+	sr := service.NewRelationshipService(s)
+	ss := service.NewStreamService(s, b)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	jim := createUser(t, su, "jim")
+	joe := createUser(t, su, "joe")
+	johnID, janeID := john.ID.String(), jane.ID.String()
+	for _, u := range []entities.User{jane, jim} {
+		if err := sf.Follow(ctx, johnID, u.ID.String()); err != nil {
+			t.Fatalf("Error following user: %v", err)
+		}
+	}
+	if err := sr.Mute(ctx, johnID, jim.ID.String()); err != nil {
+		t.Fatalf("Error muting user: %v", err)
+	}
+	c, err := sm.CreateConversation(ctx, johnID, []string{janeID})
+	if err != nil {
+		t.Fatalf("Error creating conversation: %v", err)
+	}
+	conversationID := c.ID.String()
+
+	home, err := ss.SubscribeHome(ctx, johnID)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer home.Close()
+	hashtag, err := ss.SubscribeHashtag(ctx, "#Go", johnID)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer hashtag.Close()
+	conversation, err := ss.SubscribeConversation(ctx, conversationID, johnID)
+	if err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+	defer conversation.Close()
+
+	createTweet(t, st, john.ID, "mine")
+	createTweet(t, st, jane.ID, "#golang is not #go")
+	createTweet(t, st, jim.ID, "muted #go")
+	createTweet(t, st, joe.ID, "not followed #GO")
+
+	var contents []string
+	for _, e := range received(home) {
+		contents = append(contents, e.Tweet.Content)
+	}
+	if len(contents) != 2 || contents[0] != "mine" || contents[1] != "#golang is not #go" {
+		t.Errorf("Expected the tweets of John and Jane, got %v", contents)
+	}
+	contents = nil
+	for _, e := range received(hashtag) {
+		contents = append(contents, e.Tweet.Content)
+	}
+	if len(contents) != 2 || contents[0] != "#golang is not #go" || contents[1] != "not followed #GO" {
+		t.Errorf("Expected the tweets tagged #go but the muted one, got %v", contents)
+	}
+
+	// John does not hear himself typing
+	if err = ss.Typing(ctx, conversationID, johnID); err != nil {
+		t.Fatalf("Error typing: %v", err)
+	}
+	if err = ss.Typing(ctx, conversationID, janeID); err != nil {
+		t.Fatalf("Error typing: %v", err)
+	}
+	m := &entities.Message{ConversationID: c.ID, SenderID: jane.ID, Content: "hi"}
+	if err = sm.Send(ctx, m); err != nil {
+		t.Fatalf("Error sending message: %v", err)
+	}
+	got := received(conversation)
+	if len(got) != 2 || got[0].Kind != events.KindTyping || got[0].UserID != janeID ||
+		got[1].Kind != events.KindMessage || got[1].Message.ID != m.ID {
+		t.Errorf("Expected Jane typing and her message, got %+v", got)
+	}
+
+	tests := []struct {
+		name string
+		err  func() error
+		want error
+	}{
+		{"home of unknown user", func() error {
+			_, err := ss.SubscribeHome(ctx, uuid.NewString())
+			return err
+		}, entities.ErrNotFound},
+		{"invalid hashtag", func() error {
+			_, err := ss.SubscribeHashtag(ctx, "#1", "")
+			return err
+		}, entities.ErrInvalidHashtag},
+		{"conversation of non-member", func() error {
+			_, err := ss.SubscribeConversation(ctx, conversationID, joe.ID.String())
+			return err
+		}, entities.ErrForbidden},
+		{"unknown conversation", func() error {
+			_, err := ss.SubscribeConversation(ctx, uuid.NewString(), johnID)
+			return err
+		}, entities.ErrNotFound},
+		{"typing by non-member", func() error {
+			return ss.Typing(ctx, conversationID, joe.ID.String())
+		}, entities.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.err(); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
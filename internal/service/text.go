@@ -0,0 +1,58 @@
+package service
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// token is a sign such as '#' or '@' and the word following it in the
+// content of a tweet. Start and End are offsets in runes, End being exclusive,
+// and cover the sign too.
+type token struct {
+	Body  string
+	Start int
+	End   int
+}
+
+// scanTokens returns the tokens of content starting with a rune for which
+// isSign reports true, followed by at least one word rune.
+//
+// Word runes are letters, marks, digits and underscores. The sign must not
+// follow a word rune, another sign or an '&', so "a#b", "##b" and HTML entities
+// such as "&#39;" are not tokens. Any other rune ends the word, which lets
+// punctuation such as "#go," or "(@jane)" through.
+func scanTokens(content string, isSign func(rune) bool) []token {
+	var tokens []token
+	prev := rune(-1)
+	pos := 0 // in runes
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		i += size
+		pos++
+		if !isSign(r) || (prev != -1 && (isWordRune(prev) || isSign(prev) || prev == '&')) {
+			prev = r
+			continue
+		}
+		prev = r
+		start := pos - 1
+		end := i
+		for end < len(content) {
+			next, nextSize := utf8.DecodeRuneInString(content[end:])
+			if !isWordRune(next) {
+				break
+			}
+			prev = next
+			end += nextSize
+			pos++
+		}
+		if end > i {
+			tokens = append(tokens, token{Body: content[i:end], Start: start, End: pos})
+		}
+		i = end
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// TimelineService is a domain service for users' home timelines.
+type TimelineService interface {
+	Home(ctx context.Context, userID, cursor string, limit int) (entities.Page[entities.Tweet], error)
+}
+
+// timelineService is an implementation of the TimelineService interface.
+type timelineService struct {
+	store store.Store
+}
+
+// NewTimelineService returns a new TimelineService.
+func NewTimelineService(s store.Store) TimelineService {
+	return &timelineService{s}
+}
+
+// Home returns a page of the tweets authored by userID and the users they
+// follow, newest first, starting at cursor and leaving out the users userID
+// mutes.
+func (s *timelineService) Home(
+	ctx context.Context,
+	userID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if err = checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, userID, true)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Tweets().FindTimeline(ctx, userID, beforeID, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("could not find timeline: %w", err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+}
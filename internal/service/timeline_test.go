@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+// testPasswordParams make hashing cheap, which the tests need more than they
+// need it to be hard.
+var testPasswordParams = service.PasswordParams{Memory: 64, Time: 1, Threads: 1}
+
+// testPassword is the password createUser gives every user.
+const testPassword = "correct horse battery staple"
+
+func createUser(t *testing.T, su service.UserService, username string) entities.User {
+	t.Helper()
+	user := &entities.User{
+		Username: username,
+		Email:    username + "@example.com",
+	}
+	if err := su.Create(t.Context(), user, testPassword); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	return *user
+}
+
+func createTweet(t *testing.T, st service.TweetService, userID uuid.UUID, content string) {
+	t.Helper()
+	if err := st.Create(t.Context(), &entities.Tweet{UserID: userID, Content: content}); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	// keep creation times strictly increasing so ordering is deterministic
+	time.Sleep(time.Millisecond)
+}
+
+func TestTimelineHome(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+	sf := service.NewFollowService(s, nil)
+	stl := service.NewTimelineService(s)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	bob := createUser(t, su, "bob")
+
+	createTweet(t, st, john.ID, "john 1")
+	createTweet(t, st, jane.ID, "jane 1")
+	createTweet(t, st, bob.ID, "bob 1")
+	createTweet(t, st, john.ID, "john 2")
+
+	// Before following anyone only John's own tweets are shown
+	page, err := stl.Home(t.Context(), john.ID.String(), "", 0)
+	if err != nil {
+		t.Fatalf("Error getting timeline: %v", err)
+	}
+	if len(page.Items) != 2 {
+		t.Fatalf("Expected 2 tweets, got %d", len(page.Items))
+	}
+
+	if err = sf.Follow(t.Context(), john.ID.String(), jane.ID.String()); err != nil {
+		t.Fatalf("Error following user: %v", err)
+	}
+
+	// After following Jane her tweets are included, newest first, a page at a
+	// time
+	var contents []string
+	cursor := ""
+	for {
+		page, err = stl.Home(t.Context(), john.ID.String(), cursor, 2)
+		if err != nil {
+			t.Fatalf("Error getting timeline: %v", err)
+		}
+		for _, tweet := range page.Items {
+			contents = append(contents, tweet.Content)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	expected := []string{"john 2", "jane 1", "john 1"}
+	if len(contents) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, contents)
+	}
+	for i, content := range expected {
+		if contents[i] != content {
+			t.Errorf("Expected tweet %d to be %q, got %q", i, content, contents[i])
+		}
+	}
+}
+
+func TestTimelineHomeUnknownUser(t *testing.T) {
+	stl := service.NewTimelineService(store.NewMemStore())
+
+	_, err := stl.Home(t.Context(), uuid.NewString(), "", 0)
+	if !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	_, err = stl.Home(t.Context(), uuid.NewString(), "not-a-cursor", 0)
+	if !errors.Is(err, entities.ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+}
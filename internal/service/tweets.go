@@ -4,41 +4,106 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
 
 	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service/events"
 	"github.com/ricleal/twitter-clone/internal/service/repository"
 	"github.com/ricleal/twitter-clone/internal/service/store"
 )
 
 const maxTweetLength = 280 // Twitter's character limit
 
-// validateTweet validates a tweet content is not too long.
-func validateTweet(content string) error {
-	if len(content) > maxTweetLength {
-		return errors.New("tweet content is too long")
+const (
+	// DefaultThreadDepth is the reply depth of a thread when the caller does
+	// not ask for one.
+	DefaultThreadDepth = 10
+	// MaxThreadDepth is the deepest reply level a caller can ask for.
+	MaxThreadDepth = 50
+)
+
+// validateTweet validates a tweet content is not too long, and that its kind
+// agrees with its content and reference. An empty kind is a plain tweet.
+func validateTweet(t *entities.Tweet) error {
+	if len(t.Content) > maxTweetLength {
+		return entities.ErrTweetTooLong
+	}
+	switch t.Kind {
+	case "", entities.TweetKindTweet:
+		if t.ReferencedTweetID != nil {
+			return entities.ErrUnexpectedReference
+		}
+	case entities.TweetKindRetweet, entities.TweetKindQuote:
+		if t.ReferencedTweetID == nil {
+			return entities.ErrReferenceRequired
+		}
+		if t.Kind == entities.TweetKindRetweet && t.Content != "" {
+			return entities.ErrRetweetContent
+		}
+		if t.Kind == entities.TweetKindRetweet && t.InReplyToTweetID != nil {
+			return entities.ErrRetweetReply
+		}
+	default:
+		return entities.ErrInvalidTweetKind
 	}
 	return nil
 }
 
 // TweetService is a domain service for tweets.
+//
+// Reads take the ID of the user viewing the tweets, empty for anonymous
+// viewers. Tweets of users blocking or blocked by the viewer are left out, and
+// asking for one of them, or for their profile tweets or mentions, is
+// entities.ErrBlocked. The public timeline, hashtag listings and searches
+// also leave out the users the viewer mutes.
 type TweetService interface {
 	Create(ctx context.Context, t *entities.Tweet) error
 	FindAll(ctx context.Context) ([]entities.Tweet, error)
-	FindByID(ctx context.Context, id string) (*entities.Tweet, error)
+	FindPage(ctx context.Context, viewerID, cursor string, limit int) (entities.Page[entities.Tweet], error)
+	FindByUserID(
+		ctx context.Context,
+		userID, viewerID, cursor string,
+		limit int,
+	) (entities.Page[entities.Tweet], error)
+	FindByHashtag(ctx context.Context, tag, viewerID, cursor string, limit int) (entities.Page[entities.Tweet], error)
+	FindMentions(
+		ctx context.Context,
+		userID, viewerID, cursor string,
+		limit int,
+	) (entities.Page[entities.Tweet], error)
+	Search(
+		ctx context.Context,
+		query string,
+		order entities.SearchOrder,
+		viewerID, cursor string,
+		limit int,
+	) (entities.Page[entities.Tweet], error)
+	FindByID(ctx context.Context, id, viewerID string) (*entities.Tweet, error)
+	Thread(ctx context.Context, id, viewerID string, depth int) (*entities.Thread, error)
+	Delete(ctx context.Context, id, userID string) error
+	FindDeleted(ctx context.Context) ([]entities.Tweet, error)
+	Restore(ctx context.Context, id string) error
 }
 
 // tweetService is an implementation of the TweetService interface.
 type tweetService struct {
-	store store.Store
+	store  store.Store
+	broker *events.Broker
 }
 
-// NewTweetService returns a new TweetService.
-func NewTweetService(s store.Store) TweetService {
-	return &tweetService{s}
+// NewTweetService returns a new TweetService publishing to b, which may be nil.
+func NewTweetService(s store.Store, b *events.Broker) TweetService {
+	return &tweetService{s, b}
 }
 
-// Create creates a new tweet.
+// Create creates a new tweet. Replies to and references of tweets by users
+// blocking or blocked by the author are entities.ErrBlocked, and @usernames of
+// those users are not resolved as mentions. The tweet, and the notifications
+// it causes, are published once committed.
 func (s *tweetService) Create(ctx context.Context, t *entities.Tweet) error {
+	var notified []string
 	// open a transaction
 	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
 		tweetRepo := scopedStore.Tweets()
@@ -54,18 +119,63 @@ func (s *tweetService) Create(ctx context.Context, t *entities.Tweet) error {
 		}
 
 		// validate tweet
-		if err = validateTweet(t.Content); err != nil {
+		if err = validateTweet(t); err != nil {
 			return fmt.Errorf("invalid tweet: %w", err)
 		}
+		hidden, err := hiddenAuthors(ctx, scopedStore, t.UserID.String(), false)
+		if err != nil {
+			return err
+		}
+		var parent, original *entities.Tweet
+		if t.ReferencedTweetID != nil {
+			if original, err = checkReference(ctx, tweetRepo, t, hidden); err != nil {
+				return err
+			}
+		}
+		// a reply joins the conversation of its parent, anything else starts one
+		t.ConversationID = uuid.Nil
+		if t.InReplyToTweetID != nil {
+			if parent, err = tweetRepo.FindByID(ctx, t.InReplyToTweetID.String()); err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					return entities.ErrParentTweetNotFound
+				}
+				return fmt.Errorf("error finding parent tweet: %w", err)
+			}
+			if slices.Contains(hidden, parent.UserID.String()) {
+				return entities.ErrBlocked
+			}
+			t.ConversationID = parent.ConversationID
+		}
+		mentions, err := resolveMentions(ctx, userRepo, t.Content, hidden)
+		if err != nil {
+			return err
+		}
 
 		err = tweetRepo.Create(ctx, t)
 		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return entities.ErrAlreadyRetweeted
+			}
 			return fmt.Errorf("could not create tweet: %w", err)
 		}
-		return nil
+		if tags := ExtractHashtags(t.Content); len(tags) > 0 {
+			if err = scopedStore.Hashtags().Attach(ctx, t.ID.String(), tags); err != nil {
+				return fmt.Errorf("could not attach hashtags: %w", err)
+			}
+		}
+		if err = scopedStore.Mentions().Create(ctx, t.ID.String(), mentions); err != nil {
+			return fmt.Errorf("could not create mentions: %w", err)
+		}
+		t.Mentions = mentions
+		notified, err = notifyTweet(ctx, scopedStore, t, parent, original)
+		return err
 	}); errOut != nil {
 		return fmt.Errorf("could not create tweet in the tx: %w", errOut)
 	}
+	// only committed tweets are published, and the caller keeps t to itself
+	published := *t
+	s.broker.Publish(events.Event{Kind: events.KindTweet, Tweet: &published})
+	publishNotified(s.broker, notified...)
 	return nil
 }
 
@@ -76,11 +186,180 @@ func (s *tweetService) FindAll(ctx context.Context) ([]entities.Tweet, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to find all tweets: %w", err)
 	}
+	if err = expandTweets(ctx, s.store, tweets, nil); err != nil {
+		return nil, err
+	}
 	return tweets, nil
 }
 
+// FindPage returns a page of tweets, newest first, starting at cursor.
+func (s *tweetService) FindPage(
+	ctx context.Context,
+	viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, true)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Tweets().FindPage(ctx, beforeID, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("failed to find tweets page: %w", err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+}
+
+// FindByUserID returns a page of the tweets of userID, newest first, starting
+// at cursor.
+func (s *tweetService) FindByUserID(
+	ctx context.Context,
+	userID, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if err = checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, false)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if slices.Contains(hidden, userID) {
+		return entities.Page[entities.Tweet]{}, entities.ErrBlocked
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Tweets().FindByUserID(ctx, userID, beforeID, limit+1)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("failed to find tweets of user %s: %w", userID, err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+}
+
+// FindByHashtag returns a page of the tweets tagged with tag, newest first,
+// starting at cursor. The tag is matched the way hashtags are extracted, so
+// "#Go" and "go" find the same tweets.
+func (s *tweetService) FindByHashtag(
+	ctx context.Context,
+	tag, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	tag, err = parseHashtag(tag)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, true)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Hashtags().FindTweets(ctx, tag, beforeID, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("failed to find tweets tagged %s: %w", tag, err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+}
+
+// FindMentions returns a page of the tweets mentioning userID, newest first,
+// starting at cursor.
+func (s *tweetService) FindMentions(
+	ctx context.Context,
+	userID, viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if err = checkUserExists(ctx, s.store.Users(), userID); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, false)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if slices.Contains(hidden, userID) {
+		return entities.Page[entities.Tweet]{}, entities.ErrBlocked
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Mentions().FindTweets(ctx, userID, beforeID, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("failed to find tweets mentioning user %s: %w", userID, err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+}
+
+// Search returns a page of the tweets matching query, parsed by
+// ParseTweetQuery, in the given order (relevance when empty) starting at
+// cursor. Cursors of relevance-ordered pages hold an offset rather than an ID,
+// so they are not interchangeable with the ones of recency-ordered pages.
+func (s *tweetService) Search(
+	ctx context.Context,
+	query string,
+	order entities.SearchOrder,
+	viewerID, cursor string,
+	limit int,
+) (entities.Page[entities.Tweet], error) {
+	q, err := ParseTweetQuery(query)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	beforeID, offset := "", 0
+	switch order {
+	case "", entities.SearchOrderRelevance:
+		offset, err = decodeOffsetCursor(cursor)
+	case entities.SearchOrderRecency:
+		q.Order = order
+		beforeID, err = decodeCursor(cursor)
+	default:
+		return entities.Page[entities.Tweet]{}, entities.ErrInvalidSearchOrder
+	}
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, true)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	limit = pageLimit(limit)
+	tweets, err := s.store.Tweets().Search(ctx, q, beforeID, offset, limit+1, hidden)
+	if err != nil {
+		return entities.Page[entities.Tweet]{}, fmt.Errorf("failed to search tweets: %w", err)
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return entities.Page[entities.Tweet]{}, err
+	}
+	if q.Order == entities.SearchOrderRecency {
+		return newPage(tweets, limit, func(t entities.Tweet) uuid.UUID { return t.ID }), nil
+	}
+	return newOffsetPage(tweets, offset, limit), nil
+}
+
 // FindByID returns a tweet by ID.
-func (s *tweetService) FindByID(ctx context.Context, id string) (*entities.Tweet, error) {
+func (s *tweetService) FindByID(ctx context.Context, id, viewerID string) (*entities.Tweet, error) {
 	repo := s.store.Tweets()
 	t, err := repo.FindByID(ctx, id)
 	if err != nil {
@@ -89,5 +368,205 @@ func (s *tweetService) FindByID(ctx context.Context, id string) (*entities.Tweet
 		}
 		return nil, fmt.Errorf("failed to find tweet by id %s: %w", id, err)
 	}
-	return t, nil
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, false)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(hidden, t.UserID.String()) {
+		return nil, entities.ErrBlocked
+	}
+	tweets := []entities.Tweet{*t}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return nil, err
+	}
+	return &tweets[0], nil
+}
+
+// Thread returns the tweet id and the tree of replies below it, at most depth
+// levels deep; depth is clamped to [1, MaxThreadDepth], falling back to
+// DefaultThreadDepth when none was given. Replies by users blocking or blocked
+// by viewerID are left out along with the replies below them.
+func (s *tweetService) Thread(ctx context.Context, id, viewerID string, depth int) (*entities.Thread, error) {
+	switch {
+	case depth <= 0:
+		depth = DefaultThreadDepth
+	case depth > MaxThreadDepth:
+		depth = MaxThreadDepth
+	}
+	hidden, err := hiddenAuthors(ctx, s.store, viewerID, false)
+	if err != nil {
+		return nil, err
+	}
+	repo := s.store.Tweets()
+	tweets, err := repo.FindThread(ctx, id, depth, hidden)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find thread of tweet %s: %w", id, err)
+	}
+	if slices.Contains(hidden, tweets[0].UserID.String()) {
+		return nil, entities.ErrBlocked
+	}
+	if err = expandTweets(ctx, s.store, tweets, hidden); err != nil {
+		return nil, err
+	}
+
+	replies := make(map[uuid.UUID][]entities.Tweet)
+	for _, t := range tweets[1:] {
+		replies[*t.InReplyToTweetID] = append(replies[*t.InReplyToTweetID], t)
+	}
+	thread := buildThread(tweets[0], replies)
+	return &thread, nil
+}
+
+// buildThread returns the thread rooted at t given the replies to each tweet.
+func buildThread(t entities.Tweet, replies map[uuid.UUID][]entities.Tweet) entities.Thread {
+	thread := entities.Thread{Tweet: t}
+	for _, r := range replies[t.ID] {
+		thread.Replies = append(thread.Replies, buildThread(r, replies))
+	}
+	return thread
+}
+
+// Delete soft-deletes a tweet on behalf of userID, who must be its author.
+func (s *tweetService) Delete(ctx context.Context, id, userID string) error {
+	if errOut := s.store.ExecTx(ctx, func(scopedStore store.Store) error {
+		tweet, err := findTweet(ctx, scopedStore.Tweets(), id)
+		if err != nil {
+			return err
+		}
+		if tweet.UserID.String() != userID {
+			return entities.ErrForbidden
+		}
+		if err = scopedStore.Tweets().Delete(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete tweet %s: %w", id, err)
+		}
+		return nil
+	}); errOut != nil {
+		return fmt.Errorf("could not delete tweet in the tx: %w", errOut)
+	}
+	return nil
+}
+
+// FindDeleted returns the soft-deleted tweets.
+func (s *tweetService) FindDeleted(ctx context.Context) ([]entities.Tweet, error) {
+	tweets, err := s.store.Tweets().FindDeleted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deleted tweets: %w", err)
+	}
+	return tweets, nil
+}
+
+// Restore undoes the soft delete of a tweet.
+func (s *tweetService) Restore(ctx context.Context, id string) error {
+	if err := s.store.Tweets().Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("failed to restore tweet %s: %w", id, err)
+	}
+	return nil
+}
+
+// checkReference verifies the tweet referenced by t is live, not written by
+// one of hidden and, for a retweet, not yet retweeted by the same user, and
+// returns it. A reference to a retweet is moved to the tweet it retweets, so
+// retweets never chain.
+func checkReference(
+	ctx context.Context,
+	tweetRepo repository.TweetRepository,
+	t *entities.Tweet,
+	hidden []string,
+) (*entities.Tweet, error) {
+	original, err := tweetRepo.FindByID(ctx, t.ReferencedTweetID.String())
+	if err == nil && original.Kind == entities.TweetKindRetweet {
+		original, err = tweetRepo.FindByID(ctx, original.ReferencedTweetID.String())
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrReferencedTweetNotFound
+		}
+		return nil, fmt.Errorf("error finding referenced tweet: %w", err)
+	}
+	if slices.Contains(hidden, original.UserID.String()) {
+		return nil, entities.ErrBlocked
+	}
+	t.ReferencedTweetID = &original.ID
+
+	if t.Kind != entities.TweetKindRetweet {
+		return original, nil
+	}
+	_, err = tweetRepo.FindRetweet(ctx, t.UserID.String(), original.ID.String())
+	switch {
+	case err == nil:
+		return nil, entities.ErrAlreadyRetweeted
+	case errors.Is(err, repository.ErrNotFound):
+		return original, nil
+	default:
+		return nil, fmt.Errorf("error finding retweet: %w", err)
+	}
+}
+
+// expandTweets embeds the tweets referenced by tweets, and fills in the
+// retweet and quote counts and the mentions of both. Referenced tweets written
+// by one of hidden are left out as if they were deleted.
+func expandTweets(ctx context.Context, s store.Store, tweets []entities.Tweet, hidden []string) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+	tweetRepo := s.Tweets()
+	var refIDs []string
+	for _, t := range tweets {
+		if t.ReferencedTweetID != nil {
+			refIDs = append(refIDs, t.ReferencedTweetID.String())
+		}
+	}
+	referenced, err := tweetRepo.FindByIDs(ctx, refIDs)
+	if err != nil {
+		return fmt.Errorf("could not find referenced tweets: %w", err)
+	}
+
+	ids := make([]string, 0, len(tweets)+len(referenced))
+	for _, t := range tweets {
+		ids = append(ids, t.ID.String())
+	}
+	for _, r := range referenced {
+		ids = append(ids, r.ID.String())
+	}
+	retweets, err := tweetRepo.CountReferences(ctx, ids, entities.TweetKindRetweet)
+	if err != nil {
+		return fmt.Errorf("could not count retweets: %w", err)
+	}
+	quotes, err := tweetRepo.CountReferences(ctx, ids, entities.TweetKindQuote)
+	if err != nil {
+		return fmt.Errorf("could not count quotes: %w", err)
+	}
+	mentions, err := s.Mentions().FindByTweets(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("could not find mentions: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]entities.Tweet, len(referenced))
+	for _, r := range referenced {
+		if slices.Contains(hidden, r.UserID.String()) {
+			continue
+		}
+		r.RetweetCount = retweets[r.ID.String()]
+		r.QuoteCount = quotes[r.ID.String()]
+		r.Mentions = mentions[r.ID.String()]
+		byID[r.ID] = r
+	}
+	for i := range tweets {
+		t := &tweets[i]
+		if t.ReferencedTweetID != nil {
+			if r, ok := byID[*t.ReferencedTweetID]; ok {
+				t.ReferencedTweet = &r
+			}
+		}
+		t.RetweetCount = retweets[t.ID.String()]
+		t.QuoteCount = quotes[t.ID.String()]
+		t.Mentions = mentions[t.ID.String()]
+	}
+	return nil
 }
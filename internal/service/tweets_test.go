@@ -0,0 +1,227 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func TestTweetCreateTooLong(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+
+	err := st.Create(t.Context(), &entities.Tweet{UserID: john.ID, Content: strings.Repeat("a", 281)})
+	var invalid *entities.ValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("Expected a ValidationError, got %v", err)
+	}
+	if invalid.Code != entities.CodeTooLong || invalid.Field != "content" {
+		t.Errorf("Unexpected validation error: %+v", invalid)
+	}
+	if !errors.Is(err, entities.ErrValidation) {
+		t.Errorf("Expected ErrValidation, got %v", err)
+	}
+
+	// 280 characters is still fine
+	createTweet(t, st, john.ID, strings.Repeat("a", 280))
+}
+
+func TestTweetDelete(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	tweet := &entities.Tweet{UserID: john.ID, Content: "hello"}
+	if err := st.Create(t.Context(), tweet); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+
+	// Only the author can delete a tweet
+	if err := st.Delete(t.Context(), tweet.ID.String(), jane.ID.String()); !errors.Is(err, entities.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+	if err := st.Delete(t.Context(), tweet.ID.String(), john.ID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	if err := st.Delete(t.Context(), tweet.ID.String(), john.ID.String()); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestTweetCreateRetweetAndQuote(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	original := &entities.Tweet{UserID: john.ID, Content: "hello"}
+	if err := st.Create(t.Context(), original); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+
+	retweet := &entities.Tweet{UserID: jane.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID}
+	if err := st.Create(t.Context(), retweet); err != nil {
+		t.Fatalf("Error creating retweet: %v", err)
+	}
+	// Retweeting a retweet references the original
+	quote := &entities.Tweet{
+		UserID: john.ID, Kind: entities.TweetKindQuote, Content: "indeed", ReferencedTweetID: &retweet.ID,
+	}
+	if err := st.Create(t.Context(), quote); err != nil {
+		t.Fatalf("Error creating quote: %v", err)
+	}
+	if *quote.ReferencedTweetID != original.ID {
+		t.Errorf("Expected quote of %s, got %s", original.ID, quote.ReferencedTweetID)
+	}
+
+	// Reads embed the original and count references to it
+	got, err := st.FindByID(t.Context(), original.ID.String(), "")
+	if err != nil {
+		t.Fatalf("Error finding tweet: %v", err)
+	}
+	if got.RetweetCount != 1 || got.QuoteCount != 1 {
+		t.Errorf("Expected 1 retweet and 1 quote, got %d and %d", got.RetweetCount, got.QuoteCount)
+	}
+	got, err = st.FindByID(t.Context(), retweet.ID.String(), "")
+	if err != nil {
+		t.Fatalf("Error finding retweet: %v", err)
+	}
+	if got.ReferencedTweet == nil || got.ReferencedTweet.Content != "hello" {
+		t.Errorf("Expected the original to be embedded, got %+v", got.ReferencedTweet)
+	}
+
+	// Once the original is deleted it is no longer embedded
+	if err = st.Delete(t.Context(), original.ID.String(), john.ID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	got, err = st.FindByID(t.Context(), retweet.ID.String(), "")
+	if err != nil {
+		t.Fatalf("Error finding retweet: %v", err)
+	}
+	if got.ReferencedTweet != nil {
+		t.Errorf("Expected no embedded tweet, got %+v", got.ReferencedTweet)
+	}
+}
+
+func TestTweetCreateReferenceErrors(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	original := &entities.Tweet{UserID: john.ID, Content: "hello"}
+	deleted := &entities.Tweet{UserID: john.ID, Content: "gone"}
+	for _, tweet := range []*entities.Tweet{original, deleted} {
+		if err := st.Create(t.Context(), tweet); err != nil {
+			t.Fatalf("Error creating tweet: %v", err)
+		}
+	}
+	if err := st.Delete(t.Context(), deleted.ID.String(), john.ID.String()); err != nil {
+		t.Fatalf("Error deleting tweet: %v", err)
+	}
+	retweet := entities.Tweet{UserID: john.ID, Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID}
+	if err := st.Create(t.Context(), &retweet); err != nil {
+		t.Fatalf("Error creating retweet: %v", err)
+	}
+	unknownID := uuid.New()
+
+	tests := []struct {
+		name    string
+		tweet   entities.Tweet
+		wantErr error
+	}{
+		{"retweet twice", entities.Tweet{Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID},
+			entities.ErrAlreadyRetweeted},
+		{"retweet with content", entities.Tweet{Kind: entities.TweetKindRetweet, Content: "x",
+			ReferencedTweetID: &original.ID}, entities.ErrRetweetContent},
+		{"quote without reference", entities.Tweet{Kind: entities.TweetKindQuote, Content: "x"},
+			entities.ErrReferenceRequired},
+		{"tweet with reference", entities.Tweet{Content: "x", ReferencedTweetID: &original.ID},
+			entities.ErrUnexpectedReference},
+		{"unknown kind", entities.Tweet{Kind: "reply", Content: "x"}, entities.ErrInvalidTweetKind},
+		{"unknown original", entities.Tweet{Kind: entities.TweetKindQuote, Content: "x",
+			ReferencedTweetID: &unknownID}, entities.ErrReferencedTweetNotFound},
+		{"deleted original", entities.Tweet{Kind: entities.TweetKindRetweet, ReferencedTweetID: &deleted.ID},
+			entities.ErrReferencedTweetNotFound},
+		{"retweet as reply", entities.Tweet{Kind: entities.TweetKindRetweet, ReferencedTweetID: &original.ID,
+			InReplyToTweetID: &original.ID}, entities.ErrRetweetReply},
+		{"reply to unknown tweet", entities.Tweet{Content: "x", InReplyToTweetID: &unknownID},
+			entities.ErrParentTweetNotFound},
+		{"reply to deleted tweet", entities.Tweet{Content: "x", InReplyToTweetID: &deleted.ID},
+			entities.ErrParentTweetNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.tweet.UserID = john.ID
+			if err := st.Create(t.Context(), &tt.tweet); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTweetThread(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+	st := service.NewTweetService(s, nil)
+
+	john := createUser(t, su, "john")
+	root := &entities.Tweet{UserID: john.ID, Content: "root"}
+	if err := st.Create(t.Context(), root); err != nil {
+		t.Fatalf("Error creating tweet: %v", err)
+	}
+	reply := &entities.Tweet{UserID: john.ID, Content: "reply", InReplyToTweetID: &root.ID}
+	if err := st.Create(t.Context(), reply); err != nil {
+		t.Fatalf("Error creating reply: %v", err)
+	}
+	nested := &entities.Tweet{UserID: john.ID, Content: "nested", InReplyToTweetID: &reply.ID}
+	if err := st.Create(t.Context(), nested); err != nil {
+		t.Fatalf("Error creating reply: %v", err)
+	}
+	if reply.ConversationID != root.ID || nested.ConversationID != root.ID {
+		t.Errorf("Expected replies in conversation %s, got %s and %s", root.ID, reply.ConversationID,
+			nested.ConversationID)
+	}
+
+	thread, err := st.Thread(t.Context(), root.ID.String(), "", 0)
+	if err != nil {
+		t.Fatalf("Error getting thread: %v", err)
+	}
+	if thread.Tweet.ID != root.ID || len(thread.Replies) != 1 || thread.Replies[0].Tweet.ID != reply.ID ||
+		len(thread.Replies[0].Replies) != 1 || thread.Replies[0].Replies[0].Tweet.ID != nested.ID {
+		t.Errorf("Unexpected thread: %+v", thread)
+	}
+
+	// A thread can start below the root of its conversation
+	thread, err = st.Thread(t.Context(), reply.ID.String(), "", 1)
+	if err != nil {
+		t.Fatalf("Error getting thread: %v", err)
+	}
+	if thread.Tweet.ID != reply.ID || len(thread.Replies) != 1 || len(thread.Replies[0].Replies) != 0 {
+		t.Errorf("Unexpected thread: %+v", thread)
+	}
+
+	thread, err = st.Thread(t.Context(), root.ID.String(), "", 1)
+	if err != nil {
+		t.Fatalf("Error getting thread: %v", err)
+	}
+	if len(thread.Replies) != 1 || len(thread.Replies[0].Replies) != 0 {
+		t.Errorf("Expected only direct replies at depth 1, got %+v", thread)
+	}
+
+	if _, err = st.Thread(t.Context(), uuid.New().String(), "", 0); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
 
 	"github.com/ricleal/twitter-clone/internal/entities"
 	"github.com/ricleal/twitter-clone/internal/service/repository"
@@ -23,27 +27,94 @@ func validateEmail(email string) bool {
 
 // UserService is a domain service for users.
 type UserService interface {
-	Create(ctx context.Context, u *entities.User) error
+	Create(ctx context.Context, u *entities.User, password string) error
+	Authenticate(ctx context.Context, username, password string) (*entities.User, error)
 	FindAll(ctx context.Context) ([]entities.User, error)
+	FindPage(ctx context.Context, cursor string, limit int) (entities.Page[entities.User], error)
 	FindByID(ctx context.Context, id string) (*entities.User, error)
+	FindByUsername(ctx context.Context, username string) (*entities.User, error)
+	FindByEmail(ctx context.Context, email string) (*entities.User, error)
+	Search(ctx context.Context, query, cursor string, limit int) (entities.Page[entities.User], error)
+	Update(ctx context.Context, id string, upd entities.UserUpdate) (*entities.User, error)
+	Delete(ctx context.Context, id string) error
+	FindDeleted(ctx context.Context) ([]entities.User, error)
+	Restore(ctx context.Context, id string) error
 }
 
 // userService is an implementation of the UserService interface.
 type userService struct {
-	store store.Store
+	store     store.Store
+	passwords PasswordParams
+	// dummyHash is verified against when there is no hash to verify, so that
+	// failed logins take as long whether or not the user exists.
+	dummyHash func() (string, error)
 }
 
-// NewUserService creates a new UserService.
-func NewUserService(s store.Store) UserService {
-	return &userService{s}
+// NewUserService creates a new UserService that hashes passwords with p.
+func NewUserService(s store.Store, p PasswordParams) UserService {
+	return &userService{
+		store:     s,
+		passwords: p,
+		dummyHash: sync.OnceValues(func() (string, error) { return hashPassword("", p) }),
+	}
 }
 
-// Create creates a new user.
-func (s *userService) Create(ctx context.Context, u *entities.User) error {
+// Create creates a new user with password, which must follow the password
+// policy and is stored hashed.
+func (s *userService) Create(ctx context.Context, u *entities.User, password string) error {
 	if !validateEmail(u.Email) {
 		return entities.ErrInvalidEmail
 	}
-	return s.store.Users().Create(ctx, u) //nolint:wrapcheck //no need to wrap here
+	if err := validatePassword(password, u.Username); err != nil {
+		return err
+	}
+	hash, err := hashPassword(password, s.passwords)
+	if err != nil {
+		return fmt.Errorf("could not hash password: %w", err)
+	}
+	u.PasswordHash = hash
+	if err = s.store.Users().Create(ctx, u); err != nil {
+		if conflict := asConflict(err); conflict != nil {
+			return conflict
+		}
+		return fmt.Errorf("could not create user: %w", err)
+	}
+	return nil
+}
+
+// Authenticate returns the user with username if password is theirs. A hash
+// made with other parameters than the current ones is replaced on success.
+func (s *userService) Authenticate(ctx context.Context, username, password string) (*entities.User, error) {
+	u, err := s.store.Users().FindByUsername(ctx, username)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("could not find user by username: %w", err)
+	}
+	if u == nil || u.PasswordHash == "" {
+		hash, err := s.dummyHash()
+		if err != nil {
+			return nil, fmt.Errorf("could not hash password: %w", err)
+		}
+		_, _, _ = verifyPassword(password, hash, s.passwords)
+		return nil, entities.ErrInvalidCredentials
+	}
+	ok, stale, err := verifyPassword(password, u.PasswordHash, s.passwords)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify password: %w", err)
+	}
+	if !ok {
+		return nil, entities.ErrInvalidCredentials
+	}
+	if stale {
+		hash, err := hashPassword(password, s.passwords)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash password: %w", err)
+		}
+		if err = s.store.Users().UpdatePasswordHash(ctx, u.ID.String(), hash); err != nil {
+			return nil, fmt.Errorf("could not rehash password: %w", err)
+		}
+		u.PasswordHash = hash
+	}
+	return u, nil
 }
 
 // FindAll returns all users.
@@ -55,6 +126,20 @@ func (s *userService) FindAll(ctx context.Context) ([]entities.User, error) {
 	return users, nil
 }
 
+// FindPage returns a page of users, newest first, starting at cursor.
+func (s *userService) FindPage(ctx context.Context, cursor string, limit int) (entities.Page[entities.User], error) {
+	beforeID, err := decodeCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.User]{}, err
+	}
+	limit = pageLimit(limit)
+	users, err := s.store.Users().FindPage(ctx, beforeID, limit+1)
+	if err != nil {
+		return entities.Page[entities.User]{}, fmt.Errorf("could not find users page: %w", err)
+	}
+	return newPage(users, limit, func(u entities.User) uuid.UUID { return u.ID }), nil
+}
+
 // FindByID returns a user by ID.
 func (s *userService) FindByID(ctx context.Context, id string) (*entities.User, error) {
 	u, err := s.store.Users().FindByID(ctx, id)
@@ -66,3 +151,114 @@ func (s *userService) FindByID(ctx context.Context, id string) (*entities.User,
 	}
 	return u, nil
 }
+
+// FindByUsername returns a user by username, ignoring case.
+func (s *userService) FindByUsername(ctx context.Context, username string) (*entities.User, error) {
+	u, err := s.store.Users().FindByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not find user by username: %w", err)
+	}
+	return u, nil
+}
+
+// FindByEmail returns a user by email.
+func (s *userService) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
+	u, err := s.store.Users().FindByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		return nil, fmt.Errorf("could not find user by email: %w", err)
+	}
+	return u, nil
+}
+
+// Search returns a page of the users whose username or name resembles query,
+// best match first, starting at cursor. Like those of tweet searches ordered
+// by relevance, its cursors hold an offset.
+func (s *userService) Search(
+	ctx context.Context,
+	query, cursor string,
+	limit int,
+) (entities.Page[entities.User], error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return entities.Page[entities.User]{}, entities.ErrEmptySearchQuery
+	}
+	offset, err := decodeOffsetCursor(cursor)
+	if err != nil {
+		return entities.Page[entities.User]{}, err
+	}
+	limit = pageLimit(limit)
+	users, err := s.store.Users().Search(ctx, query, offset, limit+1)
+	if err != nil {
+		return entities.Page[entities.User]{}, fmt.Errorf("failed to search users: %w", err)
+	}
+	return newOffsetPage(users, offset, limit), nil
+}
+
+// Update applies a partial update to a user and returns the result. An empty
+// update leaves the user untouched.
+func (s *userService) Update(ctx context.Context, id string, upd entities.UserUpdate) (*entities.User, error) {
+	if upd.Email != nil && !validateEmail(*upd.Email) {
+		return nil, entities.ErrInvalidEmail
+	}
+	if upd.IsEmpty() {
+		return s.FindByID(ctx, id)
+	}
+	u, err := s.store.Users().Update(ctx, id, upd)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entities.ErrNotFound
+		}
+		if conflict := asConflict(err); conflict != nil {
+			return nil, conflict
+		}
+		return nil, fmt.Errorf("could not update user: %w", err)
+	}
+	return u, nil
+}
+
+// Delete soft-deletes a user.
+func (s *userService) Delete(ctx context.Context, id string) error {
+	if err := s.store.Users().Delete(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("could not delete user: %w", err)
+	}
+	return nil
+}
+
+// FindDeleted returns the soft-deleted users.
+func (s *userService) FindDeleted(ctx context.Context) ([]entities.User, error) {
+	users, err := s.store.Users().FindDeleted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not find deleted users: %w", err)
+	}
+	return users, nil
+}
+
+// Restore undoes the soft delete of a user.
+func (s *userService) Restore(ctx context.Context, id string) error {
+	if err := s.store.Users().Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("could not restore user: %w", err)
+	}
+	return nil
+}
+
+// asConflict converts a repository conflict into an *entities.ConflictError,
+// or returns nil if err is not one.
+func asConflict(err error) error {
+	var conflict *repository.ConflictError
+	if errors.As(err, &conflict) {
+		return &entities.ConflictError{Field: conflict.Field}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ricleal/twitter-clone/internal/entities"
+	"github.com/ricleal/twitter-clone/internal/service"
+	"github.com/ricleal/twitter-clone/internal/service/store"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestUserUpdate(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	john := createUser(t, su, "john")
+	createUser(t, su, "jane")
+
+	// Only the given fields change
+	updated, err := su.Update(t.Context(), john.ID.String(), entities.UserUpdate{Name: ptr("John Doe")})
+	if err != nil {
+		t.Fatalf("Error updating user: %v", err)
+	}
+	if updated.Name != "John Doe" || updated.Username != "john" || updated.Email != john.Email {
+		t.Errorf("Unexpected user after update: %+v", updated)
+	}
+	if !updated.UpdatedAt.After(john.UpdatedAt) {
+		t.Errorf("Expected updated_at to advance, got %v", updated.UpdatedAt)
+	}
+
+	// An empty update is a no-op
+	unchanged, err := su.Update(t.Context(), john.ID.String(), entities.UserUpdate{})
+	if err != nil {
+		t.Fatalf("Error updating user: %v", err)
+	}
+	if !unchanged.UpdatedAt.Equal(updated.UpdatedAt) {
+		t.Errorf("Expected updated_at %v, got %v", updated.UpdatedAt, unchanged.UpdatedAt)
+	}
+
+	// Keeping one's own username is not a conflict
+	if _, err = su.Update(t.Context(), john.ID.String(), entities.UserUpdate{Username: ptr("john")}); err != nil {
+		t.Errorf("Error updating user: %v", err)
+	}
+}
+
+func TestUserUpdateErrors(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	john := createUser(t, su, "john")
+	jane := createUser(t, su, "jane")
+	johnID := john.ID.String()
+
+	tests := []struct {
+		name      string
+		id        string
+		upd       entities.UserUpdate
+		wantErr   error
+		wantField string
+	}{
+		{"invalid email", johnID, entities.UserUpdate{Email: ptr("not-an-email")}, entities.ErrInvalidEmail, ""},
+		{"username taken", johnID, entities.UserUpdate{Username: ptr("jane")}, entities.ErrConflict, "username"},
+		{"email taken", johnID, entities.UserUpdate{Email: ptr(jane.Email)}, entities.ErrConflict, "email"},
+		{"unknown user", uuid.NewString(), entities.UserUpdate{Name: ptr("Nobody")}, entities.ErrNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := su.Update(t.Context(), tt.id, tt.upd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+			}
+			var conflict *entities.ConflictError
+			if tt.wantField != "" && (!errors.As(err, &conflict) || conflict.Field != tt.wantField) {
+				t.Errorf("Expected conflict on %q, got %v", tt.wantField, err)
+			}
+		})
+	}
+
+	// Failed updates leave the user untouched
+	got, err := su.FindByID(t.Context(), john.ID.String())
+	if err != nil {
+		t.Fatalf("Error finding user: %v", err)
+	}
+	if got.Username != "john" || got.Email != john.Email {
+		t.Errorf("Unexpected user after failed updates: %+v", got)
+	}
+}
+
+func TestUserCreateConflict(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	john := createUser(t, su, "john")
+
+	tests := []struct {
+		name      string
+		user      entities.User
+		wantField string
+	}{
+		{"duplicate username", entities.User{Username: "john", Email: "other@example.com"}, "username"},
+		{"username differing in case", entities.User{Username: "John", Email: "other@example.com"}, "username"},
+		{"duplicate email", entities.User{Username: "other", Email: john.Email}, "email"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := su.Create(t.Context(), &tt.user, testPassword)
+			var conflict *entities.ConflictError
+			if !errors.As(err, &conflict) || conflict.Field != tt.wantField {
+				t.Errorf("Expected conflict on %q, got %v", tt.wantField, err)
+			}
+			if !errors.Is(err, entities.ErrConflict) {
+				t.Errorf("Expected ErrConflict, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUserFindByUsernameAndEmail(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	john := createUser(t, su, "john")
+
+	found, err := su.FindByUsername(t.Context(), "JOHN")
+	if err != nil {
+		t.Fatalf("Error finding user by username: %v", err)
+	}
+	if found.ID != john.ID {
+		t.Errorf("Expected user %s, got %s", john.ID, found.ID)
+	}
+	found, err = su.FindByEmail(t.Context(), john.Email)
+	if err != nil {
+		t.Fatalf("Error finding user by email: %v", err)
+	}
+	if found.ID != john.ID {
+		t.Errorf("Expected user %s, got %s", john.ID, found.ID)
+	}
+
+	if _, err = su.FindByUsername(t.Context(), "jane"); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if _, err = su.FindByEmail(t.Context(), "jane@example.com"); !errors.Is(err, entities.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserCreatePassword(t *testing.T) {
+	su := service.NewUserService(store.NewMemStore(), testPasswordParams)
+
+	tests := []struct {
+		name     string
+		password string
+		want     error
+	}{
+		{"missing", "", entities.ErrPasswordRequired},
+		{"too short", "1234567", entities.ErrPasswordTooShort},
+		{"too long", strings.Repeat("a", 129), entities.ErrPasswordTooLong},
+		{"contains username", "my-JOHN-password", entities.ErrPasswordContainsUsername},
+		{"seven multibyte characters", "ünïcödé", entities.ErrPasswordTooShort},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := su.Create(t.Context(), &entities.User{Username: "john", Email: "john@example.com"}, tt.password)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Only a salted hash of the password is kept
+	john := &entities.User{Username: "john", Email: "john@example.com"}
+	if err := su.Create(t.Context(), john, testPassword); err != nil {
+		t.Fatalf("Error creating user: %v", err)
+	}
+	jane := createUser(t, su, "jane")
+	if !strings.HasPrefix(john.PasswordHash, "$argon2id$") || strings.Contains(john.PasswordHash, testPassword) {
+		t.Errorf("Expected an argon2id hash, got %q", john.PasswordHash)
+	}
+	if john.PasswordHash == jane.PasswordHash {
+		t.Errorf("Expected hashes of the same password to differ")
+	}
+}
+
+func TestUserAuthenticate(t *testing.T) {
+	s := store.NewMemStore()
+	su := service.NewUserService(s, testPasswordParams)
+
+	john := createUser(t, su, "john")
+
+	got, err := su.Authenticate(t.Context(), "JOHN", testPassword)
+	if err != nil {
+		t.Fatalf("Error authenticating: %v", err)
+	}
+	if got.ID != john.ID || got.PasswordHash != john.PasswordHash {
+		t.Errorf("Expected John with his hash unchanged, got %+v", got)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{"wrong password", "john", "not the password"},
+		{"unknown user", "jane", testPassword},
+		{"empty password", "john", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := su.Authenticate(t.Context(), tt.username, tt.password)
+			if !errors.Is(err, entities.ErrInvalidCredentials) {
+				t.Errorf("Expected ErrInvalidCredentials, got %v", err)
+			}
+		})
+	}
+
+	// Raising the parameters rehashes on the next successful login only
+	stronger := testPasswordParams
+	stronger.Time++
+	su = service.NewUserService(s, stronger)
+	_, err = su.Authenticate(t.Context(), "john", "not the password")
+	if !errors.Is(err, entities.ErrInvalidCredentials) {
+		t.Fatalf("Expected ErrInvalidCredentials, got %v", err)
+	}
+	if got, err = su.FindByID(t.Context(), john.ID.String()); err != nil || got.PasswordHash != john.PasswordHash {
+		t.Fatalf("Expected the hash unchanged after a failed login, got %q, %v", got.PasswordHash, err)
+	}
+	if _, err = su.Authenticate(t.Context(), "john", testPassword); err != nil {
+		t.Fatalf("Error authenticating: %v", err)
+	}
+	if got, err = su.FindByID(t.Context(), john.ID.String()); err != nil {
+		t.Fatalf("Error finding user: %v", err)
+	}
+	if got.PasswordHash == john.PasswordHash || !strings.Contains(got.PasswordHash, ",t=2,") {
+		t.Errorf("Expected a hash with the new parameters, got %q", got.PasswordHash)
+	}
+	if _, err = su.Authenticate(t.Context(), "john", testPassword); err != nil {
+		t.Errorf("Error authenticating with the new hash: %v", err)
+	}
+}
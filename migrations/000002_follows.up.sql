@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id uuid REFERENCES users(id) NOT NULL,
+    followee_id uuid REFERENCES users(id) NOT NULL,
+    created_at timestamptz default now(),
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT follows_no_self_follow CHECK (follower_id <> followee_id)
+);
+
+-- followers of a user (the primary key already covers the following list)
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows (followee_id);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS tweets_set_updated_at ON tweets;
+
+DROP TRIGGER IF EXISTS users_set_updated_at ON users;
+
+DROP FUNCTION IF EXISTS set_updated_at();
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- keep updated_at current on every UPDATE, whatever the client sets
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_set_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TRIGGER tweets_set_updated_at
+    BEFORE UPDATE ON tweets
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+COMMIT;
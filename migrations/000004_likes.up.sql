@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS likes (
+    user_id uuid REFERENCES users(id) NOT NULL,
+    tweet_id uuid REFERENCES tweets(id) NOT NULL,
+    created_at timestamptz default now(),
+    PRIMARY KEY (user_id, tweet_id)
+);
+
+-- like counts and likers of a tweet (the primary key covers a user's likes)
+CREATE INDEX IF NOT EXISTS idx_likes_tweet_id ON likes (tweet_id);
+
+COMMIT;
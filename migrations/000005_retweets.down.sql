@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_tweets_unique_retweet;
+DROP INDEX IF EXISTS idx_tweets_referenced_tweet_id;
+
+ALTER TABLE tweets
+    DROP CONSTRAINT IF EXISTS tweets_reference_check,
+    DROP CONSTRAINT IF EXISTS tweets_kind_check,
+    DROP COLUMN IF EXISTS referenced_tweet_id,
+    DROP COLUMN IF EXISTS kind;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE tweets
+    ADD COLUMN IF NOT EXISTS kind varchar(16) NOT NULL DEFAULT 'tweet',
+    ADD COLUMN IF NOT EXISTS referenced_tweet_id uuid REFERENCES tweets(id);
+
+ALTER TABLE tweets
+    ADD CONSTRAINT tweets_kind_check CHECK (kind IN ('tweet', 'retweet', 'quote')),
+    ADD CONSTRAINT tweets_reference_check CHECK ((kind = 'tweet') = (referenced_tweet_id IS NULL));
+
+-- retweet and quote counts of a tweet
+CREATE INDEX IF NOT EXISTS idx_tweets_referenced_tweet_id ON tweets (referenced_tweet_id)
+    WHERE referenced_tweet_id IS NOT NULL;
+
+-- a user retweets a tweet at most once; deleting the retweet allows another
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tweets_unique_retweet ON tweets (user_id, referenced_tweet_id)
+    WHERE kind = 'retweet' AND deleted_at IS NULL;
+
+COMMIT;
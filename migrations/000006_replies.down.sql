@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_tweets_conversation_id;
+DROP INDEX IF EXISTS idx_tweets_in_reply_to_tweet_id;
+
+ALTER TABLE tweets
+    DROP COLUMN IF EXISTS conversation_id,
+    DROP COLUMN IF EXISTS in_reply_to_tweet_id;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE tweets
+    ADD COLUMN IF NOT EXISTS in_reply_to_tweet_id uuid REFERENCES tweets(id),
+    ADD COLUMN IF NOT EXISTS conversation_id uuid;
+
+-- existing tweets each start their own conversation
+UPDATE tweets SET conversation_id = id WHERE conversation_id IS NULL;
+ALTER TABLE tweets ALTER COLUMN conversation_id SET NOT NULL;
+
+-- replies to a tweet, walked by the thread query
+CREATE INDEX IF NOT EXISTS idx_tweets_in_reply_to_tweet_id ON tweets (in_reply_to_tweet_id)
+    WHERE in_reply_to_tweet_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tweets_conversation_id ON tweets (conversation_id);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users_username_lower_key;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- usernames are looked up case-insensitively, so they must also be unique
+-- regardless of case; this fails if existing usernames differ only in case
+CREATE UNIQUE INDEX IF NOT EXISTS users_username_lower_key ON users (lower(username));
+
+COMMIT;
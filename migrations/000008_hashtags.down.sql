@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS tweet_hashtags;
+DROP TABLE IF EXISTS hashtags;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS hashtags (
+    id uuid DEFAULT uuidv7() PRIMARY KEY,
+    -- normalized: without the leading # and case folded
+    tag varchar(280) UNIQUE NOT NULL,
+    created_at timestamptz default now()
+);
+
+CREATE TABLE IF NOT EXISTS tweet_hashtags (
+    tweet_id uuid REFERENCES tweets(id) NOT NULL,
+    hashtag_id uuid REFERENCES hashtags(id) NOT NULL,
+    PRIMARY KEY (tweet_id, hashtag_id)
+);
+
+-- tweets of a hashtag (the primary key covers the hashtags of a tweet)
+CREATE INDEX IF NOT EXISTS idx_tweet_hashtags_hashtag_id ON tweet_hashtags (hashtag_id);
+
+COMMIT;
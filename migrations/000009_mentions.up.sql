@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS mentions (
+    tweet_id uuid REFERENCES tweets(id) NOT NULL,
+    user_id uuid REFERENCES users(id) NOT NULL,
+    -- character offsets of the @username in the tweet content, end exclusive
+    start_offset integer NOT NULL,
+    end_offset integer NOT NULL,
+    PRIMARY KEY (tweet_id, start_offset)
+);
+
+-- tweets mentioning a user (the primary key covers the mentions of a tweet)
+CREATE INDEX IF NOT EXISTS idx_mentions_user_id ON mentions (user_id, tweet_id);
+
+COMMIT;
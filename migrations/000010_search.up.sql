@@ -0,0 +1,11 @@
+BEGIN;
+
+-- full-text search over tweet content; the simple configuration lowercases
+-- words without stemming them or dropping stop words
+ALTER TABLE tweets
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple'::regconfig, content)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tweets_search_vector ON tweets USING GIN (search_vector);
+
+COMMIT;
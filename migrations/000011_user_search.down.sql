@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_users_name_trgm;
+DROP INDEX IF EXISTS idx_users_username_trgm;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bookmarks (
+    id uuid DEFAULT uuidv7() PRIMARY KEY,
+    user_id uuid REFERENCES users(id) NOT NULL,
+    tweet_id uuid REFERENCES tweets(id) NOT NULL,
+    created_at timestamptz default now(),
+    UNIQUE (user_id, tweet_id)
+);
+
+-- a user's bookmarks, most recent first (the ID is a UUIDv7)
+CREATE INDEX IF NOT EXISTS idx_bookmarks_user_id ON bookmarks (user_id, id);
+
+COMMIT;
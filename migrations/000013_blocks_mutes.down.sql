@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS mutes;
+DROP TABLE IF EXISTS blocks;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS blocks (
+    blocker_id uuid REFERENCES users(id) NOT NULL,
+    blocked_id uuid REFERENCES users(id) NOT NULL,
+    created_at timestamptz default now(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT blocks_no_self_block CHECK (blocker_id <> blocked_id)
+);
+
+-- users blocking a user (the primary key covers the users a user blocks)
+CREATE INDEX IF NOT EXISTS idx_blocks_blocked_id ON blocks (blocked_id);
+
+CREATE TABLE IF NOT EXISTS mutes (
+    muter_id uuid REFERENCES users(id) NOT NULL,
+    muted_id uuid REFERENCES users(id) NOT NULL,
+    created_at timestamptz default now(),
+    PRIMARY KEY (muter_id, muted_id),
+    CONSTRAINT mutes_no_self_mute CHECK (muter_id <> muted_id)
+);
+
+COMMIT;
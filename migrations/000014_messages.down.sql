@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS conversation_members;
+ALTER TABLE IF EXISTS conversations DROP COLUMN IF EXISTS last_message_id;
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversations;
+
+COMMIT;
@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS conversations (
+    id uuid DEFAULT uuidv7() PRIMARY KEY,
+    created_at timestamptz default now()
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+    id uuid DEFAULT uuidv7() PRIMARY KEY,
+    conversation_id uuid REFERENCES conversations(id) NOT NULL,
+    sender_id uuid REFERENCES users(id) NOT NULL,
+    content text NOT NULL,
+    created_at timestamptz default now()
+);
+
+-- a conversation's history, newest first (the ID is a UUIDv7)
+CREATE INDEX IF NOT EXISTS idx_messages_conversation_id ON messages (conversation_id, id);
+
+-- the newest message, which orders a user's conversations by activity
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS last_message_id uuid REFERENCES messages(id);
+
+CREATE TABLE IF NOT EXISTS conversation_members (
+    conversation_id uuid REFERENCES conversations(id) NOT NULL,
+    user_id uuid REFERENCES users(id) NOT NULL,
+    -- the newest message the member read; messages after it are unread
+    last_read_message_id uuid REFERENCES messages(id),
+    created_at timestamptz default now(),
+    PRIMARY KEY (conversation_id, user_id)
+);
+
+-- the conversations of a user (the primary key covers the members of a conversation)
+CREATE INDEX IF NOT EXISTS idx_conversation_members_user_id ON conversation_members (user_id);
+
+COMMIT;
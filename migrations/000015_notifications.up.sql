@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id uuid DEFAULT uuidv7() PRIMARY KEY,
+    -- the user notified and the user whose action triggered the notification
+    user_id uuid REFERENCES users(id) NOT NULL,
+    actor_id uuid REFERENCES users(id) NOT NULL,
+    type varchar(16) NOT NULL,
+    tweet_id uuid REFERENCES tweets(id),
+    -- notifications sharing a key, such as the likes of one tweet, are listed as one
+    group_key text NOT NULL,
+    read boolean NOT NULL DEFAULT false,
+    created_at timestamptz default now(),
+    CONSTRAINT notifications_type_check
+        CHECK (type IN ('follow', 'like', 'reply', 'mention', 'retweet', 'quote')),
+    CONSTRAINT notifications_no_self_notification CHECK (user_id <> actor_id)
+);
+
+-- a user's notifications, most recent first (the ID is a UUIDv7)
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id, id);
+
+-- the unread count of a user
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (user_id) WHERE NOT read;
+
+COMMIT;
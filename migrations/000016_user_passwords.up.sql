@@ -0,0 +1,8 @@
+BEGIN;
+
+-- a PHC-formatted password hash; users created before passwords have none and
+-- cannot log in until one is set
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS password_hash text;
+
+COMMIT;